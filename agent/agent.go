@@ -24,6 +24,7 @@ import (
 
 	"github.com/armon/go-metrics"
 	"github.com/armon/go-metrics/prometheus"
+	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	"github.com/rboyer/safeio"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
@@ -66,6 +67,7 @@ import (
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/agent/systemd"
 	"github.com/hashicorp/consul/agent/token"
+	"github.com/hashicorp/consul/agent/workloadapi"
 	"github.com/hashicorp/consul/agent/xds"
 	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/consul/api/watch"
@@ -272,6 +274,10 @@ type Agent struct {
 	// cache is the in-memory cache for data the Agent requests.
 	cache *cache.Cache
 
+	// workloadAPIServer serves the SPIFFE Workload API on a unix socket when
+	// connect.workload_api_socket is configured.
+	workloadAPIServer *grpc.Server
+
 	// leafCertManager issues and caches leaf certs as needed.
 	leafCertManager *leafcert.Manager
 
@@ -391,6 +397,10 @@ type Agent struct {
 	// the configuration directly.
 	tokens *token.Store
 
+	// clientCertTokens caches what the client certificate bindings of the
+	// HTTPS API need to create their tokens.
+	clientCertTokens clientCertTokens
+
 	// proxyConfig is the manager for proxy service (Kind = connect-proxy)
 	// configuration state. This ensures all state needed by a proxy registration
 	// is maintained in cache and handles pushing updates to that state into XDS
@@ -824,9 +834,11 @@ func (a *Agent) Start(ctx context.Context) error {
 
 	go a.baseDeps.ViewStore.Run(&lib.StopChannelContext{StopCh: a.shutdownCh})
 
+	dataSources := a.proxyDataSources(consulServer)
+
 	// Start the proxy config manager.
 	a.proxyConfig, err = proxycfg.NewManager(proxycfg.ManagerConfig{
-		DataSources: a.proxyDataSources(consulServer),
+		DataSources: dataSources,
 		Logger:      a.logger.Named(logging.ProxyConfig),
 		Source: &structs.QuerySource{
 			Datacenter:    a.config.Datacenter,
@@ -910,6 +922,11 @@ func (a *Agent) Start(ctx context.Context) error {
 		return err
 	}
 
+	// Start the SPIFFE Workload API server.
+	if err := a.listenAndServeWorkloadAPI(dataSources); err != nil {
+		return err
+	}
+
 	// Start a goroutine to terminate excess xDS sessions.
 	go a.baseDeps.XDSStreamLimiter.Run(&lib.StopChannelContext{StopCh: a.shutdownCh})
 
@@ -1384,6 +1401,50 @@ func (ln tcpKeepAliveListener) Accept() (c net.Conn, err error) {
 	return tc, nil
 }
 
+// listenAndServeWorkloadAPI starts serving the SPIFFE Workload API on the
+// configured unix socket, if any.
+func (a *Agent) listenAndServeWorkloadAPI(sources proxycfg.DataSources) error {
+	path := a.config.ConnectWorkloadAPISocket
+	if path == "" {
+		return nil
+	}
+
+	l, err := a.listenSocket(path)
+	if err != nil {
+		return fmt.Errorf("failed to listen on workload API socket: %w", err)
+	}
+
+	logger := a.logger.Named(logging.WorkloadAPI)
+	recoveryOpts := middleware.PanicHandlerMiddlewareOpts(logger)
+	a.workloadAPIServer = grpc.NewServer(
+		grpc.Creds(workloadapi.Credentials()),
+		grpc.ChainUnaryInterceptor(recovery.UnaryServerInterceptor(recoveryOpts...)),
+		grpc.ChainStreamInterceptor(recovery.StreamServerInterceptor(recoveryOpts...)),
+	)
+	workloadapi.NewServer(workloadapi.Config{
+		Logger:          logger,
+		Datacenter:      a.config.Datacenter,
+		LocalState:      a.State,
+		CARoots:         sources.CARoots,
+		LeafCertificate: sources.LeafCertificate,
+		SignJWTSVID: func(ctx context.Context, req *structs.JWTSVIDSignRequest) (*structs.IssuedJWTSVID, error) {
+			var reply structs.IssuedJWTSVID
+			if err := a.RPC(ctx, "ConnectCA.SignJWTSVID", req, &reply); err != nil {
+				return nil, err
+			}
+			return &reply, nil
+		},
+	}).Register(a.workloadAPIServer)
+
+	a.logger.Info("Started SPIFFE Workload API server", "path", path)
+	go func() {
+		if err := a.workloadAPIServer.Serve(l); err != nil {
+			a.logger.Error("SPIFFE Workload API server failed", "error", err)
+		}
+	}()
+	return nil
+}
+
 func (a *Agent) listenSocket(path string) (net.Listener, error) {
 	if _, err := os.Stat(path); !os.IsNotExist(err) {
 		a.logger.Warn("Replacing socket", "path", path)
@@ -1678,6 +1739,8 @@ func newConsulConfig(runtimeCfg *config.RuntimeConfig, logger hclog.Logger) (*co
 	if runtimeCfg.ConnectEnabled {
 		cfg.ConnectEnabled = true
 		cfg.ConnectMeshGatewayWANFederationEnabled = runtimeCfg.ConnectMeshGatewayWANFederationEnabled
+		cfg.ConnectACMEDNSHook = runtimeCfg.ConnectACMEDNSHook
+		cfg.ConnectCAPlugins = runtimeCfg.ConnectCAPlugins
 
 		ca, err := runtimeCfg.ConnectCAConfiguration()
 		if err != nil {
@@ -1721,6 +1784,14 @@ func newConsulConfig(runtimeCfg *config.RuntimeConfig, logger hclog.Logger) (*co
 	cfg.RequestLimitsWriteRate = runtimeCfg.RequestLimitsWriteRate
 	cfg.Locality = runtimeCfg.StructLocality()
 
+	if prom := runtimeCfg.ConnectCanaryRolloutPrometheus; prom.BaseURL != "" {
+		cfg.CanaryRolloutPrometheus.BaseURL = prom.BaseURL
+		cfg.CanaryRolloutPrometheus.Headers = make(map[string]string)
+		for _, h := range prom.AddHeaders {
+			cfg.CanaryRolloutPrometheus.Headers[h.Name] = h.Value
+		}
+	}
+
 	cfg.Cloud = runtimeCfg.Cloud
 
 	cfg.Reporting.License.Enabled = runtimeCfg.Reporting.License.Enabled
@@ -1887,6 +1958,9 @@ func (a *Agent) ShutdownAgent() error {
 	if a.externalGRPCServer != nil {
 		a.externalGRPCServer.Stop()
 	}
+	if a.workloadAPIServer != nil {
+		a.workloadAPIServer.Stop()
+	}
 
 	// Stop the proxy config manager
 	if a.proxyConfig != nil {
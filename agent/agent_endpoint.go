@@ -1640,6 +1640,47 @@ func (s *HTTPHandlers) AgentConnectCALeafCert(resp http.ResponseWriter, req *htt
 	return reply, nil
 }
 
+// AgentConnectCAJWTSVID returns a JWT-SVID for the given service, signed by
+// the Connect CA.
+//
+// GET /v1/agent/connect/ca/jwt-svid/:service
+func (s *HTTPHandlers) AgentConnectCAJWTSVID(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	args := structs.JWTSVIDSignRequest{
+		Service: strings.TrimPrefix(req.URL.Path, "/v1/agent/connect/ca/jwt-svid/"),
+	}
+	if args.Service == "" {
+		return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: "Missing service name"}
+	}
+
+	if err := s.parseEntMetaNoWildcard(req, &args.EnterpriseMeta); err != nil {
+		return nil, err
+	}
+	s.parseDC(req, &args.Datacenter)
+	s.parseToken(req, &args.Token)
+
+	args.Audience = req.URL.Query()["audience"]
+	if len(args.Audience) == 0 {
+		return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: "At least one audience must be specified"}
+	}
+	if ttl := req.URL.Query().Get("ttl"); ttl != "" {
+		d, err := time.ParseDuration(ttl)
+		if err != nil {
+			return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: fmt.Sprintf("Invalid ttl: %v", err)}
+		}
+		args.TTL = d
+	}
+
+	if !s.validateRequestPartition(resp, &args.EnterpriseMeta) {
+		return nil, nil
+	}
+
+	var reply structs.IssuedJWTSVID
+	if err := s.agent.RPC(req.Context(), "ConnectCA.SignJWTSVID", &args, &reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
 // AgentConnectAuthorize
 //
 // POST /v1/agent/connect/authorize
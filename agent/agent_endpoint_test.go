@@ -6908,6 +6908,49 @@ func TestAgentConnectCALeafCert_aclServiceReadDeny(t *testing.T) {
 	require.Equal(t, http.StatusForbidden, resp.Code)
 }
 
+func TestAgentConnectCAJWTSVID(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+	testrpc.WaitForActiveCARoot(t, a.RPC, "dc1", nil)
+
+	t.Run("missing audience", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/v1/agent/connect/ca/jwt-svid/web", nil)
+		resp := httptest.NewRecorder()
+		a.srv.h.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+
+	t.Run("invalid ttl", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/v1/agent/connect/ca/jwt-svid/web?audience=api&ttl=soon", nil)
+		resp := httptest.NewRecorder()
+		a.srv.h.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+
+	req, _ := http.NewRequest("GET", "/v1/agent/connect/ca/jwt-svid/web?audience=api&audience=db&ttl=1m", nil)
+	resp := httptest.NewRecorder()
+	a.srv.h.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+
+	var jwtSVID structs.IssuedJWTSVID
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &jwtSVID))
+	require.True(t, strings.HasSuffix(jwtSVID.SpiffeID, "/ns/default/dc/dc1/svc/web"))
+	require.WithinDuration(t, time.Now().Add(time.Minute), jwtSVID.ExpiresAt, 10*time.Second)
+
+	var roots structs.IndexedCARoots
+	require.NoError(t, a.RPC(context.Background(), "ConnectCA.Roots", &structs.DCSpecificRequest{Datacenter: "dc1"}, &roots))
+	spiffeID, _, err := connect.ValidateJWTSVID(jwtSVID.Token, "db", roots.Roots, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, jwtSVID.SpiffeID, spiffeID)
+}
+
 func TestAgentConnectCALeafCert_good(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
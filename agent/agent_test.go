@@ -23,6 +23,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"runtime"
 	"strconv"
 	"strings"
 	"sync"
@@ -38,6 +39,8 @@ import (
 	"golang.org/x/sync/errgroup"
 	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/hashicorp/go-hclog"
@@ -61,6 +64,7 @@ import (
 	"github.com/hashicorp/consul/internal/resource"
 	"github.com/hashicorp/consul/ipaddr"
 	"github.com/hashicorp/consul/lib"
+	"github.com/hashicorp/consul/proto-public/pbspiffeworkload"
 	"github.com/hashicorp/consul/proto/private/pbautoconf"
 	"github.com/hashicorp/consul/sdk/freeport"
 	"github.com/hashicorp/consul/sdk/testutil"
@@ -221,6 +225,53 @@ func TestAgent_RPCPing(t *testing.T) {
 	}
 }
 
+func TestAgent_WorkloadAPI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+	if runtime.GOOS != "linux" {
+		t.Skip("workload attestation is only supported on linux")
+	}
+
+	t.Parallel()
+	socket := filepath.Join(testutil.TempDir(t, "workload-api"), "workload.sock")
+	a := NewTestAgent(t, `
+		connect {
+			enabled = true
+			workload_api_socket = "`+socket+`"
+		}
+	`)
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	require.NoError(t, a.State.AddServiceWithChecks(&structs.NodeService{
+		ID:      "web-1",
+		Service: "web",
+		Port:    8080,
+		Meta:    map[string]string{structs.MetaWorkloadUIDKey: strconv.Itoa(os.Getuid())},
+	}, nil, "", false))
+
+	conn, err := grpc.Dial("unix://"+socket, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, "workload.spiffe.io", "true")
+
+	stream, err := pbspiffeworkload.NewSpiffeWorkloadAPIClient(conn).FetchX509SVID(ctx, &pbspiffeworkload.X509SVIDRequest{})
+	require.NoError(t, err)
+
+	rsp, err := stream.Recv()
+	require.NoError(t, err)
+	require.Len(t, rsp.Svids, 1)
+	require.True(t, strings.HasSuffix(rsp.Svids[0].SpiffeId, "/ns/default/dc/dc1/svc/web"), rsp.Svids[0].SpiffeId)
+
+	certs, err := x509.ParseCertificates(rsp.Svids[0].X509Svid)
+	require.NoError(t, err)
+	require.Equal(t, rsp.Svids[0].SpiffeId, certs[0].URIs[0].String())
+}
+
 func TestAgent_TokenStore(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -4,8 +4,10 @@
 package config
 
 import (
+	"crypto/sha256"
 	"crypto/tls"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -722,6 +724,10 @@ func (b *builder) build() (rt RuntimeConfig, err error) {
 			"private_key":           "PrivateKey",
 			"root_cert":             "RootCert",
 			"intermediate_cert_ttl": "IntermediateCertTTL",
+			"pkcs11_module":         "PKCS11Module",
+			"pkcs11_slot":           "PKCS11Slot",
+			"pkcs11_token_label":    "PKCS11TokenLabel",
+			"pkcs11_pin":            "PKCS11PIN",
 
 			// Vault CA config
 			"address":                    "Address",
@@ -741,6 +747,9 @@ func (b *builder) build() (rt RuntimeConfig, err error) {
 			"existing_arn":   "ExistingARN",
 			"delete_on_exit": "DeleteOnExit",
 
+			// Plugin CA config
+			"plugin": "Plugin",
+
 			// Common CA config
 			"leaf_cert_ttl":      "LeafCertTTL",
 			"csr_max_per_second": "CSRMaxPerSecond",
@@ -921,15 +930,16 @@ func (b *builder) build() (rt RuntimeConfig, err error) {
 		DNSCacheMaxAge:        b.durationVal("dns_config.cache_max_age", c.DNS.CacheMaxAge),
 
 		// HTTP
-		HTTPPort:            httpPort,
-		HTTPSPort:           httpsPort,
-		HTTPAddrs:           httpAddrs,
-		HTTPSAddrs:          httpsAddrs,
-		HTTPBlockEndpoints:  c.HTTPConfig.BlockEndpoints,
-		HTTPMaxHeaderBytes:  intVal(c.HTTPConfig.MaxHeaderBytes),
-		HTTPResponseHeaders: c.HTTPConfig.ResponseHeaders,
-		AllowWriteHTTPFrom:  b.cidrsVal("allow_write_http_from", c.HTTPConfig.AllowWriteHTTPFrom),
-		HTTPUseCache:        boolValWithDefault(c.HTTPConfig.UseCache, true),
+		HTTPPort:               httpPort,
+		HTTPSPort:              httpsPort,
+		HTTPAddrs:              httpAddrs,
+		HTTPSAddrs:             httpsAddrs,
+		HTTPBlockEndpoints:     c.HTTPConfig.BlockEndpoints,
+		HTTPMaxHeaderBytes:     intVal(c.HTTPConfig.MaxHeaderBytes),
+		HTTPResponseHeaders:    c.HTTPConfig.ResponseHeaders,
+		HTTPClientCertBindings: b.httpClientCertBindingsVal(c.HTTPConfig.ClientCertBindings),
+		AllowWriteHTTPFrom:     b.cidrsVal("allow_write_http_from", c.HTTPConfig.AllowWriteHTTPFrom),
+		HTTPUseCache:           boolValWithDefault(c.HTTPConfig.UseCache, true),
 
 		// Telemetry
 		Telemetry: lib.TelemetryConfig{
@@ -990,13 +1000,17 @@ func (b *builder) build() (rt RuntimeConfig, err error) {
 		AutoEncryptAllowTLS:                    autoEncryptAllowTLS,
 		AutoConfig:                             autoConfig,
 		Cloud:                                  b.cloudConfigVal(c),
+		ConnectACMEDNSHook:                     stringVal(c.Connect.ACMEDNSHook),
 		ConnectEnabled:                         connectEnabled,
 		ConnectCAProvider:                      connectCAProvider,
 		ConnectCAConfig:                        connectCAConfig,
+		ConnectCAPlugins:                       b.connectCAPluginsVal(c.Connect.CAPlugins),
+		ConnectCanaryRolloutPrometheus:         b.canaryRolloutPrometheusVal(c.Connect.CanaryRolloutPrometheus),
 		ConnectMeshGatewayWANFederationEnabled: connectMeshGatewayWANFederationEnabled,
 		ConnectSidecarMinPort:                  sidecarMinPort,
 		ConnectSidecarMaxPort:                  sidecarMaxPort,
 		ConnectTestCALeafRootChangeSpread:      b.durationVal("connect.test_ca_leaf_root_change_spread", c.Connect.TestCALeafRootChangeSpread),
+		ConnectWorkloadAPISocket:               stringVal(c.Connect.WorkloadAPISocket),
 		ExposeMinPort:                          exposeMinPort,
 		ExposeMaxPort:                          exposeMaxPort,
 		DataDir:                                dataDir,
@@ -1271,6 +1285,14 @@ func (b *builder) validate(rt RuntimeConfig) error {
 				rt.UIConfig.MetricsProxy.BaseURL)
 		}
 	}
+	if rt.ConnectCanaryRolloutPrometheus.BaseURL != "" {
+		u, err := url.Parse(rt.ConnectCanaryRolloutPrometheus.BaseURL)
+		if err != nil || !(u.Scheme == "http" || u.Scheme == "https") {
+			return fmt.Errorf("connect.canary_rollout_prometheus.base_url must be a valid http"+
+				" or https URL. received: %q",
+				rt.ConnectCanaryRolloutPrometheus.BaseURL)
+		}
+	}
 	for _, allowedPath := range rt.UIConfig.MetricsProxy.PathAllowlist {
 		if err := validateAbsoluteURLPath(allowedPath); err != nil {
 			return fmt.Errorf("ui_config.metrics_proxy.path_allowlist: %v", err)
@@ -1401,6 +1423,9 @@ func (b *builder) validate(rt RuntimeConfig) error {
 			return fmt.Errorf("'retry_join_wan' is incompatible with 'connect.enable_mesh_gateway_wan_federation = true'")
 		}
 	}
+	if rt.ConnectWorkloadAPISocket != "" && !rt.ConnectEnabled {
+		return fmt.Errorf("'connect.workload_api_socket' requires 'connect.enabled = true'")
+	}
 	if len(rt.PrimaryGateways) > 0 {
 		if !rt.ServerMode {
 			return fmt.Errorf("'primary_gateways' requires 'server = true'")
@@ -1488,6 +1513,7 @@ func (b *builder) validate(rt RuntimeConfig) error {
 		structs.ConsulCAProvider: true,
 		structs.VaultCAProvider:  true,
 		structs.AWSCAProvider:    true,
+		structs.PluginCAProvider: true,
 	}
 	if _, ok := validCAProviders[rt.ConnectCAProvider]; !ok {
 		return fmt.Errorf("%s is not a valid CA provider", rt.ConnectCAProvider)
@@ -1505,6 +1531,14 @@ func (b *builder) validate(rt RuntimeConfig) error {
 			if _, err := ca.ParseAWSCAConfig(rt.ConnectCAConfig); err != nil {
 				return err
 			}
+		case structs.PluginCAProvider:
+			conf, err := ca.ParsePluginCAConfig(rt.ConnectCAConfig)
+			if err != nil {
+				return err
+			}
+			if rt.ServerMode && !hasCAPlugin(rt.ConnectCAPlugins, conf.Plugin) {
+				return fmt.Errorf("connect.ca_config: plugin %q is not set in connect.ca_plugins", conf.Plugin)
+			}
 		}
 	}
 
@@ -1555,6 +1589,14 @@ func (b *builder) validate(rt RuntimeConfig) error {
 		return err
 	}
 
+	if err := validateHTTPClientCertBindings(rt); err != nil {
+		return err
+	}
+
+	if err := validateConnectCAPlugins(rt); err != nil {
+		return err
+	}
+
 	if rt.AutoConfig.Enabled && rt.AutoEncryptTLS {
 		return fmt.Errorf("both auto_encrypt.tls and auto_config.enabled cannot be set to true.")
 	}
@@ -1572,6 +1614,64 @@ func (b *builder) validate(rt RuntimeConfig) error {
 	return err
 }
 
+func validateConnectCAPlugins(rt RuntimeConfig) error {
+	names := make(map[string]struct{})
+	for i, plugin := range rt.ConnectCAPlugins {
+		if plugin.Name == "" {
+			return fmt.Errorf("connect.ca_plugins[%d].name is required", i)
+		}
+		if _, ok := names[plugin.Name]; ok {
+			return fmt.Errorf("connect.ca_plugins: name %q is used more than once", plugin.Name)
+		}
+		names[plugin.Name] = struct{}{}
+
+		if !filepath.IsAbs(plugin.Command) {
+			return fmt.Errorf("connect.ca_plugins[%d].command must be an absolute path", i)
+		}
+		if sum, err := hex.DecodeString(plugin.SHA256); err != nil || len(sum) != sha256.Size {
+			return fmt.Errorf("connect.ca_plugins[%d].sha256 must be the hex encoded SHA-256 checksum of the plugin binary", i)
+		}
+	}
+	return nil
+}
+
+func hasCAPlugin(plugins []structs.CAPlugin, name string) bool {
+	for _, plugin := range plugins {
+		if plugin.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func validateHTTPClientCertBindings(rt RuntimeConfig) error {
+	if len(rt.HTTPClientCertBindings) == 0 {
+		return nil
+	}
+	if !rt.TLS.HTTPS.VerifyIncoming {
+		return fmt.Errorf("http_config.client_cert_bindings requires tls.https.verify_incoming to be enabled")
+	}
+
+	names := make(map[string]struct{})
+	for i, binding := range rt.HTTPClientCertBindings {
+		if binding.Name == "" {
+			return fmt.Errorf("http_config.client_cert_bindings[%d].name is required", i)
+		}
+		if _, ok := names[binding.Name]; ok {
+			return fmt.Errorf("http_config.client_cert_bindings: name %q is used more than once", binding.Name)
+		}
+		names[binding.Name] = struct{}{}
+
+		if binding.SubjectCN == "" && len(binding.DNSSANs) == 0 && len(binding.URISANs) == 0 && len(binding.EmailSANs) == 0 {
+			return fmt.Errorf("http_config.client_cert_bindings[%d]: at least one of subject_cn, dns_sans, uri_sans or email_sans is required", i)
+		}
+		if len(binding.Roles) == 0 && len(binding.Policies) == 0 {
+			return fmt.Errorf("http_config.client_cert_bindings[%d]: at least one of roles or policies is required", i)
+		}
+	}
+	return nil
+}
+
 // addrUnique checks if the given address is already in use for another
 // protocol.
 func addrUnique(inuse map[string]string, name string, addr net.Addr) error {
@@ -1972,6 +2072,55 @@ func (b *builder) uiMetricsProxyVal(v RawUIMetricsProxy) UIMetricsProxy {
 	}
 }
 
+func (b *builder) canaryRolloutPrometheusVal(v RawCanaryRolloutPrometheus) CanaryRolloutPrometheus {
+	var hdrs []UIMetricsProxyAddHeader
+
+	for _, hdr := range v.AddHeaders {
+		hdrs = append(hdrs, UIMetricsProxyAddHeader{
+			Name:  stringVal(hdr.Name),
+			Value: stringVal(hdr.Value),
+		})
+	}
+
+	return CanaryRolloutPrometheus{
+		BaseURL:    stringVal(v.BaseURL),
+		AddHeaders: hdrs,
+	}
+}
+
+func (b *builder) connectCAPluginsVal(v []RawCAPlugin) []structs.CAPlugin {
+	var plugins []structs.CAPlugin
+
+	for _, plugin := range v {
+		plugins = append(plugins, structs.CAPlugin{
+			Name:    stringVal(plugin.Name),
+			Command: stringVal(plugin.Command),
+			Args:    plugin.Args,
+			SHA256:  stringVal(plugin.SHA256),
+		})
+	}
+
+	return plugins
+}
+
+func (b *builder) httpClientCertBindingsVal(v []RawHTTPClientCertBinding) []HTTPClientCertBinding {
+	var bindings []HTTPClientCertBinding
+
+	for _, binding := range v {
+		bindings = append(bindings, HTTPClientCertBinding{
+			Name:      stringVal(binding.Name),
+			SubjectCN: stringVal(binding.SubjectCN),
+			DNSSANs:   binding.DNSSANs,
+			URISANs:   binding.URISANs,
+			EmailSANs: binding.EmailSANs,
+			Roles:     binding.Roles,
+			Policies:  binding.Policies,
+		})
+	}
+
+	return bindings
+}
+
 func boolValWithDefault(v *bool, defaultVal bool) bool {
 	if v == nil {
 		return defaultVal
@@ -31,15 +31,6 @@ func validateEnterpriseConfigKeys(config *Config) []error {
 	if stringVal(config.Partition) != "" {
 		add("partition")
 	}
-	if stringVal(config.Autopilot.RedundancyZoneTag) != "" {
-		add("autopilot.redundancy_zone_tag")
-	}
-	if stringVal(config.Autopilot.UpgradeVersionTag) != "" {
-		add("autopilot.upgrade_version_tag")
-	}
-	if config.Autopilot.DisableUpgradeMigration != nil {
-		add("autopilot.disable_upgrade_migration")
-	}
 	if config.DNS.PreferNamespace != nil {
 		add("dns_config.prefer_namespace")
 		config.DNS.PreferNamespace = nil
@@ -41,28 +41,6 @@ func TestValidateEnterpriseConfigKeys(t *testing.T) {
 			},
 			badKeys: []string{"segments"},
 		},
-		"autopilot.redundancy_zone_tag": {
-			config: Config{
-				Autopilot: Autopilot{
-					RedundancyZoneTag: &stringVal,
-				},
-			},
-			badKeys: []string{"autopilot.redundancy_zone_tag"},
-		},
-		"autopilot.upgrade_version_tag": {
-			config: Config{
-				Autopilot: Autopilot{
-					UpgradeVersionTag: &stringVal,
-				},
-			},
-			badKeys: []string{"autopilot.upgrade_version_tag"},
-		},
-		"autopilot.disable_upgrade_migration": {
-			config: Config{
-				Autopilot: Autopilot{DisableUpgradeMigration: &boolVal},
-			},
-			badKeys: []string{"autopilot.disable_upgrade_migration"},
-		},
 		"dns_config.prefer_namespace": {
 			config: Config{
 				DNS: DNS{PreferNamespace: &boolVal},
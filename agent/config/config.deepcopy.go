@@ -762,6 +762,32 @@ func (o *RuntimeConfig) DeepCopy() *RuntimeConfig {
 			cp.HTTPResponseHeaders[k2] = v2
 		}
 	}
+	if o.HTTPClientCertBindings != nil {
+		cp.HTTPClientCertBindings = make([]HTTPClientCertBinding, len(o.HTTPClientCertBindings))
+		copy(cp.HTTPClientCertBindings, o.HTTPClientCertBindings)
+		for i2 := range o.HTTPClientCertBindings {
+			if o.HTTPClientCertBindings[i2].DNSSANs != nil {
+				cp.HTTPClientCertBindings[i2].DNSSANs = make([]string, len(o.HTTPClientCertBindings[i2].DNSSANs))
+				copy(cp.HTTPClientCertBindings[i2].DNSSANs, o.HTTPClientCertBindings[i2].DNSSANs)
+			}
+			if o.HTTPClientCertBindings[i2].URISANs != nil {
+				cp.HTTPClientCertBindings[i2].URISANs = make([]string, len(o.HTTPClientCertBindings[i2].URISANs))
+				copy(cp.HTTPClientCertBindings[i2].URISANs, o.HTTPClientCertBindings[i2].URISANs)
+			}
+			if o.HTTPClientCertBindings[i2].EmailSANs != nil {
+				cp.HTTPClientCertBindings[i2].EmailSANs = make([]string, len(o.HTTPClientCertBindings[i2].EmailSANs))
+				copy(cp.HTTPClientCertBindings[i2].EmailSANs, o.HTTPClientCertBindings[i2].EmailSANs)
+			}
+			if o.HTTPClientCertBindings[i2].Roles != nil {
+				cp.HTTPClientCertBindings[i2].Roles = make([]string, len(o.HTTPClientCertBindings[i2].Roles))
+				copy(cp.HTTPClientCertBindings[i2].Roles, o.HTTPClientCertBindings[i2].Roles)
+			}
+			if o.HTTPClientCertBindings[i2].Policies != nil {
+				cp.HTTPClientCertBindings[i2].Policies = make([]string, len(o.HTTPClientCertBindings[i2].Policies))
+				copy(cp.HTTPClientCertBindings[i2].Policies, o.HTTPClientCertBindings[i2].Policies)
+			}
+		}
+	}
 	if o.Telemetry.DogstatsdTags != nil {
 		cp.Telemetry.DogstatsdTags = make([]string, len(o.Telemetry.DogstatsdTags))
 		copy(cp.Telemetry.DogstatsdTags, o.Telemetry.DogstatsdTags)
@@ -931,6 +957,20 @@ func (o *RuntimeConfig) DeepCopy() *RuntimeConfig {
 			cp.ConnectCAConfig[k2] = v2
 		}
 	}
+	if o.ConnectCAPlugins != nil {
+		cp.ConnectCAPlugins = make([]structs.CAPlugin, len(o.ConnectCAPlugins))
+		copy(cp.ConnectCAPlugins, o.ConnectCAPlugins)
+		for i2 := range o.ConnectCAPlugins {
+			if o.ConnectCAPlugins[i2].Args != nil {
+				cp.ConnectCAPlugins[i2].Args = make([]string, len(o.ConnectCAPlugins[i2].Args))
+				copy(cp.ConnectCAPlugins[i2].Args, o.ConnectCAPlugins[i2].Args)
+			}
+		}
+	}
+	if o.ConnectCanaryRolloutPrometheus.AddHeaders != nil {
+		cp.ConnectCanaryRolloutPrometheus.AddHeaders = make([]UIMetricsProxyAddHeader, len(o.ConnectCanaryRolloutPrometheus.AddHeaders))
+		copy(cp.ConnectCanaryRolloutPrometheus.AddHeaders, o.ConnectCanaryRolloutPrometheus.AddHeaders)
+	}
 	if o.DNSAddrs != nil {
 		cp.DNSAddrs = make([]net.Addr, len(o.DNSAddrs))
 		copy(cp.DNSAddrs, o.DNSAddrs)
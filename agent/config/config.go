@@ -626,10 +626,14 @@ type AutoEncrypt struct {
 type Connect struct {
 	// Enabled opts the agent into connect. It should be set on all clients and
 	// servers in a cluster for correct connect operation.
-	Enabled                         *bool                  `mapstructure:"enabled" json:"enabled,omitempty"`
-	CAProvider                      *string                `mapstructure:"ca_provider" json:"ca_provider,omitempty"`
-	CAConfig                        map[string]interface{} `mapstructure:"ca_config" json:"ca_config,omitempty"`
-	MeshGatewayWANFederationEnabled *bool                  `mapstructure:"enable_mesh_gateway_wan_federation" json:"enable_mesh_gateway_wan_federation,omitempty"`
+	Enabled                         *bool                      `mapstructure:"enabled" json:"enabled,omitempty"`
+	CAProvider                      *string                    `mapstructure:"ca_provider" json:"ca_provider,omitempty"`
+	CAConfig                        map[string]interface{}     `mapstructure:"ca_config" json:"ca_config,omitempty"`
+	MeshGatewayWANFederationEnabled *bool                      `mapstructure:"enable_mesh_gateway_wan_federation" json:"enable_mesh_gateway_wan_federation,omitempty"`
+	WorkloadAPISocket               *string                    `mapstructure:"workload_api_socket" json:"workload_api_socket,omitempty"`
+	ACMEDNSHook                     *string                    `mapstructure:"acme_dns_hook" json:"acme_dns_hook,omitempty"`
+	CAPlugins                       []RawCAPlugin              `mapstructure:"ca_plugins" json:"ca_plugins,omitempty"`
+	CanaryRolloutPrometheus         RawCanaryRolloutPrometheus `mapstructure:"canary_rollout_prometheus" json:"canary_rollout_prometheus,omitempty"`
 
 	// TestCALeafRootChangeSpread controls how long after a CA roots change before new leaf certs will be generated.
 	// This is only tuned in tests, generally set to 1ns to make tests deterministic with when to expect updated leaf
@@ -637,6 +641,20 @@ type Connect struct {
 	TestCALeafRootChangeSpread *string `mapstructure:"test_ca_leaf_root_change_spread" json:"test_ca_leaf_root_change_spread,omitempty"`
 }
 
+// RawCanaryRolloutPrometheus is the Prometheus server that canary rollouts
+// query for the error ratio of their canary subsets.
+type RawCanaryRolloutPrometheus struct {
+	BaseURL    *string                      `mapstructure:"base_url" json:"base_url,omitempty"`
+	AddHeaders []RawUIMetricsProxyAddHeader `mapstructure:"add_headers" json:"add_headers,omitempty"`
+}
+
+type RawCAPlugin struct {
+	Name    *string  `mapstructure:"name" json:"name,omitempty"`
+	Command *string  `mapstructure:"command" json:"command,omitempty"`
+	Args    []string `mapstructure:"args" json:"args,omitempty"`
+	SHA256  *string  `mapstructure:"sha256" json:"sha256,omitempty"`
+}
+
 // SOA is the configuration of SOA for DNS
 type SOA struct {
 	Refresh *uint32 `mapstructure:"refresh"`
@@ -667,11 +685,22 @@ type DNS struct {
 }
 
 type HTTPConfig struct {
-	BlockEndpoints     []string          `mapstructure:"block_endpoints"`
-	AllowWriteHTTPFrom []string          `mapstructure:"allow_write_http_from"`
-	ResponseHeaders    map[string]string `mapstructure:"response_headers"`
-	UseCache           *bool             `mapstructure:"use_cache"`
-	MaxHeaderBytes     *int              `mapstructure:"max_header_bytes"`
+	BlockEndpoints     []string                   `mapstructure:"block_endpoints"`
+	AllowWriteHTTPFrom []string                   `mapstructure:"allow_write_http_from"`
+	ResponseHeaders    map[string]string          `mapstructure:"response_headers"`
+	UseCache           *bool                      `mapstructure:"use_cache"`
+	MaxHeaderBytes     *int                       `mapstructure:"max_header_bytes"`
+	ClientCertBindings []RawHTTPClientCertBinding `mapstructure:"client_cert_bindings"`
+}
+
+type RawHTTPClientCertBinding struct {
+	Name      *string  `mapstructure:"name"`
+	SubjectCN *string  `mapstructure:"subject_cn"`
+	DNSSANs   []string `mapstructure:"dns_sans"`
+	URISANs   []string `mapstructure:"uri_sans"`
+	EmailSANs []string `mapstructure:"email_sans"`
+	Roles     []string `mapstructure:"roles"`
+	Policies  []string `mapstructure:"policies"`
 }
 
 type Performance struct {
@@ -116,7 +116,7 @@ type RuntimeConfig struct {
 
 	// AutopilotDisableUpgradeMigration will disable Autopilot's upgrade migration
 	// strategy of waiting until enough newer-versioned servers have been added to the
-	// cluster before promoting them to voters. (Enterprise-only)
+	// cluster before promoting them to voters.
 	//
 	// hcl: autopilot { disable_upgrade_migration = (true|false)
 	AutopilotDisableUpgradeMigration bool
@@ -141,7 +141,6 @@ type RuntimeConfig struct {
 
 	// AutopilotRedundancyZoneTag is the Meta tag to use for separating servers
 	// into zones for redundancy. If left blank, this feature will be disabled.
-	// (Enterprise-only)
 	//
 	// hcl: autopilot { redundancy_zone_tag = string }
 	AutopilotRedundancyZoneTag string
@@ -156,8 +155,6 @@ type RuntimeConfig struct {
 	// AutopilotUpgradeVersionTag is the node tag to use for version info when
 	// performing upgrade migrations. If left blank, the Consul version will be used.
 	//
-	// (Enterprise-only)
-	//
 	// hcl: autopilot { upgrade_version_tag = string }
 	AutopilotUpgradeVersionTag string
 
@@ -325,6 +322,14 @@ type RuntimeConfig struct {
 	// hcl: http_config { response_headers = map[string]string }
 	HTTPResponseHeaders map[string]string
 
+	// HTTPClientCertBindings map the identity of verified HTTPS client
+	// certificates to the ACL roles and policies used for requests that do
+	// not provide a token themselves. The first binding that matches the
+	// certificate is used.
+	//
+	// hcl: http_config { client_cert_bindings = []{ name = string subject_cn = string dns_sans = []string uri_sans = []string email_sans = []string roles = []string policies = []string } }
+	HTTPClientCertBindings []HTTPClientCertBinding
+
 	// Embed Telemetry Config
 	Telemetry lib.TelemetryConfig
 
@@ -515,12 +520,33 @@ type RuntimeConfig struct {
 	// allocated to the agent for exposing checks through a proxy
 	ExposeMaxPort int
 
+	// ConnectACMEDNSHook is the path of the executable that servers run to
+	// create and remove the TXT records of the dns-01 challenges of
+	// acme-certificate config entries.
+	//
+	// hcl: connect { acme_dns_hook = string }
+	ConnectACMEDNSHook string
+
 	// ConnectCAProvider is the type of CA provider to use with Connect.
 	ConnectCAProvider string
 
 	// ConnectCAConfig is the config to use for the CA provider.
 	ConnectCAConfig map[string]interface{}
 
+	// ConnectCAPlugins are the CA provider plugin binaries that servers may
+	// launch for the plugin CA provider. The CA configuration selects one of
+	// them by name.
+	//
+	// hcl: connect { ca_plugins = []{ name = string command = string args = []string sha256 = string } }
+	ConnectCAPlugins []structs.CAPlugin
+
+	// ConnectCanaryRolloutPrometheus is the Prometheus server that servers
+	// query for the error ratio of canary rollout subsets. It is optional;
+	// rollouts with an error ratio threshold are held until it is set.
+	//
+	// hcl: connect { canary_rollout_prometheus { base_url = string add_headers = []{ name = string value = string } } }
+	ConnectCanaryRolloutPrometheus CanaryRolloutPrometheus
+
 	// ConnectMeshGatewayWANFederationEnabled determines if wan federation of
 	// datacenters should exclusively traverse mesh gateways.
 	ConnectMeshGatewayWANFederationEnabled bool
@@ -532,6 +558,13 @@ type RuntimeConfig struct {
 	// deterministic again.
 	ConnectTestCALeafRootChangeSpread time.Duration
 
+	// ConnectWorkloadAPISocket is the path of the unix socket on which the
+	// agent serves the SPIFFE Workload API to local workloads. The API is
+	// disabled when empty.
+	//
+	// hcl: connect { workload_api_socket = string }
+	ConnectWorkloadAPISocket string
+
 	// DNSAddrs contains the list of TCP and UDP addresses the DNS server will
 	// bind to. If the DNS endpoint is disabled (ports.dns <= 0) the list is
 	// empty.
@@ -1569,11 +1602,26 @@ type UIMetricsProxy struct {
 	PathAllowlist []string
 }
 
+type CanaryRolloutPrometheus struct {
+	BaseURL    string
+	AddHeaders []UIMetricsProxyAddHeader
+}
+
 type UIMetricsProxyAddHeader struct {
 	Name  string
 	Value string
 }
 
+type HTTPClientCertBinding struct {
+	Name      string
+	SubjectCN string
+	DNSSANs   []string
+	URISANs   []string
+	EmailSANs []string
+	Roles     []string
+	Policies  []string
+}
+
 func (c *RuntimeConfig) apiAddresses(maxPerType int) (unixAddrs, httpAddrs, httpsAddrs []string) {
 	if len(c.HTTPSAddrs) > 0 {
 		for i, addr := range c.HTTPSAddrs {
@@ -1880,7 +1928,8 @@ func sanitize(name string, v reflect.Value) reflect.Value {
 		ma := make([]interface{}, 0, v.Len())
 
 		if name == "AddHeaders" {
-			// must be UIConfig.MetricsProxy.AddHeaders
+			// must be UIConfig.MetricsProxy.AddHeaders or
+			// ConnectCanaryRolloutPrometheus.AddHeaders
 			for i := 0; i < v.Len(); i++ {
 				addr := v.Index(i).Addr()
 				hdr := addr.Interface().(*UIMetricsProxyAddHeader)
@@ -3700,6 +3700,250 @@ func TestLoad_IntegrationWithFlags(t *testing.T) {
 			`},
 		expectedErr: "AWS PCA only supports P256 EC curve",
 	})
+	run(t, testCase{
+		desc: "Connect plugin CA provider configuration",
+		args: []string{
+			`-data-dir=` + dataDir,
+		},
+		json: []string{`{
+				"connect": {
+					"enabled": true,
+					"ca_plugins": [
+						{
+							"name": "signer",
+							"command": "/usr/local/bin/ca-plugin",
+							"args": ["-signer", "pki"],
+							"sha256": "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+						}
+					],
+					"ca_provider": "plugin",
+					"ca_config": {
+						"plugin": "signer"
+					}
+				}
+			}`},
+		hcl: []string{`
+			  connect {
+					enabled = true
+					ca_plugins = [
+						{
+							name = "signer"
+							command = "/usr/local/bin/ca-plugin"
+							args = ["-signer", "pki"]
+							sha256 = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+						}
+					]
+					ca_provider = "plugin"
+					ca_config {
+						plugin = "signer"
+					}
+				}
+			`},
+		expected: func(rt *RuntimeConfig) {
+			rt.DataDir = dataDir
+			rt.ConnectEnabled = true
+			rt.ConnectCAProvider = "plugin"
+			rt.ConnectCAConfig = map[string]interface{}{
+				"Plugin": "signer",
+			}
+			rt.ConnectCAPlugins = []structs.CAPlugin{
+				{
+					Name:    "signer",
+					Command: "/usr/local/bin/ca-plugin",
+					Args:    []string{"-signer", "pki"},
+					SHA256:  "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+				},
+			}
+		},
+	})
+	run(t, testCase{
+		desc: "Connect canary rollout prometheus",
+		args: []string{
+			`-data-dir=` + dataDir,
+		},
+		json: []string{`{
+				"connect": {
+					"canary_rollout_prometheus": {
+						"base_url": "https://prometheus.example.com",
+						"add_headers": [{ "name": "X-Scope-OrgID", "value": "mesh" }]
+					}
+				}
+			}`},
+		hcl: []string{`
+				connect {
+					canary_rollout_prometheus {
+						base_url = "https://prometheus.example.com"
+						add_headers = [{ name = "X-Scope-OrgID" value = "mesh" }]
+					}
+				}
+			`},
+		expected: func(rt *RuntimeConfig) {
+			rt.DataDir = dataDir
+			rt.ConnectCanaryRolloutPrometheus = CanaryRolloutPrometheus{
+				BaseURL: "https://prometheus.example.com",
+				AddHeaders: []UIMetricsProxyAddHeader{
+					{Name: "X-Scope-OrgID", Value: "mesh"},
+				},
+			}
+		},
+	})
+	run(t, testCase{
+		desc: "Connect canary rollout prometheus rejects a non-http base URL",
+		args: []string{
+			`-data-dir=` + dataDir,
+		},
+		json: []string{`{
+				"connect": {
+					"canary_rollout_prometheus": { "base_url": "ftp://prometheus.example.com" }
+				}
+			}`},
+		hcl: []string{`
+				connect {
+					canary_rollout_prometheus { base_url = "ftp://prometheus.example.com" }
+				}
+			`},
+		expectedErr: `connect.canary_rollout_prometheus.base_url must be a valid http or https URL. received: "ftp://prometheus.example.com"`,
+	})
+	run(t, testCase{
+		desc: "Connect plugin CA provider rejects the command in the CA config",
+		args: []string{
+			`-data-dir=` + dataDir,
+		},
+		json: []string{`{
+				"connect": {
+					"enabled": true,
+					"ca_provider": "plugin",
+					"ca_config": {
+						"plugin": "signer",
+						"command": "/usr/local/bin/ca-plugin"
+					}
+				}
+			}`},
+		hcl: []string{`
+			  connect {
+					enabled = true
+					ca_provider = "plugin"
+					ca_config {
+						plugin = "signer"
+						command = "/usr/local/bin/ca-plugin"
+					}
+				}
+			`},
+		expectedErr: "Command can not be set in the CA configuration",
+	})
+	run(t, testCase{
+		desc: "Connect plugin CA provider requires the plugin on servers",
+		args: []string{
+			`-data-dir=` + dataDir,
+			`-server`,
+		},
+		json: []string{`{
+				"connect": {
+					"enabled": true,
+					"ca_provider": "plugin",
+					"ca_config": {
+						"plugin": "signer"
+					}
+				}
+			}`},
+		hcl: []string{`
+			  connect {
+					enabled = true
+					ca_provider = "plugin"
+					ca_config {
+						plugin = "signer"
+					}
+				}
+			`},
+		expectedErr: `connect.ca_config: plugin "signer" is not set in connect.ca_plugins`,
+	})
+	run(t, testCase{
+		desc: "connect.ca_plugins requires a checksum",
+		args: []string{
+			`-data-dir=` + dataDir,
+		},
+		json: []string{`{
+				"connect": {
+					"ca_plugins": [
+						{
+							"name": "signer",
+							"command": "/usr/local/bin/ca-plugin"
+						}
+					]
+				}
+			}`},
+		hcl: []string{`
+			  connect {
+					ca_plugins = [
+						{
+							name = "signer"
+							command = "/usr/local/bin/ca-plugin"
+						}
+					]
+				}
+			`},
+		expectedErr: "connect.ca_plugins[0].sha256 must be the hex encoded SHA-256 checksum of the plugin binary",
+	})
+	run(t, testCase{
+		desc: "Connect Consul CA provider PKCS#11 configuration",
+		args: []string{
+			`-data-dir=` + dataDir,
+		},
+		json: []string{`{
+				"connect": {
+					"enabled": true,
+					"ca_config": {
+						"pkcs11_module": "/usr/lib/softhsm/libsofthsm2.so",
+						"pkcs11_token_label": "consul",
+						"pkcs11_pin": "1234"
+					}
+				}
+			}`},
+		hcl: []string{`
+			  connect {
+					enabled = true
+					ca_config {
+						pkcs11_module = "/usr/lib/softhsm/libsofthsm2.so"
+						pkcs11_token_label = "consul"
+						pkcs11_pin = "1234"
+					}
+				}
+			`},
+		expected: func(rt *RuntimeConfig) {
+			rt.DataDir = dataDir
+			rt.ConnectEnabled = true
+			rt.ConnectCAConfig = map[string]interface{}{
+				"PKCS11Module":     "/usr/lib/softhsm/libsofthsm2.so",
+				"PKCS11TokenLabel": "consul",
+				"PKCS11PIN":        "1234",
+			}
+		},
+	})
+	run(t, testCase{
+		desc: "Connect Consul CA provider PKCS#11 requires a token",
+		args: []string{
+			`-data-dir=` + dataDir,
+		},
+		json: []string{`{
+				"connect": {
+					"enabled": true,
+					"ca_provider": "consul",
+					"ca_config": {
+						"pkcs11_module": "/usr/lib/softhsm/libsofthsm2.so"
+					}
+				}
+			}`},
+		hcl: []string{`
+			  connect {
+					enabled = true
+					ca_provider = "consul"
+					ca_config {
+						pkcs11_module = "/usr/lib/softhsm/libsofthsm2.so"
+					}
+				}
+			`},
+		expectedErr: "exactly one of PKCS11Slot or PKCS11TokenLabel must be set to select a PKCS#11 token",
+	})
 	run(t, testCase{
 		desc: "connect.enable_mesh_gateway_wan_federation requires connect.enabled",
 		args: []string{
@@ -3795,6 +4039,25 @@ func TestLoad_IntegrationWithFlags(t *testing.T) {
 			`},
 		expectedErr: "'connect.enable_mesh_gateway_wan_federation = true' requires 'server = true'",
 	})
+	run(t, testCase{
+		desc: "connect.workload_api_socket requires connect",
+		args: []string{
+			`-data-dir=` + dataDir,
+		},
+		json: []string{`{
+			  "connect": {
+				"enabled": false,
+				"workload_api_socket": "/tmp/workload-api.sock"
+			  }
+			}`},
+		hcl: []string{`
+			  connect {
+			    enabled = false
+			    workload_api_socket = "/tmp/workload-api.sock"
+			  }
+			`},
+		expectedErr: "'connect.workload_api_socket' requires 'connect.enabled = true'",
+	})
 	run(t, testCase{
 		desc: "connect.enable_mesh_gateway_wan_federation requires no slashes in node names",
 		args: []string{
@@ -5433,6 +5696,117 @@ func TestLoad_IntegrationWithFlags(t *testing.T) {
 			`},
 		expectedErr: `ui_config.metrics_proxy.base_url must be a valid http or https URL.`,
 	})
+	run(t, testCase{
+		desc: "http_config.client_cert_bindings without verify_incoming",
+		args: []string{`-data-dir=` + dataDir},
+		json: []string{`{
+				"http_config": {
+					"client_cert_bindings": [
+						{ "name": "ops", "subject_cn": "ops", "roles": ["operator"] }
+					]
+				}
+			}`},
+		hcl: []string{`
+			http_config {
+				client_cert_bindings = [
+					{ name = "ops" subject_cn = "ops" roles = ["operator"] }
+				]
+			}
+			`},
+		expectedErr: `http_config.client_cert_bindings requires tls.https.verify_incoming to be enabled`,
+	})
+	run(t, testCase{
+		desc: "http_config.client_cert_bindings without identity",
+		args: []string{`-data-dir=` + dataDir},
+		json: []string{`{
+				"tls": { "https": { "verify_incoming": true } },
+				"http_config": {
+					"client_cert_bindings": [
+						{ "name": "ops", "roles": ["operator"] }
+					]
+				}
+			}`},
+		hcl: []string{`
+			tls { https { verify_incoming = true } }
+			http_config {
+				client_cert_bindings = [
+					{ name = "ops" roles = ["operator"] }
+				]
+			}
+			`},
+		expectedErr: `http_config.client_cert_bindings[0]: at least one of subject_cn, dns_sans, uri_sans or email_sans is required`,
+	})
+	run(t, testCase{
+		desc: "http_config.client_cert_bindings duplicate name",
+		args: []string{`-data-dir=` + dataDir},
+		json: []string{`{
+				"tls": { "https": { "verify_incoming": true } },
+				"http_config": {
+					"client_cert_bindings": [
+						{ "name": "ops", "subject_cn": "ops", "roles": ["operator"] },
+						{ "name": "ops", "dns_sans": ["ops.example.com"], "roles": ["operator"] }
+					]
+				}
+			}`},
+		hcl: []string{`
+			tls { https { verify_incoming = true } }
+			http_config {
+				client_cert_bindings = [
+					{ name = "ops" subject_cn = "ops" roles = ["operator"] },
+					{ name = "ops" dns_sans = ["ops.example.com"] roles = ["operator"] }
+				]
+			}
+			`},
+		expectedErr: `http_config.client_cert_bindings: name "ops" is used more than once`,
+	})
+	run(t, testCase{
+		desc: "http_config.client_cert_bindings without roles or policies",
+		args: []string{`-data-dir=` + dataDir},
+		json: []string{`{
+				"tls": { "https": { "verify_incoming": true } },
+				"http_config": {
+					"client_cert_bindings": [
+						{ "name": "ops", "subject_cn": "ops" }
+					]
+				}
+			}`},
+		hcl: []string{`
+			tls { https { verify_incoming = true } }
+			http_config {
+				client_cert_bindings = [
+					{ name = "ops" subject_cn = "ops" }
+				]
+			}
+			`},
+		expectedErr: `http_config.client_cert_bindings[0]: at least one of roles or policies is required`,
+	})
+	run(t, testCase{
+		desc: "http_config.client_cert_bindings",
+		args: []string{`-data-dir=` + dataDir},
+		json: []string{`{
+				"tls": { "https": { "verify_incoming": true } },
+				"http_config": {
+					"client_cert_bindings": [
+						{ "name": "ops", "uri_sans": ["spiffe://example.com/ops"], "roles": ["operator"] }
+					]
+				}
+			}`},
+		hcl: []string{`
+			tls { https { verify_incoming = true } }
+			http_config {
+				client_cert_bindings = [
+					{ name = "ops" uri_sans = ["spiffe://example.com/ops"] roles = ["operator"] }
+				]
+			}
+			`},
+		expected: func(rt *RuntimeConfig) {
+			rt.DataDir = dataDir
+			rt.TLS.HTTPS.VerifyIncoming = true
+			rt.HTTPClientCertBindings = []HTTPClientCertBinding{
+				{Name: "ops", URISANs: []string{"spiffe://example.com/ops"}, Roles: []string{"operator"}},
+			}
+		},
+	})
 	run(t, testCase{
 		desc: "metrics_proxy.path_allowlist invalid (empty)",
 		args: []string{`-data-dir=` + dataDir},
@@ -6505,7 +6879,23 @@ func TestLoad_FullConfig(t *testing.T) {
 			"CSRMaxPerSecond":     float64(100),
 			"CSRMaxConcurrent":    float64(2),
 		},
+		ConnectCAPlugins: []structs.CAPlugin{
+			{
+				Name:    "Wq4nFs8D",
+				Command: "/usr/local/bin/zK3pVb7R",
+				Args:    []string{"mT6xHc2J"},
+				SHA256:  "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			},
+		},
+		ConnectCanaryRolloutPrometheus: CanaryRolloutPrometheus{
+			BaseURL: "http://prometheus.dc1:9090",
+			AddHeaders: []UIMetricsProxyAddHeader{
+				{Name: "Authorization", Value: "Bearer Kq8vRt3X"},
+			},
+		},
 		ConnectMeshGatewayWANFederationEnabled: false,
+		ConnectWorkloadAPISocket:               "/var/run/consul/workload-api.sock",
+		ConnectACMEDNSHook:                     "/usr/local/bin/acme-dns-hook",
 		Cloud: hcpconfig.CloudConfig{
 			ResourceID:   "N43DsscE",
 			ClientID:     "6WvsDZCP",
@@ -6571,6 +6961,17 @@ func TestLoad_FullConfig(t *testing.T) {
 		AllowWriteHTTPFrom:    []*net.IPNet{cidr("127.0.0.0/8"), cidr("22.33.44.55/32"), cidr("0.0.0.0/0")},
 		HTTPPort:              7999,
 		HTTPResponseHeaders:   map[string]string{"M6TKa9NP": "xjuxjOzQ", "JRCrHZed": "rl0mTx81"},
+		HTTPClientCertBindings: []HTTPClientCertBinding{
+			{
+				Name:      "v6pHwT7n",
+				SubjectCN: "Jw7gB3hN",
+				DNSSANs:   []string{"qH4rXd8K"},
+				URISANs:   []string{"spiffe://vT2kNw9r/ops"},
+				EmailSANs: []string{"Rt5mLz3Q@example.com"},
+				Roles:     []string{"c8Yf2Pxa"},
+				Policies:  []string{"Hn3kWq6T"},
+			},
+		},
 		HTTPSAddrs:            []net.Addr{tcpAddr("95.17.17.19:15127")},
 		HTTPMaxConnsPerClient: 100,
 		HTTPMaxHeaderBytes:    10,
@@ -7334,6 +7735,9 @@ func TestRuntimeConfig_Sanitize(t *testing.T) {
 			&net.TCPAddr{IP: net.ParseIP("1.2.3.4"), Port: 5678},
 			&net.UnixAddr{Name: "/var/run/foo"},
 		},
+		HTTPClientCertBindings: []HTTPClientCertBinding{
+			{Name: "ops", SubjectCN: "ops.example.com", Roles: []string{"operator"}},
+		},
 		Cache: cache.Options{
 			EntryFetchMaxBurst: 42,
 			EntryFetchRate:     0.334,
@@ -7380,6 +7784,11 @@ func TestRuntimeConfig_Sanitize(t *testing.T) {
 			*parseCIDR(t, "192.168.1.0/24"),
 			*parseCIDR(t, "127.0.0.0/8"),
 		},
+		ConnectCanaryRolloutPrometheus: CanaryRolloutPrometheus{
+			AddHeaders: []UIMetricsProxyAddHeader{
+				{Name: "Authorization", Value: "secret"},
+			},
+		},
 		TxnMaxReqLen: 5678000000000000,
 		UIConfig: UIConfig{
 			MetricsProxy: UIMetricsProxy{
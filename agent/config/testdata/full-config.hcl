@@ -216,6 +216,24 @@ cloud {
     scada_address = "aoeusth232"
 }
 connect {
+    acme_dns_hook = "/usr/local/bin/acme-dns-hook"
+    ca_plugins = [
+        {
+            name = "Wq4nFs8D"
+            command = "/usr/local/bin/zK3pVb7R"
+            args = [ "mT6xHc2J" ]
+            sha256 = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+        }
+    ]
+    canary_rollout_prometheus {
+        base_url = "http://prometheus.dc1:9090"
+        add_headers = [
+            {
+                name = "Authorization"
+                value = "Bearer Kq8vRt3X"
+            }
+        ]
+    }
     ca_provider = "consul"
     ca_config {
         intermediate_cert_ttl = "8760h"
@@ -228,6 +246,7 @@ connect {
     }
     enable_mesh_gateway_wan_federation = false
     enabled = true
+    workload_api_socket = "/var/run/consul/workload-api.sock"
 }
 gossip_lan {
     gossip_nodes    = 6
@@ -297,6 +316,17 @@ http_config {
     }
     use_cache = false
     max_header_bytes = 10
+    client_cert_bindings = [
+        {
+            name = "v6pHwT7n"
+            subject_cn = "Jw7gB3hN"
+            dns_sans = [ "qH4rXd8K" ]
+            uri_sans = [ "spiffe://vT2kNw9r/ops" ]
+            email_sans = [ "Rt5mLz3Q@example.com" ]
+            roles = [ "c8Yf2Pxa" ]
+            policies = [ "Hn3kWq6T" ]
+        }
+    ]
 }
 key_file = "IEkkwgIA"
 leave_on_terminate = true
@@ -973,7 +973,7 @@ func Test_MergeServiceConfig_UpstreamOverrides(t *testing.T) {
 	}
 }
 
-// Tests that RateLimit config is a no-op in non-enterprise.
+// Tests that RateLimit config is injected as an extension.
 // In practice, the ratelimit config would have been validated
 // on write.
 func Test_MergeServiceConfig_RateLimit(t *testing.T) {
@@ -5,6 +5,7 @@ package ca
 
 import (
 	"bytes"
+	"crypto"
 	"crypto/x509"
 	"fmt"
 
@@ -43,17 +44,12 @@ func validateSetIntermediate(intermediatePEM, rootPEM string, spiffeID *connect.
 	return nil
 }
 
-func validateIntermediateSignedByPrivateKey(intermediatePEM string, privateKey string) error {
+func validateIntermediateSignedByPrivateKey(intermediatePEM string, privKey crypto.Signer) error {
 	intermediate, err := connect.ParseCert(intermediatePEM)
 	if err != nil {
 		return fmt.Errorf("error parsing intermediate PEM: %v", err)
 	}
 
-	privKey, err := connect.ParseSigner(privateKey)
-	if err != nil {
-		return err
-	}
-
 	// Compare the two keys to make sure they match.
 	b1, err := x509.MarshalPKIXPublicKey(intermediate.PublicKey)
 	if err != nil {
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package ca
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+const (
+	// DefaultJWTSVIDTTL is the lifetime of JWT-SVIDs when none is requested.
+	DefaultJWTSVIDTTL = 5 * time.Minute
+
+	// MaxJWTSVIDTTL is the longest lifetime a JWT-SVID may be issued with.
+	// JWT-SVIDs cannot be revoked, so they are kept short-lived.
+	MaxJWTSVIDTTL = time.Hour
+)
+
+// GenerateJWTSigningKey generates a new ES256 key pair for signing JWT-SVIDs
+// and sets it on the given root. A key is generated alongside every new CA
+// root so that JWT signing keys rotate with the CA: the public keys of all
+// roots that are still trusted are published in the JWKS.
+func GenerateJWTSigningKey(root *structs.CARoot) error {
+	signer, keyPEM, err := connect.GeneratePrivateKeyWithConfig("ec", 256)
+	if err != nil {
+		return fmt.Errorf("error generating JWT signing key: %w", err)
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(signer.Public())
+	if err != nil {
+		return fmt.Errorf("error encoding JWT public key: %w", err)
+	}
+
+	thumbprint, err := (&jose.JSONWebKey{Key: signer.Public()}).Thumbprint(crypto.SHA256)
+	if err != nil {
+		return fmt.Errorf("error computing JWT key ID: %w", err)
+	}
+
+	root.JWTKeyID = base64.RawURLEncoding.EncodeToString(thumbprint)
+	root.JWTPublicKey = string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	root.JWTSigningKey = keyPEM
+	return nil
+}
+
+// SignJWTSVID mints a JWT-SVID for the given SPIFFE ID using the JWT signing
+// key of the given root.
+func SignJWTSVID(root *structs.CARoot, spiffeID string, audience []string, now time.Time, ttl time.Duration) (string, time.Time, error) {
+	if root.JWTSigningKey == "" {
+		return "", time.Time{}, fmt.Errorf("CA root %q does not have a JWT signing key", root.ID)
+	}
+	if len(audience) == 0 {
+		return "", time.Time{}, fmt.Errorf("at least one audience is required")
+	}
+	if ttl <= 0 {
+		ttl = DefaultJWTSVIDTTL
+	}
+	if ttl > MaxJWTSVIDTTL {
+		ttl = MaxJWTSVIDTTL
+	}
+
+	key, err := connect.ParseSigner(root.JWTSigningKey)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("error parsing JWT signing key: %w", err)
+	}
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.ES256, Key: key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", root.JWTKeyID),
+	)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	expiry := now.Add(ttl)
+	token, err := jwt.Signed(signer).Claims(jwt.Claims{
+		Subject:  spiffeID,
+		Audience: jwt.Audience(audience),
+		IssuedAt: jwt.NewNumericDate(now),
+		Expiry:   jwt.NewNumericDate(expiry),
+	}).CompactSerialize()
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("error signing JWT-SVID: %w", err)
+	}
+	return token, expiry, nil
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package ca
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+func TestJWTSVID_SignAndValidate(t *testing.T) {
+	root := &structs.CARoot{ID: "root-1"}
+	require.NoError(t, GenerateJWTSigningKey(root))
+	require.NotEmpty(t, root.JWTKeyID)
+	require.Contains(t, root.JWTPublicKey, "PUBLIC KEY")
+	require.NotEmpty(t, root.JWTSigningKey)
+
+	otherRoot := &structs.CARoot{ID: "root-2"}
+	require.NoError(t, GenerateJWTSigningKey(otherRoot))
+	require.NotEqual(t, root.JWTKeyID, otherRoot.JWTKeyID)
+
+	spiffeID := "spiffe://11111111-2222-3333-4444-555555555555.consul/ns/default/dc/dc1/svc/web"
+	now := time.Now()
+
+	token, expiresAt, err := SignJWTSVID(root, spiffeID, []string{"api", "db"}, now, 0)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(DefaultJWTSVIDTTL), expiresAt)
+
+	t.Run("valid", func(t *testing.T) {
+		sub, claims, err := connect.ValidateJWTSVID(token, "db", []*structs.CARoot{otherRoot, root}, now)
+		require.NoError(t, err)
+		require.Equal(t, spiffeID, sub)
+		require.Equal(t, spiffeID, claims["sub"])
+	})
+
+	t.Run("wrong audience", func(t *testing.T) {
+		_, _, err := connect.ValidateJWTSVID(token, "other", []*structs.CARoot{root}, now)
+		require.Error(t, err)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		_, _, err := connect.ValidateJWTSVID(token, "api", []*structs.CARoot{root}, now.Add(DefaultJWTSVIDTTL+time.Second))
+		require.Error(t, err)
+	})
+
+	t.Run("rotated out key", func(t *testing.T) {
+		_, _, err := connect.ValidateJWTSVID(token, "api", []*structs.CARoot{otherRoot}, now)
+		require.ErrorContains(t, err, "unknown key")
+	})
+
+	t.Run("public key only", func(t *testing.T) {
+		public := &structs.CARoot{ID: root.ID, JWTKeyID: root.JWTKeyID, JWTPublicKey: root.JWTPublicKey}
+		_, _, err := connect.ValidateJWTSVID(token, "api", []*structs.CARoot{public}, now)
+		require.NoError(t, err)
+
+		_, _, err = SignJWTSVID(public, spiffeID, []string{"api"}, now, 0)
+		require.ErrorContains(t, err, "does not have a JWT signing key")
+	})
+
+	t.Run("ttl is capped", func(t *testing.T) {
+		token, expiresAt, err := SignJWTSVID(root, spiffeID, []string{"api"}, now, 24*time.Hour)
+		require.NoError(t, err)
+		require.Equal(t, now.Add(MaxJWTSVIDTTL), expiresAt)
+
+		parsed, err := jwt.ParseSigned(token)
+		require.NoError(t, err)
+		require.Equal(t, root.JWTKeyID, parsed.Headers[0].KeyID)
+	})
+
+	t.Run("audience is required", func(t *testing.T) {
+		_, _, err := SignJWTSVID(root, spiffeID, nil, now, 0)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build cgo
+
+package ca
+
+import (
+	"crypto"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ThalesIgnite/crypto11"
+
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// pkcs11KeyLabel is the label of the keys the Consul CA provider generates
+// in PKCS#11 tokens.
+const pkcs11KeyLabel = "consul-connect-ca"
+
+// cryptoKeyStore implements pkcs11KeyStore using a crypto11 context.
+type cryptoKeyStore struct {
+	ctx *crypto11.Context
+
+	// keys caches the keys that were generated or loaded by ID, so that
+	// signing doesn't need to search the token every time.
+	lock sync.Mutex
+	keys map[string]crypto11.Signer
+}
+
+func openPKCS11KeyStore(config *structs.ConsulCAProviderConfig) (pkcs11KeyStore, error) {
+	ctx, err := crypto11.Configure(&crypto11.Config{
+		Path:       config.PKCS11Module,
+		SlotNumber: config.PKCS11Slot,
+		TokenLabel: config.PKCS11TokenLabel,
+		Pin:        config.PKCS11PIN,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error opening PKCS#11 token: %w", err)
+	}
+	return &cryptoKeyStore{ctx: ctx, keys: make(map[string]crypto11.Signer)}, nil
+}
+
+func (s *cryptoKeyStore) GenerateKey(keyType string, keyBits int) (string, crypto.Signer, error) {
+	rawID := make([]byte, 16)
+	if _, err := rand.Read(rawID); err != nil {
+		return "", nil, err
+	}
+	id := hex.EncodeToString(rawID)
+
+	var (
+		key crypto11.Signer
+		err error
+	)
+	switch strings.ToLower(keyType) {
+	case connect.PrivateKeyTypeRSA:
+		key, err = s.ctx.GenerateRSAKeyPairWithLabel(rawID, []byte(pkcs11KeyLabel), keyBits)
+	case connect.DefaultPrivateKeyType:
+		var curve elliptic.Curve
+		switch keyBits {
+		case 224:
+			curve = elliptic.P224()
+		case 256:
+			curve = elliptic.P256()
+		case 384:
+			curve = elliptic.P384()
+		case 521:
+			curve = elliptic.P521()
+		default:
+			return "", nil, fmt.Errorf("error generating ECDSA private key: unknown curve length %d", keyBits)
+		}
+		key, err = s.ctx.GenerateECDSAKeyPairWithLabel(rawID, []byte(pkcs11KeyLabel), curve)
+	default:
+		return "", nil, fmt.Errorf("unknown private key type requested: %s", keyType)
+	}
+	if err != nil {
+		return "", nil, fmt.Errorf("error generating private key in PKCS#11 token: %w", err)
+	}
+
+	s.lock.Lock()
+	s.keys[id] = key
+	s.lock.Unlock()
+
+	return id, key, nil
+}
+
+func (s *cryptoKeyStore) FindKey(id string) (crypto.Signer, error) {
+	return s.findKey(id)
+}
+
+func (s *cryptoKeyStore) findKey(id string) (crypto11.Signer, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if key, ok := s.keys[id]; ok {
+		return key, nil
+	}
+
+	rawID, err := hex.DecodeString(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid PKCS#11 key ID %q: %w", id, err)
+	}
+	key, err := s.ctx.FindKeyPair(rawID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error finding private key in PKCS#11 token: %w", err)
+	}
+	if key == nil {
+		return nil, fmt.Errorf("private key %s not found in PKCS#11 token", id)
+	}
+	s.keys[id] = key
+	return key, nil
+}
+
+func (s *cryptoKeyStore) DeleteKey(id string) error {
+	key, err := s.findKey(id)
+	if err != nil {
+		return err
+	}
+	if err := key.Delete(); err != nil {
+		return fmt.Errorf("error deleting private key from PKCS#11 token: %w", err)
+	}
+
+	s.lock.Lock()
+	delete(s.keys, id)
+	s.lock.Unlock()
+	return nil
+}
+
+func (s *cryptoKeyStore) Close() error {
+	return s.ctx.Close()
+}
@@ -0,0 +1,16 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !cgo
+
+package ca
+
+import (
+	"errors"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+func openPKCS11KeyStore(_ *structs.ConsulCAProviderConfig) (pkcs11KeyStore, error) {
+	return nil, errors.New("PKCS#11 tokens require a Consul binary built with cgo")
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build cgo
+
+package ca
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// softHSMModulePaths are the usual install locations of the SoftHSM module.
+var softHSMModulePaths = []string{
+	"/usr/lib/softhsm/libsofthsm2.so",
+	"/usr/lib/x86_64-linux-gnu/softhsm/libsofthsm2.so",
+	"/usr/lib64/pkcs11/libsofthsm2.so",
+	"/usr/local/lib/softhsm/libsofthsm2.so",
+	"/opt/homebrew/lib/softhsm/libsofthsm2.so",
+}
+
+// testSoftHSMToken initializes a new token in SoftHSM and returns the
+// configuration of the Consul provider that keeps its keys in it. The test is
+// skipped if SoftHSM is not installed. SOFTHSM2_MODULE can be used to set the
+// path of the SoftHSM module.
+func testSoftHSMToken(t *testing.T) map[string]interface{} {
+	t.Helper()
+
+	// Try to safeguard against tests that will never run in CI.
+	if !strings.Contains(t.Name(), "SoftHSM") {
+		t.Fatalf("test name must contain SoftHSM, otherwise CI will never run it")
+	}
+
+	module := os.Getenv("SOFTHSM2_MODULE")
+	if module == "" {
+		for _, path := range softHSMModulePaths {
+			if _, err := os.Stat(path); err == nil {
+				module = path
+				break
+			}
+		}
+	}
+	if module == "" {
+		t.Skip("SoftHSM module not found - install SoftHSM or set SOFTHSM2_MODULE to run this test")
+	}
+	util, err := exec.LookPath("softhsm2-util")
+	if err != nil {
+		t.Skip("softhsm2-util not found on $PATH - install SoftHSM to run this test")
+	}
+
+	// Keep the tokens of the test in a temporary directory.
+	dir := t.TempDir()
+	tokenDir := filepath.Join(dir, "tokens")
+	require.NoError(t, os.Mkdir(tokenDir, 0700))
+	conf := filepath.Join(dir, "softhsm2.conf")
+	require.NoError(t, os.WriteFile(conf, []byte("directories.tokendir = "+tokenDir+"\nobjectstore.backend = file\n"), 0600))
+	t.Setenv("SOFTHSM2_CONF", conf)
+
+	out, err := exec.Command(util, "--init-token", "--free",
+		"--label", "consul-test", "--pin", "1234", "--so-pin", "5678").CombinedOutput()
+	require.NoError(t, err, "failed to initialize SoftHSM token: %s", out)
+
+	return map[string]interface{}{
+		"PKCS11Module":     module,
+		"PKCS11TokenLabel": "consul-test",
+		"PKCS11PIN":        "1234",
+	}
+}
+
+func testSoftHSMProvider(t *testing.T, conf *structs.CAConfiguration, token map[string]interface{}, isPrimary bool) *ConsulProvider {
+	t.Helper()
+
+	for k, v := range token {
+		conf.Config[k] = v
+	}
+	provider := TestConsulProvider(t, newMockDelegate(t, conf))
+	t.Cleanup(provider.Stop)
+
+	cfg := testProviderConfig(conf)
+	if !isPrimary {
+		cfg.IsPrimary = false
+		cfg.Datacenter = "dc2"
+	}
+	require.NoError(t, provider.Configure(cfg))
+	return provider
+}
+
+func TestConsulCAProvider_SoftHSM_Bootstrap(t *testing.T) {
+	for _, tc := range KeyTestCases {
+		tc := tc
+		t.Run(tc.Desc, func(t *testing.T) {
+			token := testSoftHSMToken(t)
+
+			conf := testConsulCAConfig()
+			conf.Config["PrivateKeyType"] = tc.KeyType
+			conf.Config["PrivateKeyBits"] = tc.KeyBits
+			provider := testSoftHSMProvider(t, conf, token, true)
+
+			rootPEM, err := provider.GenerateCAChain()
+			require.NoError(t, err)
+			root, err := connect.ParseCert(rootPEM)
+			require.NoError(t, err)
+			require.True(t, root.IsCA)
+
+			// The private key is kept in the token, not in the state store.
+			_, providerState, err := provider.Delegate.(*consulCAMockDelegate).state.CAProviderState(provider.id)
+			require.NoError(t, err)
+			require.Empty(t, providerState.PrivateKey)
+			require.NotEmpty(t, providerState.PKCS11KeyID)
+
+			signer, err := provider.keyStore.FindKey(providerState.PKCS11KeyID)
+			require.NoError(t, err)
+			require.Equal(t, connect.SigAlgoForKey(signer), root.SignatureAlgorithm)
+
+			// Leaves are signed by the key in the token.
+			raw, _ := connect.TestCSR(t, &connect.SpiffeIDService{
+				Host:       connect.TestClusterID + ".consul",
+				Namespace:  "default",
+				Datacenter: "dc1",
+				Service:    "foo",
+			})
+			csr, err := connect.ParseCSR(raw)
+			require.NoError(t, err)
+			leafPEM, err := provider.Sign(csr)
+			require.NoError(t, err)
+			leaf, err := connect.ParseCert(leafPEM)
+			require.NoError(t, err)
+			require.NoError(t, leaf.CheckSignatureFrom(root))
+
+			// Reconfiguring the provider opens the token again and finds the
+			// existing key.
+			require.NoError(t, provider.Configure(testProviderConfig(conf)))
+			again, err := provider.GenerateCAChain()
+			require.NoError(t, err)
+			require.Equal(t, rootPEM, again)
+			leafPEM, err = provider.Sign(csr)
+			require.NoError(t, err)
+			leaf, err = connect.ParseCert(leafPEM)
+			require.NoError(t, err)
+			require.NoError(t, leaf.CheckSignatureFrom(root))
+
+			// Cleanup deletes the key from the token.
+			require.NoError(t, provider.Cleanup(true, nil))
+			_, err = provider.keyStore.FindKey(providerState.PKCS11KeyID)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestConsulCAProvider_SoftHSM_EphemeralKeys(t *testing.T) {
+	token := testSoftHSMToken(t)
+
+	provider := testSoftHSMProvider(t, testConsulCAConfig(), token, true)
+	provider.EphemeralKeys = true
+
+	_, err := provider.GenerateCAChain()
+	require.NoError(t, err)
+
+	// The private key is generated in memory instead of the token.
+	_, providerState, err := provider.Delegate.(*consulCAMockDelegate).state.CAProviderState(provider.id)
+	require.NoError(t, err)
+	require.NotEmpty(t, providerState.PrivateKey)
+	require.Empty(t, providerState.PKCS11KeyID)
+}
+
+func TestConsulCAProvider_SoftHSM_SignIntermediate(t *testing.T) {
+	token := testSoftHSMToken(t)
+
+	conf1 := testConsulCAConfig()
+	provider1 := testSoftHSMProvider(t, conf1, token, true)
+	_, err := provider1.GenerateCAChain()
+	require.NoError(t, err)
+
+	conf2 := testConsulCAConfig()
+	conf2.CreateIndex = 10
+	provider2 := testSoftHSMProvider(t, conf2, token, false)
+
+	testSignIntermediateCrossDC(t, provider1, provider2)
+}
+
+func TestConsulCAProvider_SoftHSM_CrossSignCA(t *testing.T) {
+	token := testSoftHSMToken(t)
+
+	conf1 := testConsulCAConfig()
+	provider1 := testSoftHSMProvider(t, conf1, token, true)
+	_, err := provider1.GenerateCAChain()
+	require.NoError(t, err)
+
+	// Rotate to a root whose key is not kept in the token.
+	conf2 := testConsulCAConfig()
+	conf2.CreateIndex = 10
+	provider2 := TestConsulProvider(t, newMockDelegate(t, conf2))
+	require.NoError(t, provider2.Configure(testProviderConfig(conf2)))
+	_, err = provider2.GenerateCAChain()
+	require.NoError(t, err)
+
+	testCrossSignProviders(t, provider1, provider2)
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package reference implements a reference CA provider plugin. It serves the
+// built-in Consul CA provider with its state kept in memory, which makes it
+// suitable for exercising the plugin CA provider in tests. Plugin authors can
+// use it as a starting point for their own providers.
+package reference
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/mitchellh/go-testing-interface"
+
+	"github.com/hashicorp/consul/agent/connect/ca"
+	"github.com/hashicorp/consul/agent/consul/fsm"
+	"github.com/hashicorp/consul/agent/consul/state"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// NewProvider returns the provider served by the reference plugin.
+func NewProvider(logger hclog.Logger) ca.Provider {
+	return ca.NewConsulProvider(&delegate{store: state.NewStateStore(nil)}, logger)
+}
+
+// Serve serves the reference provider as a CA provider plugin. It blocks
+// until Consul terminates the plugin process.
+func Serve() {
+	logger := hclog.New(&hclog.LoggerOptions{
+		Name:       "reference-ca-plugin",
+		Level:      hclog.Trace,
+		Output:     os.Stderr,
+		JSONFormat: true,
+	})
+	ca.ServePlugin(NewProvider(logger))
+}
+
+// ServeIfPlugin serves the reference provider and exits if the current
+// process was launched as a CA provider plugin, and returns otherwise. This
+// allows test binaries to act as the plugin by calling it from TestMain.
+func ServeIfPlugin() {
+	if os.Getenv(ca.PluginHandshake.MagicCookieKey) != ca.PluginHandshake.MagicCookieValue {
+		return
+	}
+	Serve()
+	os.Exit(0)
+}
+
+// TestPluginName is the name of the plugin returned by TestPlugin.
+const TestPluginName = "reference"
+
+// TestPlugin returns a plugin that launches the current test binary as the
+// reference plugin. The test binary must call ServeIfPlugin from TestMain.
+func TestPlugin(t testing.T) structs.CAPlugin {
+	f, err := os.Open(os.Args[0])
+	if err != nil {
+		t.Fatalf("error opening test binary: %v", err)
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		t.Fatalf("error hashing test binary: %v", err)
+	}
+
+	return structs.CAPlugin{
+		Name:    TestPluginName,
+		Command: os.Args[0],
+		SHA256:  hex.EncodeToString(hash.Sum(nil)),
+	}
+}
+
+// TestConfig returns the configuration of a plugin CA provider that selects
+// the plugin returned by TestPlugin.
+func TestConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"Plugin": TestPluginName,
+	}
+}
+
+// delegate implements ca.ConsulProviderStateDelegate with an in-memory state
+// store.
+type delegate struct {
+	store *state.Store
+
+	lock  sync.Mutex
+	index uint64
+}
+
+func (d *delegate) ProviderState(id string) (*structs.CAConsulProviderState, error) {
+	_, s, err := d.store.CAProviderState(id)
+	return s, err
+}
+
+func (d *delegate) ApplyCARequest(req *structs.CARequest) (interface{}, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.index++
+	result := fsm.ApplyConnectCAOperationFromRequest(d.store, req, d.index)
+	if err, ok := result.(error); ok && err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package reference
+
+import (
+	"crypto/x509"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/agent/connect/ca"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+func TestMain(m *testing.M) {
+	ServeIfPlugin()
+	os.Exit(m.Run())
+}
+
+func testPluginProvider(t *testing.T, dc string, isPrimary bool) *ca.PluginProvider {
+	t.Helper()
+
+	provider := ca.NewPluginProvider(hclog.New(&hclog.LoggerOptions{
+		Name:   "plugin-" + dc,
+		Output: hclog.DefaultOutput,
+		Level:  hclog.Debug,
+	}), []structs.CAPlugin{TestPlugin(t)})
+	t.Cleanup(provider.Stop)
+
+	config := TestConfig()
+	config["LeafCertTTL"] = []byte("72h")
+	config["IntermediateCertTTL"] = "288h"
+	require.NoError(t, provider.Configure(ca.ProviderConfig{
+		ClusterID:  connect.TestClusterID,
+		Datacenter: dc,
+		IsPrimary:  isPrimary,
+		RawConfig:  config,
+	}))
+	return provider
+}
+
+func testSignLeaf(t *testing.T, provider ca.Provider, dc string) string {
+	t.Helper()
+
+	raw, _ := connect.TestCSR(t, &connect.SpiffeIDService{
+		Host:       connect.TestClusterID + ".consul",
+		Namespace:  "default",
+		Datacenter: dc,
+		Service:    "web",
+	})
+	csr, err := connect.ParseCSR(raw)
+	require.NoError(t, err)
+
+	leafPEM, err := provider.Sign(csr)
+	require.NoError(t, err)
+	return leafPEM
+}
+
+func requireChainsTo(t *testing.T, leafPEM string, intermediatePEMs []string, rootPEM string) {
+	t.Helper()
+
+	leaf, err := connect.ParseCert(leafPEM)
+	require.NoError(t, err)
+
+	roots := x509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM([]byte(rootPEM)))
+	intermediates := x509.NewCertPool()
+	for _, pem := range intermediatePEMs {
+		require.True(t, intermediates.AppendCertsFromPEM([]byte(pem)))
+	}
+
+	_, err = leaf.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	require.NoError(t, err)
+}
+
+func TestPluginProvider_Primary(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	provider := testPluginProvider(t, "dc1", true)
+
+	rootPEM, err := provider.GenerateCAChain()
+	require.NoError(t, err)
+	root, err := connect.ParseCert(rootPEM)
+	require.NoError(t, err)
+	require.True(t, root.IsCA)
+
+	// The chain is stable across calls.
+	again, err := provider.GenerateCAChain()
+	require.NoError(t, err)
+	require.Equal(t, rootPEM, again)
+
+	signingPEM, err := provider.ActiveLeafSigningCert()
+	require.NoError(t, err)
+	require.Equal(t, rootPEM, signingPEM)
+
+	_, err = provider.State()
+	require.NoError(t, err)
+
+	leafPEM := testSignLeaf(t, provider, "dc1")
+	requireChainsTo(t, leafPEM, nil, rootPEM)
+}
+
+func TestPluginProvider_CrossSignCA(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	oldProvider := testPluginProvider(t, "dc1", true)
+	oldRootPEM, err := oldProvider.GenerateCAChain()
+	require.NoError(t, err)
+
+	supported, err := oldProvider.SupportsCrossSigning()
+	require.NoError(t, err)
+	require.True(t, supported)
+
+	// Cross-sign a root that was not generated by the plugin.
+	newRoot := connect.TestCA(t, nil)
+	newRootCert, err := connect.ParseCert(newRoot.RootCert)
+	require.NoError(t, err)
+
+	xcPEM, err := oldProvider.CrossSignCA(newRootCert)
+	require.NoError(t, err)
+	xc, err := connect.ParseCert(xcPEM)
+	require.NoError(t, err)
+
+	oldRoot, err := connect.ParseCert(oldRootPEM)
+	require.NoError(t, err)
+	require.Equal(t, newRootCert.Subject.CommonName, xc.Subject.CommonName)
+	require.Equal(t, newRootCert.SubjectKeyId, xc.SubjectKeyId)
+	require.Equal(t, oldRoot.SubjectKeyId, xc.AuthorityKeyId)
+
+	// A leaf issued by the new root is trusted by the old root through the
+	// cross-signed certificate.
+	leafPEM, _ := connect.TestLeaf(t, "web", newRoot)
+	requireChainsTo(t, leafPEM, []string{xcPEM}, oldRootPEM)
+}
+
+func TestPluginProvider_Secondary(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	primary := testPluginProvider(t, "dc1", true)
+	rootPEM, err := primary.GenerateCAChain()
+	require.NoError(t, err)
+
+	secondary := testPluginProvider(t, "dc2", false)
+
+	csrPEM, opaque, err := secondary.GenerateIntermediateCSR()
+	require.NoError(t, err)
+	csr, err := connect.ParseCSR(csrPEM)
+	require.NoError(t, err)
+
+	intermediatePEM, err := primary.SignIntermediate(csr)
+	require.NoError(t, err)
+	require.NoError(t, secondary.SetIntermediate(intermediatePEM, rootPEM, opaque))
+
+	active, err := secondary.ActiveLeafSigningCert()
+	require.NoError(t, err)
+	require.Equal(t, intermediatePEM, active)
+
+	leafPEM := testSignLeaf(t, secondary, "dc2")
+	requireChainsTo(t, leafPEM, []string{intermediatePEM}, rootPEM)
+}
+
+func TestPluginProvider_Lifecycle(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		plugin := TestPlugin(t)
+		plugin.SHA256 = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+		provider := ca.NewPluginProvider(hclog.NewNullLogger(), []structs.CAPlugin{plugin})
+		t.Cleanup(provider.Stop)
+
+		err := provider.Configure(ca.ProviderConfig{
+			ClusterID:  connect.TestClusterID,
+			Datacenter: "dc1",
+			IsPrimary:  true,
+			RawConfig:  TestConfig(),
+		})
+		require.ErrorContains(t, err, "checksums did not match")
+	})
+
+	t.Run("unknown plugin", func(t *testing.T) {
+		provider := ca.NewPluginProvider(hclog.NewNullLogger(), nil)
+		t.Cleanup(provider.Stop)
+
+		err := provider.Configure(ca.ProviderConfig{
+			ClusterID:  connect.TestClusterID,
+			Datacenter: "dc1",
+			IsPrimary:  true,
+			RawConfig:  TestConfig(),
+		})
+		require.EqualError(t, err, `CA plugin "reference" is not set in the connect.ca_plugins configuration of this server`)
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		provider := testPluginProvider(t, "dc1", true)
+		_, err := provider.GenerateCAChain()
+		require.NoError(t, err)
+
+		provider.Stop()
+		_, err = provider.GenerateCAChain()
+		require.EqualError(t, err, "CA plugin provider is stopped")
+	})
+}
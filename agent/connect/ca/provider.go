@@ -6,6 +6,8 @@ package ca
 import (
 	"crypto/x509"
 	"errors"
+	"math/big"
+	"time"
 )
 
 //go:generate mockery --name Provider --inpackage
@@ -212,6 +214,16 @@ type CAChainResult struct {
 	IntermediatePEM string
 }
 
+// RevocationListSigner is an optional interface that CA providers may
+// implement to sign certificate revocation lists for the leaf certificates they
+// issue. Proxies can only reject revoked leaf certificates issued by providers
+// that implement it.
+type RevocationListSigner interface {
+	// SignRevocationList returns a PEM encoded revocation list for the given
+	// serial numbers, signed by the active leaf signing CA.
+	SignRevocationList(serials []*big.Int, thisUpdate, nextUpdate time.Time) (string, error)
+}
+
 // NeedsStop is an optional interface that allows a CA to define a function
 // to be called when the CA instance is no longer in use. This is different
 // from Cleanup(), as only the local provider instance is being shut down
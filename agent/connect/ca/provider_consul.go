@@ -5,6 +5,7 @@ package ca
 
 import (
 	"bytes"
+	"crypto"
 	"crypto/rand"
 	"crypto/sha256"
 	"crypto/x509"
@@ -34,6 +35,11 @@ var (
 type ConsulProvider struct {
 	Delegate ConsulProviderStateDelegate
 
+	// EphemeralKeys generates new private keys in memory even if a PKCS#11
+	// token is configured, so that a provider used to validate a
+	// configuration doesn't create or delete keys in the token.
+	EphemeralKeys bool
+
 	config    *structs.ConsulCAProviderConfig
 	id        string
 	clusterID string
@@ -41,6 +47,9 @@ type ConsulProvider struct {
 	spiffeID  *connect.SpiffeIDSigning
 	logger    hclog.Logger
 
+	// keyStore is set when the private keys are kept in a PKCS#11 token.
+	keyStore pkcs11KeyStore
+
 	// testState is only used to test Consul leader's handling of providers that
 	// need to persist state. Consul provider actually manages it's state directly
 	// in the FSM since it is highly sensitive not (root private keys) not just
@@ -53,6 +62,16 @@ type ConsulProvider struct {
 }
 
 var _ Provider = (*ConsulProvider)(nil)
+var _ NeedsStop = (*ConsulProvider)(nil)
+
+// pkcs11KeyStore manages the private keys of the provider in a PKCS#11 token.
+// Keys are referenced by their hex encoded ID.
+type pkcs11KeyStore interface {
+	GenerateKey(keyType string, keyBits int) (string, crypto.Signer, error)
+	FindKey(id string) (crypto.Signer, error)
+	DeleteKey(id string) error
+	Close() error
+}
 
 // NewConsulProvider returns a new ConsulProvider that is ready to be used.
 func NewConsulProvider(delegate ConsulProviderStateDelegate, logger hclog.Logger) *ConsulProvider {
@@ -77,7 +96,7 @@ func (c *ConsulProvider) Configure(cfg ProviderConfig) error {
 		return err
 	}
 	c.config = config
-	c.id = hexStringHash(fmt.Sprintf("%s,%s,%s,%d,%v", config.PrivateKey, config.RootCert, config.PrivateKeyType, config.PrivateKeyBits, cfg.IsPrimary))
+	c.id = hexStringHash(providerID(config, cfg.IsPrimary))
 	c.clusterID = cfg.ClusterID
 	c.isPrimary = cfg.IsPrimary
 	c.spiffeID = connect.SpiffeIDSigningForCluster(c.clusterID)
@@ -85,6 +104,15 @@ func (c *ConsulProvider) Configure(cfg ProviderConfig) error {
 	// Passthrough test state for state handling tests. See testState doc.
 	c.parseTestState(cfg.RawConfig, cfg.State)
 
+	c.closeKeyStore()
+	if config.PKCS11Module != "" {
+		keyStore, err := openPKCS11KeyStore(config)
+		if err != nil {
+			return err
+		}
+		c.keyStore = keyStore
+	}
+
 	// Exit early if the state store has an entry for this provider's config.
 	providerState, err := c.Delegate.ProviderState(c.id)
 	if err != nil {
@@ -145,6 +173,36 @@ func (c *ConsulProvider) Configure(cfg ProviderConfig) error {
 	return nil
 }
 
+// providerID returns the input to the hash that identifies the state of the
+// provider. The PKCS#11 token is only part of it when configured so that IDs
+// of existing providers don't change.
+func providerID(config *structs.ConsulCAProviderConfig, isPrimary bool) string {
+	id := fmt.Sprintf("%s,%s,%s,%d,%v", config.PrivateKey, config.RootCert, config.PrivateKeyType, config.PrivateKeyBits, isPrimary)
+	if config.PKCS11Module != "" {
+		slot := ""
+		if config.PKCS11Slot != nil {
+			slot = fmt.Sprint(*config.PKCS11Slot)
+		}
+		id += fmt.Sprintf(",pkcs11,%s,%s,%s", config.PKCS11Module, slot, config.PKCS11TokenLabel)
+	}
+	return id
+}
+
+// Stop implements NeedsStop by closing the PKCS#11 token if one is used.
+func (c *ConsulProvider) Stop() {
+	c.closeKeyStore()
+}
+
+func (c *ConsulProvider) closeKeyStore() {
+	if c.keyStore == nil {
+		return
+	}
+	if err := c.keyStore.Close(); err != nil {
+		c.logger.Warn("failed to close PKCS#11 token", "error", err)
+	}
+	c.keyStore = nil
+}
+
 // State implements Provider. Consul actually does store all it's state in raft
 // but it manages it independently through a separate table already so this is a
 // no-op. This method just passes through testState which allows tests to verify
@@ -170,14 +228,18 @@ func (c *ConsulProvider) GenerateCAChain() (string, error) {
 
 	// Generate a private key if needed
 	newState := *providerState
+	var signer crypto.Signer
 	if c.config.PrivateKey == "" {
-		_, pk, err := connect.GeneratePrivateKeyWithConfig(c.config.PrivateKeyType, c.config.PrivateKeyBits)
+		signer, err = c.generatePrivateKey(&newState)
 		if err != nil {
 			return "", err
 		}
-		newState.PrivateKey = pk
 	} else {
 		newState.PrivateKey = c.config.PrivateKey
+		signer, err = connect.ParseSigner(newState.PrivateKey)
+		if err != nil {
+			return "", fmt.Errorf("error parsing private key %q: %s", newState.PrivateKey, err)
+		}
 	}
 
 	// Generate the root CA if necessary
@@ -187,7 +249,7 @@ func (c *ConsulProvider) GenerateCAChain() (string, error) {
 			return "", fmt.Errorf("error computing next serial number: %v", err)
 		}
 
-		ca, err := c.generateCA(newState.PrivateKey, nextSerial, c.config.RootCertTTL)
+		ca, err := c.generateCA(signer, nextSerial, c.config.RootCertTTL)
 		if err != nil {
 			return "", fmt.Errorf("error generating CA: %v", err)
 		}
@@ -222,7 +284,8 @@ func (c *ConsulProvider) GenerateIntermediateCSR() (string, string, error) {
 	}
 
 	// Create a new private key and CSR.
-	signer, pk, err := connect.GeneratePrivateKeyWithConfig(c.config.PrivateKeyType, c.config.PrivateKeyBits)
+	newState := *providerState
+	signer, err := c.generatePrivateKey(&newState)
 	if err != nil {
 		return "", "", err
 	}
@@ -233,8 +296,6 @@ func (c *ConsulProvider) GenerateIntermediateCSR() (string, string, error) {
 	}
 
 	// Write the new provider state to the store.
-	newState := *providerState
-	newState.PrivateKey = pk
 	args := &structs.CARequest{
 		Op:            structs.CAOpSetProviderState,
 		ProviderState: &newState,
@@ -243,6 +304,9 @@ func (c *ConsulProvider) GenerateIntermediateCSR() (string, string, error) {
 		return "", "", err
 	}
 
+	// The key of the previous intermediate is no longer referenced.
+	c.deletePKCS11Key(providerState.PKCS11KeyID)
+
 	return csr, "", nil
 }
 
@@ -261,7 +325,11 @@ func (c *ConsulProvider) SetIntermediate(intermediatePEM, rootPEM, _ string) err
 	if err = validateSetIntermediate(intermediatePEM, rootPEM, c.spiffeID); err != nil {
 		return err
 	}
-	if err := validateIntermediateSignedByPrivateKey(intermediatePEM, providerState.PrivateKey); err != nil {
+	signer, err := c.signer(providerState)
+	if err != nil {
+		return err
+	}
+	if err := validateIntermediateSignedByPrivateKey(intermediatePEM, signer); err != nil {
 		return err
 	}
 
@@ -298,6 +366,11 @@ func (c *ConsulProvider) Cleanup(_ bool, _ map[string]interface{}) error {
 	// need to worry about the case where a ca config update is made to
 	// change the cert ttls but leaving the private key and root cert the
 	// same. Changing those would change the id field on the provider.
+	providerState, err := c.Delegate.ProviderState(c.id)
+	if err != nil {
+		return err
+	}
+
 	args := &structs.CARequest{
 		Op:            structs.CAOpDeleteProviderState,
 		ProviderState: &structs.CAConsulProviderState{ID: c.id},
@@ -306,6 +379,9 @@ func (c *ConsulProvider) Cleanup(_ bool, _ map[string]interface{}) error {
 		return err
 	}
 
+	if providerState != nil {
+		c.deletePKCS11Key(providerState.PKCS11KeyID)
+	}
 	return nil
 }
 
@@ -324,12 +400,12 @@ func (c *ConsulProvider) Sign(csr *x509.CertificateRequest) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	if providerState.PrivateKey == "" {
+	if !hasPrivateKey(providerState) {
 		return "", ErrNotInitialized
 	}
 
 	// Create the keyId for the cert from the signing private key.
-	signer, err := connect.ParseSigner(providerState.PrivateKey)
+	signer, err := c.signer(providerState)
 	if err != nil {
 		return "", err
 	}
@@ -412,6 +488,59 @@ func (c *ConsulProvider) Sign(csr *x509.CertificateRequest) (string, error) {
 	return buf.String(), nil
 }
 
+// SignRevocationList returns a revocation list for the given serial numbers
+// signed by the active leaf signing CA.
+func (c *ConsulProvider) SignRevocationList(serials []*big.Int, thisUpdate, nextUpdate time.Time) (string, error) {
+	providerState, err := c.getState()
+	if err != nil {
+		return "", err
+	}
+	if !hasPrivateKey(providerState) {
+		return "", ErrNotInitialized
+	}
+
+	signer, err := c.signer(providerState)
+	if err != nil {
+		return "", err
+	}
+
+	certPEM, err := c.ActiveLeafSigningCert()
+	if err != nil {
+		return "", err
+	}
+	caCert, err := connect.ParseCert(certPEM)
+	if err != nil {
+		return "", fmt.Errorf("error parsing CA cert: %s", err)
+	}
+
+	entries := make([]x509.RevocationListEntry, 0, len(serials))
+	for _, serial := range serials {
+		entries = append(entries, x509.RevocationListEntry{
+			SerialNumber:   serial,
+			RevocationTime: thisUpdate,
+		})
+	}
+
+	template := &x509.RevocationList{
+		SignatureAlgorithm:        connect.SigAlgoForKey(signer),
+		RevokedCertificateEntries: entries,
+		// The list number must increase with every list the CA signs.
+		Number:     big.NewInt(thisUpdate.UnixNano()),
+		ThisUpdate: thisUpdate,
+		NextUpdate: nextUpdate,
+	}
+	bs, err := x509.CreateRevocationList(rand.Reader, template, caCert, signer)
+	if err != nil {
+		return "", fmt.Errorf("error generating revocation list: %s", err)
+	}
+
+	var buf bytes.Buffer
+	if err := pem.Encode(&buf, &pem.Block{Type: "X509 CRL", Bytes: bs}); err != nil {
+		return "", fmt.Errorf("error encoding revocation list: %s", err)
+	}
+	return buf.String(), nil
+}
+
 // SignIntermediate will validate the CSR to ensure the trust domain in the
 // URI SAN matches the local one and that basic constraints for a CA certificate
 // are met. It should return a signed CA certificate with a path length constraint
@@ -428,7 +557,7 @@ func (c *ConsulProvider) SignIntermediate(csr *x509.CertificateRequest) (string,
 	}
 
 	// Get the signing private key.
-	signer, err := connect.ParseSigner(providerState.PrivateKey)
+	signer, err := c.signer(providerState)
 	if err != nil {
 		return "", err
 	}
@@ -509,9 +638,9 @@ func (c *ConsulProvider) CrossSignCA(cert *x509.Certificate) (string, error) {
 		return "", err
 	}
 
-	privKey, err := connect.ParseSigner(providerState.PrivateKey)
+	privKey, err := c.signer(providerState)
 	if err != nil {
-		return "", fmt.Errorf("error parsing private key %q: %s", providerState.PrivateKey, err)
+		return "", err
 	}
 
 	rootCA, err := connect.ParseCert(providerState.RootCert)
@@ -568,6 +697,59 @@ func (c *ConsulProvider) SupportsCrossSigning() (bool, error) {
 	return !c.config.DisableCrossSigning, nil
 }
 
+// generatePrivateKey generates a new private key, either in the PKCS#11 token
+// or in memory, and references it in the given provider state.
+func (c *ConsulProvider) generatePrivateKey(state *structs.CAConsulProviderState) (crypto.Signer, error) {
+	if c.keyStore != nil && !c.EphemeralKeys {
+		id, signer, err := c.keyStore.GenerateKey(c.config.PrivateKeyType, c.config.PrivateKeyBits)
+		if err != nil {
+			return nil, err
+		}
+		state.PrivateKey = ""
+		state.PKCS11KeyID = id
+		return signer, nil
+	}
+
+	signer, pk, err := connect.GeneratePrivateKeyWithConfig(c.config.PrivateKeyType, c.config.PrivateKeyBits)
+	if err != nil {
+		return nil, err
+	}
+	state.PrivateKey = pk
+	state.PKCS11KeyID = ""
+	return signer, nil
+}
+
+// signer returns the signer of the private key referenced by the given
+// provider state.
+func (c *ConsulProvider) signer(state *structs.CAConsulProviderState) (crypto.Signer, error) {
+	if state.PKCS11KeyID == "" {
+		signer, err := connect.ParseSigner(state.PrivateKey)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing private key: %s", err)
+		}
+		return signer, nil
+	}
+	if c.keyStore == nil {
+		return nil, fmt.Errorf("private key is stored in a PKCS#11 token but PKCS11Module is not configured")
+	}
+	return c.keyStore.FindKey(state.PKCS11KeyID)
+}
+
+// deletePKCS11Key deletes a key that is no longer referenced from the PKCS#11
+// token. Failures are only logged since the key is unused.
+func (c *ConsulProvider) deletePKCS11Key(id string) {
+	if id == "" || c.keyStore == nil || c.EphemeralKeys {
+		return
+	}
+	if err := c.keyStore.DeleteKey(id); err != nil {
+		c.logger.Warn("failed to delete private key from PKCS#11 token", "id", id, "error", err)
+	}
+}
+
+func hasPrivateKey(state *structs.CAConsulProviderState) bool {
+	return state.PrivateKey != "" || state.PKCS11KeyID != ""
+}
+
 // getState returns the current provider state from the state delegate, and returns
 // ErrNotInitialized if no entry is found.
 func (c *ConsulProvider) getState() (*structs.CAConsulProviderState, error) {
@@ -597,12 +779,7 @@ func (c *ConsulProvider) incrementAndGetNextSerialNumber() (uint64, error) {
 }
 
 // generateCA makes a new root CA using the current private key
-func (c *ConsulProvider) generateCA(privateKey string, sn uint64, rootCertTTL time.Duration) (string, error) {
-	privKey, err := connect.ParseSigner(privateKey)
-	if err != nil {
-		return "", fmt.Errorf("error parsing private key %q: %s", privateKey, err)
-	}
-
+func (c *ConsulProvider) generateCA(privKey crypto.Signer, sn uint64, rootCertTTL time.Duration) (string, error) {
 	// The URI (SPIFFE compatible) for the cert
 	id := connect.SpiffeIDSigningForCluster(c.clusterID)
 	keyId, err := connect.KeyId(privKey.Public())
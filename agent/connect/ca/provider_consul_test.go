@@ -5,7 +5,9 @@ package ca
 
 import (
 	"crypto/x509"
+	"encoding/pem"
 	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
@@ -255,6 +257,42 @@ func TestConsulCAProvider_SignLeaf(t *testing.T) {
 	}
 }
 
+func TestConsulCAProvider_SignRevocationList(t *testing.T) {
+	t.Parallel()
+
+	conf := testConsulCAConfig()
+	delegate := newMockDelegate(t, conf)
+
+	provider := TestConsulProvider(t, delegate)
+	require.NoError(t, provider.Configure(testProviderConfig(conf)))
+	_, err := provider.GenerateCAChain()
+	require.NoError(t, err)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	crlPEM, err := provider.SignRevocationList([]*big.Int{big.NewInt(10), big.NewInt(27)}, now, now.Add(time.Hour))
+	require.NoError(t, err)
+
+	block, _ := pem.Decode([]byte(crlPEM))
+	require.NotNil(t, block)
+	require.Equal(t, "X509 CRL", block.Type)
+
+	crl, err := x509.ParseRevocationList(block.Bytes)
+	require.NoError(t, err)
+	require.Equal(t, now, crl.ThisUpdate)
+	require.Equal(t, now.Add(time.Hour), crl.NextUpdate)
+	require.Len(t, crl.RevokedCertificateEntries, 2)
+	require.Equal(t, big.NewInt(10), crl.RevokedCertificateEntries[0].SerialNumber)
+	require.Equal(t, big.NewInt(27), crl.RevokedCertificateEntries[1].SerialNumber)
+
+	// The list must be signed by the leaf signing CA.
+	signingPEM, err := provider.ActiveLeafSigningCert()
+	require.NoError(t, err)
+	signing, err := connect.ParseCert(signingPEM)
+	require.NoError(t, err)
+	require.NoError(t, crl.CheckSignatureFrom(signing))
+	require.Equal(t, signing.SubjectKeyId, crl.AuthorityKeyId)
+}
+
 func TestConsulCAProvider_CrossSignCA(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
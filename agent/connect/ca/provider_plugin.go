@@ -0,0 +1,331 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package ca
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os/exec"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-plugin"
+	"github.com/mitchellh/mapstructure"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// PluginHandshake is the handshake configuration shared by Consul and CA
+// provider plugins. The magic cookie only guards against the plugin binary
+// being executed directly, it is not a security measure.
+var PluginHandshake = plugin.HandshakeConfig{
+	ProtocolVersion:  1,
+	MagicCookieKey:   "CONSUL_CA_PROVIDER_PLUGIN",
+	MagicCookieValue: "8c2f5f3e0c5d4ac9b1fdc9c5a4a0d7a4",
+}
+
+// pluginName is the name the CA provider is dispensed under by plugins.
+const pluginName = "ca-provider"
+
+// PluginProvider implements Provider by delegating to an external plugin
+// binary that is launched on Configure and speaks the CA provider gRPC
+// protocol. The CA configuration selects one of the plugins set in the agent
+// configuration by name. If the plugin process exits it is relaunched and
+// reconfigured with the last configuration on the next call.
+type PluginProvider struct {
+	logger  hclog.Logger
+	plugins []structs.CAPlugin
+
+	lock     sync.Mutex
+	stopped  bool
+	binary   *structs.CAPlugin
+	cfg      ProviderConfig
+	client   *plugin.Client
+	provider Provider
+}
+
+var _ Provider = (*PluginProvider)(nil)
+var _ NeedsStop = (*PluginProvider)(nil)
+
+// NewPluginProvider returns a new PluginProvider that may launch the given
+// plugins.
+func NewPluginProvider(logger hclog.Logger, plugins []structs.CAPlugin) *PluginProvider {
+	return &PluginProvider{logger: logger, plugins: plugins}
+}
+
+// Configure implements Provider
+func (p *PluginProvider) Configure(cfg ProviderConfig) error {
+	config, err := ParsePluginCAConfig(cfg.RawConfig)
+	if err != nil {
+		return err
+	}
+	binary, err := p.lookupPlugin(config.Plugin)
+	if err != nil {
+		return err
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.stopped {
+		return errors.New("CA plugin provider is stopped")
+	}
+
+	// A new plugin process is only needed if the way it is launched changed.
+	if p.client != nil && !pluginCommandEqual(p.binary, binary) {
+		p.killLocked()
+	}
+	p.binary = binary
+	p.cfg = cfg
+
+	if p.client == nil || p.client.Exited() {
+		return p.launchLocked()
+	}
+	return p.provider.Configure(cfg)
+}
+
+// launchLocked starts the plugin process and configures the provider it
+// serves. It must be called with the lock held.
+func (p *PluginProvider) launchLocked() error {
+	p.killLocked()
+
+	sum, err := hex.DecodeString(p.binary.SHA256)
+	if err != nil {
+		return fmt.Errorf("invalid SHA256 of CA plugin %q: %w", p.binary.Name, err)
+	}
+
+	client := plugin.NewClient(&plugin.ClientConfig{
+		HandshakeConfig:  PluginHandshake,
+		Plugins:          map[string]plugin.Plugin{pluginName: &caProviderPlugin{}},
+		Cmd:              exec.Command(p.binary.Command, p.binary.Args...),
+		SecureConfig:     &plugin.SecureConfig{Checksum: sum, Hash: sha256.New()},
+		AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC},
+		Logger:           p.logger,
+	})
+
+	rpcClient, err := client.Client()
+	if err != nil {
+		client.Kill()
+		return fmt.Errorf("error launching CA plugin %q: %w", p.binary.Name, err)
+	}
+	raw, err := rpcClient.Dispense(pluginName)
+	if err != nil {
+		client.Kill()
+		return fmt.Errorf("error dispensing CA plugin %q: %w", p.binary.Name, err)
+	}
+	provider := raw.(Provider)
+
+	if err := provider.Configure(p.cfg); err != nil {
+		client.Kill()
+		return err
+	}
+
+	p.client = client
+	p.provider = provider
+	return nil
+}
+
+// killLocked terminates the plugin process if there is one. It must be called
+// with the lock held.
+func (p *PluginProvider) killLocked() {
+	if p.client != nil {
+		p.client.Kill()
+	}
+	p.client = nil
+	p.provider = nil
+}
+
+// plugin returns the provider served by the plugin process, relaunching the
+// process if it exited.
+func (p *PluginProvider) plugin() (Provider, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	switch {
+	case p.stopped:
+		return nil, errors.New("CA plugin provider is stopped")
+	case p.binary == nil:
+		return nil, errors.New("CA plugin provider is not configured")
+	case p.client == nil || p.client.Exited():
+		p.logger.Warn("CA plugin process is not running, relaunching", "plugin", p.binary.Name)
+		if err := p.launchLocked(); err != nil {
+			return nil, err
+		}
+	}
+	return p.provider, nil
+}
+
+// State implements Provider
+func (p *PluginProvider) State() (map[string]string, error) {
+	provider, err := p.plugin()
+	if err != nil {
+		return nil, err
+	}
+	state, err := provider.State()
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the latest state so that a relaunched plugin resumes from it.
+	p.lock.Lock()
+	p.cfg.State = state
+	p.lock.Unlock()
+
+	return state, nil
+}
+
+// GenerateCAChain implements Provider
+func (p *PluginProvider) GenerateCAChain() (string, error) {
+	provider, err := p.plugin()
+	if err != nil {
+		return "", err
+	}
+	return provider.GenerateCAChain()
+}
+
+// ActiveLeafSigningCert implements Provider
+func (p *PluginProvider) ActiveLeafSigningCert() (string, error) {
+	provider, err := p.plugin()
+	if err != nil {
+		return "", err
+	}
+	return provider.ActiveLeafSigningCert()
+}
+
+// Sign implements Provider
+func (p *PluginProvider) Sign(csr *x509.CertificateRequest) (string, error) {
+	provider, err := p.plugin()
+	if err != nil {
+		return "", err
+	}
+	return provider.Sign(csr)
+}
+
+// SignIntermediate implements Provider
+func (p *PluginProvider) SignIntermediate(csr *x509.CertificateRequest) (string, error) {
+	provider, err := p.plugin()
+	if err != nil {
+		return "", err
+	}
+	return provider.SignIntermediate(csr)
+}
+
+// CrossSignCA implements Provider
+func (p *PluginProvider) CrossSignCA(cert *x509.Certificate) (string, error) {
+	provider, err := p.plugin()
+	if err != nil {
+		return "", err
+	}
+	return provider.CrossSignCA(cert)
+}
+
+// SupportsCrossSigning implements Provider
+func (p *PluginProvider) SupportsCrossSigning() (bool, error) {
+	provider, err := p.plugin()
+	if err != nil {
+		return false, err
+	}
+	return provider.SupportsCrossSigning()
+}
+
+// GenerateIntermediateCSR implements Provider
+func (p *PluginProvider) GenerateIntermediateCSR() (string, string, error) {
+	provider, err := p.plugin()
+	if err != nil {
+		return "", "", err
+	}
+	return provider.GenerateIntermediateCSR()
+}
+
+// SetIntermediate implements Provider
+func (p *PluginProvider) SetIntermediate(intermediatePEM, rootPEM, opaque string) error {
+	provider, err := p.plugin()
+	if err != nil {
+		return err
+	}
+	return provider.SetIntermediate(intermediatePEM, rootPEM, opaque)
+}
+
+// Cleanup implements Provider
+func (p *PluginProvider) Cleanup(providerTypeChange bool, otherConfig map[string]interface{}) error {
+	provider, err := p.plugin()
+	if err != nil {
+		return err
+	}
+	return provider.Cleanup(providerTypeChange, otherConfig)
+}
+
+// Stop implements NeedsStop by terminating the plugin process.
+func (p *PluginProvider) Stop() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.stopped = true
+	p.killLocked()
+}
+
+// lookupPlugin returns the plugin with the given name from the agent
+// configuration.
+func (p *PluginProvider) lookupPlugin(name string) (*structs.CAPlugin, error) {
+	for i := range p.plugins {
+		if p.plugins[i].Name == name {
+			return &p.plugins[i], nil
+		}
+	}
+	return nil, fmt.Errorf("CA plugin %q is not set in the connect.ca_plugins configuration of this server", name)
+}
+
+// pluginCommandEqual returns whether two plugins launch the same process.
+func pluginCommandEqual(a, b *structs.CAPlugin) bool {
+	return a.Command == b.Command && a.SHA256 == b.SHA256 && reflect.DeepEqual(a.Args, b.Args)
+}
+
+// pluginLaunchKeys are the keys that set how a plugin binary is launched.
+// They are only accepted in the agent configuration of the plugin, not in the
+// CA configuration, which can be changed through the API.
+var pluginLaunchKeys = []string{"Command", "Args", "SHA256"}
+
+func ParsePluginCAConfig(raw map[string]interface{}) (*structs.PluginCAProviderConfig, error) {
+	for key := range raw {
+		for _, launchKey := range pluginLaunchKeys {
+			if strings.EqualFold(key, launchKey) {
+				return nil, fmt.Errorf("%s can not be set in the CA configuration, set the plugin in the connect.ca_plugins agent configuration and select it with Plugin", launchKey)
+			}
+		}
+	}
+
+	config := structs.PluginCAProviderConfig{
+		CommonCAProviderConfig: defaultCommonConfig(),
+	}
+
+	decodeConf := &mapstructure.DecoderConfig{
+		DecodeHook:       structs.ParseDurationFunc(),
+		Result:           &config,
+		WeaklyTypedInput: true,
+	}
+
+	decoder, err := mapstructure.NewDecoder(decodeConf)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := decoder.Decode(raw); err != nil {
+		return nil, fmt.Errorf("error decoding config: %s", err)
+	}
+
+	if config.Plugin == "" {
+		return nil, fmt.Errorf("must provide the Plugin name of the CA plugin")
+	}
+
+	if err := config.CommonCAProviderConfig.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
@@ -0,0 +1,328 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package ca
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/lib"
+	"github.com/hashicorp/consul/proto/private/pbcaplugin"
+)
+
+// ServePlugin serves the given provider as a CA provider plugin. It is called
+// from the main function of plugin binaries and blocks until Consul
+// terminates the plugin process.
+func ServePlugin(provider Provider) {
+	plugin.Serve(&plugin.ServeConfig{
+		HandshakeConfig: PluginHandshake,
+		Plugins:         map[string]plugin.Plugin{pluginName: &caProviderPlugin{provider: provider}},
+		GRPCServer:      plugin.DefaultGRPCServer,
+	})
+}
+
+// caProviderPlugin implements plugin.GRPCPlugin for CA providers. Only the
+// gRPC protocol is supported.
+type caProviderPlugin struct {
+	plugin.NetRPCUnsupportedPlugin
+
+	// provider is the provider served by the plugin, it is only set in the
+	// plugin process.
+	provider Provider
+}
+
+var _ plugin.GRPCPlugin = (*caProviderPlugin)(nil)
+
+func (p *caProviderPlugin) GRPCServer(_ *plugin.GRPCBroker, s *grpc.Server) error {
+	pbcaplugin.RegisterCAProviderServer(s, &pluginServer{provider: p.provider})
+	return nil
+}
+
+func (p *caProviderPlugin) GRPCClient(_ context.Context, _ *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	return &pluginClient{client: pbcaplugin.NewCAProviderClient(c)}, nil
+}
+
+// pluginClient implements Provider by calling the CA provider gRPC service of
+// a plugin process.
+type pluginClient struct {
+	client pbcaplugin.CAProviderClient
+}
+
+var _ Provider = (*pluginClient)(nil)
+
+func (c *pluginClient) Configure(cfg ProviderConfig) error {
+	rawConfig, err := encodePluginConfig(cfg.RawConfig)
+	if err != nil {
+		return err
+	}
+	_, err = c.client.Configure(context.Background(), &pbcaplugin.ConfigureRequest{
+		ClusterID:  cfg.ClusterID,
+		Datacenter: cfg.Datacenter,
+		IsPrimary:  cfg.IsPrimary,
+		RawConfig:  rawConfig,
+		State:      cfg.State,
+	})
+	return fromPluginError(err)
+}
+
+func (c *pluginClient) State() (map[string]string, error) {
+	resp, err := c.client.State(context.Background(), &pbcaplugin.StateRequest{})
+	if err != nil {
+		return nil, fromPluginError(err)
+	}
+	return resp.State, nil
+}
+
+func (c *pluginClient) GenerateCAChain() (string, error) {
+	resp, err := c.client.GenerateCAChain(context.Background(), &pbcaplugin.GenerateCAChainRequest{})
+	if err != nil {
+		return "", fromPluginError(err)
+	}
+	return resp.PEM, nil
+}
+
+func (c *pluginClient) ActiveLeafSigningCert() (string, error) {
+	resp, err := c.client.ActiveLeafSigningCert(context.Background(), &pbcaplugin.ActiveLeafSigningCertRequest{})
+	if err != nil {
+		return "", fromPluginError(err)
+	}
+	return resp.CertPEM, nil
+}
+
+func (c *pluginClient) Sign(csr *x509.CertificateRequest) (string, error) {
+	resp, err := c.client.Sign(context.Background(), &pbcaplugin.SignRequest{CSR: csr.Raw})
+	if err != nil {
+		return "", fromPluginError(err)
+	}
+	return resp.CertPEM, nil
+}
+
+func (c *pluginClient) SignIntermediate(csr *x509.CertificateRequest) (string, error) {
+	resp, err := c.client.SignIntermediate(context.Background(), &pbcaplugin.SignIntermediateRequest{CSR: csr.Raw})
+	if err != nil {
+		return "", fromPluginError(err)
+	}
+	return resp.CertPEM, nil
+}
+
+func (c *pluginClient) CrossSignCA(cert *x509.Certificate) (string, error) {
+	resp, err := c.client.CrossSignCA(context.Background(), &pbcaplugin.CrossSignCARequest{Cert: cert.Raw})
+	if err != nil {
+		return "", fromPluginError(err)
+	}
+	return resp.CertPEM, nil
+}
+
+func (c *pluginClient) SupportsCrossSigning() (bool, error) {
+	resp, err := c.client.SupportsCrossSigning(context.Background(), &pbcaplugin.SupportsCrossSigningRequest{})
+	if err != nil {
+		return false, fromPluginError(err)
+	}
+	return resp.Supported, nil
+}
+
+func (c *pluginClient) GenerateIntermediateCSR() (string, string, error) {
+	resp, err := c.client.GenerateIntermediateCSR(context.Background(), &pbcaplugin.GenerateIntermediateCSRRequest{})
+	if err != nil {
+		return "", "", fromPluginError(err)
+	}
+	return resp.CSRPEM, resp.Opaque, nil
+}
+
+func (c *pluginClient) SetIntermediate(intermediatePEM, rootPEM, opaque string) error {
+	_, err := c.client.SetIntermediate(context.Background(), &pbcaplugin.SetIntermediateRequest{
+		IntermediatePEM: intermediatePEM,
+		RootPEM:         rootPEM,
+		Opaque:          opaque,
+	})
+	return fromPluginError(err)
+}
+
+func (c *pluginClient) Cleanup(providerTypeChange bool, otherConfig map[string]interface{}) error {
+	rawConfig, err := encodePluginConfig(otherConfig)
+	if err != nil {
+		return err
+	}
+	_, err = c.client.Cleanup(context.Background(), &pbcaplugin.CleanupRequest{
+		ProviderTypeChange: providerTypeChange,
+		OtherConfig:        rawConfig,
+	})
+	return fromPluginError(err)
+}
+
+// pluginServer implements the CA provider gRPC service in a plugin process by
+// calling the provider served by the plugin.
+type pluginServer struct {
+	provider Provider
+}
+
+var _ pbcaplugin.CAProviderServer = (*pluginServer)(nil)
+
+func (s *pluginServer) Configure(_ context.Context, req *pbcaplugin.ConfigureRequest) (*pbcaplugin.ConfigureResponse, error) {
+	rawConfig, err := decodePluginConfig(req.RawConfig)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	err = s.provider.Configure(ProviderConfig{
+		ClusterID:  req.ClusterID,
+		Datacenter: req.Datacenter,
+		IsPrimary:  req.IsPrimary,
+		RawConfig:  rawConfig,
+		State:      req.State,
+	})
+	if err != nil {
+		return nil, toPluginError(err)
+	}
+	return &pbcaplugin.ConfigureResponse{}, nil
+}
+
+func (s *pluginServer) State(context.Context, *pbcaplugin.StateRequest) (*pbcaplugin.StateResponse, error) {
+	state, err := s.provider.State()
+	if err != nil {
+		return nil, toPluginError(err)
+	}
+	return &pbcaplugin.StateResponse{State: state}, nil
+}
+
+func (s *pluginServer) GenerateCAChain(context.Context, *pbcaplugin.GenerateCAChainRequest) (*pbcaplugin.GenerateCAChainResponse, error) {
+	pem, err := s.provider.GenerateCAChain()
+	if err != nil {
+		return nil, toPluginError(err)
+	}
+	return &pbcaplugin.GenerateCAChainResponse{PEM: pem}, nil
+}
+
+func (s *pluginServer) ActiveLeafSigningCert(context.Context, *pbcaplugin.ActiveLeafSigningCertRequest) (*pbcaplugin.ActiveLeafSigningCertResponse, error) {
+	pem, err := s.provider.ActiveLeafSigningCert()
+	if err != nil {
+		return nil, toPluginError(err)
+	}
+	return &pbcaplugin.ActiveLeafSigningCertResponse{CertPEM: pem}, nil
+}
+
+func (s *pluginServer) Sign(_ context.Context, req *pbcaplugin.SignRequest) (*pbcaplugin.SignResponse, error) {
+	csr, err := x509.ParseCertificateRequest(req.CSR)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	pem, err := s.provider.Sign(csr)
+	if err != nil {
+		return nil, toPluginError(err)
+	}
+	return &pbcaplugin.SignResponse{CertPEM: pem}, nil
+}
+
+func (s *pluginServer) SignIntermediate(_ context.Context, req *pbcaplugin.SignIntermediateRequest) (*pbcaplugin.SignIntermediateResponse, error) {
+	csr, err := x509.ParseCertificateRequest(req.CSR)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	pem, err := s.provider.SignIntermediate(csr)
+	if err != nil {
+		return nil, toPluginError(err)
+	}
+	return &pbcaplugin.SignIntermediateResponse{CertPEM: pem}, nil
+}
+
+func (s *pluginServer) CrossSignCA(_ context.Context, req *pbcaplugin.CrossSignCARequest) (*pbcaplugin.CrossSignCAResponse, error) {
+	cert, err := x509.ParseCertificate(req.Cert)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	pem, err := s.provider.CrossSignCA(cert)
+	if err != nil {
+		return nil, toPluginError(err)
+	}
+	return &pbcaplugin.CrossSignCAResponse{CertPEM: pem}, nil
+}
+
+func (s *pluginServer) SupportsCrossSigning(context.Context, *pbcaplugin.SupportsCrossSigningRequest) (*pbcaplugin.SupportsCrossSigningResponse, error) {
+	supported, err := s.provider.SupportsCrossSigning()
+	if err != nil {
+		return nil, toPluginError(err)
+	}
+	return &pbcaplugin.SupportsCrossSigningResponse{Supported: supported}, nil
+}
+
+func (s *pluginServer) GenerateIntermediateCSR(context.Context, *pbcaplugin.GenerateIntermediateCSRRequest) (*pbcaplugin.GenerateIntermediateCSRResponse, error) {
+	csr, opaque, err := s.provider.GenerateIntermediateCSR()
+	if err != nil {
+		return nil, toPluginError(err)
+	}
+	return &pbcaplugin.GenerateIntermediateCSRResponse{CSRPEM: csr, Opaque: opaque}, nil
+}
+
+func (s *pluginServer) SetIntermediate(_ context.Context, req *pbcaplugin.SetIntermediateRequest) (*pbcaplugin.SetIntermediateResponse, error) {
+	if err := s.provider.SetIntermediate(req.IntermediatePEM, req.RootPEM, req.Opaque); err != nil {
+		return nil, toPluginError(err)
+	}
+	return &pbcaplugin.SetIntermediateResponse{}, nil
+}
+
+func (s *pluginServer) Cleanup(_ context.Context, req *pbcaplugin.CleanupRequest) (*pbcaplugin.CleanupResponse, error) {
+	otherConfig, err := decodePluginConfig(req.OtherConfig)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.provider.Cleanup(req.ProviderTypeChange, otherConfig); err != nil {
+		return nil, toPluginError(err)
+	}
+	return &pbcaplugin.CleanupResponse{}, nil
+}
+
+// encodePluginConfig encodes a raw provider configuration as JSON. Byte slices
+// left over from msgpack decoding are converted to strings first.
+func encodePluginConfig(raw map[string]interface{}) ([]byte, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	config, err := lib.MapWalk(raw)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(config)
+}
+
+func decodePluginConfig(data []byte) (map[string]interface{}, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var config map[string]interface{}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// toPluginError converts an error returned by the provider in the plugin
+// process to a gRPC status, preserving ErrRateLimited.
+func toPluginError(err error) error {
+	if errors.Is(err, ErrRateLimited) {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return status.Error(codes.Unknown, err.Error())
+}
+
+// fromPluginError converts a gRPC status returned by the plugin process back
+// to a provider error.
+func fromPluginError(err error) error {
+	if err == nil {
+		return nil
+	}
+	s, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	if s.Code() == codes.ResourceExhausted {
+		return ErrRateLimited
+	}
+	return errors.New(s.Message())
+}
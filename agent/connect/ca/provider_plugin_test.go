@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package ca
+
+import (
+	"bytes"
+	"crypto/x509"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/connect"
+)
+
+func TestParsePluginCAConfig(t *testing.T) {
+	cases := map[string]struct {
+		rawConfig map[string]interface{}
+		expErr    string
+	}{
+		"missing plugin": {
+			rawConfig: map[string]interface{}{},
+			expErr:    "must provide the Plugin name of the CA plugin",
+		},
+		"command": {
+			rawConfig: map[string]interface{}{
+				"Plugin":  "signer",
+				"Command": "/bin/sh",
+			},
+			expErr: "Command can not be set in the CA configuration",
+		},
+		"args": {
+			rawConfig: map[string]interface{}{
+				"Plugin": "signer",
+				"args":   []interface{}{"-c", "id"},
+			},
+			expErr: "Args can not be set in the CA configuration",
+		},
+		"checksum": {
+			rawConfig: map[string]interface{}{
+				"Plugin": "signer",
+				"SHA256": "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			},
+			expErr: "SHA256 can not be set in the CA configuration",
+		},
+		"invalid common config": {
+			rawConfig: map[string]interface{}{
+				"Plugin":         "signer",
+				"PrivateKeyType": "foo",
+			},
+			expErr: "private key type must be either 'ec' or 'rsa'",
+		},
+		"valid": {
+			rawConfig: map[string]interface{}{
+				"Plugin":      "signer",
+				"LeafCertTTL": []byte("24h"),
+				"Endpoint":    "https://signer.example.com",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			config, err := ParsePluginCAConfig(tc.rawConfig)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "signer", config.Plugin)
+			require.Equal(t, 24*time.Hour, config.LeafCertTTL)
+		})
+	}
+}
+
+func testPluginClient(t *testing.T, provider Provider) Provider {
+	client, _ := plugin.TestPluginGRPCConn(t, map[string]plugin.Plugin{
+		pluginName: &caProviderPlugin{provider: provider},
+	})
+	t.Cleanup(func() { client.Close() })
+
+	raw, err := client.Dispense(pluginName)
+	require.NoError(t, err)
+	return raw.(Provider)
+}
+
+func TestPluginProvider_GRPC(t *testing.T) {
+	mockProvider := NewMockProvider(t)
+	client := testPluginClient(t, mockProvider)
+
+	root := connect.TestCA(t, nil)
+	rootCert, err := connect.ParseCert(root.RootCert)
+	require.NoError(t, err)
+
+	rawCSR, _ := connect.TestCSR(t, &connect.SpiffeIDService{
+		Host:       connect.TestClusterID + ".consul",
+		Namespace:  "default",
+		Datacenter: "dc1",
+		Service:    "web",
+	})
+	csr, err := connect.ParseCSR(rawCSR)
+	require.NoError(t, err)
+
+	// The raw configuration is converted to JSON compatible values.
+	mockProvider.On("Configure", mock.MatchedBy(func(cfg ProviderConfig) bool {
+		return cfg.ClusterID == connect.TestClusterID &&
+			cfg.Datacenter == "dc2" &&
+			!cfg.IsPrimary &&
+			cfg.RawConfig["LeafCertTTL"] == "72h" &&
+			cfg.RawConfig["PrivateKeyBits"] == float64(256) &&
+			cfg.State["id"] == "foo"
+	})).Return(nil).Once()
+	require.NoError(t, client.Configure(ProviderConfig{
+		ClusterID:  connect.TestClusterID,
+		Datacenter: "dc2",
+		RawConfig: map[string]interface{}{
+			"LeafCertTTL":    []byte("72h"),
+			"PrivateKeyBits": 256,
+		},
+		State: map[string]string{"id": "foo"},
+	}))
+
+	mockProvider.On("State").Return(map[string]string{"id": "bar"}, nil).Once()
+	state, err := client.State()
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"id": "bar"}, state)
+
+	mockProvider.On("Sign", mock.MatchedBy(func(req *x509.CertificateRequest) bool {
+		return bytes.Equal(req.Raw, csr.Raw)
+	})).Return("leaf", nil).Once()
+	leaf, err := client.Sign(csr)
+	require.NoError(t, err)
+	require.Equal(t, "leaf", leaf)
+
+	mockProvider.On("CrossSignCA", rootCert).Return("cross-signed", nil).Once()
+	xc, err := client.CrossSignCA(rootCert)
+	require.NoError(t, err)
+	require.Equal(t, "cross-signed", xc)
+
+	mockProvider.On("GenerateIntermediateCSR").Return("csr", "opaque", nil).Once()
+	csrPEM, opaque, err := client.GenerateIntermediateCSR()
+	require.NoError(t, err)
+	require.Equal(t, "csr", csrPEM)
+	require.Equal(t, "opaque", opaque)
+
+	mockProvider.On("SetIntermediate", "intermediate", "root", "opaque").Return(nil).Once()
+	require.NoError(t, client.SetIntermediate("intermediate", "root", "opaque"))
+
+	mockProvider.On("Cleanup", true, map[string]interface{}{"Address": "http://vault:8200"}).Return(nil).Once()
+	require.NoError(t, client.Cleanup(true, map[string]interface{}{"Address": "http://vault:8200"}))
+}
+
+func TestPluginProvider_GRPCErrors(t *testing.T) {
+	mockProvider := NewMockProvider(t)
+	client := testPluginClient(t, mockProvider)
+
+	rawCSR, _ := connect.TestCSR(t, &connect.SpiffeIDService{
+		Host:       connect.TestClusterID + ".consul",
+		Namespace:  "default",
+		Datacenter: "dc1",
+		Service:    "web",
+	})
+	csr, err := connect.ParseCSR(rawCSR)
+	require.NoError(t, err)
+
+	// Rate limiting is preserved across the plugin boundary so that callers
+	// can back off.
+	mockProvider.On("Sign", mock.Anything).Return("", ErrRateLimited).Once()
+	_, err = client.Sign(csr)
+	require.ErrorIs(t, err, ErrRateLimited)
+
+	mockProvider.On("SignIntermediate", mock.Anything).
+		Return("", errors.New("signer unavailable: "+ErrRateLimited.Error())).Once()
+	_, err = client.SignIntermediate(csr)
+	require.NotErrorIs(t, err, ErrRateLimited)
+
+	mockProvider.On("GenerateCAChain").Return("", errors.New("HSM is offline")).Once()
+	_, err = client.GenerateCAChain()
+	require.EqualError(t, err, "HSM is offline")
+}
@@ -23,7 +23,7 @@ import (
 // ECDSAWithSHA256 on the basis that it will fail anyway and we've already type
 // checked keys by the time we call this in general.
 func SigAlgoForKey(key crypto.Signer) x509.SignatureAlgorithm {
-	if _, ok := key.(*rsa.PrivateKey); ok {
+	if _, ok := key.Public().(*rsa.PublicKey); ok {
 		return x509.SHA256WithRSA
 	}
 	// We default to ECDSA but don't bother detecting invalid key types as we do
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// JWKSFromRoots returns the JSON Web Key Set containing the public JWT-SVID
+// signing keys of the given CA roots.
+func JWKSFromRoots(roots []*structs.CARoot) (*jose.JSONWebKeySet, error) {
+	jwks := &jose.JSONWebKeySet{Keys: []jose.JSONWebKey{}}
+	for _, root := range roots {
+		if root.JWTPublicKey == "" {
+			continue
+		}
+		block, _ := pem.Decode([]byte(root.JWTPublicKey))
+		if block == nil {
+			return nil, fmt.Errorf("invalid JWT public key for CA root %q", root.ID)
+		}
+		pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing JWT public key for CA root %q: %w", root.ID, err)
+		}
+		jwks.Keys = append(jwks.Keys, jose.JSONWebKey{
+			Key:       pub,
+			KeyID:     root.JWTKeyID,
+			Algorithm: string(jose.ES256),
+			Use:       "sig",
+		})
+	}
+	return jwks, nil
+}
+
+// ValidateJWTSVID checks the signature, expiry and audience of the given
+// JWT-SVID against the signing keys of the given CA roots. It returns the
+// SPIFFE ID of the token and all of its claims.
+func ValidateJWTSVID(token, audience string, roots []*structs.CARoot, now time.Time) (string, map[string]interface{}, error) {
+	if audience == "" {
+		return "", nil, errors.New("audience is required")
+	}
+
+	parsed, err := jwt.ParseSigned(token)
+	if err != nil {
+		return "", nil, fmt.Errorf("error parsing JWT-SVID: %w", err)
+	}
+	if len(parsed.Headers) != 1 {
+		return "", nil, errors.New("JWT-SVID must have exactly one signature")
+	}
+
+	jwks, err := JWKSFromRoots(roots)
+	if err != nil {
+		return "", nil, err
+	}
+	keys := jwks.Key(parsed.Headers[0].KeyID)
+	if len(keys) == 0 {
+		return "", nil, fmt.Errorf("JWT-SVID signed by unknown key %q", parsed.Headers[0].KeyID)
+	}
+
+	var (
+		claims    jwt.Claims
+		allClaims map[string]interface{}
+	)
+	if err := parsed.Claims(keys[0].Key, &claims, &allClaims); err != nil {
+		return "", nil, fmt.Errorf("error verifying JWT-SVID: %w", err)
+	}
+	if claims.Expiry == nil {
+		return "", nil, errors.New("JWT-SVID does not have an expiry")
+	}
+	err = claims.ValidateWithLeeway(jwt.Expected{
+		Audience: jwt.Audience{audience},
+		Time:     now,
+	}, 0)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid JWT-SVID: %w", err)
+	}
+
+	if _, err := ParseCertURIFromString(claims.Subject); err != nil {
+		return "", nil, fmt.Errorf("invalid JWT-SVID subject: %w", err)
+	}
+	return claims.Subject, allClaims, nil
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+func TestJWKSFromRoots(t *testing.T) {
+	signer, _, err := GeneratePrivateKey()
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(signer.Public())
+	require.NoError(t, err)
+
+	withKey := &structs.CARoot{
+		ID:           "with-key",
+		JWTKeyID:     "key-1",
+		JWTPublicKey: string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})),
+	}
+	withoutKey := &structs.CARoot{ID: "without-key"}
+
+	jwks, err := JWKSFromRoots([]*structs.CARoot{withoutKey, withKey})
+	require.NoError(t, err)
+	require.Len(t, jwks.Keys, 1)
+	require.Equal(t, "key-1", jwks.Keys[0].KeyID)
+	require.Equal(t, "ES256", jwks.Keys[0].Algorithm)
+	require.Equal(t, "sig", jwks.Keys[0].Use)
+
+	jwks, err = JWKSFromRoots(nil)
+	require.NoError(t, err)
+	require.NotNil(t, jwks.Keys)
+
+	_, err = JWKSFromRoots([]*structs.CARoot{{ID: "invalid", JWTPublicKey: "not a key"}})
+	require.Error(t, err)
+}
+
+func TestValidateJWTSVID_Invalid(t *testing.T) {
+	_, _, err := ValidateJWTSVID("token", "", nil, time.Now())
+	require.ErrorContains(t, err, "audience is required")
+
+	_, _, err = ValidateJWTSVID("not-a-jwt", "api", nil, time.Now())
+	require.ErrorContains(t, err, "error parsing JWT-SVID")
+}
@@ -191,6 +191,16 @@ func EncodeSerialNumber(serial *big.Int) string {
 	return HexString(serial.Bytes())
 }
 
+// DecodeSerialNumber decodes a hex encoded serial number. The hex digits may
+// be separated by colons like the output of EncodeSerialNumber.
+func DecodeSerialNumber(serial string) (*big.Int, error) {
+	n, ok := new(big.Int).SetString(strings.ReplaceAll(serial, ":", ""), 16)
+	if !ok || n.Sign() < 0 {
+		return nil, fmt.Errorf("invalid serial number %q", serial)
+	}
+	return n, nil
+}
+
 // EncodeSigningKeyID encodes the given AuthorityKeyId or SubjectKeyId into a
 // colon-hex encoded string suitable for using as a SigningKeyID value.
 func EncodeSigningKeyID(keyID []byte) string { return HexString(keyID) }
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+const (
+	// SPIFFEBundleUseX509SVID is the use of a key in a SPIFFE bundle that
+	// holds an X.509 authority of the trust domain.
+	SPIFFEBundleUseX509SVID = "x509-svid"
+
+	// SPIFFEBundleUseJWTSVID is the use of a key in a SPIFFE bundle that holds
+	// a JWT-SVID signing key of the trust domain.
+	SPIFFEBundleUseJWTSVID = "jwt-svid"
+
+	// DefaultSPIFFEBundleRefreshHint is the refresh hint advertised in the
+	// bundles served by Consul.
+	DefaultSPIFFEBundleRefreshHint = 5 * time.Minute
+)
+
+// SPIFFEBundle is a trust bundle in the JWK Set format defined by the SPIFFE
+// Trust Domain and Bundle specification.
+type SPIFFEBundle struct {
+	Keys        []jose.JSONWebKey `json:"keys"`
+	Sequence    uint64            `json:"spiffe_sequence,omitempty"`
+	RefreshHint int64             `json:"spiffe_refresh_hint,omitempty"`
+}
+
+// SPIFFEBundleFromRoots returns the SPIFFE bundle for the given CA roots. It
+// contains an X.509 authority for each root certificate and the public
+// JWT-SVID signing key of each root that has one.
+func SPIFFEBundleFromRoots(roots []*structs.CARoot, sequence uint64, refreshHint time.Duration) (*SPIFFEBundle, error) {
+	bundle := &SPIFFEBundle{
+		Keys:        []jose.JSONWebKey{},
+		Sequence:    sequence,
+		RefreshHint: int64(refreshHint / time.Second),
+	}
+	for _, root := range roots {
+		cert, err := ParseCert(root.RootCert)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing certificate of CA root %q: %w", root.ID, err)
+		}
+		bundle.Keys = append(bundle.Keys, jose.JSONWebKey{
+			Key:          cert.PublicKey,
+			Use:          SPIFFEBundleUseX509SVID,
+			Certificates: []*x509.Certificate{cert},
+		})
+	}
+
+	jwks, err := JWKSFromRoots(roots)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range jwks.Keys {
+		key.Use = SPIFFEBundleUseJWTSVID
+		bundle.Keys = append(bundle.Keys, key)
+	}
+	return bundle, nil
+}
+
+// ParseSPIFFEBundle decodes a SPIFFE bundle. Keys with a use other than
+// x509-svid or jwt-svid are ignored, as required by the specification.
+func ParseSPIFFEBundle(data []byte) (*SPIFFEBundle, error) {
+	var raw struct {
+		Keys        []json.RawMessage `json:"keys"`
+		Sequence    uint64            `json:"spiffe_sequence"`
+		RefreshHint int64             `json:"spiffe_refresh_hint"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("error decoding SPIFFE bundle: %w", err)
+	}
+	if raw.Keys == nil {
+		return nil, errors.New("SPIFFE bundle does not have a keys member")
+	}
+
+	bundle := &SPIFFEBundle{
+		Keys:        []jose.JSONWebKey{},
+		Sequence:    raw.Sequence,
+		RefreshHint: raw.RefreshHint,
+	}
+	for i, data := range raw.Keys {
+		var use struct {
+			Use string `json:"use"`
+		}
+		if err := json.Unmarshal(data, &use); err != nil {
+			return nil, fmt.Errorf("error decoding key %d of SPIFFE bundle: %w", i, err)
+		}
+		if use.Use != SPIFFEBundleUseX509SVID && use.Use != SPIFFEBundleUseJWTSVID {
+			continue
+		}
+
+		var key jose.JSONWebKey
+		if err := key.UnmarshalJSON(data); err != nil {
+			return nil, fmt.Errorf("error decoding key %d of SPIFFE bundle: %w", i, err)
+		}
+		if key.Use == SPIFFEBundleUseX509SVID && len(key.Certificates) != 1 {
+			return nil, fmt.Errorf("x509-svid key %d of SPIFFE bundle must have exactly one certificate", i)
+		}
+		bundle.Keys = append(bundle.Keys, key)
+	}
+	return bundle, nil
+}
+
+// X509Authorities returns the PEM encoded X.509 authorities of the bundle.
+func (b *SPIFFEBundle) X509Authorities() []string {
+	var pems []string
+	for _, key := range b.Keys {
+		if key.Use != SPIFFEBundleUseX509SVID {
+			continue
+		}
+		for _, cert := range key.Certificates {
+			pems = append(pems, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})))
+		}
+	}
+	return pems
+}
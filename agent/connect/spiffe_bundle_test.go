@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+func TestSPIFFEBundle_RoundTrip(t *testing.T) {
+	root := TestCA(t, nil)
+
+	signer, _, err := GeneratePrivateKey()
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(signer.Public())
+	require.NoError(t, err)
+	root.JWTKeyID = "key-1"
+	root.JWTPublicKey = string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+
+	bundle, err := SPIFFEBundleFromRoots([]*structs.CARoot{root}, 42, DefaultSPIFFEBundleRefreshHint)
+	require.NoError(t, err)
+	require.Len(t, bundle.Keys, 2)
+
+	data, err := json.Marshal(bundle)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"spiffe_sequence":42`)
+	require.Contains(t, string(data), `"spiffe_refresh_hint":300`)
+	require.Contains(t, string(data), `"x5c"`)
+
+	parsed, err := ParseSPIFFEBundle(data)
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), parsed.Sequence)
+	require.Equal(t, int64(300), parsed.RefreshHint)
+	require.Len(t, parsed.Keys, 2)
+	require.Equal(t, SPIFFEBundleUseJWTSVID, parsed.Keys[1].Use)
+	require.Equal(t, "key-1", parsed.Keys[1].KeyID)
+
+	authorities := parsed.X509Authorities()
+	require.Len(t, authorities, 1)
+	require.Equal(t, strings.TrimSpace(root.RootCert), strings.TrimSpace(authorities[0]))
+}
+
+func TestParseSPIFFEBundle(t *testing.T) {
+	t.Run("unknown use is ignored", func(t *testing.T) {
+		bundle, err := ParseSPIFFEBundle([]byte(`{"keys":[{"use":"other","kty":"unknown"}]}`))
+		require.NoError(t, err)
+		require.Empty(t, bundle.Keys)
+	})
+
+	t.Run("missing keys", func(t *testing.T) {
+		_, err := ParseSPIFFEBundle([]byte(`{"spiffe_sequence":1}`))
+		require.ErrorContains(t, err, "does not have a keys member")
+	})
+
+	t.Run("x509-svid without certificate", func(t *testing.T) {
+		root := TestCA(t, nil)
+		bundle, err := SPIFFEBundleFromRoots([]*structs.CARoot{root}, 1, time.Minute)
+		require.NoError(t, err)
+		bundle.Keys[0].Certificates = nil
+		data, err := json.Marshal(bundle)
+		require.NoError(t, err)
+
+		_, err = ParseSPIFFEBundle(data)
+		require.ErrorContains(t, err, "exactly one certificate")
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		_, err := ParseSPIFFEBundle([]byte(`not json`))
+		require.Error(t, err)
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/hashicorp/consul/agent/connect"
 	"github.com/hashicorp/consul/agent/consul"
 	"github.com/hashicorp/consul/agent/structs"
 )
@@ -54,6 +55,41 @@ func (s *HTTPHandlers) ConnectCARoots(resp http.ResponseWriter, req *http.Reques
 	return nil, nil
 }
 
+// GET /v1/connect/ca/jwks
+func (s *HTTPHandlers) ConnectCAJWKS(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var args structs.DCSpecificRequest
+	if done := s.parse(resp, req, &args.Datacenter, &args.QueryOptions); done {
+		return nil, nil
+	}
+
+	var reply structs.IndexedCARoots
+	defer setMeta(resp, &reply.QueryMeta)
+	if err := s.agent.RPC(req.Context(), "ConnectCA.Roots", &args, &reply); err != nil {
+		return nil, err
+	}
+
+	return connect.JWKSFromRoots(reply.Roots)
+}
+
+// GET /v1/connect/ca/bundle
+//
+// ConnectCABundle serves the CA roots of the cluster as a SPIFFE bundle so
+// that other trust domains can federate with it.
+func (s *HTTPHandlers) ConnectCABundle(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var args structs.DCSpecificRequest
+	if done := s.parse(resp, req, &args.Datacenter, &args.QueryOptions); done {
+		return nil, nil
+	}
+
+	var reply structs.IndexedCARoots
+	defer setMeta(resp, &reply.QueryMeta)
+	if err := s.agent.RPC(req.Context(), "ConnectCA.Roots", &args, &reply); err != nil {
+		return nil, err
+	}
+
+	return connect.SPIFFEBundleFromRoots(reply.Roots, reply.Index, connect.DefaultSPIFFEBundleRefreshHint)
+}
+
 // /v1/connect/ca/configuration
 func (s *HTTPHandlers) ConnectCAConfiguration(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
 	switch req.Method {
@@ -61,6 +97,9 @@ func (s *HTTPHandlers) ConnectCAConfiguration(resp http.ResponseWriter, req *htt
 		return s.ConnectCAConfigurationGet(resp, req)
 
 	case "PUT":
+		if _, ok := req.URL.Query()["dry-run"]; ok {
+			return s.ConnectCAConfigurationDryRun(req)
+		}
 		return s.ConnectCAConfigurationSet(req)
 
 	default:
@@ -107,3 +146,91 @@ func (s *HTTPHandlers) ConnectCAConfigurationSet(req *http.Request) (interface{}
 	}
 	return nil, err
 }
+
+// PUT /v1/connect/ca/configuration?dry-run
+func (s *HTTPHandlers) ConnectCAConfigurationDryRun(req *http.Request) (interface{}, error) {
+	// Method is tested in ConnectCAConfiguration
+
+	var args structs.CARequest
+	s.parseDC(req, &args.Datacenter)
+	s.parseToken(req, &args.Token)
+	if err := decodeBody(req.Body, &args.Config); err != nil {
+		return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: fmt.Sprintf("Request decode failed: %v", err)}
+	}
+
+	var reply structs.CARotationPlan
+	err := s.agent.RPC(req.Context(), "ConnectCA.ConfigurationDryRun", &args, &reply)
+	if err != nil && err.Error() == consul.ErrStateReadOnly.Error() {
+		return nil, HTTPError{
+			StatusCode: http.StatusBadRequest,
+			Reason: "Provider State is read-only. It must be omitted" +
+				" or identical to the current value",
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// GET /v1/connect/ca/rotation
+func (s *HTTPHandlers) ConnectCARotationStatus(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var args structs.DCSpecificRequest
+	if done := s.parse(resp, req, &args.Datacenter, &args.QueryOptions); done {
+		return nil, nil
+	}
+
+	var reply structs.CARotationStatus
+	defer setMeta(resp, &reply.QueryMeta)
+	if err := s.agent.RPC(req.Context(), "ConnectCA.RotationStatus", &args, &reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// PUT /v1/connect/ca/revoke
+func (s *HTTPHandlers) ConnectCARevoke(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var args structs.CARevocationRequest
+	s.parseDC(req, &args.Datacenter)
+	s.parseToken(req, &args.Token)
+
+	var revocation structs.CARevocation
+	if err := s.parseEntMetaNoWildcard(req, &revocation.EnterpriseMeta); err != nil {
+		return nil, err
+	}
+	if err := decodeBody(req.Body, &revocation); err != nil {
+		return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: fmt.Sprintf("Request decode failed: %v", err)}
+	}
+	if err := revocation.Validate(); err != nil {
+		return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: err.Error()}
+	}
+	args.Revocations = []*structs.CARevocation{&revocation}
+
+	var reply structs.CARevocation
+	if err := s.agent.RPC(req.Context(), "ConnectCA.Revoke", &args, &reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// GET /v1/connect/ca/revocations
+func (s *HTTPHandlers) ConnectCARevocations(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var args structs.DCSpecificRequest
+	if done := s.parse(resp, req, &args.Datacenter, &args.QueryOptions); done {
+		return nil, nil
+	}
+
+	var reply structs.IndexedCARevocations
+	defer setMeta(resp, &reply.QueryMeta)
+	if err := s.agent.RPC(req.Context(), "ConnectCA.Revocations", &args, &reply); err != nil {
+		return nil, err
+	}
+
+	if reply.Revocations == nil {
+		reply.Revocations = make(structs.CARevocations, 0)
+	}
+	if reply.RevocationLists == nil {
+		reply.RevocationLists = make([]*structs.CARevocationList, 0)
+	}
+	return reply, nil
+}
@@ -5,12 +5,18 @@ package agent
 
 import (
 	"bytes"
+	"context"
 	"crypto/x509"
+	"encoding/json"
 	"encoding/pem"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
 
 	"github.com/hashicorp/consul/testrpc"
 
@@ -72,6 +78,70 @@ func TestConnectCARoots_list(t *testing.T) {
 	}
 }
 
+func TestConnectCAJWKS(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	// Rotate the CA so that the keys of both roots are published.
+	connect.TestCAConfigSet(t, a, nil)
+
+	var roots structs.IndexedCARoots
+	require.NoError(t, a.RPC(context.Background(), "ConnectCA.Roots", &structs.DCSpecificRequest{Datacenter: "dc1"}, &roots))
+	require.Len(t, roots.Roots, 2)
+
+	req, _ := http.NewRequest("GET", "/v1/connect/ca/jwks", nil)
+	resp := httptest.NewRecorder()
+	a.srv.h.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	var jwks jose.JSONWebKeySet
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &jwks))
+	require.Len(t, jwks.Keys, 2)
+	for _, r := range roots.Roots {
+		keys := jwks.Key(r.JWTKeyID)
+		require.Len(t, keys, 1)
+		require.True(t, keys[0].IsPublic())
+	}
+}
+
+func TestConnectCABundle(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	var roots structs.IndexedCARoots
+	require.NoError(t, a.RPC(context.Background(), "ConnectCA.Roots", &structs.DCSpecificRequest{Datacenter: "dc1"}, &roots))
+	require.Len(t, roots.Roots, 1)
+
+	req, _ := http.NewRequest("GET", "/v1/connect/ca/bundle", nil)
+	resp := httptest.NewRecorder()
+	a.srv.h.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	bundle, err := connect.ParseSPIFFEBundle(resp.Body.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, roots.Index, bundle.Sequence)
+	require.Equal(t, int64(connect.DefaultSPIFFEBundleRefreshHint/time.Second), bundle.RefreshHint)
+	require.Len(t, bundle.Keys, 2)
+
+	authorities := bundle.X509Authorities()
+	require.Len(t, authorities, 1)
+	require.Equal(t, strings.TrimSpace(roots.Roots[0].RootCert), strings.TrimSpace(authorities[0]))
+}
+
 func TestConnectCAConfig(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -265,6 +335,68 @@ func TestConnectCAConfig(t *testing.T) {
 	}
 }
 
+func TestConnectCAConfig_DryRun(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	body := bytes.NewBufferString(`
+	{
+		"Provider": "consul",
+		"Config": {
+			"PrivateKeyType": "ec",
+			"PrivateKeyBits": 384
+		}
+	}`)
+	req, _ := http.NewRequest("PUT", "/v1/connect/ca/configuration?dry-run", body)
+	resp := httptest.NewRecorder()
+	obj, err := a.srv.ConnectCAConfiguration(resp, req)
+	require.NoError(t, err)
+
+	plan := obj.(structs.CARotationPlan)
+	require.Equal(t, "consul", plan.Provider)
+	require.True(t, plan.RootChanged)
+	require.True(t, plan.CrossSigned)
+	require.NotEqual(t, plan.ActiveRootID, plan.NewRootID)
+
+	// The configuration was not updated.
+	req, _ = http.NewRequest("GET", "/v1/connect/ca/configuration", nil)
+	resp = httptest.NewRecorder()
+	obj, err = a.srv.ConnectCAConfiguration(resp, req)
+	require.NoError(t, err)
+	require.NotContains(t, obj.(structs.CAConfiguration).Config, "PrivateKeyBits")
+}
+
+func TestConnectCARotationStatus(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	req, _ := http.NewRequest("GET", "/v1/connect/ca/rotation", nil)
+	resp := httptest.NewRecorder()
+	obj, err := a.srv.ConnectCARotationStatus(resp, req)
+	require.NoError(t, err)
+	assertIndex(t, resp)
+
+	status := obj.(structs.CARotationStatus)
+	require.Equal(t, "dc1", status.Datacenter)
+	require.NotEmpty(t, status.ActiveRootID)
+	require.Len(t, status.Roots, 1)
+	require.True(t, status.Roots[0].Active)
+}
+
 func TestConnectCARoots_PEMEncoding(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package consul
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-memdb"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// resolveClientCertToken synthesizes the identity of a token that an agent
+// created for a request authenticated by a client certificate binding, along
+// with the index of the tokens table. The first return value is false if the
+// token is not a client certificate token.
+//
+// The identity is linked to the roles and policies of the binding. They are
+// only granted if the agent token that signed the token is linked to them
+// itself, or is allowed to write ACLs and so could create a token for them, so
+// that an agent can't hand out more than it was given. Deleting the agent
+// token or unlinking a role takes effect on the next resolution.
+func (s *Server) resolveClientCertToken(ws memdb.WatchSet, token string) (bool, uint64, *structs.ACLToken, error) {
+	identity, ok := structs.ParseACLClientCertToken(token)
+	if !ok {
+		return false, 0, nil, nil
+	}
+
+	state := s.fsm.State()
+	index, agentToken, err := state.ACLTokenGetByAccessor(ws, identity.AgentTokenAccessorID, nil)
+	if err != nil {
+		return true, index, nil, err
+	}
+	// The secret of the anonymous token is well known, so it can't sign.
+	if agentToken == nil || agentToken.AccessorID == acl.AnonymousTokenID || agentToken.IsExpired(time.Now()) ||
+		!structs.VerifyACLClientCertToken(token, agentToken.SecretID) {
+		return true, index, nil, acl.ErrNotFound
+	}
+
+	var aclWrite bool
+	if authz, err := s.ResolveToken(agentToken.SecretID); err == nil {
+		var authzContext acl.AuthorizerContext
+		agentToken.EnterpriseMeta.FillAuthzContext(&authzContext)
+		aclWrite = authz.ACLWrite(&authzContext) == acl.Allow
+	}
+
+	synthesized := &structs.ACLToken{
+		AccessorID:     structs.ACLClientCertAccessorID(token),
+		SecretID:       token,
+		Description:    fmt.Sprintf("synthesized for client certificate binding %q on node %q", identity.Binding, identity.Node),
+		Local:          agentToken.Local,
+		CreateTime:     agentToken.CreateTime,
+		EnterpriseMeta: agentToken.EnterpriseMeta,
+	}
+
+	for _, name := range identity.Roles {
+		_, role, err := state.ACLRoleGetByName(ws, name, &agentToken.EnterpriseMeta)
+		if err != nil {
+			return true, index, nil, err
+		}
+		if role == nil {
+			return true, index, nil, fmt.Errorf("client certificate binding %q: role %q does not exist: %w", identity.Binding, name, acl.ErrNotFound)
+		}
+		if !aclWrite && !hasRoleLink(agentToken.Roles, role.ID) {
+			return true, index, nil, acl.PermissionDeniedError{
+				Cause: fmt.Sprintf("the agent token of node %q is not linked to role %q of client certificate binding %q", identity.Node, name, identity.Binding),
+			}
+		}
+		synthesized.Roles = append(synthesized.Roles, structs.ACLTokenRoleLink{ID: role.ID, Name: role.Name})
+	}
+
+	for _, name := range identity.Policies {
+		_, policy, err := state.ACLPolicyGetByName(ws, name, &agentToken.EnterpriseMeta)
+		if err != nil {
+			return true, index, nil, err
+		}
+		if policy == nil {
+			return true, index, nil, fmt.Errorf("client certificate binding %q: policy %q does not exist: %w", identity.Binding, name, acl.ErrNotFound)
+		}
+		if !aclWrite && !hasPolicyLink(agentToken.Policies, policy.ID) {
+			return true, index, nil, acl.PermissionDeniedError{
+				Cause: fmt.Sprintf("the agent token of node %q is not linked to policy %q of client certificate binding %q", identity.Node, name, identity.Binding),
+			}
+		}
+		synthesized.Policies = append(synthesized.Policies, structs.ACLTokenPolicyLink{ID: policy.ID, Name: policy.Name})
+	}
+
+	synthesized.SetHash(true)
+	return true, index, synthesized, nil
+}
+
+func hasRoleLink(links []structs.ACLTokenRoleLink, id string) bool {
+	for _, link := range links {
+		if link.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func hasPolicyLink(links []structs.ACLTokenPolicyLink, id string) bool {
+	for _, link := range links {
+		if link.ID == id {
+			return true
+		}
+	}
+	return false
+}
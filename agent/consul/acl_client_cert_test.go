@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package consul
+
+import (
+	"testing"
+
+	msgpackrpc "github.com/hashicorp/consul-net-rpc/net-rpc-msgpackrpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+func TestServer_resolveClientCertToken(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	_, srv, codec := testACLServerWithConfig(t, nil, false)
+	waitForLeaderEstablishment(t, srv)
+
+	// The agent token is linked to the "bound" policy, but not to "other".
+	agentToken := createTokenWithPolicyNameFull(t, codec, "bound", `node_prefix "" { policy = "read" }`, TestDefaultInitialManagementToken)
+	_, err := upsertTestCustomizedPolicy(codec, TestDefaultInitialManagementToken, "dc1", func(policy *structs.ACLPolicy) {
+		policy.Name = "other"
+		policy.Rules = `key_prefix "" { policy = "read" }`
+	})
+	require.NoError(t, err)
+
+	newToken := func(t *testing.T, accessorID, secretID string, policies ...string) string {
+		identity := structs.ACLClientCertIdentity{
+			AgentTokenAccessorID: accessorID,
+			Node:                 "node1",
+			Binding:              "operators",
+			Policies:             policies,
+		}
+		token, err := identity.Token(secretID)
+		require.NoError(t, err)
+		return token
+	}
+
+	t.Run("linked policy", func(t *testing.T) {
+		token := newToken(t, agentToken.AccessorID, agentToken.SecretID, "bound")
+
+		authz, err := srv.ResolveToken(token)
+		require.NoError(t, err)
+		require.Equal(t, acl.Allow, authz.NodeRead("node1", nil))
+		require.Equal(t, acl.Deny, authz.KeyRead("foo", nil))
+
+		// The identity is synthesized, not stored.
+		var out structs.ACLTokenResponse
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "ACL.TokenRead", &structs.ACLTokenGetRequest{
+			Datacenter:   "dc1",
+			TokenID:      token,
+			TokenIDType:  structs.ACLTokenSecret,
+			QueryOptions: structs.QueryOptions{Token: token},
+		}, &out))
+		require.NotNil(t, out.Token)
+		require.Equal(t, structs.ACLClientCertAccessorID(token), out.Token.AccessorID)
+		require.Equal(t, "bound", out.Token.Policies[0].Name)
+
+		_, stored, err := srv.fsm.State().ACLTokenGetByAccessor(nil, out.Token.AccessorID, nil)
+		require.NoError(t, err)
+		require.Nil(t, stored)
+	})
+
+	t.Run("unlinked policy", func(t *testing.T) {
+		token := newToken(t, agentToken.AccessorID, agentToken.SecretID, "other")
+		_, err := srv.ResolveToken(token)
+		require.True(t, acl.IsErrPermissionDenied(err), "unexpected error: %v", err)
+	})
+
+	t.Run("agent token that can write ACLs", func(t *testing.T) {
+		_, management, err := srv.fsm.State().ACLTokenGetBySecret(nil, TestDefaultInitialManagementToken, nil)
+		require.NoError(t, err)
+
+		token := newToken(t, management.AccessorID, management.SecretID, "other")
+		authz, err := srv.ResolveToken(token)
+		require.NoError(t, err)
+		require.Equal(t, acl.Allow, authz.KeyRead("foo", nil))
+		require.Equal(t, acl.Deny, authz.NodeRead("node1", nil))
+	})
+
+	t.Run("forged signature", func(t *testing.T) {
+		token := newToken(t, agentToken.AccessorID, "not-the-agent-token", "bound")
+		_, err := srv.ResolveToken(token)
+		require.True(t, acl.IsErrNotFound(err), "unexpected error: %v", err)
+	})
+
+	t.Run("deleted agent token", func(t *testing.T) {
+		token := newToken(t, agentToken.AccessorID, agentToken.SecretID, "bound")
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "ACL.TokenDelete", &structs.ACLTokenDeleteRequest{
+			Datacenter:   "dc1",
+			TokenID:      agentToken.AccessorID,
+			WriteRequest: structs.WriteRequest{Token: TestDefaultInitialManagementToken},
+		}, new(string)))
+
+		_, err := srv.ResolveToken(token)
+		require.True(t, acl.IsErrNotFound(err), "unexpected error: %v", err)
+	})
+}
@@ -312,6 +312,13 @@ func (a *ACL) TokenRead(args *structs.ACLTokenGetRequest, reply *structs.ACLToke
 						reply.Redacted = true
 					}
 				}
+			} else if ok, tokensIndex, synthesized, resolveErr := a.srv.resolveClientCertToken(ws, args.TokenID); ok {
+				// Tokens of client certificate bindings aren't stored, their
+				// identity is synthesized from the agent token.
+				index, token = tokensIndex, synthesized
+				if resolveErr != nil && !acl.IsErrNotFound(resolveErr) {
+					err = resolveErr
+				}
 			} else {
 				index, token, err = state.ACLTokenGetBySecret(ws, args.TokenID, nil)
 				// no extra validation is needed here. If you have the secret ID you can read it.
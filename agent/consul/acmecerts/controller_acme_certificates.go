@@ -0,0 +1,306 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package acmecerts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/hashicorp/consul/agent/consul/controller"
+	"github.com/hashicorp/consul/agent/consul/fsm"
+	"github.com/hashicorp/consul/agent/consul/state"
+	"github.com/hashicorp/consul/agent/consul/stream"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+)
+
+const (
+	// retryInterval is how long to wait before ordering a certificate again
+	// after a failed attempt. ACME servers limit the number of failed
+	// validations, so this is deliberately long.
+	retryInterval = 10 * time.Minute
+
+	// orderTimeout bounds a single order, from the registration of the
+	// account to the download of the certificate.
+	orderTimeout = 5 * time.Minute
+
+	// challengePropagationDelay is how long to wait for the API gateways to
+	// serve the responses to HTTP-01 challenges before asking the ACME
+	// server to validate them.
+	challengePropagationDelay = 5 * time.Second
+
+	// dnsHookTimeout bounds a single run of the DNS hook.
+	dnsHookTimeout = 2 * time.Minute
+)
+
+// orderState is what the reconciler remembers about a certificate between
+// reconciliations. It is not persisted, so a new leader orders every
+// certificate that is due once when it takes over.
+type orderState struct {
+	lastAttempt time.Time
+}
+
+// acmeCertificateReconciler orders the certificates of acme-certificate
+// config entries from their ACME directories and stores them in the entries.
+type acmeCertificateReconciler struct {
+	fsm     *fsm.FSM
+	logger  hclog.Logger
+	updater *controller.Updater
+	issuer  issuer
+	now     func() time.Time
+
+	// dnsHook is the executable that creates and removes the TXT records of
+	// dns-01 challenges.
+	dnsHook string
+
+	// propagationDelay is how long to wait for HTTP-01 challenge responses
+	// to reach the gateways.
+	propagationDelay time.Duration
+
+	lock  sync.Mutex
+	state map[string]orderState
+}
+
+// NewACMECertificateController initializes a controller that orders and
+// renews the certificates of all acme-certificate config entries. It must
+// only be run in the primary datacenter, the certificates are replicated to
+// secondary datacenters with the entries.
+func NewACMECertificateController(fsm *fsm.FSM, publisher state.EventPublisher, updater *controller.Updater, dnsHook string, logger hclog.Logger) controller.Controller {
+	reconciler := &acmeCertificateReconciler{
+		fsm:              fsm,
+		logger:           logger,
+		updater:          updater,
+		issuer:           acmeIssuer{},
+		now:              time.Now,
+		dnsHook:          dnsHook,
+		propagationDelay: challengePropagationDelay,
+		state:            make(map[string]orderState),
+	}
+	return controller.New(publisher, reconciler).
+		WithLogger(logger.With("controller", "acmeCertificateController")).
+		Subscribe(&stream.SubscribeRequest{
+			Topic:   state.EventTopicACMECertificate,
+			Subject: stream.SubjectWildcard,
+		})
+}
+
+// Reconcile orders the certificate of a single acme-certificate config
+// entry if it has not been issued yet, no longer covers the domains of the
+// entry, or is due to be renewed. A RequeueAfterError is returned so that
+// the entry is reconciled again when the certificate is due for renewal.
+func (r *acmeCertificateReconciler) Reconcile(ctx context.Context, req controller.Request) error {
+	if req.Kind != structs.ACMECertificate {
+		return nil
+	}
+
+	logger := r.logger.With("acme-certificate", req.Name, "namespace", req.Meta.NamespaceOrDefault(), "partition", req.Meta.PartitionOrDefault())
+	key := req.Meta.PartitionOrDefault() + "/" + req.Meta.NamespaceOrDefault() + "/" + req.Name
+
+	_, raw, err := r.fsm.State().ConfigEntry(nil, req.Kind, req.Name, req.Meta)
+	if err != nil {
+		logger.Warn("error fetching config entry for reconciliation request", "error", err)
+		return err
+	}
+	entry, _ := raw.(*structs.ACMECertificateConfigEntry)
+	if entry == nil {
+		r.lock.Lock()
+		delete(r.state, key)
+		r.lock.Unlock()
+		return nil
+	}
+
+	now := r.now().UTC()
+
+	cert, err := entry.IssuedCertificate()
+	if err != nil {
+		logger.Warn("error parsing issued certificate, ordering a new one", "error", err)
+	} else if cert != nil && entry.CoversDomains(cert) {
+		if renewAt := entry.RenewAt(cert); now.Before(renewAt) {
+			return controller.RequeueAfter(renewAt.Sub(now))
+		}
+	}
+
+	r.lock.Lock()
+	st := r.state[key]
+	r.lock.Unlock()
+	if retry := st.lastAttempt.Add(retryInterval); now.Before(retry) {
+		return controller.RequeueAfter(retry.Sub(now))
+	}
+	st.lastAttempt = now
+	r.lock.Lock()
+	r.state[key] = st
+	r.lock.Unlock()
+
+	entry = entry.DeepCopy()
+
+	orderCtx, cancel := context.WithTimeout(ctx, orderTimeout)
+	defer cancel()
+	logger.Debug("ordering certificate", "domains", entry.Domains)
+	result, err := r.issuer.issue(orderCtx, entry, func(ctx context.Context, challenges []pendingChallenge) (func(), error) {
+		return r.present(ctx, logger, entry, challenges)
+	})
+
+	updater := structs.NewStatusUpdater(entry)
+	entry.Challenges = nil
+	if err != nil {
+		logger.Warn("error ordering certificate", "error", err)
+
+		reason := api.ACMECertificateReasonOrderFailed
+		var challengeErr *challengeError
+		if errors.As(err, &challengeErr) {
+			reason = api.ACMECertificateReasonChallengeFailed
+		}
+		updater.SetCondition(condition(entry, api.ConditionStatusFalse, reason, err.Error(), now))
+		updater.UpdateEntry()
+		if err := r.persist(entry); err != nil {
+			logger.Warn("error persisting certificate status", "error", err)
+			return err
+		}
+		return controller.RequeueAfter(retryInterval)
+	}
+
+	entry.Certificate = result.Certificate
+	entry.PrivateKey = result.PrivateKey
+	entry.AccountKey = result.AccountKey
+	cert, err = entry.IssuedCertificate()
+	if err != nil || cert == nil {
+		// This would be a bug in the ACME server, it is retried like any
+		// other failed order.
+		logger.Warn("ACME server issued an invalid certificate", "error", err)
+		return controller.RequeueAfter(retryInterval)
+	}
+
+	r.lock.Lock()
+	delete(r.state, key)
+	r.lock.Unlock()
+
+	updater.SetCondition(condition(entry, api.ConditionStatusTrue, api.ACMECertificateReasonIssued,
+		fmt.Sprintf("issued certificate expiring at %s", cert.NotAfter.UTC().Format(time.RFC3339)), now))
+	updater.UpdateEntry()
+
+	logger.Info("persisting issued certificate", "serial", cert.SerialNumber, "expires", cert.NotAfter)
+	if err := r.persist(entry); err != nil {
+		logger.Warn("error persisting issued certificate", "error", err)
+		return err
+	}
+	return controller.RequeueAfter(entry.RenewAt(cert).Sub(now))
+}
+
+// present makes the responses to the challenges of an order available to the
+// ACME server. The responses to HTTP-01 challenges are stored in the entry so
+// that the API gateways referencing it serve them, the TXT records of DNS-01
+// challenges are created by the DNS hook.
+func (r *acmeCertificateReconciler) present(ctx context.Context, logger hclog.Logger, entry *structs.ACMECertificateConfigEntry, challenges []pendingChallenge) (func(), error) {
+	if entry.Challenge == structs.ACMEChallengeDNS01 {
+		return r.presentDNS(ctx, logger, challenges)
+	}
+
+	entry.Challenges = make([]structs.ACMEChallenge, 0, len(challenges))
+	for _, c := range challenges {
+		entry.Challenges = append(entry.Challenges, structs.ACMEChallenge{
+			Domain:           c.Domain,
+			Token:            c.Token,
+			KeyAuthorization: c.Response,
+		})
+	}
+	if err := r.persist(entry); err != nil {
+		return nil, fmt.Errorf("error storing challenges: %w", err)
+	}
+
+	// The entry is written again once the order completes, which requires
+	// the index of the write above.
+	_, latest, err := r.fsm.State().ConfigEntry(nil, entry.Kind, entry.Name, &entry.EnterpriseMeta)
+	if err != nil {
+		return nil, err
+	}
+	if latest == nil {
+		return nil, errors.New("config entry was deleted")
+	}
+	entry.RaftIndex = *latest.GetRaftIndex()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(r.propagationDelay):
+	}
+	// The challenges are removed from the entry when its result is stored.
+	return func() {}, nil
+}
+
+// presentDNS runs the DNS hook to create the TXT records of DNS-01
+// challenges. The hook is called with the action, the name of the record and
+// its value, for instance:
+//
+//	hook present _acme-challenge.example.com. <value>
+//
+// It must only return once the record is visible to the ACME server.
+func (r *acmeCertificateReconciler) presentDNS(ctx context.Context, logger hclog.Logger, challenges []pendingChallenge) (func(), error) {
+	if r.dnsHook == "" {
+		return nil, errors.New("dns-01 challenges require connect.acme_dns_hook to be set on the servers")
+	}
+
+	var presented []pendingChallenge
+	cleanup := func() {
+		for _, c := range presented {
+			if err := r.runDNSHook(context.Background(), "cleanup", c); err != nil {
+				logger.Warn("error removing DNS challenge record", "domain", c.Domain, "error", err)
+			}
+		}
+	}
+	for _, c := range challenges {
+		if err := r.runDNSHook(ctx, "present", c); err != nil {
+			cleanup()
+			return nil, fmt.Errorf("error creating DNS challenge record for %q: %w", c.Domain, err)
+		}
+		presented = append(presented, c)
+	}
+	return cleanup, nil
+}
+
+func (r *acmeCertificateReconciler) runDNSHook(ctx context.Context, action string, c pendingChallenge) error {
+	ctx, cancel := context.WithTimeout(ctx, dnsHookTimeout)
+	defer cancel()
+
+	fqdn := "_acme-challenge." + strings.TrimSuffix(c.Domain, ".") + "."
+	out, err := exec.CommandContext(ctx, r.dnsHook, action, fqdn, c.Response).CombinedOutput()
+	if err != nil {
+		if len(out) > 0 {
+			return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(out)))
+		}
+		return err
+	}
+	return nil
+}
+
+// persist writes the entry after recomputing its hash, so that the change is
+// picked up by config entry replication.
+func (r *acmeCertificateReconciler) persist(entry structs.ControlledConfigEntry) error {
+	if err := entry.Normalize(); err != nil {
+		return err
+	}
+	_, err := r.updater.UpdateWithStatus(entry)
+	return err
+}
+
+// condition builds the Issued condition of the certificate, keeping the
+// transition time of the existing condition if its status is unchanged.
+func condition(entry *structs.ACMECertificateConfigEntry, status api.ConditionStatus, reason api.ACMECertificateConditionReason, message string, now time.Time) structs.Condition {
+	condition := structs.NewACMECertificateCondition(api.ACMECertificateConditionIssued, status, reason, message)
+	condition.LastTransitionTime = &now
+
+	for _, existing := range entry.Status.Conditions {
+		if existing.Type == string(api.ACMECertificateConditionIssued) &&
+			existing.Status == string(status) && existing.LastTransitionTime != nil {
+			condition.LastTransitionTime = existing.LastTransitionTime
+		}
+	}
+	return condition
+}
@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package acmecerts
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/hashicorp/consul/agent/consul/controller"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+)
+
+const testLifetime = 90 * 24 * time.Hour
+
+func TestACMECertificateReconciler_HTTP01(t *testing.T) {
+	t.Parallel()
+
+	h := newACMEHarness(t, &structs.ACMECertificateConfigEntry{
+		Kind:      structs.ACMECertificate,
+		Name:      "example",
+		Directory: "https://acme.example.com/directory",
+		Domains:   []string{"example.com", "www.example.com"},
+	})
+
+	// The certificate is ordered straight away and renewed 30 days before it
+	// expires.
+	h.requireRequeue(testLifetime - structs.DefaultACMERenewBefore)
+	require.Equal(t, 1, h.issuer.calls)
+	h.requireCondition(api.ConditionStatusTrue, api.ACMECertificateReasonIssued)
+
+	// The responses to the challenges are stored in the entry while the
+	// order is pending so that the gateways serve them.
+	require.Equal(t, []structs.ACMEChallenge{
+		{Domain: "example.com", Token: "token-0", KeyAuthorization: "token-0.thumbprint"},
+		{Domain: "www.example.com", Token: "token-1", KeyAuthorization: "token-1.thumbprint"},
+	}, h.issuer.presented)
+
+	entry := h.entry()
+	require.Empty(t, entry.Challenges)
+	require.Equal(t, h.issuer.issuedAccountKey, entry.AccountKey)
+	cert, err := entry.IssuedCertificate()
+	require.NoError(t, err)
+	require.Equal(t, []string{"example.com", "www.example.com"}, cert.DNSNames)
+	require.NotNil(t, entry.InlineCertificate())
+
+	// The certificate is not ordered again until it is due.
+	h.Advance(24 * time.Hour)
+	h.requireRequeue(testLifetime - structs.DefaultACMERenewBefore - 24*time.Hour)
+	require.Equal(t, 1, h.issuer.calls)
+
+	h.Advance(testLifetime - structs.DefaultACMERenewBefore)
+	h.requireRequeue(testLifetime - structs.DefaultACMERenewBefore)
+	require.Equal(t, 2, h.issuer.calls)
+	require.Equal(t, h.issuer.issuedAccountKey, h.issuer.accountKey)
+	renewed, err := h.entry().IssuedCertificate()
+	require.NoError(t, err)
+	require.True(t, renewed.NotAfter.After(cert.NotAfter))
+
+	// Changing the domains orders a new certificate.
+	entry = h.entry()
+	entry.Domains = append(entry.Domains, "api.example.com")
+	h.Write(entry)
+	h.requireRequeue(testLifetime - structs.DefaultACMERenewBefore)
+	require.Equal(t, 3, h.issuer.calls)
+	cert, err = h.entry().IssuedCertificate()
+	require.NoError(t, err)
+	require.Contains(t, cert.DNSNames, "api.example.com")
+}
+
+func TestACMECertificateReconciler_RenewBefore(t *testing.T) {
+	t.Parallel()
+
+	h := newACMEHarness(t, &structs.ACMECertificateConfigEntry{
+		Kind:        structs.ACMECertificate,
+		Name:        "example",
+		Directory:   "https://acme.example.com/directory",
+		Domains:     []string{"example.com"},
+		RenewBefore: 7 * 24 * time.Hour,
+	})
+	h.requireRequeue(testLifetime - 7*24*time.Hour)
+
+	// Renewing a short lived certificate is capped to a third of its
+	// lifetime.
+	h.issuer.lifetime = 6 * 24 * time.Hour
+	h.Advance(testLifetime - 7*24*time.Hour)
+	h.requireRequeue(4 * 24 * time.Hour)
+}
+
+func TestACMECertificateReconciler_OrderFailed(t *testing.T) {
+	t.Parallel()
+
+	h := newACMEHarness(t, &structs.ACMECertificateConfigEntry{
+		Kind:      structs.ACMECertificate,
+		Name:      "example",
+		Directory: "https://acme.example.com/directory",
+		Domains:   []string{"example.com"},
+	})
+	h.issuer.err = errors.New("rate limited")
+	h.requireRequeue(retryInterval)
+	h.requireCondition(api.ConditionStatusFalse, api.ACMECertificateReasonOrderFailed)
+	require.Empty(t, h.entry().Certificate)
+
+	// Writing the status does not cause the ACME server to be hammered.
+	h.Advance(time.Second)
+	h.requireRequeue(retryInterval - time.Second)
+	require.Equal(t, 1, h.issuer.calls)
+
+	h.issuer.err = &challengeError{errors.New("connection refused")}
+	h.Advance(retryInterval)
+	h.requireRequeue(retryInterval)
+	h.requireCondition(api.ConditionStatusFalse, api.ACMECertificateReasonChallengeFailed)
+	require.Empty(t, h.entry().Challenges)
+
+	h.issuer.err = nil
+	h.Advance(retryInterval)
+	h.requireRequeue(testLifetime - structs.DefaultACMERenewBefore)
+	h.requireCondition(api.ConditionStatusTrue, api.ACMECertificateReasonIssued)
+	require.Equal(t, 3, h.issuer.calls)
+}
+
+func TestACMECertificateReconciler_DNS01(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	calls := filepath.Join(dir, "calls")
+	hook := filepath.Join(dir, "hook")
+	require.NoError(t, os.WriteFile(hook, []byte(fmt.Sprintf("#!/bin/sh\necho \"$@\" >> %s\n", calls)), 0700))
+
+	h := newACMEHarness(t, &structs.ACMECertificateConfigEntry{
+		Kind:      structs.ACMECertificate,
+		Name:      "example",
+		Directory: "https://acme.example.com/directory",
+		Domains:   []string{"*.example.com"},
+		Challenge: structs.ACMEChallengeDNS01,
+	})
+	h.reconciler.dnsHook = hook
+	h.requireRequeue(testLifetime - structs.DefaultACMERenewBefore)
+	h.requireCondition(api.ConditionStatusTrue, api.ACMECertificateReasonIssued)
+	require.Empty(t, h.issuer.presented)
+
+	out, err := os.ReadFile(calls)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"present _acme-challenge.example.com. token-0.thumbprint",
+		"cleanup _acme-challenge.example.com. token-0.thumbprint",
+	}, strings.Split(strings.TrimSpace(string(out)), "\n"))
+}
+
+func TestACMECertificateReconciler_DNS01WithoutHook(t *testing.T) {
+	t.Parallel()
+
+	h := newACMEHarness(t, &structs.ACMECertificateConfigEntry{
+		Kind:      structs.ACMECertificate,
+		Name:      "example",
+		Directory: "https://acme.example.com/directory",
+		Domains:   []string{"example.com"},
+		Challenge: structs.ACMEChallengeDNS01,
+	})
+	h.requireRequeue(retryInterval)
+	h.requireCondition(api.ConditionStatusFalse, api.ACMECertificateReasonChallengeFailed)
+	require.Contains(t, h.entry().Status.Conditions[0].Message, "acme_dns_hook")
+}
+
+// fakeIssuer issues self-signed certificates after presenting one challenge
+// per domain.
+type fakeIssuer struct {
+	h        *acmeHarness
+	err      error
+	lifetime time.Duration
+	calls    int
+
+	// issuedAccountKey is the account key returned with the certificates,
+	// accountKey the one of the last order.
+	issuedAccountKey string
+	accountKey       string
+
+	// presented are the challenges stored in the entry while the last order
+	// was pending.
+	presented []structs.ACMEChallenge
+}
+
+func (f *fakeIssuer) issue(ctx context.Context, entry *structs.ACMECertificateConfigEntry, present presentFunc) (*issued, error) {
+	f.calls++
+	f.accountKey = entry.AccountKey
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	var pending []pendingChallenge
+	for i, domain := range entry.Domains {
+		token := fmt.Sprintf("token-%d", i)
+		pending = append(pending, pendingChallenge{
+			Type:     entry.Challenge,
+			Domain:   strings.TrimPrefix(domain, "*."),
+			Token:    token,
+			Response: token + ".thumbprint",
+		})
+	}
+	cleanup, err := present(ctx, pending)
+	if err != nil {
+		return nil, &challengeError{err}
+	}
+	f.presented = f.h.entry().Challenges
+	cleanup()
+
+	cert, key := testCertificate(f.h.t, entry.Domains, f.h.Now(), f.h.Now().Add(f.lifetime))
+	return &issued{Certificate: cert, PrivateKey: key, AccountKey: f.issuedAccountKey}, nil
+}
+
+type acmeHarness struct {
+	*controller.TestHarness
+	t          *testing.T
+	reconciler *acmeCertificateReconciler
+	issuer     *fakeIssuer
+	name       string
+}
+
+func newACMEHarness(t *testing.T, entry *structs.ACMECertificateConfigEntry) *acmeHarness {
+	t.Helper()
+
+	h := &acmeHarness{TestHarness: controller.NewTestHarness(t), t: t, name: entry.Name}
+	accountKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	accountKeyPEM, err := encodeKey(accountKey)
+	require.NoError(t, err)
+	h.issuer = &fakeIssuer{h: h, lifetime: testLifetime, issuedAccountKey: accountKeyPEM}
+	h.reconciler = &acmeCertificateReconciler{
+		fsm:     h.FSM(),
+		logger:  hclog.New(nil),
+		updater: h.Updater(),
+		issuer:  h.issuer,
+		now:     h.Now,
+		state:   make(map[string]orderState),
+	}
+
+	h.Write(entry)
+	return h
+}
+
+func (h *acmeHarness) reconcile() error {
+	return h.Reconcile(h.reconciler, structs.ACMECertificate, h.name)
+}
+
+func (h *acmeHarness) requireRequeue(after time.Duration) {
+	h.t.Helper()
+	h.RequireRequeue(h.reconciler, structs.ACMECertificate, h.name, after)
+}
+
+func (h *acmeHarness) entry() *structs.ACMECertificateConfigEntry {
+	h.t.Helper()
+	return h.Entry(structs.ACMECertificate, h.name).(*structs.ACMECertificateConfigEntry).DeepCopy()
+}
+
+func (h *acmeHarness) requireCondition(status api.ConditionStatus, reason api.ACMECertificateConditionReason) {
+	h.t.Helper()
+	conditions := h.entry().Status.Conditions
+	require.Len(h.t, conditions, 1)
+	require.Equal(h.t, string(api.ACMECertificateConditionIssued), conditions[0].Type)
+	require.Equal(h.t, string(status), conditions[0].Status, conditions[0].Message)
+	require.Equal(h.t, string(reason), conditions[0].Reason, conditions[0].Message)
+}
+
+// testCertificate returns a self-signed certificate for the given domains and
+// its private key.
+func testCertificate(t *testing.T, domains []string, notBefore, notAfter time.Time) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(notBefore.Unix()),
+		DNSNames:     domains,
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}, &x509.Certificate{SerialNumber: big.NewInt(1)}, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyPEM, err := encodeKey(key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), keyPEM
+}
@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package acmecerts
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"golang.org/x/crypto/acme"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// pendingChallenge is a challenge that has to be answered before the ACME
+// server is asked to validate it.
+type pendingChallenge struct {
+	// Type is the type of the challenge, either http-01 or dns-01.
+	Type string
+
+	// Domain is the domain being validated, without the wildcard label.
+	Domain string
+
+	// Token is the token of the challenge.
+	Token string
+
+	// Response is the key authorization of an HTTP-01 challenge or the value
+	// of the TXT record of a DNS-01 challenge.
+	Response string
+}
+
+// presentFunc makes the responses to the given challenges available to the
+// ACME server. The returned function removes them once the challenges were
+// validated.
+type presentFunc func(ctx context.Context, challenges []pendingChallenge) (func(), error)
+
+// issued is a certificate issued by an ACME server.
+type issued struct {
+	// Certificate is the PEM encoded certificate chain.
+	Certificate string
+
+	// PrivateKey is the PEM encoded private key of the certificate.
+	PrivateKey string
+
+	// AccountKey is the PEM encoded key of the ACME account that ordered the
+	// certificate.
+	AccountKey string
+}
+
+// issuer orders certificates from an ACME server.
+type issuer interface {
+	issue(ctx context.Context, entry *structs.ACMECertificateConfigEntry, present presentFunc) (*issued, error)
+}
+
+// challengeError is returned when the control of a domain could not be
+// proven, as opposed to errors with the account or the order itself.
+type challengeError struct {
+	err error
+}
+
+func (e *challengeError) Error() string { return e.err.Error() }
+func (e *challengeError) Unwrap() error { return e.err }
+
+// acmeIssuer orders certificates using the ACME protocol described in
+// RFC 8555.
+type acmeIssuer struct{}
+
+func (acmeIssuer) issue(ctx context.Context, entry *structs.ACMECertificateConfigEntry, present presentFunc) (*issued, error) {
+	accountKey, err := parseOrGenerateKey(entry.AccountKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid account key: %w", err)
+	}
+	client := &acme.Client{
+		Key:          accountKey,
+		DirectoryURL: entry.Directory,
+		HTTPClient:   directoryHTTPClient(entry.DirectoryCACert),
+		UserAgent:    "consul",
+	}
+
+	account := &acme.Account{}
+	if entry.Email != "" {
+		account.Contact = []string{"mailto:" + entry.Email}
+	}
+	if _, err := client.Register(ctx, account, acme.AcceptTOS); err != nil && !errors.Is(err, acme.ErrAccountAlreadyExists) {
+		return nil, fmt.Errorf("error registering ACME account: %w", err)
+	}
+
+	order, err := client.AuthorizeOrder(ctx, acme.DomainIDs(entry.Domains...))
+	if err != nil {
+		return nil, fmt.Errorf("error creating order: %w", err)
+	}
+
+	// Collect the challenges of the authorizations that aren't valid yet.
+	type accepted struct {
+		authzURL  string
+		challenge *acme.Challenge
+	}
+	var (
+		pending []pendingChallenge
+		accept  []accepted
+	)
+	for _, authzURL := range order.AuthzURLs {
+		authz, err := client.GetAuthorization(ctx, authzURL)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching authorization: %w", err)
+		}
+		if authz.Status == acme.StatusValid {
+			continue
+		}
+
+		var challenge *acme.Challenge
+		for _, c := range authz.Challenges {
+			if c.Type == entry.Challenge {
+				challenge = c
+				break
+			}
+		}
+		if challenge == nil {
+			return nil, &challengeError{fmt.Errorf("the ACME server does not offer a %s challenge for %q", entry.Challenge, authz.Identifier.Value)}
+		}
+
+		var response string
+		switch challenge.Type {
+		case structs.ACMEChallengeDNS01:
+			response, err = client.DNS01ChallengeRecord(challenge.Token)
+		default:
+			response, err = client.HTTP01ChallengeResponse(challenge.Token)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		pending = append(pending, pendingChallenge{
+			Type:     challenge.Type,
+			Domain:   authz.Identifier.Value,
+			Token:    challenge.Token,
+			Response: response,
+		})
+		accept = append(accept, accepted{authzURL: authzURL, challenge: challenge})
+	}
+
+	if len(pending) > 0 {
+		cleanup, err := present(ctx, pending)
+		if err != nil {
+			return nil, &challengeError{err}
+		}
+		defer cleanup()
+
+		for _, a := range accept {
+			if _, err := client.Accept(ctx, a.challenge); err != nil {
+				return nil, &challengeError{fmt.Errorf("error accepting challenge: %w", err)}
+			}
+		}
+		for _, a := range accept {
+			if _, err := client.WaitAuthorization(ctx, a.authzURL); err != nil {
+				return nil, &challengeError{fmt.Errorf("error validating challenge: %w", err)}
+			}
+		}
+	}
+
+	order, err = client.WaitOrder(ctx, order.URI)
+	if err != nil {
+		return nil, fmt.Errorf("error waiting for order: %w", err)
+	}
+
+	certKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	template := &x509.CertificateRequest{DNSNames: entry.Domains}
+	if len(entry.Domains[0]) <= 64 {
+		template.Subject = pkix.Name{CommonName: entry.Domains[0]}
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, template, certKey)
+	if err != nil {
+		return nil, err
+	}
+	chain, _, err := client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return nil, fmt.Errorf("error finalizing order: %w", err)
+	}
+
+	var certPEM strings.Builder
+	for _, der := range chain {
+		if err := pem.Encode(&certPEM, &pem.Block{Type: "CERTIFICATE", Bytes: der}); err != nil {
+			return nil, err
+		}
+	}
+	keyPEM, err := encodeKey(certKey)
+	if err != nil {
+		return nil, err
+	}
+	accountKeyPEM, err := encodeKey(accountKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &issued{
+		Certificate: certPEM.String(),
+		PrivateKey:  keyPEM,
+		AccountKey:  accountKeyPEM,
+	}, nil
+}
+
+// directoryHTTPClient returns the HTTP client used to talk to the ACME
+// server, which trusts the given CA certificates instead of the system roots
+// if any are given.
+func directoryHTTPClient(caCert string) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	if caCert != "" {
+		transport.TLSClientConfig.RootCAs = x509.NewCertPool()
+		transport.TLSClientConfig.RootCAs.AppendCertsFromPEM([]byte(caCert))
+	}
+	return &http.Client{Transport: transport}
+}
+
+// parseOrGenerateKey parses the given PEM encoded EC private key, or
+// generates a new key if the PEM is empty.
+func parseOrGenerateKey(keyPEM string) (crypto.Signer, error) {
+	if keyPEM == "" {
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	}
+	block, _ := pem.Decode([]byte(keyPEM))
+	if block == nil {
+		return nil, errors.New("failed to parse key PEM")
+	}
+	return x509.ParseECPrivateKey(block.Bytes)
+}
+
+func encodeKey(key crypto.Signer) (string, error) {
+	ecKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return "", fmt.Errorf("unsupported key type %T", key)
+	}
+	der, err := x509.MarshalECPrivateKey(ecKey)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})), nil
+}
@@ -0,0 +1,535 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package acmecerts
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/sdk/freeport"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+	"github.com/hashicorp/consul/tlsutil"
+)
+
+func TestACMEIssuer_HTTP01(t *testing.T) {
+	t.Parallel()
+
+	srv := newACMEServer(t)
+	entry := srv.entry(structs.ACMEChallengeHTTP01, "example.com", "www.example.com")
+
+	var presented []pendingChallenge
+	present := func(_ context.Context, challenges []pendingChallenge) (func(), error) {
+		presented = challenges
+		srv.present(challenges)
+		return func() { srv.present(nil) }, nil
+	}
+
+	result, err := acmeIssuer{}.issue(context.Background(), entry, present)
+	require.NoError(t, err)
+	require.Len(t, presented, 2)
+	for _, c := range presented {
+		require.Equal(t, structs.ACMEChallengeHTTP01, c.Type)
+		require.True(t, strings.HasPrefix(c.Response, c.Token+"."))
+	}
+
+	// The certificate and its key are usable as a gateway certificate.
+	entry.Certificate = result.Certificate
+	entry.PrivateKey = result.PrivateKey
+	entry.AccountKey = result.AccountKey
+	require.NoError(t, entry.Validate())
+	cert, err := entry.IssuedCertificate()
+	require.NoError(t, err)
+	require.Equal(t, []string{"example.com", "www.example.com"}, cert.DNSNames)
+	require.True(t, entry.CoversDomains(cert))
+	require.Equal(t, 2, strings.Count(result.Certificate, "BEGIN CERTIFICATE"))
+
+	// Renewing with the account key of the entry reuses its account.
+	result, err = acmeIssuer{}.issue(context.Background(), entry, present)
+	require.NoError(t, err)
+	require.Equal(t, entry.AccountKey, result.AccountKey)
+	require.Equal(t, 1, srv.accountCount())
+}
+
+func TestACMEIssuer_DNS01(t *testing.T) {
+	t.Parallel()
+
+	srv := newACMEServer(t)
+	entry := srv.entry(structs.ACMEChallengeDNS01, "*.example.com")
+
+	var presented []pendingChallenge
+	result, err := acmeIssuer{}.issue(context.Background(), entry, func(_ context.Context, challenges []pendingChallenge) (func(), error) {
+		presented = challenges
+		srv.present(challenges)
+		return func() {}, nil
+	})
+	require.NoError(t, err)
+
+	// The TXT record of a wildcard domain is created for its base domain.
+	require.Len(t, presented, 1)
+	require.Equal(t, structs.ACMEChallengeDNS01, presented[0].Type)
+	require.Equal(t, "example.com", presented[0].Domain)
+	require.NotContains(t, presented[0].Response, ".")
+
+	block, _ := pem.Decode([]byte(result.Certificate))
+	cert, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	require.Equal(t, []string{"*.example.com"}, cert.DNSNames)
+}
+
+func TestACMEIssuer_ChallengeFailed(t *testing.T) {
+	t.Parallel()
+
+	srv := newACMEServer(t)
+	entry := srv.entry(structs.ACMEChallengeHTTP01, "example.com")
+
+	cleanedUp := false
+	_, err := acmeIssuer{}.issue(context.Background(), entry, func(_ context.Context, challenges []pendingChallenge) (func(), error) {
+		// The gateways do not serve the responses.
+		return func() { cleanedUp = true }, nil
+	})
+	require.Error(t, err)
+	var challengeErr *challengeError
+	require.ErrorAs(t, err, &challengeErr)
+	require.True(t, cleanedUp)
+}
+
+func TestACMEIssuer_UnsupportedChallenge(t *testing.T) {
+	t.Parallel()
+
+	srv := newACMEServer(t)
+	srv.challengeTypes = []string{structs.ACMEChallengeHTTP01}
+	entry := srv.entry(structs.ACMEChallengeDNS01, "example.com")
+
+	_, err := acmeIssuer{}.issue(context.Background(), entry, func(context.Context, []pendingChallenge) (func(), error) {
+		t.Fatal("no challenge should be presented")
+		return nil, nil
+	})
+	require.ErrorContains(t, err, "does not offer a dns-01 challenge")
+}
+
+func TestACMEIssuer_UntrustedDirectory(t *testing.T) {
+	t.Parallel()
+
+	srv := newACMEServer(t)
+	entry := srv.entry(structs.ACMEChallengeHTTP01, "example.com")
+	entry.DirectoryCACert = ""
+
+	_, err := acmeIssuer{}.issue(context.Background(), entry, nil)
+	require.ErrorContains(t, err, "certificate")
+}
+
+// TestACMEIssuer_Pebble orders a certificate from Pebble, the ACME test
+// server of Let's Encrypt, if it is installed. Pebble is configured to skip
+// the validation of the challenges since it cannot reach a gateway.
+func TestACMEIssuer_Pebble(t *testing.T) {
+	pebble, err := exec.LookPath("pebble")
+	if err != nil {
+		t.Skip("pebble not found in $PATH")
+	}
+
+	ca, caKey, err := tlsutil.GenerateCA(tlsutil.CAOpts{})
+	require.NoError(t, err)
+	signer, err := tlsutil.ParseSigner(caKey)
+	require.NoError(t, err)
+	cert, key, err := tlsutil.GenerateCert(tlsutil.CertOpts{
+		Signer:      signer,
+		CA:          ca,
+		Name:        "pebble",
+		Days:        1,
+		DNSNames:    []string{"localhost"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "cert.pem"), []byte(cert), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "key.pem"), []byte(key), 0600))
+
+	ports := freeport.GetN(t, 2)
+	config, err := json.Marshal(map[string]any{
+		"pebble": map[string]any{
+			"listenAddress":           fmt.Sprintf("localhost:%d", ports[0]),
+			"managementListenAddress": fmt.Sprintf("localhost:%d", ports[1]),
+			"certificate":             filepath.Join(dir, "cert.pem"),
+			"privateKey":              filepath.Join(dir, "key.pem"),
+			"httpPort":                80,
+			"tlsPort":                 443,
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pebble.json"), config, 0600))
+
+	cmd := exec.Command(pebble, "-config", filepath.Join(dir, "pebble.json"))
+	cmd.Env = append(os.Environ(), "PEBBLE_VA_ALWAYS_VALID=1", "PEBBLE_VA_NOSLEEP=1", "PEBBLE_WFE_NONCEREJECT=0")
+	require.NoError(t, cmd.Start())
+	t.Cleanup(func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	})
+
+	entry := &structs.ACMECertificateConfigEntry{
+		Kind:            structs.ACMECertificate,
+		Name:            "pebble",
+		Directory:       fmt.Sprintf("https://localhost:%d/dir", ports[0]),
+		DirectoryCACert: ca,
+		Email:           "admin@example.com",
+		Domains:         []string{"example.com", "www.example.com"},
+		Challenge:       structs.ACMEChallengeHTTP01,
+	}
+	client := directoryHTTPClient(ca)
+	retry.Run(t, func(r *retry.R) {
+		resp, err := client.Get(entry.Directory)
+		require.NoError(r, err)
+		resp.Body.Close()
+	})
+
+	var presented []pendingChallenge
+	result, err := acmeIssuer{}.issue(context.Background(), entry, func(_ context.Context, challenges []pendingChallenge) (func(), error) {
+		presented = challenges
+		return func() {}, nil
+	})
+	require.NoError(t, err)
+	require.Len(t, presented, 2)
+
+	entry.Certificate = result.Certificate
+	entry.PrivateKey = result.PrivateKey
+	entry.AccountKey = result.AccountKey
+	require.NoError(t, entry.Validate())
+	issuedCert, err := entry.IssuedCertificate()
+	require.NoError(t, err)
+	require.True(t, entry.CoversDomains(issuedCert))
+}
+
+// acmeServer is a minimal ACME server. It does not verify the signatures of
+// requests, but it validates the challenges against the responses presented
+// by the tests.
+type acmeServer struct {
+	t   *testing.T
+	srv *httptest.Server
+
+	caCert *x509.Certificate
+	caKey  *ecdsa.PrivateKey
+
+	// challengeTypes are the types of challenges offered for each domain.
+	challengeTypes []string
+
+	lock       sync.Mutex
+	nonce      int
+	accounts   map[string]string // thumbprint -> account URL
+	thumbprint string
+	presented  map[string]string // token -> response
+	authzs     []*fakeAuthz
+	orders     []*fakeOrder
+}
+
+type fakeAuthz struct {
+	Identifier map[string]string `json:"identifier"`
+	Wildcard   bool              `json:"wildcard,omitempty"`
+	Status     string            `json:"status"`
+	Challenges []*fakeChallenge  `json:"challenges"`
+}
+
+type fakeChallenge struct {
+	Type   string         `json:"type"`
+	URL    string         `json:"url"`
+	Token  string         `json:"token"`
+	Status string         `json:"status"`
+	Error  map[string]any `json:"error,omitempty"`
+}
+
+type fakeOrder struct {
+	Status         string              `json:"status"`
+	Identifiers    []map[string]string `json:"identifiers"`
+	Authorizations []string            `json:"authorizations"`
+	Finalize       string              `json:"finalize"`
+	Certificate    string              `json:"certificate,omitempty"`
+
+	chain []byte
+}
+
+func newACMEServer(t *testing.T) *acmeServer {
+	t.Helper()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Fake ACME CA"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	s := &acmeServer{
+		t:              t,
+		caCert:         caCert,
+		caKey:          caKey,
+		challengeTypes: []string{structs.ACMEChallengeHTTP01, structs.ACMEChallengeDNS01},
+		accounts:       make(map[string]string),
+		presented:      make(map[string]string),
+	}
+	s.srv = httptest.NewTLSServer(http.HandlerFunc(s.handle))
+	t.Cleanup(s.srv.Close)
+	return s
+}
+
+// entry returns an acme-certificate config entry using the server.
+func (s *acmeServer) entry(challenge string, domains ...string) *structs.ACMECertificateConfigEntry {
+	entry := &structs.ACMECertificateConfigEntry{
+		Kind:            structs.ACMECertificate,
+		Name:            "example",
+		Directory:       s.srv.URL + "/directory",
+		DirectoryCACert: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: s.srv.Certificate().Raw})),
+		Email:           "admin@example.com",
+		Domains:         domains,
+		Challenge:       challenge,
+	}
+	require.NoError(s.t, entry.Normalize())
+	require.NoError(s.t, entry.Validate())
+	return entry
+}
+
+// present makes the responses to the given challenges available to the
+// server, as the gateways or the DNS would.
+func (s *acmeServer) present(challenges []pendingChallenge) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.presented = make(map[string]string)
+	for _, c := range challenges {
+		s.presented[c.Token] = c.Response
+	}
+}
+
+func (s *acmeServer) accountCount() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return len(s.accounts)
+}
+
+func (s *acmeServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.nonce++
+	w.Header().Set("Replay-Nonce", fmt.Sprintf("nonce-%d", s.nonce))
+
+	url := s.srv.URL
+	path := r.URL.Path
+	switch {
+	case path == "/directory":
+		s.reply(w, http.StatusOK, map[string]string{
+			"newNonce":   url + "/nonce",
+			"newAccount": url + "/account",
+			"newOrder":   url + "/order",
+		})
+	case path == "/nonce":
+		w.WriteHeader(http.StatusOK)
+	case path == "/account":
+		protected, _ := s.decode(r)
+		var header struct {
+			JWK json.RawMessage `json:"jwk"`
+		}
+		require.NoError(s.t, json.Unmarshal(protected, &header))
+		s.thumbprint = jwkThumbprint(s.t, header.JWK)
+		status := http.StatusOK
+		if _, ok := s.accounts[s.thumbprint]; !ok {
+			s.accounts[s.thumbprint] = fmt.Sprintf("%s/account/%d", url, len(s.accounts))
+			status = http.StatusCreated
+		}
+		w.Header().Set("Location", s.accounts[s.thumbprint])
+		s.reply(w, status, map[string]string{"status": "valid"})
+	case path == "/order":
+		_, payload := s.decode(r)
+		var req struct {
+			Identifiers []map[string]string `json:"identifiers"`
+		}
+		require.NoError(s.t, json.Unmarshal(payload, &req))
+		order := &fakeOrder{
+			Status:      "pending",
+			Identifiers: req.Identifiers,
+			Finalize:    fmt.Sprintf("%s/finalize/%d", url, len(s.orders)),
+		}
+		for _, id := range req.Identifiers {
+			authz := &fakeAuthz{
+				Identifier: map[string]string{"type": "dns", "value": strings.TrimPrefix(id["value"], "*.")},
+				Wildcard:   strings.HasPrefix(id["value"], "*."),
+				Status:     "pending",
+			}
+			for _, typ := range s.challengeTypes {
+				authz.Challenges = append(authz.Challenges, &fakeChallenge{
+					Type:   typ,
+					URL:    fmt.Sprintf("%s/challenge/%d/%d", url, len(s.authzs), len(authz.Challenges)),
+					Token:  fmt.Sprintf("token-%d-%s", len(s.authzs), typ),
+					Status: "pending",
+				})
+			}
+			order.Authorizations = append(order.Authorizations, fmt.Sprintf("%s/authz/%d", url, len(s.authzs)))
+			s.authzs = append(s.authzs, authz)
+		}
+		s.orders = append(s.orders, order)
+		w.Header().Set("Location", fmt.Sprintf("%s/orders/%d", url, len(s.orders)-1))
+		s.reply(w, http.StatusCreated, order)
+	case strings.HasPrefix(path, "/orders/"):
+		var i int
+		fmt.Sscanf(path, "/orders/%d", &i)
+		order := s.orders[i]
+		if order.Status == "pending" && s.allValid(order) {
+			order.Status = "ready"
+		}
+		w.Header().Set("Location", url+path)
+		s.reply(w, http.StatusOK, order)
+	case strings.HasPrefix(path, "/authz/"):
+		var i int
+		fmt.Sscanf(path, "/authz/%d", &i)
+		s.reply(w, http.StatusOK, s.authzs[i])
+	case strings.HasPrefix(path, "/challenge/"):
+		var i, j int
+		fmt.Sscanf(path, "/challenge/%d/%d", &i, &j)
+		authz, challenge := s.authzs[i], s.authzs[i].Challenges[j]
+		if challenge.Status == "pending" {
+			if s.validate(challenge) {
+				challenge.Status, authz.Status = "valid", "valid"
+			} else {
+				challenge.Status, authz.Status = "invalid", "invalid"
+				challenge.Error = map[string]any{
+					"type":   "urn:ietf:params:acme:error:unauthorized",
+					"detail": "invalid response to the challenge",
+				}
+			}
+		}
+		s.reply(w, http.StatusOK, challenge)
+	case strings.HasPrefix(path, "/finalize/"):
+		var i int
+		fmt.Sscanf(path, "/finalize/%d", &i)
+		order := s.orders[i]
+		_, payload := s.decode(r)
+		var req struct {
+			CSR string `json:"csr"`
+		}
+		require.NoError(s.t, json.Unmarshal(payload, &req))
+		order.chain = s.sign(req.CSR)
+		order.Status = "valid"
+		order.Certificate = fmt.Sprintf("%s/cert/%d", url, i)
+		w.Header().Set("Location", fmt.Sprintf("%s/orders/%d", url, i))
+		s.reply(w, http.StatusOK, order)
+	case strings.HasPrefix(path, "/cert/"):
+		var i int
+		fmt.Sscanf(path, "/cert/%d", &i)
+		w.Header().Set("Content-Type", "application/pem-certificate-chain")
+		w.Write(s.orders[i].chain)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// decode returns the protected header and the payload of a JWS request.
+func (s *acmeServer) decode(r *http.Request) ([]byte, []byte) {
+	var jws struct {
+		Protected string `json:"protected"`
+		Payload   string `json:"payload"`
+	}
+	require.NoError(s.t, json.NewDecoder(r.Body).Decode(&jws))
+	protected, err := base64.RawURLEncoding.DecodeString(jws.Protected)
+	require.NoError(s.t, err)
+	payload, err := base64.RawURLEncoding.DecodeString(jws.Payload)
+	require.NoError(s.t, err)
+	return protected, payload
+}
+
+func (s *acmeServer) reply(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	require.NoError(s.t, json.NewEncoder(w).Encode(v))
+}
+
+// validate checks the presented response to a challenge like an ACME server
+// would after fetching it from the gateway or the DNS.
+func (s *acmeServer) validate(c *fakeChallenge) bool {
+	keyAuth := c.Token + "." + s.thumbprint
+	switch c.Type {
+	case structs.ACMEChallengeDNS01:
+		sum := sha256.Sum256([]byte(keyAuth))
+		return s.presented[c.Token] == base64.RawURLEncoding.EncodeToString(sum[:])
+	default:
+		return s.presented[c.Token] == keyAuth
+	}
+}
+
+func (s *acmeServer) allValid(order *fakeOrder) bool {
+	for _, authzURL := range order.Authorizations {
+		var i int
+		fmt.Sscanf(authzURL[len(s.srv.URL):], "/authz/%d", &i)
+		if s.authzs[i].Status != "valid" {
+			return false
+		}
+	}
+	return true
+}
+
+// sign issues a certificate for the given CSR and returns it along with the
+// certificate of the CA.
+func (s *acmeServer) sign(encodedCSR string) []byte {
+	der, err := base64.RawURLEncoding.DecodeString(encodedCSR)
+	require.NoError(s.t, err)
+	csr, err := x509.ParseCertificateRequest(der)
+	require.NoError(s.t, err)
+
+	serial, err := tlsutil.GenerateSerialNumber()
+	require.NoError(s.t, err)
+	der, err = x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      csr.Subject,
+		DNSNames:     csr.DNSNames,
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, s.caCert, csr.PublicKey, s.caKey)
+	require.NoError(s.t, err)
+
+	chain := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	return append(chain, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: s.caCert.Raw})...)
+}
+
+// jwkThumbprint computes the RFC 7638 thumbprint of an EC JSON web key.
+func jwkThumbprint(t *testing.T, raw json.RawMessage) string {
+	var jwk struct {
+		Crv string `json:"crv"`
+		Kty string `json:"kty"`
+		X   string `json:"x"`
+		Y   string `json:"y"`
+	}
+	require.NoError(t, json.Unmarshal(raw, &jwk))
+	require.Equal(t, "EC", jwk.Kty)
+	sum := sha256.Sum256([]byte(fmt.Sprintf(`{"crv":%q,"kty":%q,"x":%q,"y":%q}`, jwk.Crv, jwk.Kty, jwk.X, jwk.Y)))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
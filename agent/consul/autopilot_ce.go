@@ -11,7 +11,7 @@ import (
 )
 
 func (s *Server) autopilotPromoter() autopilot.Promoter {
-	return autopilot.DefaultPromoter()
+	return newZonePromoter()
 }
 
 func (_ *Server) autopilotServerExt(_ *metadata.Server) interface{} {
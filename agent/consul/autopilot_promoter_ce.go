@@ -0,0 +1,510 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !consulent
+
+package consul
+
+import (
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/raft"
+	autopilot "github.com/hashicorp/raft-autopilot"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// Node types that the promoter assigns to servers in a redundancy zone. Servers
+// outside of redundancy zones keep the autopilot.NodeVoter type.
+const (
+	// NodeZoneVoter is the voter of a redundancy zone.
+	NodeZoneVoter autopilot.NodeType = "zone-voter"
+
+	// NodeZoneExtraVoter is an additional voter of a redundancy zone that is
+	// used while there are fewer zones than minZoneVoters.
+	NodeZoneExtraVoter autopilot.NodeType = "zone-extra-voter"
+
+	// NodeZoneStandby is a non-voter of a redundancy zone that is promoted
+	// when the voter of its zone fails.
+	NodeZoneStandby autopilot.NodeType = "zone-standby"
+)
+
+// minZoneVoters is the number of voters that is kept when there are fewer
+// redundancy zones, so that a single failed server does not cost the quorum.
+const minZoneVoters = 3
+
+// AutopilotUpgradeStatus describes the progress of an upgrade migration.
+type AutopilotUpgradeStatus string
+
+const (
+	AutopilotUpgradeIdle               AutopilotUpgradeStatus = "idle"
+	AutopilotUpgradeAwaitNewVoters     AutopilotUpgradeStatus = "await-new-voters"
+	AutopilotUpgradePromoting          AutopilotUpgradeStatus = "promoting"
+	AutopilotUpgradeDemoting           AutopilotUpgradeStatus = "demoting"
+	AutopilotUpgradeLeaderTransfer     AutopilotUpgradeStatus = "leader-transfer"
+	AutopilotUpgradeAwaitServerRemoval AutopilotUpgradeStatus = "await-server-removal"
+	AutopilotUpgradeDisabled           AutopilotUpgradeStatus = "disabled"
+)
+
+// AutopilotServerExt is stored in the Ext field of autopilot servers.
+type AutopilotServerExt struct {
+	RedundancyZone string
+	UpgradeVersion string
+}
+
+// AutopilotStateExt is stored in the Ext field of the autopilot state.
+type AutopilotStateExt struct {
+	OptimisticFailureTolerance int
+	RedundancyZones            map[string]AutopilotZone
+	Upgrade                    *AutopilotUpgrade
+}
+
+// AutopilotZone is the state of a single redundancy zone.
+type AutopilotZone struct {
+	Servers          []raft.ServerID
+	Voters           []raft.ServerID
+	FailureTolerance int
+}
+
+// AutopilotUpgrade is the state of the upgrade migration.
+type AutopilotUpgrade struct {
+	Status                 AutopilotUpgradeStatus
+	TargetVersion          string
+	TargetVersionVoters    []raft.ServerID
+	TargetVersionNonVoters []raft.ServerID
+	OtherVersionVoters     []raft.ServerID
+	OtherVersionNonVoters  []raft.ServerID
+}
+
+// zonePromoter is the autopilot promoter of the community edition. It keeps
+// one voter per redundancy zone with the other servers of the zone as hot
+// standbys, and migrates the voters to servers of a newer version once enough
+// of them have joined. Without a redundancy zone tag and with a single version
+// every server becomes a voter, as with autopilot.StablePromoter.
+type zonePromoter struct{}
+
+func newZonePromoter() autopilot.Promoter {
+	return &zonePromoter{}
+}
+
+// promoterPlan is the desired voter configuration computed from the state.
+type promoterPlan struct {
+	nodeTypes map[raft.ServerID]autopilot.NodeType
+	voters    map[raft.ServerID]bool
+	zones     map[string]AutopilotZone
+	upgrade   *AutopilotUpgrade
+
+	// standbys counts the healthy zone standbys that can replace a failed
+	// voter.
+	standbys int
+}
+
+func (p *zonePromoter) GetServerExt(c *autopilot.Config, srv *autopilot.ServerState) interface{} {
+	conf := promoterConfig(c)
+	ext := &AutopilotServerExt{
+		RedundancyZone: srv.Server.Meta[conf.RedundancyZoneTag],
+		UpgradeVersion: srv.Server.Version,
+	}
+	if conf.RedundancyZoneTag == "" {
+		ext.RedundancyZone = ""
+	}
+	if v, ok := srv.Server.Meta[conf.UpgradeVersionTag]; ok && conf.UpgradeVersionTag != "" {
+		ext.UpgradeVersion = v
+	}
+	return ext
+}
+
+func (p *zonePromoter) GetStateExt(c *autopilot.Config, s *autopilot.State) interface{} {
+	plan := p.plan(c, s)
+	return &AutopilotStateExt{
+		OptimisticFailureTolerance: s.FailureTolerance + plan.standbys,
+		RedundancyZones:            plan.zones,
+		Upgrade:                    plan.upgrade,
+	}
+}
+
+func (p *zonePromoter) GetNodeTypes(c *autopilot.Config, s *autopilot.State) map[raft.ServerID]autopilot.NodeType {
+	return p.plan(c, s).nodeTypes
+}
+
+func (p *zonePromoter) FilterFailedServerRemovals(_ *autopilot.Config, _ *autopilot.State, failed *autopilot.FailedServers) *autopilot.FailedServers {
+	return failed
+}
+
+// CalculatePromotionsAndDemotions promotes the desired voters and, once all
+// of them have voting rights, demotes the other servers. A leader that is not
+// a desired voter hands leadership to one that is before it is demoted.
+func (p *zonePromoter) CalculatePromotionsAndDemotions(c *autopilot.Config, s *autopilot.State) autopilot.RaftChanges {
+	var changes autopilot.RaftChanges
+	plan := p.plan(c, s)
+
+	now := time.Now()
+	minStableDuration := s.ServerStabilizationTime(c)
+	for _, id := range sortedServerIDs(s) {
+		srv := s.Servers[id]
+		if plan.voters[id] && srv.State == autopilot.RaftNonVoter && srv.Health.IsStable(now, minStableDuration) {
+			changes.Promotions = append(changes.Promotions, id)
+		}
+	}
+	if len(changes.Promotions) > 0 || anyPendingPromotion(s, plan) {
+		return changes
+	}
+
+	var leaderTarget raft.ServerID
+	for _, id := range sortedServerIDs(s) {
+		srv := s.Servers[id]
+		switch {
+		case plan.voters[id]:
+			if leaderTarget == "" && srv.HasVotingRights() && srv.Health.Healthy {
+				leaderTarget = id
+			}
+		case srv.State == autopilot.RaftLeader:
+			// The leader is demoted by its successor.
+		case srv.HasVotingRights():
+			changes.Demotions = append(changes.Demotions, id)
+		}
+	}
+
+	if s.Leader != "" && !plan.voters[s.Leader] {
+		changes.Leader = leaderTarget
+	}
+	return changes
+}
+
+// plan computes the desired voters of the state. During an upgrade migration
+// servers of the target version only replace the voters of other versions
+// once there are enough of them to take over every redundancy zone.
+func (p *zonePromoter) plan(c *autopilot.Config, s *autopilot.State) *promoterPlan {
+	conf := promoterConfig(c)
+	ids := sortedServerIDs(s)
+
+	plan := p.planVoters(c, s, ids)
+	if conf.DisableUpgradeMigration {
+		plan.upgrade = &AutopilotUpgrade{Status: AutopilotUpgradeDisabled}
+		return plan
+	}
+	if conf.RedundancyZoneTag == "" && conf.UpgradeVersionTag == "" {
+		// Clusters that use none of the promoter features keep every server
+		// as a voter so that in-place rolling upgrades are not affected.
+		return plan
+	}
+
+	target, ok := targetVersion(s, ids)
+	if !ok {
+		return plan
+	}
+	upgrade := &AutopilotUpgrade{Status: AutopilotUpgradeIdle, TargetVersion: target.String()}
+
+	var targetIDs, otherIDs []raft.ServerID
+	for _, id := range ids {
+		srv := s.Servers[id]
+		if isVersion(srv, target) {
+			targetIDs = append(targetIDs, id)
+			if srv.HasVotingRights() {
+				upgrade.TargetVersionVoters = append(upgrade.TargetVersionVoters, id)
+			} else {
+				upgrade.TargetVersionNonVoters = append(upgrade.TargetVersionNonVoters, id)
+			}
+		} else {
+			otherIDs = append(otherIDs, id)
+			if srv.HasVotingRights() {
+				upgrade.OtherVersionVoters = append(upgrade.OtherVersionVoters, id)
+			} else {
+				upgrade.OtherVersionNonVoters = append(upgrade.OtherVersionNonVoters, id)
+			}
+		}
+	}
+	if len(otherIDs) == 0 {
+		plan.upgrade = upgrade
+		return plan
+	}
+
+	targetPlan := p.planVoters(c, s, eligibleServers(c, s, targetIDs))
+	if !coversVoters(plan, targetPlan, len(upgrade.OtherVersionVoters)) {
+		// Servers of the target version that are not voters yet wait until
+		// enough of them have joined.
+		var held []raft.ServerID
+		for _, id := range ids {
+			if s.Servers[id].HasVotingRights() || !isVersion(s.Servers[id], target) {
+				held = append(held, id)
+			}
+		}
+		plan = p.planVoters(c, s, held)
+		for _, id := range upgrade.TargetVersionNonVoters {
+			plan.nodeTypes[id] = standbyType(s.Servers[id])
+		}
+		upgrade.Status = AutopilotUpgradeAwaitNewVoters
+		plan.upgrade = upgrade
+		return plan
+	}
+
+	plan = targetPlan
+	for _, id := range otherIDs {
+		plan.nodeTypes[id] = standbyType(s.Servers[id])
+	}
+	for _, id := range targetIDs {
+		if _, ok := plan.nodeTypes[id]; !ok {
+			plan.nodeTypes[id] = standbyType(s.Servers[id])
+		}
+	}
+
+	switch {
+	case len(upgrade.TargetVersionNonVoters) > 0 && anyPendingPromotion(s, plan):
+		upgrade.Status = AutopilotUpgradePromoting
+	case anyPendingDemotion(s, plan):
+		upgrade.Status = AutopilotUpgradeDemoting
+	case s.Leader != "" && !plan.voters[s.Leader]:
+		upgrade.Status = AutopilotUpgradeLeaderTransfer
+	default:
+		upgrade.Status = AutopilotUpgradeAwaitServerRemoval
+	}
+	plan.upgrade = upgrade
+	return plan
+}
+
+// planVoters selects the voters among the given servers. Servers outside of
+// redundancy zones are voters unless other servers are in zones, in which
+// case they are kept as standbys. Each zone has a single voter, and extra
+// voters are added while there are fewer than minZoneVoters.
+func (p *zonePromoter) planVoters(c *autopilot.Config, s *autopilot.State, ids []raft.ServerID) *promoterPlan {
+	plan := &promoterPlan{
+		nodeTypes: make(map[raft.ServerID]autopilot.NodeType),
+		voters:    make(map[raft.ServerID]bool),
+	}
+
+	zoneServers := make(map[string][]raft.ServerID)
+	var unzoned []raft.ServerID
+	for _, id := range ids {
+		if zone := serverZone(s.Servers[id]); zone != "" {
+			zoneServers[zone] = append(zoneServers[zone], id)
+		} else {
+			unzoned = append(unzoned, id)
+		}
+	}
+
+	if len(zoneServers) == 0 {
+		for _, id := range ids {
+			plan.nodeTypes[id] = autopilot.NodeVoter
+			plan.voters[id] = true
+		}
+		return plan
+	}
+
+	now := time.Now()
+	minStableDuration := s.ServerStabilizationTime(c)
+	rank := func(id raft.ServerID) int {
+		srv := s.Servers[id]
+		switch {
+		case srv.State == autopilot.RaftLeader:
+			return 0
+		case srv.HasVotingRights() && srv.Health.Healthy:
+			return 1
+		case srv.Health.IsStable(now, minStableDuration):
+			return 2
+		case srv.HasVotingRights():
+			return 3
+		default:
+			return 4
+		}
+	}
+	byRank := func(ids []raft.ServerID) {
+		sort.SliceStable(ids, func(i, j int) bool {
+			return rank(ids[i]) < rank(ids[j])
+		})
+	}
+
+	zoneNames := make([]string, 0, len(zoneServers))
+	for zone := range zoneServers {
+		zoneNames = append(zoneNames, zone)
+	}
+	sort.Strings(zoneNames)
+
+	standbys := make(map[string][]raft.ServerID)
+	for _, zone := range zoneNames {
+		servers := zoneServers[zone]
+		byRank(servers)
+		plan.nodeTypes[servers[0]] = NodeZoneVoter
+		plan.voters[servers[0]] = true
+		standbys[zone] = servers[1:]
+	}
+
+	// Add extra voters from the zones in turn, preferring servers that are
+	// already voters, until there are enough of them.
+	for voters := len(zoneNames); voters < minZoneVoters; {
+		added := false
+		for _, zone := range zoneNames {
+			if voters >= minZoneVoters || len(standbys[zone]) == 0 {
+				continue
+			}
+			id := standbys[zone][0]
+			standbys[zone] = standbys[zone][1:]
+			plan.nodeTypes[id] = NodeZoneExtraVoter
+			plan.voters[id] = true
+			voters++
+			added = true
+		}
+		if !added {
+			break
+		}
+	}
+
+	plan.zones = make(map[string]AutopilotZone, len(zoneNames))
+	for _, zone := range zoneNames {
+		var info AutopilotZone
+		healthy := 0
+		for _, id := range ids {
+			if serverZone(s.Servers[id]) != zone {
+				continue
+			}
+			info.Servers = append(info.Servers, id)
+			if plan.voters[id] {
+				info.Voters = append(info.Voters, id)
+			}
+			if s.Servers[id].Health.Healthy {
+				healthy++
+			}
+		}
+		for _, id := range standbys[zone] {
+			plan.nodeTypes[id] = NodeZoneStandby
+			if s.Servers[id].Health.Healthy {
+				plan.standbys++
+			}
+		}
+		if healthy > 0 {
+			info.FailureTolerance = healthy - 1
+		}
+		plan.zones[zone] = info
+	}
+
+	for _, id := range unzoned {
+		plan.nodeTypes[id] = NodeZoneStandby
+	}
+	return plan
+}
+
+// coversVoters reports whether the plan of the target version servers can
+// replace the voters of other versions: it must have at least as many voters
+// and a voter in every redundancy zone that currently has one.
+func coversVoters(current, target *promoterPlan, otherVoters int) bool {
+	if len(target.voters) < otherVoters || len(target.voters) == 0 {
+		return false
+	}
+	for zone, info := range current.zones {
+		if len(info.Voters) == 0 {
+			continue
+		}
+		if targetZone, ok := target.zones[zone]; !ok || len(targetZone.Voters) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// anyPendingPromotion reports whether a healthy desired voter does not have
+// voting rights yet. Demotions wait for such servers so that the number of
+// voters never drops while their replacements become stable.
+func anyPendingPromotion(s *autopilot.State, plan *promoterPlan) bool {
+	for id := range plan.voters {
+		srv := s.Servers[id]
+		if !srv.HasVotingRights() && srv.Health.Healthy {
+			return true
+		}
+	}
+	return false
+}
+
+func anyPendingDemotion(s *autopilot.State, plan *promoterPlan) bool {
+	for id, srv := range s.Servers {
+		if !plan.voters[id] && srv.State == autopilot.RaftVoter {
+			return true
+		}
+	}
+	return false
+}
+
+// eligibleServers returns the servers that can become voters: current
+// voters and stable servers.
+func eligibleServers(c *autopilot.Config, s *autopilot.State, ids []raft.ServerID) []raft.ServerID {
+	now := time.Now()
+	minStableDuration := s.ServerStabilizationTime(c)
+
+	var eligible []raft.ServerID
+	for _, id := range ids {
+		srv := s.Servers[id]
+		if srv.HasVotingRights() || srv.Health.IsStable(now, minStableDuration) {
+			eligible = append(eligible, id)
+		}
+	}
+	return eligible
+}
+
+// targetVersion returns the highest upgrade version of the alive servers.
+func targetVersion(s *autopilot.State, ids []raft.ServerID) (*version.Version, bool) {
+	var target *version.Version
+	for _, id := range ids {
+		srv := s.Servers[id]
+		if srv.Server.NodeStatus != autopilot.NodeAlive {
+			continue
+		}
+		v, err := version.NewVersion(serverUpgradeVersion(srv))
+		if err != nil {
+			// Without comparable versions there is nothing to migrate to.
+			return nil, false
+		}
+		if target == nil || v.GreaterThan(target) {
+			target = v
+		}
+	}
+	return target, target != nil
+}
+
+func isVersion(srv *autopilot.ServerState, target *version.Version) bool {
+	v, err := version.NewVersion(serverUpgradeVersion(srv))
+	return err == nil && v.Equal(target)
+}
+
+func standbyType(srv *autopilot.ServerState) autopilot.NodeType {
+	if serverZone(srv) != "" {
+		return NodeZoneStandby
+	}
+	return autopilot.NodeVoter
+}
+
+func serverZone(srv *autopilot.ServerState) string {
+	if ext, ok := srv.Server.Ext.(*AutopilotServerExt); ok {
+		return ext.RedundancyZone
+	}
+	return ""
+}
+
+func serverUpgradeVersion(srv *autopilot.ServerState) string {
+	if ext, ok := srv.Server.Ext.(*AutopilotServerExt); ok {
+		return ext.UpgradeVersion
+	}
+	return srv.Server.Version
+}
+
+// sortedServerIDs returns the IDs of the servers in the state with the leader
+// first, then voters, healthy servers and servers that are stable longer.
+func sortedServerIDs(s *autopilot.State) []raft.ServerID {
+	ids := make([]raft.ServerID, 0, len(s.Servers))
+	for id := range s.Servers {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	sort.SliceStable(ids, func(i, j int) bool {
+		return autopilot.ServerLessThan(ids[i], ids[j], s)
+	})
+	return ids
+}
+
+func promoterConfig(c *autopilot.Config) *structs.AutopilotConfigExt {
+	if c != nil {
+		if ext, ok := c.Ext.(*structs.AutopilotConfigExt); ok {
+			return ext
+		}
+	}
+	return &structs.AutopilotConfigExt{}
+}
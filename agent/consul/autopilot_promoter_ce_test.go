@@ -0,0 +1,281 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !consulent
+
+package consul
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/raft"
+	autopilot "github.com/hashicorp/raft-autopilot"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+type testPromoterServer struct {
+	id      raft.ServerID
+	zone    string
+	version string
+	state   autopilot.RaftState
+	healthy bool
+}
+
+func testPromoterState(t *testing.T, c *autopilot.Config, servers ...testPromoterServer) *autopilot.State {
+	t.Helper()
+
+	p := newZonePromoter()
+	s := &autopilot.State{Servers: make(map[raft.ServerID]*autopilot.ServerState)}
+	for _, srv := range servers {
+		state := &autopilot.ServerState{
+			Server: autopilot.Server{
+				ID:         srv.id,
+				Name:       string(srv.id),
+				NodeStatus: autopilot.NodeAlive,
+				Version:    srv.version,
+				Meta:       map[string]string{"zone": srv.zone},
+				IsLeader:   srv.state == autopilot.RaftLeader,
+			},
+			State: srv.state,
+			Health: autopilot.ServerHealth{
+				Healthy:     srv.healthy,
+				StableSince: time.Now().Add(-time.Hour),
+			},
+		}
+		state.Server.Ext = p.GetServerExt(c, state)
+		s.Servers[srv.id] = state
+		if srv.state == autopilot.RaftLeader {
+			s.Leader = srv.id
+		}
+		if state.HasVotingRights() {
+			s.Voters = append(s.Voters, srv.id)
+		}
+	}
+	return s
+}
+
+func testPromoterConfig(ext structs.AutopilotConfigExt) *autopilot.Config {
+	return &autopilot.Config{
+		ServerStabilizationTime: 10 * time.Second,
+		Ext:                     &ext,
+	}
+}
+
+func TestZonePromoter_NoZones(t *testing.T) {
+	c := testPromoterConfig(structs.AutopilotConfigExt{})
+	s := testPromoterState(t, c,
+		testPromoterServer{id: "a", version: "1.0.0", state: autopilot.RaftLeader, healthy: true},
+		testPromoterServer{id: "b", version: "1.0.0", state: autopilot.RaftNonVoter, healthy: true},
+		testPromoterServer{id: "c", version: "1.1.0", state: autopilot.RaftNonVoter, healthy: true},
+	)
+
+	p := newZonePromoter()
+	changes := p.CalculatePromotionsAndDemotions(c, s)
+	require.ElementsMatch(t, []raft.ServerID{"b", "c"}, changes.Promotions)
+	require.Empty(t, changes.Demotions)
+	require.Empty(t, changes.Leader)
+
+	require.Equal(t, map[raft.ServerID]autopilot.NodeType{
+		"a": autopilot.NodeVoter,
+		"b": autopilot.NodeVoter,
+		"c": autopilot.NodeVoter,
+	}, p.GetNodeTypes(c, s))
+
+	ext := p.GetStateExt(c, s).(*AutopilotStateExt)
+	require.Empty(t, ext.RedundancyZones)
+	require.Nil(t, ext.Upgrade)
+}
+
+func TestZonePromoter_RedundancyZones(t *testing.T) {
+	c := testPromoterConfig(structs.AutopilotConfigExt{RedundancyZoneTag: "zone"})
+	p := newZonePromoter()
+
+	t.Run("one voter per zone", func(t *testing.T) {
+		s := testPromoterState(t, c,
+			testPromoterServer{id: "a1", zone: "a", version: "1.0.0", state: autopilot.RaftLeader, healthy: true},
+			testPromoterServer{id: "a2", zone: "a", version: "1.0.0", state: autopilot.RaftNonVoter, healthy: true},
+			testPromoterServer{id: "b1", zone: "b", version: "1.0.0", state: autopilot.RaftNonVoter, healthy: true},
+			testPromoterServer{id: "b2", zone: "b", version: "1.0.0", state: autopilot.RaftNonVoter, healthy: true},
+			testPromoterServer{id: "c1", zone: "c", version: "1.0.0", state: autopilot.RaftVoter, healthy: true},
+			testPromoterServer{id: "c2", zone: "c", version: "1.0.0", state: autopilot.RaftVoter, healthy: true},
+		)
+		s.FailureTolerance = 0
+
+		changes := p.CalculatePromotionsAndDemotions(c, s)
+		require.Equal(t, []raft.ServerID{"b1"}, changes.Promotions)
+		require.Empty(t, changes.Demotions)
+
+		require.Equal(t, map[raft.ServerID]autopilot.NodeType{
+			"a1": NodeZoneVoter,
+			"a2": NodeZoneStandby,
+			"b1": NodeZoneVoter,
+			"b2": NodeZoneStandby,
+			"c1": NodeZoneVoter,
+			"c2": NodeZoneStandby,
+		}, p.GetNodeTypes(c, s))
+
+		ext := p.GetStateExt(c, s).(*AutopilotStateExt)
+		require.Equal(t, 3, ext.OptimisticFailureTolerance)
+		require.Equal(t, AutopilotZone{
+			Servers:          []raft.ServerID{"c1", "c2"},
+			Voters:           []raft.ServerID{"c1"},
+			FailureTolerance: 1,
+		}, ext.RedundancyZones["c"])
+		require.Equal(t, AutopilotUpgradeIdle, ext.Upgrade.Status)
+	})
+
+	t.Run("demotes extra voters once promotions finished", func(t *testing.T) {
+		s := testPromoterState(t, c,
+			testPromoterServer{id: "a1", zone: "a", version: "1.0.0", state: autopilot.RaftLeader, healthy: true},
+			testPromoterServer{id: "b1", zone: "b", version: "1.0.0", state: autopilot.RaftVoter, healthy: true},
+			testPromoterServer{id: "c1", zone: "c", version: "1.0.0", state: autopilot.RaftVoter, healthy: true},
+			testPromoterServer{id: "c2", zone: "c", version: "1.0.0", state: autopilot.RaftVoter, healthy: true},
+		)
+
+		changes := p.CalculatePromotionsAndDemotions(c, s)
+		require.Empty(t, changes.Promotions)
+		require.Equal(t, []raft.ServerID{"c2"}, changes.Demotions)
+	})
+
+	t.Run("standby replaces failed voter", func(t *testing.T) {
+		s := testPromoterState(t, c,
+			testPromoterServer{id: "a1", zone: "a", version: "1.0.0", state: autopilot.RaftLeader, healthy: true},
+			testPromoterServer{id: "b1", zone: "b", version: "1.0.0", state: autopilot.RaftVoter, healthy: true},
+			testPromoterServer{id: "c1", zone: "c", version: "1.0.0", state: autopilot.RaftVoter, healthy: false},
+			testPromoterServer{id: "c2", zone: "c", version: "1.0.0", state: autopilot.RaftNonVoter, healthy: true},
+		)
+
+		changes := p.CalculatePromotionsAndDemotions(c, s)
+		require.Equal(t, []raft.ServerID{"c2"}, changes.Promotions)
+		require.Empty(t, changes.Demotions)
+
+		s = testPromoterState(t, c,
+			testPromoterServer{id: "a1", zone: "a", version: "1.0.0", state: autopilot.RaftLeader, healthy: true},
+			testPromoterServer{id: "b1", zone: "b", version: "1.0.0", state: autopilot.RaftVoter, healthy: true},
+			testPromoterServer{id: "c1", zone: "c", version: "1.0.0", state: autopilot.RaftVoter, healthy: false},
+			testPromoterServer{id: "c2", zone: "c", version: "1.0.0", state: autopilot.RaftVoter, healthy: true},
+		)
+
+		changes = p.CalculatePromotionsAndDemotions(c, s)
+		require.Empty(t, changes.Promotions)
+		require.Equal(t, []raft.ServerID{"c1"}, changes.Demotions)
+	})
+
+	t.Run("extra voters with fewer zones", func(t *testing.T) {
+		s := testPromoterState(t, c,
+			testPromoterServer{id: "a1", zone: "a", version: "1.0.0", state: autopilot.RaftLeader, healthy: true},
+			testPromoterServer{id: "a2", zone: "a", version: "1.0.0", state: autopilot.RaftNonVoter, healthy: true},
+			testPromoterServer{id: "a3", zone: "a", version: "1.0.0", state: autopilot.RaftNonVoter, healthy: true},
+			testPromoterServer{id: "b1", zone: "b", version: "1.0.0", state: autopilot.RaftVoter, healthy: true},
+			testPromoterServer{id: "b2", zone: "b", version: "1.0.0", state: autopilot.RaftNonVoter, healthy: true},
+		)
+
+		require.Equal(t, map[raft.ServerID]autopilot.NodeType{
+			"a1": NodeZoneVoter,
+			"a2": NodeZoneExtraVoter,
+			"a3": NodeZoneStandby,
+			"b1": NodeZoneVoter,
+			"b2": NodeZoneStandby,
+		}, p.GetNodeTypes(c, s))
+
+		changes := p.CalculatePromotionsAndDemotions(c, s)
+		require.Equal(t, []raft.ServerID{"a2"}, changes.Promotions)
+	})
+}
+
+func TestZonePromoter_UpgradeMigration(t *testing.T) {
+	c := testPromoterConfig(structs.AutopilotConfigExt{RedundancyZoneTag: "zone"})
+	p := newZonePromoter()
+
+	oldServers := []testPromoterServer{
+		{id: "a1", zone: "a", version: "1.0.0", state: autopilot.RaftLeader, healthy: true},
+		{id: "b1", zone: "b", version: "1.0.0", state: autopilot.RaftVoter, healthy: true},
+		{id: "c1", zone: "c", version: "1.0.0", state: autopilot.RaftVoter, healthy: true},
+	}
+
+	t.Run("waits for new voters", func(t *testing.T) {
+		s := testPromoterState(t, c, append(oldServers,
+			testPromoterServer{id: "a2", zone: "a", version: "1.1.0", state: autopilot.RaftNonVoter, healthy: true},
+			testPromoterServer{id: "b2", zone: "b", version: "1.1.0", state: autopilot.RaftNonVoter, healthy: true},
+		)...)
+
+		changes := p.CalculatePromotionsAndDemotions(c, s)
+		require.Empty(t, changes.Promotions)
+		require.Empty(t, changes.Demotions)
+
+		ext := p.GetStateExt(c, s).(*AutopilotStateExt)
+		require.Equal(t, &AutopilotUpgrade{
+			Status:                 AutopilotUpgradeAwaitNewVoters,
+			TargetVersion:          "1.1.0",
+			TargetVersionNonVoters: []raft.ServerID{"a2", "b2"},
+			OtherVersionVoters:     []raft.ServerID{"a1", "b1", "c1"},
+		}, ext.Upgrade)
+	})
+
+	t.Run("promotes new voters", func(t *testing.T) {
+		s := testPromoterState(t, c, append(oldServers,
+			testPromoterServer{id: "a2", zone: "a", version: "1.1.0", state: autopilot.RaftNonVoter, healthy: true},
+			testPromoterServer{id: "b2", zone: "b", version: "1.1.0", state: autopilot.RaftNonVoter, healthy: true},
+			testPromoterServer{id: "c2", zone: "c", version: "1.1.0", state: autopilot.RaftNonVoter, healthy: true},
+		)...)
+
+		changes := p.CalculatePromotionsAndDemotions(c, s)
+		require.Equal(t, []raft.ServerID{"a2", "b2", "c2"}, changes.Promotions)
+		require.Empty(t, changes.Demotions)
+
+		ext := p.GetStateExt(c, s).(*AutopilotStateExt)
+		require.Equal(t, AutopilotUpgradePromoting, ext.Upgrade.Status)
+	})
+
+	t.Run("demotes old voters and transfers leadership", func(t *testing.T) {
+		s := testPromoterState(t, c, append(oldServers,
+			testPromoterServer{id: "a2", zone: "a", version: "1.1.0", state: autopilot.RaftVoter, healthy: true},
+			testPromoterServer{id: "b2", zone: "b", version: "1.1.0", state: autopilot.RaftVoter, healthy: true},
+			testPromoterServer{id: "c2", zone: "c", version: "1.1.0", state: autopilot.RaftVoter, healthy: true},
+		)...)
+
+		changes := p.CalculatePromotionsAndDemotions(c, s)
+		require.Empty(t, changes.Promotions)
+		require.Equal(t, []raft.ServerID{"b1", "c1"}, changes.Demotions)
+		require.Equal(t, raft.ServerID("a2"), changes.Leader)
+
+		ext := p.GetStateExt(c, s).(*AutopilotStateExt)
+		require.Equal(t, AutopilotUpgradeDemoting, ext.Upgrade.Status)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		c := testPromoterConfig(structs.AutopilotConfigExt{RedundancyZoneTag: "zone", DisableUpgradeMigration: true})
+		s := testPromoterState(t, c, append(oldServers,
+			testPromoterServer{id: "a2", zone: "a", version: "1.1.0", state: autopilot.RaftNonVoter, healthy: true},
+			testPromoterServer{id: "b2", zone: "b", version: "1.1.0", state: autopilot.RaftNonVoter, healthy: true},
+			testPromoterServer{id: "c2", zone: "c", version: "1.1.0", state: autopilot.RaftNonVoter, healthy: true},
+		)...)
+
+		changes := p.CalculatePromotionsAndDemotions(c, s)
+		require.Empty(t, changes.Promotions)
+		require.Empty(t, changes.Demotions)
+
+		ext := p.GetStateExt(c, s).(*AutopilotStateExt)
+		require.Equal(t, AutopilotUpgradeDisabled, ext.Upgrade.Status)
+	})
+
+	t.Run("upgrade version tag", func(t *testing.T) {
+		c := testPromoterConfig(structs.AutopilotConfigExt{UpgradeVersionTag: "zone"})
+		s := testPromoterState(t, c,
+			testPromoterServer{id: "a", zone: "1.0.0", version: "1.5.0", state: autopilot.RaftLeader, healthy: true},
+			testPromoterServer{id: "b", zone: "2.0.0", version: "1.5.0", state: autopilot.RaftNonVoter, healthy: true},
+		)
+
+		require.Equal(t, "2.0.0", s.Servers["b"].Server.Ext.(*AutopilotServerExt).UpgradeVersion)
+
+		changes := p.CalculatePromotionsAndDemotions(c, s)
+		require.Equal(t, []raft.ServerID{"b"}, changes.Promotions)
+
+		ext := p.GetStateExt(c, s).(*AutopilotStateExt)
+		require.Equal(t, AutopilotUpgradePromoting, ext.Upgrade.Status)
+		require.Equal(t, "2.0.0", ext.Upgrade.TargetVersion)
+	})
+}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/hashicorp/consul/agent/checks"
 	consulrate "github.com/hashicorp/consul/agent/consul/rate"
+	"github.com/hashicorp/consul/agent/consul/rollouts"
 	hcpconfig "github.com/hashicorp/consul/agent/hcp/config"
 	"github.com/hashicorp/consul/agent/structs"
 	libserf "github.com/hashicorp/consul/lib/serf"
@@ -413,6 +414,14 @@ type Config struct {
 	// datacenters should exclusively traverse mesh gateways.
 	ConnectMeshGatewayWANFederationEnabled bool
 
+	// ConnectACMEDNSHook is the executable run to answer the dns-01
+	// challenges of acme-certificate config entries.
+	ConnectACMEDNSHook string
+
+	// ConnectCAPlugins are the CA provider plugins that the plugin CA
+	// provider may launch.
+	ConnectCAPlugins []structs.CAPlugin
+
 	// DefaultIntentionPolicy is used to define a default intention action for all
 	// sources and destinations. Possible values are "allow", "deny", or "" (blank).
 	// For compatibility, falls back to ACLResolverSettings.ACLDefaultPolicy (which
@@ -452,6 +461,11 @@ type Config struct {
 
 	Locality *structs.Locality
 
+	// CanaryRolloutPrometheus is the Prometheus server that canary rollouts
+	// query for the error ratio of canary subsets. It is taken from the UI
+	// metrics proxy when the UI uses the Prometheus metrics provider.
+	CanaryRolloutPrometheus rollouts.PrometheusConfig
+
 	Cloud hcpconfig.CloudConfig
 
 	Reporting Reporting
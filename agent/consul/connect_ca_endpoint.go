@@ -5,6 +5,7 @@ package consul
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-hclog"
@@ -76,7 +77,7 @@ func (s *ConnectCA) ConfigurationGet(
 	if err != nil {
 		return err
 	}
-	*reply = *config
+	*reply = *config.RedactPKCS11PIN()
 
 	return nil
 }
@@ -103,9 +104,106 @@ func (s *ConnectCA) ConfigurationSet(
 		return err
 	}
 
+	if err := s.restorePKCS11PIN(args.Config); err != nil {
+		return err
+	}
+
 	return s.srv.caManager.UpdateConfiguration(args)
 }
 
+// restorePKCS11PIN keeps the current PKCS#11 PIN when an update contains the
+// redacted PIN returned by ConfigurationGet.
+func (s *ConnectCA) restorePKCS11PIN(config *structs.CAConfiguration) error {
+	if config == nil {
+		return nil
+	}
+	_, current, err := s.srv.fsm.State().CAConfig(nil)
+	if err != nil {
+		return err
+	}
+	config.RestorePKCS11PIN(current)
+	return nil
+}
+
+// ConfigurationDryRun validates an update of the CA configuration and reports
+// its expected impact without applying it.
+func (s *ConnectCA) ConfigurationDryRun(
+	args *structs.CARequest,
+	reply *structs.CARotationPlan) error {
+	// Exit early if Connect hasn't been enabled.
+	if !s.srv.config.ConnectEnabled {
+		return ErrConnectNotEnabled
+	}
+
+	if done, err := s.srv.ForwardRPC("ConnectCA.ConfigurationDryRun", args, reply); done {
+		return err
+	}
+
+	// This action requires operator write access.
+	authz, err := s.srv.ResolveToken(args.Token)
+	if err != nil {
+		return err
+	}
+	if err := authz.ToAllowAuthorizer().OperatorWriteAllowed(nil); err != nil {
+		return err
+	}
+
+	if err := s.restorePKCS11PIN(args.Config); err != nil {
+		return err
+	}
+
+	plan, err := s.srv.caManager.DryRunUpdateConfiguration(args)
+	if err != nil {
+		return err
+	}
+	*reply = *plan
+	return nil
+}
+
+// RotationStatus reports how many leaf certificates, proxies and peers have
+// picked up the active root.
+func (s *ConnectCA) RotationStatus(
+	args *structs.DCSpecificRequest,
+	reply *structs.CARotationStatus) error {
+	// Peering streams are handled by the leader, so the status is only
+	// complete when it is answered by the leader.
+	if done, err := s.srv.ForwardRPC("ConnectCA.RotationStatus", args, reply); done {
+		return err
+	}
+
+	// Exit early if Connect hasn't been enabled.
+	if !s.srv.config.ConnectEnabled {
+		return ErrConnectNotEnabled
+	}
+
+	// This action requires operator read access.
+	authz, err := s.srv.ResolveToken(args.Token)
+	if err != nil {
+		return err
+	}
+	if err := authz.ToAllowAuthorizer().OperatorReadAllowed(nil); err != nil {
+		return err
+	}
+
+	return s.srv.blockingQuery(
+		&args.QueryOptions, &reply.QueryMeta,
+		func(ws memdb.WatchSet, state *state.Store) error {
+			status, err := s.srv.caRotationStatus(ws, state)
+			if err != nil {
+				return err
+			}
+
+			reply.Datacenter = status.Datacenter
+			reply.ActiveRootID = status.ActiveRootID
+			reply.Roots = status.Roots
+			reply.LeafCerts = status.LeafCerts
+			reply.Proxies = status.Proxies
+			reply.Peers = status.Peers
+			return nil
+		},
+	)
+}
+
 // Roots returns the currently trusted root certificates.
 func (s *ConnectCA) Roots(
 	args *structs.DCSpecificRequest,
@@ -161,10 +259,57 @@ func (s *ConnectCA) Sign(
 	if err != nil {
 		return err
 	}
+
+	if args.ReplacedCert != "" {
+		// Failing to revoke the replaced cert must not prevent the caller from
+		// getting a new one.
+		if err := s.srv.revokeReplacedLeafCert(args.ReplacedCert, csr); err != nil {
+			s.logger.Warn("failed to revoke replaced leaf certificate", "error", err)
+		}
+	}
+
 	*reply = *cert
 	return nil
 }
 
+// SignJWTSVID mints a JWT-SVID for a service.
+func (s *ConnectCA) SignJWTSVID(
+	args *structs.JWTSVIDSignRequest,
+	reply *structs.IssuedJWTSVID) error {
+	// Exit early if Connect hasn't been enabled.
+	if !s.srv.config.ConnectEnabled {
+		return ErrConnectNotEnabled
+	}
+
+	// JWT-SVIDs are signed with the key of the primary datacenter's root so
+	// that a single JWKS can validate them in every datacenter. Record the
+	// datacenter of the service before forwarding the request there.
+	if args.ServiceDatacenter == "" {
+		args.ServiceDatacenter = args.Datacenter
+		if args.ServiceDatacenter == "" {
+			args.ServiceDatacenter = s.srv.config.Datacenter
+		}
+	}
+	args.Datacenter = s.srv.config.PrimaryDatacenter
+
+	if done, err := s.srv.ForwardRPC("ConnectCA.SignJWTSVID", args, reply); done {
+		return err
+	}
+
+	authz, err := s.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, nil)
+	if err != nil {
+		return err
+	}
+
+	jwtSVID, err := s.srv.caManager.AuthorizeAndSignJWTSVID(args, authz)
+	if err != nil {
+		return err
+	}
+
+	*reply = *jwtSVID
+	return nil
+}
+
 // SignIntermediate signs an intermediate certificate for a remote datacenter.
 func (s *ConnectCA) SignIntermediate(
 	args *structs.CASignRequest,
@@ -211,3 +356,161 @@ func (s *ConnectCA) SignIntermediate(
 
 	return nil
 }
+
+// Revoke revokes leaf certificates by serial number or by service identity.
+func (s *ConnectCA) Revoke(
+	args *structs.CARevocationRequest,
+	reply *structs.CARevocation) error {
+	// Exit early if Connect hasn't been enabled.
+	if !s.srv.config.ConnectEnabled {
+		return ErrConnectNotEnabled
+	}
+
+	// Ensure that all revocations go to the primary datacenter. These will
+	// then be replicated to all the other datacenters.
+	args.Datacenter = s.srv.config.PrimaryDatacenter
+
+	if done, err := s.srv.ForwardRPC("ConnectCA.Revoke", args, reply); done {
+		return err
+	}
+
+	// This action requires operator write access.
+	authz, err := s.srv.ResolveToken(args.Token)
+	if err != nil {
+		return err
+	}
+	if err := authz.ToAllowAuthorizer().OperatorWriteAllowed(nil); err != nil {
+		return err
+	}
+
+	if len(args.Revocations) != 1 || args.Revocations[0] == nil {
+		return fmt.Errorf("exactly one revocation must be provided")
+	}
+	revocation := args.Revocations[0]
+	if err := revocation.Validate(); err != nil {
+		return err
+	}
+
+	// Normalize the encoding so that the serial number can be compared with
+	// the serial numbers of issued certificates.
+	if revocation.SerialNumber != "" {
+		serial, err := connect.DecodeSerialNumber(revocation.SerialNumber)
+		if err != nil {
+			return err
+		}
+		revocation.SerialNumber = connect.EncodeSerialNumber(serial)
+	}
+	if revocation.AuthorityKeyID != "" {
+		revocation.AuthorityKeyID = strings.ToLower(revocation.AuthorityKeyID)
+	}
+	if revocation.Service != "" {
+		revocation.EnterpriseMeta.Normalize()
+	}
+
+	// Proxies can only reject revoked certificates when every signing CA
+	// signs revocation lists.
+	if err := s.srv.checkCARevocationSupport(); err != nil {
+		return err
+	}
+
+	if err := s.srv.prepareCARevocation(revocation); err != nil {
+		return err
+	}
+
+	args.Op = structs.CARevocationOpRevoke
+	if _, err := s.srv.raftApply(structs.ConnectCARevocationRequestType, args); err != nil {
+		return err
+	}
+
+	*reply = *revocation
+	return nil
+}
+
+// Revocations returns the leaf certificate revocations and the revocation
+// lists signed for them.
+func (s *ConnectCA) Revocations(
+	args *structs.DCSpecificRequest,
+	reply *structs.IndexedCARevocations) error {
+	// Exit early if Connect hasn't been enabled.
+	if !s.srv.config.ConnectEnabled {
+		return ErrConnectNotEnabled
+	}
+
+	if done, err := s.srv.ForwardRPC("ConnectCA.Revocations", args, reply); done {
+		return err
+	}
+
+	// This action requires operator read access.
+	authz, err := s.srv.ResolveToken(args.Token)
+	if err != nil {
+		return err
+	}
+	if err := authz.ToAllowAuthorizer().OperatorReadAllowed(nil); err != nil {
+		return err
+	}
+
+	return s.srv.blockingQuery(
+		&args.QueryOptions, &reply.QueryMeta,
+		func(ws memdb.WatchSet, state *state.Store) error {
+			index, revocations, lists, err := state.CARevocations(ws)
+			if err != nil {
+				return err
+			}
+			_, activeRoot, err := state.CARootActive(ws)
+			if err != nil {
+				return err
+			}
+
+			reply.Index, reply.Revocations, reply.RevocationLists = index, revocations, lists
+			reply.Enforced, reply.NotEnforcedReason = true, ""
+			if activeRoot == nil {
+				reply.Enforced, reply.NotEnforcedReason = false, "CA has not finished initializing"
+			} else if _, err := s.srv.caRevocationListCoverage(lists, activeRoot.SigningKeyID); err != nil {
+				reply.Enforced, reply.NotEnforcedReason = false, err.Error()
+			}
+			return nil
+		},
+	)
+}
+
+// RevocationListSet stores the revocation lists signed by the leaf signing CA
+// of a datacenter. It is called by the leader of each datacenter.
+func (s *ConnectCA) RevocationListSet(
+	args *structs.CARevocationRequest,
+	reply *bool) error {
+	// Exit early if Connect hasn't been enabled.
+	if !s.srv.config.ConnectEnabled {
+		return ErrConnectNotEnabled
+	}
+
+	// Ensure that all revocation lists are stored in the primary datacenter.
+	// They will then be replicated to all the other datacenters.
+	args.Datacenter = s.srv.config.PrimaryDatacenter
+
+	if done, err := s.srv.ForwardRPC("ConnectCA.RevocationListSet", args, reply); done {
+		return err
+	}
+
+	// This action requires operator write access.
+	authz, err := s.srv.ResolveToken(args.Token)
+	if err != nil {
+		return err
+	}
+	if err := authz.ToAllowAuthorizer().OperatorWriteAllowed(nil); err != nil {
+		return err
+	}
+
+	for _, l := range args.RevocationLists {
+		if l == nil || l.AuthorityKeyID == "" {
+			return fmt.Errorf("invalid request: missing revocation list authority key ID")
+		}
+	}
+
+	args.Op = structs.CARevocationOpSetList
+	if _, err := s.srv.raftApply(structs.ConnectCARevocationRequestType, args); err != nil {
+		return err
+	}
+
+	*reply = true
+	return nil
+}
@@ -8,17 +8,20 @@ import (
 	"encoding/pem"
 	"fmt"
 	"os"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	msgpackrpc "github.com/hashicorp/consul-net-rpc/net-rpc-msgpackrpc"
+	"github.com/hashicorp/consul-net-rpc/net/rpc"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/agent/connect"
 	ca "github.com/hashicorp/consul/agent/connect/ca"
+	"github.com/hashicorp/consul/agent/consul/state"
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/sdk/testutil"
 	"github.com/hashicorp/consul/sdk/testutil/retry"
@@ -153,6 +156,49 @@ func TestConnectCAConfig_GetSet(t *testing.T) {
 	}
 }
 
+func TestConnectCAConfig_Plugin_RejectsCommand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	_, s1 := testServerWithConfig(t, func(c *Config) {
+		c.ConnectCAPlugins = []structs.CAPlugin{{
+			Name:    "signer",
+			Command: "/usr/local/bin/ca-plugin",
+			SHA256:  "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		}}
+	})
+	codec := rpcClient(t, s1)
+
+	testrpc.WaitForTestAgent(t, s1.RPC, "dc1")
+
+	for _, key := range []string{"Command", "Args", "SHA256"} {
+		args := &structs.CARequest{
+			Datacenter: "dc1",
+			Config: &structs.CAConfiguration{
+				Provider: structs.PluginCAProvider,
+				Config: map[string]interface{}{
+					"Plugin": "signer",
+					key:      "/bin/sh",
+				},
+			},
+		}
+		var reply interface{}
+		err := msgpackrpc.CallWithCodec(codec, "ConnectCA.ConfigurationSet", args, &reply)
+		require.ErrorContains(t, err, key+" can not be set in the CA configuration")
+	}
+
+	// The configuration was not changed.
+	args := &structs.DCSpecificRequest{
+		Datacenter: "dc1",
+	}
+	var reply structs.CAConfiguration
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.ConfigurationGet", args, &reply))
+	require.Equal(t, structs.ConsulCAProvider, reply.Provider)
+}
+
 func TestConnectCAConfig_GetSet_ACLDeny(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -353,6 +399,301 @@ func TestConnectCAConfig_GetSetForceNoCrossSigning(t *testing.T) {
 	}
 }
 
+func testConnectCARegisterProxy(t *testing.T, codec rpc.ClientCodec, node, destination string) {
+	t.Helper()
+
+	args := &structs.RegisterRequest{
+		Datacenter: "dc1",
+		Node:       node,
+		Address:    "127.0.0.1",
+		Service: &structs.NodeService{
+			Kind:    structs.ServiceKindConnectProxy,
+			ID:      destination + "-proxy",
+			Service: destination + "-proxy",
+			Port:    20000,
+			Proxy: structs.ConnectProxyConfig{
+				DestinationServiceName: destination,
+			},
+		},
+	}
+	var out struct{}
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Catalog.Register", args, &out))
+}
+
+func testConnectCASignService(t *testing.T, codec rpc.ClientCodec, service string) *structs.IssuedCert {
+	t.Helper()
+
+	csr, _ := connect.TestCSR(t, connect.TestSpiffeIDService(t, service))
+	args := &structs.CASignRequest{
+		Datacenter: "dc1",
+		CSR:        csr,
+	}
+	var reply structs.IssuedCert
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.Sign", args, &reply))
+	return &reply
+}
+
+func TestConnectCAConfig_DryRun(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	_, oldRoot, err := s1.fsm.State().CARootActive(nil)
+	require.NoError(t, err)
+	_, oldConfig, err := s1.fsm.State().CAConfig(nil)
+	require.NoError(t, err)
+
+	testConnectCASignService(t, codec, "web")
+	testConnectCARegisterProxy(t, codec, "node1", "web")
+	oldProviderStates, oldSerial := testCAProviderStates(t, s1.fsm.State())
+
+	testutil.RunStep(t, "no-op update", func(t *testing.T) {
+		args := &structs.CARequest{
+			Datacenter: "dc1",
+			Config: &structs.CAConfiguration{
+				Provider: oldConfig.Provider,
+				Config:   oldConfig.Config,
+			},
+		}
+		var plan structs.CARotationPlan
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.ConfigurationDryRun", args, &plan))
+		require.False(t, plan.RootChanged)
+		require.Equal(t, oldRoot.ID, plan.ActiveRootID)
+		require.Equal(t, oldRoot.ID, plan.NewRootID)
+	})
+
+	testutil.RunStep(t, "rotation", func(t *testing.T) {
+		_, newKey, err := connect.GeneratePrivateKey()
+		require.NoError(t, err)
+		args := &structs.CARequest{
+			Datacenter: "dc1",
+			Config: &structs.CAConfiguration{
+				Provider: "consul",
+				Config: map[string]interface{}{
+					"PrivateKey": newKey,
+				},
+			},
+		}
+		var plan structs.CARotationPlan
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.ConfigurationDryRun", args, &plan))
+		require.True(t, plan.RootChanged)
+		require.True(t, plan.CrossSigned)
+		require.Equal(t, oldRoot.ID, plan.ActiveRootID)
+		require.NotEmpty(t, plan.NewRootID)
+		require.NotEqual(t, oldRoot.ID, plan.NewRootID)
+		require.Equal(t, 1, plan.LeafCerts)
+		require.Equal(t, 1, plan.Proxies)
+	})
+
+	testutil.RunStep(t, "invalid config", func(t *testing.T) {
+		args := &structs.CARequest{
+			Datacenter: "dc1",
+			Config: &structs.CAConfiguration{
+				Provider: "consul",
+				Config: map[string]interface{}{
+					"PrivateKeyType": "rsa",
+					"PrivateKeyBits": 1024,
+				},
+			},
+		}
+		var plan structs.CARotationPlan
+		err := msgpackrpc.CallWithCodec(codec, "ConnectCA.ConfigurationDryRun", args, &plan)
+		require.Error(t, err)
+	})
+
+	testutil.RunStep(t, "other providers are only validated", func(t *testing.T) {
+		// The Vault address is unreachable, the dry run must not contact it.
+		args := &structs.CARequest{
+			Datacenter: "dc1",
+			Config: &structs.CAConfiguration{
+				Provider: "vault",
+				Config: map[string]interface{}{
+					"Address":             "http://127.0.0.1:1",
+					"Token":               "root",
+					"RootPKIPath":         "pki-root/",
+					"IntermediatePKIPath": "pki-intermediate/",
+				},
+			},
+		}
+		var plan structs.CARotationPlan
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.ConfigurationDryRun", args, &plan))
+		require.True(t, plan.ConfigOnly)
+		require.False(t, plan.RootChanged)
+		require.Empty(t, plan.NewRootID)
+		require.Len(t, plan.Warnings, 1)
+
+		delete(args.Config.Config, "Token")
+		err := msgpackrpc.CallWithCodec(codec, "ConnectCA.ConfigurationDryRun", args, &plan)
+		testutil.RequireErrorContains(t, err, "must provide a Vault token")
+	})
+
+	// The dry runs didn't change the configuration or the roots.
+	_, roots, err := s1.fsm.State().CARoots(nil)
+	require.NoError(t, err)
+	require.Len(t, roots, 1)
+	require.Equal(t, oldRoot.ID, roots[0].ID)
+	_, config, err := s1.fsm.State().CAConfig(nil)
+	require.NoError(t, err)
+	require.Equal(t, oldConfig, config)
+
+	// Neither the new providers nor the test cross-sign changed the state of
+	// the built-in provider.
+	providerStates, serial := testCAProviderStates(t, s1.fsm.State())
+	require.Equal(t, oldProviderStates, providerStates)
+	require.Equal(t, oldSerial, serial)
+}
+
+// testCAProviderStates returns the states of the built-in CA provider and its
+// last serial number.
+func testCAProviderStates(t *testing.T, store *state.Store) ([]*structs.CAConsulProviderState, uint64) {
+	t.Helper()
+
+	snap := store.Snapshot()
+	defer snap.Close()
+
+	providerStates, err := snap.CAProviderState()
+	require.NoError(t, err)
+
+	indexes, err := snap.Indexes()
+	require.NoError(t, err)
+	var serial uint64
+	for raw := indexes.Next(); raw != nil; raw = indexes.Next() {
+		if entry := raw.(*state.IndexEntry); entry.Key == "connect-ca-builtin-serial" {
+			serial = entry.Value
+		}
+	}
+	return providerStates, serial
+}
+
+func TestConnectCAConfig_DryRun_ACLDeny(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	dir1, s1 := testServerWithConfig(t, func(c *Config) {
+		c.PrimaryDatacenter = "dc1"
+		c.ACLsEnabled = true
+		c.ACLInitialManagementToken = TestDefaultInitialManagementToken
+		c.ACLResolverSettings.ACLDefaultPolicy = "deny"
+	})
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1", testrpc.WithToken(TestDefaultInitialManagementToken))
+
+	opReadToken, err := upsertTestTokenWithPolicyRules(
+		codec, TestDefaultInitialManagementToken, "dc1", `operator = "read"`)
+	require.NoError(t, err)
+
+	args := &structs.CARequest{
+		Datacenter: "dc1",
+		Config: &structs.CAConfiguration{
+			Provider: "consul",
+			Config: map[string]interface{}{
+				"PrivateKeyType": "ec",
+				"PrivateKeyBits": 384,
+			},
+		},
+		WriteRequest: structs.WriteRequest{Token: opReadToken.SecretID},
+	}
+	var plan structs.CARotationPlan
+	err = msgpackrpc.CallWithCodec(codec, "ConnectCA.ConfigurationDryRun", args, &plan)
+	require.True(t, acl.IsErrPermissionDenied(err))
+
+	args.Token = TestDefaultInitialManagementToken
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.ConfigurationDryRun", args, &plan))
+	require.True(t, plan.RootChanged)
+}
+
+func TestConnectCARotationStatus(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	_, oldRoot, err := s1.fsm.State().CARootActive(nil)
+	require.NoError(t, err)
+
+	testConnectCASignService(t, codec, "web")
+	testConnectCARegisterProxy(t, codec, "node1", "web")
+	testConnectCARegisterProxy(t, codec, "node1", "api")
+
+	rotationStatus := func(t *testing.T) structs.CARotationStatus {
+		args := &structs.DCSpecificRequest{Datacenter: "dc1"}
+		var status structs.CARotationStatus
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.RotationStatus", args, &status))
+		return status
+	}
+
+	testutil.RunStep(t, "before rotation", func(t *testing.T) {
+		status := rotationStatus(t)
+		require.Equal(t, "dc1", status.Datacenter)
+		require.Equal(t, oldRoot.ID, status.ActiveRootID)
+		require.Equal(t, structs.CARotationProgress{Active: 1}, status.LeafCerts)
+		// The api proxy never requested a certificate.
+		require.Equal(t, structs.CARotationProgress{Active: 1, Unknown: 1}, status.Proxies)
+		require.Len(t, status.Roots, 1)
+		require.Equal(t, 1, status.Roots[0].LeafCerts)
+	})
+
+	_, newKey, err := connect.GeneratePrivateKey()
+	require.NoError(t, err)
+	args := &structs.CARequest{
+		Datacenter: "dc1",
+		Config: &structs.CAConfiguration{
+			Provider: "consul",
+			Config: map[string]interface{}{
+				"PrivateKey": newKey,
+			},
+		},
+	}
+	var reply interface{}
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.ConfigurationSet", args, &reply))
+
+	testutil.RunStep(t, "after rotation", func(t *testing.T) {
+		status := rotationStatus(t)
+		require.NotEqual(t, oldRoot.ID, status.ActiveRootID)
+		require.Equal(t, structs.CARotationProgress{Previous: 1}, status.LeafCerts)
+		require.Equal(t, structs.CARotationProgress{Previous: 1, Unknown: 1}, status.Proxies)
+		require.Len(t, status.Roots, 2)
+	})
+
+	testutil.RunStep(t, "after reissuing", func(t *testing.T) {
+		cert := testConnectCASignService(t, codec, "web")
+
+		status := rotationStatus(t)
+		require.Equal(t, status.ActiveRootID, cert.RootID)
+		require.Equal(t, structs.CARotationProgress{Active: 1, Previous: 1}, status.LeafCerts)
+		require.Equal(t, structs.CARotationProgress{Active: 1, Unknown: 1}, status.Proxies)
+		for _, root := range status.Roots {
+			require.Equal(t, 1, root.LeafCerts)
+		}
+	})
+}
+
 func TestConnectCAConfig_TriggerRotation(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -1185,3 +1526,282 @@ func TestConnectCASignValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestConnectCARevoke(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	// Sign a certificate to revoke.
+	csr, _ := connect.TestCSR(t, connect.TestSpiffeIDService(t, "web"))
+	var issued structs.IssuedCert
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.Sign", &structs.CASignRequest{
+		Datacenter: "dc1",
+		CSR:        csr,
+	}, &issued))
+
+	waitForCARevocationLists(t, s1, "dc1")
+
+	// Revocations must target either a serial number or a service.
+	var revocation structs.CARevocation
+	err := msgpackrpc.CallWithCodec(codec, "ConnectCA.Revoke", &structs.CARevocationRequest{
+		Revocations: []*structs.CARevocation{{SerialNumber: issued.SerialNumber, Service: "web"}},
+	}, &revocation)
+	testutil.RequireErrorContains(t, err, "only one of SerialNumber or Service can be set")
+
+	// The serial number is normalized.
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.Revoke", &structs.CARevocationRequest{
+		Revocations: []*structs.CARevocation{{
+			SerialNumber: strings.ToUpper(issued.SerialNumber),
+			Reason:       "key compromise",
+		}},
+	}, &revocation))
+	require.NotEmpty(t, revocation.ID)
+	require.Equal(t, issued.SerialNumber, revocation.SerialNumber)
+	require.False(t, revocation.RevokedAt.IsZero())
+	require.True(t, revocation.ExpiresAt.After(revocation.RevokedAt))
+
+	var revocations structs.IndexedCARevocations
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.Revocations", &structs.DCSpecificRequest{
+		Datacenter: "dc1",
+	}, &revocations))
+	require.Len(t, revocations.Revocations, 1)
+	require.Equal(t, revocation.ID, revocations.Revocations[0].ID)
+	require.True(t, revocations.Enforced)
+	require.Empty(t, revocations.NotEnforcedReason)
+
+	// The leader signs a revocation list for the revoked serial number.
+	_, activeRoot, err := s1.fsm.State().CARootActive(nil)
+	require.NoError(t, err)
+	retry.Run(t, func(r *retry.R) {
+		var roots structs.IndexedCARoots
+		r.Check(msgpackrpc.CallWithCodec(codec, "ConnectCA.Roots", &structs.DCSpecificRequest{
+			Datacenter: "dc1",
+		}, &roots))
+		require.Len(r, roots.Revocations, 1)
+		require.Len(r, roots.RevocationLists, 1)
+
+		block, _ := pem.Decode([]byte(roots.RevocationLists[0]))
+		require.NotNil(r, block)
+		crl, err := x509.ParseRevocationList(block.Bytes)
+		require.NoError(r, err)
+		require.Len(r, crl.RevokedCertificateEntries, 1)
+		require.Equal(r, issued.SerialNumber, connect.EncodeSerialNumber(crl.RevokedCertificateEntries[0].SerialNumber))
+		require.Equal(r, activeRoot.SigningKeyID, connect.EncodeSigningKeyID(crl.AuthorityKeyId))
+	})
+
+	// Revocations are rejected while a datacenter's CA provider can't sign
+	// revocation lists.
+	_, err = s1.raftApply(structs.ConnectCARevocationRequestType, &structs.CARevocationRequest{
+		Op: structs.CARevocationOpSetList,
+		RevocationLists: []*structs.CARevocationList{{
+			AuthorityKeyID: "aa:bb",
+			Datacenter:     "dc2",
+			Unsupported:    true,
+			NextUpdate:     time.Now().Add(time.Hour),
+		}},
+	})
+	require.NoError(t, err)
+	err = msgpackrpc.CallWithCodec(codec, "ConnectCA.Revoke", &structs.CARevocationRequest{
+		Revocations: []*structs.CARevocation{{Service: "web"}},
+	}, &revocation)
+	testutil.RequireErrorContains(t, err, `revocations can't be enforced: the CA provider of datacenter "dc2" does not support revocation lists`)
+
+	// The revocations report that proxies don't enforce them.
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.Revocations", &structs.DCSpecificRequest{
+		Datacenter: "dc1",
+	}, &revocations))
+	require.False(t, revocations.Enforced)
+	require.Equal(t, `the CA provider of datacenter "dc2" does not support revocation lists`, revocations.NotEnforcedReason)
+}
+
+func TestConnectCASignJWTSVID(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	getRoots := func() structs.IndexedCARoots {
+		var roots structs.IndexedCARoots
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.Roots", &structs.DCSpecificRequest{
+			Datacenter: "dc1",
+		}, &roots))
+		return roots
+	}
+
+	// The public JWT key of the root is exposed but never its private key.
+	roots := getRoots()
+	require.Len(t, roots.Roots, 1)
+	require.NotEmpty(t, roots.Roots[0].JWTKeyID)
+	require.NotEmpty(t, roots.Roots[0].JWTPublicKey)
+	require.Empty(t, roots.Roots[0].JWTSigningKey)
+
+	var jwtSVID structs.IssuedJWTSVID
+	err := msgpackrpc.CallWithCodec(codec, "ConnectCA.SignJWTSVID", &structs.JWTSVIDSignRequest{
+		Datacenter: "dc1",
+		Service:    "web",
+	}, &jwtSVID)
+	testutil.RequireErrorContains(t, err, "at least one audience is required")
+
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.SignJWTSVID", &structs.JWTSVIDSignRequest{
+		Datacenter: "dc1",
+		Service:    "web",
+		Audience:   []string{"api"},
+	}, &jwtSVID))
+	require.Equal(t, "spiffe://"+roots.TrustDomain+"/ns/default/dc/dc1/svc/web", jwtSVID.SpiffeID)
+	require.Equal(t, roots.Roots[0].JWTKeyID, jwtSVID.KeyID)
+
+	spiffeID, _, err := connect.ValidateJWTSVID(jwtSVID.Token, "api", roots.Roots, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, jwtSVID.SpiffeID, spiffeID)
+
+	// Rotating the CA rotates the JWT signing key, and tokens signed with the
+	// old key stay valid while the old root is trusted.
+	_, newKey, err := connect.GeneratePrivateKey()
+	require.NoError(t, err)
+	var reply interface{}
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.ConfigurationSet", &structs.CARequest{
+		Datacenter: "dc1",
+		Config: &structs.CAConfiguration{
+			Provider: "consul",
+			Config: map[string]interface{}{
+				"PrivateKey": newKey,
+			},
+		},
+	}, &reply))
+
+	roots = getRoots()
+	require.Len(t, roots.Roots, 2)
+
+	var rotated structs.IssuedJWTSVID
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.SignJWTSVID", &structs.JWTSVIDSignRequest{
+		Datacenter: "dc1",
+		Service:    "web",
+		Audience:   []string{"api"},
+	}, &rotated))
+	require.NotEqual(t, jwtSVID.KeyID, rotated.KeyID)
+
+	for _, token := range []string{jwtSVID.Token, rotated.Token} {
+		_, _, err := connect.ValidateJWTSVID(token, "api", roots.Roots, time.Now())
+		require.NoError(t, err)
+	}
+}
+
+func TestConnectCASignJWTSVID_ACLDeny(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	dir1, s1 := testServerWithConfig(t, func(c *Config) {
+		c.PrimaryDatacenter = "dc1"
+		c.ACLsEnabled = true
+		c.ACLInitialManagementToken = TestDefaultInitialManagementToken
+		c.ACLResolverSettings.ACLDefaultPolicy = "deny"
+	})
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	token, err := upsertTestTokenWithPolicyRules(codec, TestDefaultInitialManagementToken, "dc1", `
+	service "web" { policy = "write" }
+	`)
+	require.NoError(t, err)
+
+	var jwtSVID structs.IssuedJWTSVID
+	err = msgpackrpc.CallWithCodec(codec, "ConnectCA.SignJWTSVID", &structs.JWTSVIDSignRequest{
+		Datacenter:   "dc1",
+		Service:      "db",
+		Audience:     []string{"api"},
+		WriteRequest: structs.WriteRequest{Token: token.SecretID},
+	}, &jwtSVID)
+	require.True(t, acl.IsErrPermissionDenied(err))
+
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.SignJWTSVID", &structs.JWTSVIDSignRequest{
+		Datacenter:   "dc1",
+		Service:      "web",
+		Audience:     []string{"api"},
+		WriteRequest: structs.WriteRequest{Token: token.SecretID},
+	}, &jwtSVID))
+	require.NotEmpty(t, jwtSVID.Token)
+}
+
+func TestConnectCASign_ReplacedCert(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	spiffeID := connect.TestSpiffeIDService(t, "web")
+	csr, _ := connect.TestCSR(t, spiffeID)
+	var issued structs.IssuedCert
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.Sign", &structs.CASignRequest{
+		Datacenter: "dc1",
+		CSR:        csr,
+	}, &issued))
+
+	// Revoke the service identity.
+	var revocation structs.CARevocation
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.Revoke", &structs.CARevocationRequest{
+		Revocations: []*structs.CARevocation{{Service: "web"}},
+	}, &revocation))
+
+	// Replacing a certificate of another service doesn't revoke it.
+	otherCSR, _ := connect.TestCSR(t, connect.TestSpiffeIDService(t, "db"))
+	var other structs.IssuedCert
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.Sign", &structs.CASignRequest{
+		Datacenter:   "dc1",
+		CSR:          otherCSR,
+		ReplacedCert: issued.CertPEM,
+	}, &other))
+
+	// Replacing the certificate revokes its serial number.
+	csr, _ = connect.TestCSR(t, spiffeID)
+	var replacement structs.IssuedCert
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.Sign", &structs.CASignRequest{
+		Datacenter:   "dc1",
+		CSR:          csr,
+		ReplacedCert: issued.CertPEM,
+	}, &replacement))
+
+	// The leader may have revoked the serial number already, but it is only
+	// revoked once.
+	_, revocations, _, err := s1.fsm.State().CARevocations(nil)
+	require.NoError(t, err)
+	require.Len(t, revocations, 2)
+	require.Equal(t, revocation.ID, revocations[0].ID)
+	require.Equal(t, issued.SerialNumber, revocations[1].SerialNumber)
+	require.True(t, issued.ValidBefore.Equal(revocations[1].ExpiresAt))
+}
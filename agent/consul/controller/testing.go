@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/mitchellh/go-testing-interface"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/consul/controller/queue"
+	"github.com/hashicorp/consul/agent/consul/fsm"
+	"github.com/hashicorp/consul/agent/consul/state"
+	"github.com/hashicorp/consul/agent/consul/stream"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// TestHarness runs a config entry reconciler against an in-memory FSM with a
+// fake clock, so that reconcilers can be tested without running a controller
+// or a raft cluster.
+type TestHarness struct {
+	t     testing.T
+	fsm   *fsm.FSM
+	index uint64
+	now   time.Time
+}
+
+// NewTestHarness returns a TestHarness with an empty state store.
+func NewTestHarness(t testing.T) *TestHarness {
+	publisher := stream.NewEventPublisher(1 * time.Millisecond)
+	return &TestHarness{
+		t: t,
+		fsm: fsm.NewFromDeps(fsm.Deps{
+			Logger: hclog.New(nil),
+			NewStateStore: func() *state.Store {
+				return state.NewStateStoreWithEventPublisher(nil, publisher)
+			},
+			Publisher:      publisher,
+			StorageBackend: fsm.NullStorageBackend,
+		}),
+		now: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+// FSM returns the FSM to hand to the reconciler.
+func (h *TestHarness) FSM() *fsm.FSM {
+	return h.fsm
+}
+
+// NextIndex returns the raft index to use for the next write.
+func (h *TestHarness) NextIndex() uint64 {
+	h.index++
+	return h.index
+}
+
+// Updater returns an Updater that writes entries directly to the state store.
+func (h *TestHarness) Updater() *Updater {
+	return &Updater{
+		UpdateWithStatus: func(entry structs.ControlledConfigEntry) (bool, error) {
+			return h.fsm.State().EnsureConfigEntryWithStatusCAS(h.NextIndex(), entry.GetRaftIndex().ModifyIndex, entry)
+		},
+		Update: func(entry structs.ConfigEntry) (bool, error) {
+			return h.fsm.State().EnsureConfigEntryCAS(h.NextIndex(), entry.GetRaftIndex().ModifyIndex, entry)
+		},
+	}
+}
+
+// Now returns the current time of the fake clock.
+func (h *TestHarness) Now() time.Time {
+	return h.now
+}
+
+// Advance moves the fake clock forward.
+func (h *TestHarness) Advance(d time.Duration) {
+	h.now = h.now.Add(d)
+}
+
+// Write validates and stores a config entry.
+func (h *TestHarness) Write(entry structs.ConfigEntry) {
+	h.t.Helper()
+	require.NoError(h.t, entry.Normalize())
+	require.NoError(h.t, entry.Validate())
+	require.NoError(h.t, h.fsm.State().EnsureConfigEntry(h.NextIndex(), entry))
+}
+
+// Entry returns the stored config entry, failing the test if it does not
+// exist.
+func (h *TestHarness) Entry(kind, name string) structs.ConfigEntry {
+	h.t.Helper()
+	_, entry, err := h.fsm.State().ConfigEntry(nil, kind, name, acl.DefaultEnterpriseMeta())
+	require.NoError(h.t, err)
+	require.NotNil(h.t, entry)
+	return entry
+}
+
+// Reconcile runs the reconciler once for the given config entry.
+func (h *TestHarness) Reconcile(reconciler Reconciler, kind, name string) error {
+	return reconciler.Reconcile(context.Background(), Request{
+		Kind: kind,
+		Name: name,
+		Meta: acl.DefaultEnterpriseMeta(),
+	})
+}
+
+// RequireRequeue runs the reconciler once and requires that it asks to be
+// requeued after the given duration.
+func (h *TestHarness) RequireRequeue(reconciler Reconciler, kind, name string, after time.Duration) {
+	h.t.Helper()
+	require.Equal(h.t, RequeueAfter(after), h.Reconcile(reconciler, kind, name))
+}
+
+// TestController is a Controller that doesn't run and only records the
+// triggers that reconcilers add, for reconcilers that add triggers while
+// being tested with a TestHarness.
+type TestController struct {
+	Triggers map[Request]struct{}
+}
+
+// NewTestController returns a TestController without triggers.
+func NewTestController() *TestController {
+	return &TestController{Triggers: make(map[Request]struct{})}
+}
+
+func (c *TestController) Run(ctx context.Context) error { return nil }
+func (c *TestController) Subscribe(request *stream.SubscribeRequest, transformers ...Transformer) Controller {
+	return c
+}
+func (c *TestController) WithBackoff(base, max time.Duration) Controller { return c }
+func (c *TestController) WithLogger(logger hclog.Logger) Controller      { return c }
+func (c *TestController) WithWorkers(i int) Controller                   { return c }
+func (c *TestController) WithQueueFactory(fn func(ctx context.Context, baseBackoff time.Duration, maxBackoff time.Duration) queue.WorkQueue[Request]) Controller {
+	return c
+}
+
+func (c *TestController) AddTrigger(request Request, trigger func(ctx context.Context) error) {
+	c.Triggers[request] = struct{}{}
+}
+
+func (c *TestController) RemoveTrigger(request Request) {
+	delete(c.Triggers, request)
+}
+
+func (c *TestController) Enqueue(requests ...Request) {}
@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package controller
+
+import (
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// Updater is a thin wrapper around a set of callbacks used by config entry
+// reconcilers for updating config entries via raft operations. Entries are
+// written with a check-and-set on their modify index, the callbacks return
+// false if the entry was modified since it was read.
+type Updater struct {
+	UpdateWithStatus func(entry structs.ControlledConfigEntry) (bool, error)
+	Update           func(entry structs.ConfigEntry) (bool, error)
+}
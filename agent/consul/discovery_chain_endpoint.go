@@ -61,13 +61,15 @@ func (c *DiscoveryChain) Get(args *structs.DiscoveryChainRequest, reply *structs
 		&reply.QueryMeta,
 		func(ws memdb.WatchSet, state *state.Store) error {
 			req := discoverychain.CompileRequest{
-				ServiceName:            args.Name,
-				EvaluateInNamespace:    entMeta.NamespaceOrDefault(),
-				EvaluateInPartition:    entMeta.PartitionOrDefault(),
-				EvaluateInDatacenter:   evalDC,
-				OverrideMeshGateway:    args.OverrideMeshGateway,
-				OverrideProtocol:       args.OverrideProtocol,
-				OverrideConnectTimeout: args.OverrideConnectTimeout,
+				ServiceName:                args.Name,
+				EvaluateInNamespace:        entMeta.NamespaceOrDefault(),
+				EvaluateInPartition:        entMeta.PartitionOrDefault(),
+				EvaluateInDatacenter:       evalDC,
+				OverrideMeshGateway:        args.OverrideMeshGateway,
+				OverrideProtocol:           args.OverrideProtocol,
+				OverrideConnectTimeout:     args.OverrideConnectTimeout,
+				OverrideLimits:             args.OverrideLimits,
+				OverridePassiveHealthCheck: args.OverridePassiveHealthCheck,
 			}
 			index, chain, entries, err := state.ServiceDiscoveryChain(ws, args.Name, entMeta, req)
 			if err != nil {
@@ -41,6 +41,14 @@ type CompileRequest struct {
 	// overridden for any resolver in the compiled chain.
 	OverrideConnectTimeout time.Duration
 
+	// OverrideLimits allows for the circuit breaking limits of any resolver
+	// in the compiled chain to be overridden.
+	OverrideLimits *structs.UpstreamLimits
+
+	// OverridePassiveHealthCheck allows for the outlier detection of any
+	// resolver in the compiled chain to be overridden.
+	OverridePassiveHealthCheck *structs.PassiveHealthCheck
+
 	Entries *configentry.DiscoveryChainSet
 
 	// AutoVirtualIPs and ManualVirtualIPs are lists of IPs associated with
@@ -92,17 +100,19 @@ func Compile(req CompileRequest) (*structs.CompiledDiscoveryChain, error) {
 	}
 
 	c := &compiler{
-		serviceName:            serviceName,
-		evaluateInPartition:    evaluateInPartition,
-		evaluateInNamespace:    evaluateInNamespace,
-		evaluateInDatacenter:   evaluateInDatacenter,
-		evaluateInTrustDomain:  evaluateInTrustDomain,
-		overrideMeshGateway:    req.OverrideMeshGateway,
-		overrideProtocol:       req.OverrideProtocol,
-		overrideConnectTimeout: req.OverrideConnectTimeout,
-		entries:                entries,
-		autoVirtualIPs:         req.AutoVirtualIPs,
-		manualVirtualIPs:       req.ManualVirtualIPs,
+		serviceName:                serviceName,
+		evaluateInPartition:        evaluateInPartition,
+		evaluateInNamespace:        evaluateInNamespace,
+		evaluateInDatacenter:       evaluateInDatacenter,
+		evaluateInTrustDomain:      evaluateInTrustDomain,
+		overrideMeshGateway:        req.OverrideMeshGateway,
+		overrideProtocol:           req.OverrideProtocol,
+		overrideConnectTimeout:     req.OverrideConnectTimeout,
+		overrideLimits:             req.OverrideLimits,
+		overridePassiveHealthCheck: req.OverridePassiveHealthCheck,
+		entries:                    entries,
+		autoVirtualIPs:             req.AutoVirtualIPs,
+		manualVirtualIPs:           req.ManualVirtualIPs,
 
 		resolvers:     make(map[structs.ServiceID]*structs.ServiceResolverConfigEntry),
 		splitterNodes: make(map[string]*structs.DiscoveryGraphNode),
@@ -139,6 +149,9 @@ type compiler struct {
 	overrideProtocol       string
 	overrideConnectTimeout time.Duration
 
+	overrideLimits             *structs.UpstreamLimits
+	overridePassiveHealthCheck *structs.PassiveHealthCheck
+
 	// config entries that are being compiled (will be mutated during compilation)
 	//
 	// This is an INPUT field.
@@ -535,6 +548,9 @@ func (c *compiler) removeUnusedNodes() error {
 		case structs.DiscoveryGraphNodeTypeRouter:
 			for _, route := range node.Routes {
 				todo[route.NextNode] = struct{}{}
+				if route.MirrorNode != "" {
+					todo[route.MirrorNode] = struct{}{}
+				}
 			}
 		case structs.DiscoveryGraphNodeTypeSplitter:
 			for _, split := range node.Splits {
@@ -673,6 +689,24 @@ func (c *compiler) assembleChain() error {
 			return err
 		}
 		compiledRoute.NextNode = node.MapKey()
+
+		// Mirrored traffic goes straight to a resolver, there is no further
+		// splitting of the copy.
+		if mirror := dest.Mirror; mirror != nil {
+			mirrorNode, err := c.getResolverNode(
+				c.newTarget(structs.DiscoveryTargetOpts{
+					Service:       defaultIfEmpty(mirror.Service, c.serviceName),
+					ServiceSubset: mirror.ServiceSubset,
+					Namespace:     defaultIfEmpty(mirror.Namespace, router.NamespaceOrDefault()),
+					Partition:     defaultIfEmpty(mirror.Partition, router.PartitionOrDefault()),
+				}),
+				false,
+			)
+			if err != nil {
+				return err
+			}
+			compiledRoute.MirrorNode = mirrorNode.MapKey()
+		}
 	}
 
 	// If we have a router, we'll add a catch-all route at the end to send
@@ -994,15 +1028,28 @@ RESOLVE_AGAIN:
 	// Expose a copy of this on the targets for ease of access.
 	target.ConnectTimeout = connectTimeout
 
+	// The limits and outlier detection of the resolver are defaults that
+	// apply to every caller that does not configure its own.
+	limits := resolver.Limits
+	if c.overrideLimits != nil {
+		limits = c.overrideLimits
+	}
+	passiveHealthCheck := resolver.PassiveHealthCheck
+	if c.overridePassiveHealthCheck != nil {
+		passiveHealthCheck = c.overridePassiveHealthCheck
+	}
+
 	// Build node.
 	node := &structs.DiscoveryGraphNode{
 		Type: structs.DiscoveryGraphNodeTypeResolver,
 		Name: target.ID,
 		Resolver: &structs.DiscoveryResolver{
-			Default:        resolver.IsDefault(),
-			Target:         target.ID,
-			ConnectTimeout: connectTimeout,
-			RequestTimeout: resolver.RequestTimeout,
+			Default:            resolver.IsDefault(),
+			Target:             target.ID,
+			ConnectTimeout:     connectTimeout,
+			RequestTimeout:     resolver.RequestTimeout,
+			Limits:             limits,
+			PassiveHealthCheck: passiveHealthCheck,
 		},
 		LoadBalancer: resolver.LoadBalancer,
 	}
@@ -35,6 +35,7 @@ func TestCompile(t *testing.T) {
 		"router with defaults and noop split and resolver": testcase_RouterWithDefaults_WithNoopSplit_WithResolver(),
 		"router with no destination":                       testcase_JustRouterWithNoDestination(),
 		"route bypasses splitter":                          testcase_RouteBypassesSplit(),
+		"route with mirror":                                testcase_RouteWithMirror(),
 		"noop split":                                       testcase_NoopSplit_DefaultResolver(),
 		"noop split with protocol from proxy defaults":     testcase_NoopSplit_DefaultResolver_ProtocolFromProxyDefaults(),
 		"noop split with resolver":                         testcase_NoopSplit_WithResolver(),
@@ -86,6 +87,7 @@ func TestCompile(t *testing.T) {
 		"resolver with protocol from override":         testcase_ResolverProtocolOverride(),
 		"resolver with protocol from override ignored": testcase_ResolverProtocolOverrideIgnored(),
 		"router ignored due to protocol override":      testcase_RouterIgnored_ResolverProtocolOverride(),
+		"resolver with limits from override":           testcase_ResolverLimitsOverride(),
 
 		// circular references
 		"circular resolver redirect": testcase_Resolver_CircularRedirect(),
@@ -617,6 +619,84 @@ func testcase_RouteBypassesSplit() compileTestCase {
 	return compileTestCase{entries: entries, expect: expect}
 }
 
+func testcase_RouteWithMirror() compileTestCase {
+	entries := newEntries()
+	setServiceProtocol(entries, "main", "http")
+	setServiceProtocol(entries, "shadow", "http")
+
+	entries.AddRouters(
+		&structs.ServiceRouterConfigEntry{
+			Kind: "service-router",
+			Name: "main",
+			Routes: []structs.ServiceRoute{
+				newSimpleRoute("main", func(r *structs.ServiceRoute) {
+					r.Destination.Mirror = &structs.ServiceRouteMirror{
+						Service: "shadow",
+						Percent: ptrTo[float32](25),
+					}
+				}),
+			},
+		},
+	)
+	entries.AddSplitters(
+		&structs.ServiceSplitterConfigEntry{
+			Kind: "service-splitter",
+			Name: "shadow",
+			Splits: []structs.ServiceSplit{
+				{Weight: 100, Service: "ignored"},
+			},
+		},
+	)
+
+	router := entries.GetRouter(structs.NewServiceID("main", nil))
+
+	expect := &structs.CompiledDiscoveryChain{
+		Protocol:  "http",
+		StartNode: "router:main.default.default",
+		Nodes: map[string]*structs.DiscoveryGraphNode{
+			"router:main.default.default": {
+				Type: structs.DiscoveryGraphNodeTypeRouter,
+				Name: "main.default.default",
+				Routes: []*structs.DiscoveryRoute{
+					{
+						Definition: &router.Routes[0],
+						NextNode:   "resolver:main.default.default.dc1",
+						MirrorNode: "resolver:shadow.default.default.dc1",
+					},
+					{
+						Definition: newDefaultServiceRoute("main", "default", "default"),
+						NextNode:   "resolver:main.default.default.dc1",
+					},
+				},
+			},
+			"resolver:main.default.default.dc1": {
+				Type: structs.DiscoveryGraphNodeTypeResolver,
+				Name: "main.default.default.dc1",
+				Resolver: &structs.DiscoveryResolver{
+					Default:        true,
+					ConnectTimeout: 5 * time.Second,
+					Target:         "main.default.default.dc1",
+				},
+			},
+			"resolver:shadow.default.default.dc1": {
+				Type: structs.DiscoveryGraphNodeTypeResolver,
+				Name: "shadow.default.default.dc1",
+				Resolver: &structs.DiscoveryResolver{
+					Default:        true,
+					ConnectTimeout: 5 * time.Second,
+					Target:         "shadow.default.default.dc1",
+				},
+			},
+		},
+		Targets: map[string]*structs.DiscoveryTarget{
+			"main.default.default.dc1":   newTarget(structs.DiscoveryTargetOpts{Service: "main"}, nil),
+			"shadow.default.default.dc1": newTarget(structs.DiscoveryTargetOpts{Service: "shadow"}, nil),
+		},
+	}
+
+	return compileTestCase{entries: entries, expect: expect}
+}
+
 func testcase_NoopSplit_DefaultResolver() compileTestCase {
 	entries := newEntries()
 	setServiceProtocol(entries, "main", "http")
@@ -2844,6 +2924,57 @@ func testcase_RouterIgnored_ResolverProtocolOverride() compileTestCase {
 	}
 }
 
+func testcase_ResolverLimitsOverride() compileTestCase {
+	// This shows that the limits of the caller replace the defaults from the
+	// resolver while the outlier detection of the resolver is kept.
+	entries := newEntries()
+	entries.AddResolvers(
+		&structs.ServiceResolverConfigEntry{
+			Kind: "service-resolver",
+			Name: "main",
+			Limits: &structs.UpstreamLimits{
+				MaxConnections: ptrTo(100),
+			},
+			PassiveHealthCheck: &structs.PassiveHealthCheck{
+				Interval:    10 * time.Second,
+				MaxFailures: 5,
+			},
+		},
+	)
+
+	expect := &structs.CompiledDiscoveryChain{
+		Protocol:  "tcp",
+		StartNode: "resolver:main.default.default.dc1",
+		Nodes: map[string]*structs.DiscoveryGraphNode{
+			"resolver:main.default.default.dc1": {
+				Type: structs.DiscoveryGraphNodeTypeResolver,
+				Name: "main.default.default.dc1",
+				Resolver: &structs.DiscoveryResolver{
+					ConnectTimeout: 5 * time.Second,
+					Target:         "main.default.default.dc1",
+					Limits: &structs.UpstreamLimits{
+						MaxConnections: ptrTo(10),
+					},
+					PassiveHealthCheck: &structs.PassiveHealthCheck{
+						Interval:    10 * time.Second,
+						MaxFailures: 5,
+					},
+				},
+			},
+		},
+		Targets: map[string]*structs.DiscoveryTarget{
+			"main.default.default.dc1": newTarget(structs.DiscoveryTargetOpts{Service: "main"}, nil),
+		},
+	}
+	return compileTestCase{entries: entries, expect: expect,
+		setup: func(req *CompileRequest) {
+			req.OverrideLimits = &structs.UpstreamLimits{
+				MaxConnections: ptrTo(10),
+			}
+		},
+	}
+}
+
 func testcase_Resolver_CircularRedirect() compileTestCase {
 	entries := newEntries()
 	entries.AddResolvers(
@@ -3312,3 +3443,7 @@ func targetWithConnectTimeout(t *structs.DiscoveryTarget, connectTimeout time.Du
 	t.ConnectTimeout = connectTimeout
 	return t
 }
+
+func ptrTo[T any](v T) *T {
+	return &v
+}
@@ -24,6 +24,7 @@ type GatewayChainSynthesizer struct {
 	hostname          string
 	matchesByHostname map[string][]hostnameMatch
 	tcpRoutes         []structs.TCPRouteConfigEntry
+	tlsRoutes         []structs.TLSRouteConfigEntry
 }
 
 type hostnameMatch struct {
@@ -50,6 +51,11 @@ func (l *GatewayChainSynthesizer) AddTCPRoute(route structs.TCPRouteConfigEntry)
 	l.tcpRoutes = append(l.tcpRoutes, route)
 }
 
+// AddTLSRoute adds a TLSRoute to use in synthesizing a discovery chain
+func (l *GatewayChainSynthesizer) AddTLSRoute(route structs.TLSRouteConfigEntry) {
+	l.tlsRoutes = append(l.tlsRoutes, route)
+}
+
 // SetHostname sets the base hostname for a listener that this is being synthesized for
 func (l *GatewayChainSynthesizer) SetHostname(hostname string) {
 	l.hostname = hostname
@@ -63,6 +69,12 @@ func (l *GatewayChainSynthesizer) AddHTTPRoute(route structs.HTTPRouteConfigEntr
 	l.matchesByHostname = initHostMatches(l.hostname, &route, l.matchesByHostname)
 }
 
+// AddGRPCRoute converts a GRPCRoute into its equivalent HTTPRoute and adds it
+// in the same way as AddHTTPRoute.
+func (l *GatewayChainSynthesizer) AddGRPCRoute(route structs.GRPCRouteConfigEntry) {
+	l.AddHTTPRoute(*GRPCRouteToHTTPRoute(&route))
+}
+
 func initHostMatches(hostname string, route *structs.HTTPRouteConfigEntry, currentMatches map[string][]hostnameMatch) map[string][]hostnameMatch {
 	hostnames := route.FilteredHostnames(hostname)
 	for _, host := range hostnames {
@@ -107,7 +119,7 @@ func initHostMatches(hostname string, route *structs.HTTPRouteConfigEntry, curre
 //
 // This is currently used to help API gateways masquarade as ingress gateways
 // by providing a set of virtual config entries that change the routing behavior
-// to upstreams referenced in the given HTTPRoutes or TCPRoutes.
+// to upstreams referenced in the given HTTPRoutes, GRPCRoutes, TCPRoutes, or TLSRoutes.
 func (l *GatewayChainSynthesizer) Synthesize(chains ...*structs.CompiledDiscoveryChain) ([]structs.IngressService, []*structs.CompiledDiscoveryChain, error) {
 	if len(chains) == 0 {
 		return nil, nil, fmt.Errorf("must provide at least one compiled discovery chain")
@@ -305,5 +317,9 @@ func (l *GatewayChainSynthesizer) synthesizeEntries() ([]structs.IngressService,
 		services = append(services, synthesizeTCPRouteDiscoveryChain(route)...)
 	}
 
+	for _, route := range l.tlsRoutes {
+		services = append(services, synthesizeTLSRouteDiscoveryChain(route)...)
+	}
+
 	return services, entries
 }
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package discoverychain
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// grpcAnySegment matches any single segment of a gRPC request path
+const grpcAnySegment = "[^/]+"
+
+// GRPCRouteToHTTPRoute converts a GRPCRoute into the equivalent HTTPRoute. gRPC
+// requests are HTTP/2 POST requests to a path of the form "/<service>/<method>",
+// so every gRPC method match can be represented as an HTTP path match, which
+// lets gRPC routes share the HTTP routing machinery of a gateway listener.
+func GRPCRouteToHTTPRoute(route *structs.GRPCRouteConfigEntry) *structs.HTTPRouteConfigEntry {
+	httpRoute := &structs.HTTPRouteConfigEntry{
+		Kind:           structs.HTTPRoute,
+		Name:           route.Name,
+		Parents:        route.Parents,
+		Hostnames:      route.Hostnames,
+		Rules:          make([]structs.HTTPRouteRule, 0, len(route.Rules)),
+		Meta:           route.Meta,
+		Status:         route.Status,
+		EnterpriseMeta: route.EnterpriseMeta,
+	}
+
+	for _, rule := range route.Rules {
+		httpRule := structs.HTTPRouteRule{
+			Filters:         grpcFiltersToHTTPFilters(rule.Filters),
+			ResponseFilters: rule.ResponseFilters,
+			Matches:         make([]structs.HTTPMatch, 0, len(rule.Matches)),
+			Services:        make([]structs.HTTPService, 0, len(rule.Services)),
+		}
+
+		for _, match := range rule.Matches {
+			httpRule.Matches = append(httpRule.Matches, structs.HTTPMatch{
+				Headers: match.Headers,
+				Path:    grpcMethodMatchToHTTPPathMatch(match.Method),
+			})
+		}
+
+		for _, service := range rule.Services {
+			httpRule.Services = append(httpRule.Services, structs.HTTPService{
+				Name:            service.Name,
+				Weight:          service.Weight,
+				Filters:         grpcFiltersToHTTPFilters(service.Filters),
+				ResponseFilters: service.ResponseFilters,
+				EnterpriseMeta:  service.EnterpriseMeta,
+			})
+		}
+
+		httpRoute.Rules = append(httpRoute.Rules, httpRule)
+	}
+
+	return httpRoute
+}
+
+func grpcFiltersToHTTPFilters(filters structs.GRPCFilters) structs.HTTPFilters {
+	return structs.HTTPFilters{
+		Headers:       filters.Headers,
+		RetryFilter:   filters.RetryFilter,
+		TimeoutFilter: filters.TimeoutFilter,
+	}
+}
+
+// grpcMethodMatchToHTTPPathMatch converts a gRPC service and method match into
+// a match on the request path. Empty service or method values match any value.
+func grpcMethodMatchToHTTPPathMatch(match structs.GRPCMethodMatch) structs.HTTPPathMatch {
+	if match.Service == "" && match.Method == "" {
+		return structs.HTTPPathMatch{
+			Match: structs.HTTPPathMatchPrefix,
+			Value: "/",
+		}
+	}
+
+	if match.Match == structs.GRPCMethodMatchRegularExpression {
+		service, method := grpcAnySegment, grpcAnySegment
+		if match.Service != "" {
+			service = match.Service
+		}
+		if match.Method != "" {
+			method = match.Method
+		}
+		return structs.HTTPPathMatch{
+			Match: structs.HTTPPathMatchRegularExpression,
+			Value: "/(" + service + ")/(" + method + ")",
+		}
+	}
+
+	switch {
+	case match.Method == "":
+		return structs.HTTPPathMatch{
+			Match: structs.HTTPPathMatchPrefix,
+			Value: "/" + match.Service + "/",
+		}
+	case match.Service == "":
+		return structs.HTTPPathMatch{
+			Match: structs.HTTPPathMatchRegularExpression,
+			Value: "/" + grpcAnySegment + "/" + regexp.QuoteMeta(match.Method),
+		}
+	default:
+		return structs.HTTPPathMatch{
+			Match: structs.HTTPPathMatchExact,
+			Value: "/" + match.Service + "/" + match.Method,
+		}
+	}
+}
@@ -79,6 +79,29 @@ func httpRouteToDiscoveryChain(route structs.HTTPRouteConfigEntry) (*structs.Ser
 	var defaults []*structs.ServiceConfigEntry
 
 	for idx, rule := range route.Rules {
+		// rules answering the request themselves have no destination, they
+		// are only matched to issue the redirect or the fixed response
+		if rule.Filters.RedirectFilter != nil || rule.Filters.DirectResponseFilter != nil {
+			redirect := httpRouteFiltersToServiceRouteRedirect(rule.Filters.RedirectFilter)
+			directResponse := httpRouteFiltersToServiceRouteDirectResponse(rule.Filters.DirectResponseFilter)
+
+			if len(rule.Matches) == 0 {
+				router.Routes = append(router.Routes, structs.ServiceRoute{
+					Redirect:       redirect,
+					DirectResponse: directResponse,
+				})
+			}
+
+			for _, match := range rule.Matches {
+				router.Routes = append(router.Routes, structs.ServiceRoute{
+					Match:          &structs.ServiceRouteMatch{HTTP: httpRouteMatchToServiceRouteHTTPMatch(match)},
+					Redirect:       redirect,
+					DirectResponse: directResponse,
+				})
+			}
+			continue
+		}
+
 		requestModifier := httpRouteFiltersToServiceRouteHeaderModifier(rule.Filters.Headers)
 		responseModifier := httpRouteFiltersToServiceRouteHeaderModifier(rule.ResponseFilters.Headers)
 		prefixRewrite := httpRouteFiltersToDestinationPrefixRewrite(rule.Filters.URLRewrite)
@@ -114,6 +137,7 @@ func httpRouteToDiscoveryChain(route structs.HTTPRouteConfigEntry) (*structs.Ser
 			destination.PrefixRewrite = servicePrefixRewrite
 			destination.RequestHeaders = requestModifier
 			destination.ResponseHeaders = responseModifier
+			destination.SessionAffinity = service.SessionAffinity
 
 			// since we have already validated the protocol elsewhere, we
 			// create a new service defaults here to make sure we pass validation
@@ -188,6 +212,10 @@ func httpRouteToDiscoveryChain(route structs.HTTPRouteConfigEntry) (*structs.Ser
 			if len(rule.Filters.RetryFilter.RetryOnStatusCodes) > 0 {
 				destination.RetryOnStatusCodes = rule.Filters.RetryFilter.RetryOnStatusCodes
 			}
+
+			destination.PerTryTimeout = rule.Filters.RetryFilter.PerTryTimeout
+			destination.HedgePolicy = rule.Filters.RetryFilter.HedgePolicy
+			destination.RetryBudget = rule.Filters.RetryFilter.RetryBudget
 		}
 
 		if rule.Filters.TimeoutFilter != nil {
@@ -220,6 +248,30 @@ func httpRouteFiltersToDestinationPrefixRewrite(rewrite *structs.URLRewrite) str
 	return rewrite.Path
 }
 
+func httpRouteFiltersToServiceRouteRedirect(redirect *structs.RedirectFilter) *structs.ServiceRouteRedirect {
+	if redirect == nil {
+		return nil
+	}
+	return &structs.ServiceRouteRedirect{
+		Scheme:        redirect.Scheme,
+		Host:          redirect.Hostname,
+		Port:          redirect.Port,
+		Path:          redirect.Path,
+		PrefixRewrite: redirect.PrefixRewrite,
+		StatusCode:    redirect.StatusCode,
+	}
+}
+
+func httpRouteFiltersToServiceRouteDirectResponse(response *structs.DirectResponseFilter) *structs.ServiceRouteDirectResponse {
+	if response == nil {
+		return nil
+	}
+	return &structs.ServiceRouteDirectResponse{
+		Status: response.Status,
+		Body:   response.Body,
+	}
+}
+
 // httpRouteFiltersToServiceRouteHeaderModifier will consolidate a list of HTTP filters
 // into a single set of header modifications for Consul to make as a request passes through.
 func httpRouteFiltersToServiceRouteHeaderModifier(filters []structs.HTTPHeaderFilter) *structs.HTTPHeaderModifiers {
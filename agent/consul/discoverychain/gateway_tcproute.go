@@ -18,3 +18,18 @@ func synthesizeTCPRouteDiscoveryChain(route structs.TCPRouteConfigEntry) []struc
 
 	return services
 }
+
+func synthesizeTLSRouteDiscoveryChain(route structs.TLSRouteConfigEntry) []structs.IngressService {
+	services := make([]structs.IngressService, 0, len(route.Services))
+	for _, service := range route.Services {
+		ingress := structs.IngressService{
+			Name:           service.Name,
+			Hosts:          route.Hostnames,
+			EnterpriseMeta: service.EnterpriseMeta,
+		}
+
+		services = append(services, ingress)
+	}
+
+	return services
+}
@@ -43,6 +43,171 @@ func TestGatewayChainSynthesizer_AddTCPRoute(t *testing.T) {
 	require.Equal(t, expected, *gatewayChainSynthesizer)
 }
 
+func TestGatewayChainSynthesizer_AddTLSRoute(t *testing.T) {
+	t.Parallel()
+
+	datacenter := "dc1"
+	gateway := &structs.APIGatewayConfigEntry{
+		Kind: structs.APIGateway,
+		Name: "gateway",
+	}
+	route := structs.TLSRouteConfigEntry{
+		Kind:      structs.TLSRoute,
+		Name:      "route",
+		Hostnames: []string{"example.com"},
+		Services: []structs.TCPService{{
+			Name: "foo",
+		}},
+	}
+
+	gatewayChainSynthesizer := NewGatewayChainSynthesizer(datacenter, "domain", "suffix", gateway)
+	gatewayChainSynthesizer.AddTLSRoute(route)
+
+	require.Equal(t, []structs.TLSRouteConfigEntry{route}, gatewayChainSynthesizer.tlsRoutes)
+
+	services, entries := gatewayChainSynthesizer.synthesizeEntries()
+	require.Empty(t, entries)
+	require.Equal(t, []structs.IngressService{{
+		Name:  "foo",
+		Hosts: []string{"example.com"},
+	}}, services)
+}
+
+func TestGRPCRouteToHTTPRoute(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		match    structs.GRPCMethodMatch
+		expected structs.HTTPPathMatch
+	}{
+		"no method match": {
+			expected: structs.HTTPPathMatch{Match: structs.HTTPPathMatchPrefix, Value: "/"},
+		},
+		"exact service and method": {
+			match:    structs.GRPCMethodMatch{Match: structs.GRPCMethodMatchExact, Service: "foo.Bar", Method: "Baz"},
+			expected: structs.HTTPPathMatch{Match: structs.HTTPPathMatchExact, Value: "/foo.Bar/Baz"},
+		},
+		"exact service": {
+			match:    structs.GRPCMethodMatch{Match: structs.GRPCMethodMatchExact, Service: "foo.Bar"},
+			expected: structs.HTTPPathMatch{Match: structs.HTTPPathMatchPrefix, Value: "/foo.Bar/"},
+		},
+		"exact method": {
+			match:    structs.GRPCMethodMatch{Match: structs.GRPCMethodMatchExact, Method: "Baz"},
+			expected: structs.HTTPPathMatch{Match: structs.HTTPPathMatchRegularExpression, Value: "/[^/]+/Baz"},
+		},
+		"regex service": {
+			match:    structs.GRPCMethodMatch{Match: structs.GRPCMethodMatchRegularExpression, Service: "foo\\..*"},
+			expected: structs.HTTPPathMatch{Match: structs.HTTPPathMatchRegularExpression, Value: "/(foo\\..*)/([^/]+)"},
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			route := &structs.GRPCRouteConfigEntry{
+				Kind:      structs.GRPCRoute,
+				Name:      "route",
+				Hostnames: []string{"example.com"},
+				Rules: []structs.GRPCRouteRule{{
+					Filters: structs.GRPCFilters{
+						TimeoutFilter: &structs.TimeoutFilter{RequestTimeout: 5},
+					},
+					Matches: []structs.GRPCMatch{{
+						Headers: []structs.HTTPHeaderMatch{{Match: structs.HTTPHeaderMatchExact, Name: "x-foo", Value: "bar"}},
+						Method:  tc.match,
+					}},
+					Services: []structs.GRPCService{{
+						Name:   "foo",
+						Weight: 2,
+					}},
+				}},
+			}
+
+			expected := &structs.HTTPRouteConfigEntry{
+				Kind:      structs.HTTPRoute,
+				Name:      "route",
+				Hostnames: []string{"example.com"},
+				Rules: []structs.HTTPRouteRule{{
+					Filters: structs.HTTPFilters{
+						TimeoutFilter: &structs.TimeoutFilter{RequestTimeout: 5},
+					},
+					Matches: []structs.HTTPMatch{{
+						Headers: []structs.HTTPHeaderMatch{{Match: structs.HTTPHeaderMatchExact, Name: "x-foo", Value: "bar"}},
+						Path:    tc.expected,
+					}},
+					Services: []structs.HTTPService{{
+						Name:   "foo",
+						Weight: 2,
+					}},
+				}},
+			}
+
+			require.Equal(t, expected, GRPCRouteToHTTPRoute(route))
+		})
+	}
+}
+
+func TestHTTPRouteToDiscoveryChain_RedirectAndDirectResponse(t *testing.T) {
+	t.Parallel()
+
+	route := structs.HTTPRouteConfigEntry{
+		Kind: structs.HTTPRoute,
+		Name: "route",
+		Rules: []structs.HTTPRouteRule{
+			{
+				Filters: structs.HTTPFilters{
+					RedirectFilter: &structs.RedirectFilter{
+						Scheme:     "https",
+						Hostname:   "example.com",
+						Port:       8443,
+						StatusCode: 308,
+					},
+				},
+				Matches: []structs.HTTPMatch{
+					{Path: structs.HTTPPathMatch{Match: structs.HTTPPathMatchPrefix, Value: "/v1/"}},
+					{Path: structs.HTTPPathMatch{Match: structs.HTTPPathMatchExact, Value: "/v1"}},
+				},
+			},
+			{
+				Filters: structs.HTTPFilters{
+					DirectResponseFilter: &structs.DirectResponseFilter{
+						Status: 503,
+						Body:   "down for maintenance",
+					},
+				},
+			},
+		},
+	}
+
+	router, splitters, defaults := httpRouteToDiscoveryChain(route)
+	require.Empty(t, splitters)
+	require.Empty(t, defaults)
+
+	redirect := &structs.ServiceRouteRedirect{
+		Scheme:     "https",
+		Host:       "example.com",
+		Port:       8443,
+		StatusCode: 308,
+	}
+	require.Equal(t, []structs.ServiceRoute{
+		{
+			Match:    &structs.ServiceRouteMatch{HTTP: &structs.ServiceRouteHTTPMatch{PathPrefix: "/v1/"}},
+			Redirect: redirect,
+		},
+		{
+			Match:    &structs.ServiceRouteMatch{HTTP: &structs.ServiceRouteHTTPMatch{PathExact: "/v1"}},
+			Redirect: redirect,
+		},
+		{
+			DirectResponse: &structs.ServiceRouteDirectResponse{
+				Status: 503,
+				Body:   "down for maintenance",
+			},
+		},
+	}, router.Routes)
+	require.NoError(t, router.Validate())
+}
+
 func TestGatewayChainSynthesizer_AddHTTPRoute(t *testing.T) {
 	t.Parallel()
 
@@ -1067,3 +1232,27 @@ func TestGatewayChainSynthesizer_ComplexChain(t *testing.T) {
 		})
 	}
 }
+
+func TestHTTPRouteToDiscoveryChain_SessionAffinity(t *testing.T) {
+	t.Parallel()
+
+	affinity := &structs.SessionAffinity{
+		Type: structs.SessionAffinityHeader,
+		Name: "x-session-id",
+	}
+	route := structs.HTTPRouteConfigEntry{
+		Kind: structs.HTTPRoute,
+		Name: "route",
+		Rules: []structs.HTTPRouteRule{
+			{
+				Services: []structs.HTTPService{
+					{Name: "foo", SessionAffinity: affinity},
+				},
+			},
+		},
+	}
+
+	router, _, _ := httpRouteToDiscoveryChain(route)
+	require.Len(t, router.Routes, 1)
+	require.Equal(t, affinity, router.Routes[0].Destination.SessionAffinity)
+}
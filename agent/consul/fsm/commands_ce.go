@@ -148,6 +148,7 @@ func init() {
 	registerCommand(structs.PeeringSecretsWriteType, (*FSM).applyPeeringSecretsWrite)
 	registerCommand(structs.ResourceOperationType, (*FSM).applyResourceOperation)
 	registerCommand(structs.UpdateVirtualIPRequestType, (*FSM).applyManualVirtualIPs)
+	registerCommand(structs.ConnectCARevocationRequestType, (*FSM).applyConnectCARevocationOperation)
 }
 
 func (c *FSM) applyRegister(buf []byte, index uint64) interface{} {
@@ -493,11 +494,19 @@ func (c *FSM) applyConnectCALeafOperation(buf []byte, index uint64) interface{}
 		[]metrics.Label{{Name: "op", Value: string(req.Op)}})
 	switch req.Op {
 	case structs.CALeafOpIncrementIndex:
+		if req.IssuedCert != nil {
+			if err := c.state.CALeafIssueCert(index, req.IssuedCert); err != nil {
+				return err
+			}
+			return index
+		}
 		// Use current index as the new value as well as the value to write at.
 		if err := c.state.CALeafSetIndex(index, index); err != nil {
 			return err
 		}
 		return index
+	case structs.CALeafOpDeleteCerts:
+		return c.state.CALeafCertsDelete(index, req.SerialNumbers)
 	default:
 		c.logger.Warn("Invalid CA Leaf operation", "operation", req.Op)
 		return fmt.Errorf("Invalid CA operation '%s'", req.Op)
@@ -708,6 +717,29 @@ func (c *FSM) applyFederationStateOperation(buf []byte, index uint64) interface{
 	}
 }
 
+func (c *FSM) applyConnectCARevocationOperation(buf []byte, index uint64) interface{} {
+	var req structs.CARevocationRequest
+	if err := structs.Decode(buf, &req); err != nil {
+		panic(fmt.Errorf("failed to decode request: %v", err))
+	}
+
+	defer metrics.MeasureSinceWithLabels([]string{"fsm", "ca", "revocation"}, time.Now(),
+		[]metrics.Label{{Name: "op", Value: string(req.Op)}})
+
+	switch req.Op {
+	case structs.CARevocationOpRevoke:
+		return c.state.CARevoke(index, req.Revocations)
+	case structs.CARevocationOpSetList:
+		return c.state.CARevocationListSet(index, req.RevocationLists)
+	case structs.CARevocationOpDelete:
+		return c.state.CARevocationsDelete(index, req.Revocations, req.RevocationLists)
+	case structs.CARevocationOpReplace:
+		return c.state.CARevocationsReplace(index, req.Revocations, req.RevocationLists)
+	default:
+		return fmt.Errorf("invalid CA revocation operation type: %v", req.Op)
+	}
+}
+
 func (c *FSM) applySystemMetadataOperation(buf []byte, index uint64) interface{} {
 	var req structs.SystemMetadataRequest
 	if err := structs.Decode(buf, &req); err != nil {
@@ -627,6 +627,16 @@ func MakeShadowConfigEntry(kind, name string) (structs.ConfigEntry, error) {
 		return &ShadowHTTPRouteConfigEntry{HTTPRouteConfigEntry: &structs.HTTPRouteConfigEntry{Name: name}}, nil
 	case structs.TCPRoute:
 		return &ShadowTCPRouteConfigEntry{TCPRouteConfigEntry: &structs.TCPRouteConfigEntry{Name: name}}, nil
+	case structs.GRPCRoute:
+		return &ShadowGRPCRouteConfigEntry{GRPCRouteConfigEntry: &structs.GRPCRouteConfigEntry{Name: name}}, nil
+	case structs.TLSRoute:
+		return &ShadowTLSRouteConfigEntry{TLSRouteConfigEntry: &structs.TLSRouteConfigEntry{Name: name}}, nil
+	case structs.CanaryRollout:
+		return &ShadowCanaryRolloutConfigEntry{CanaryRolloutConfigEntry: &structs.CanaryRolloutConfigEntry{Name: name}}, nil
+	case structs.TrustBundle:
+		return &ShadowTrustBundleConfigEntry{TrustBundleConfigEntry: &structs.TrustBundleConfigEntry{Name: name}}, nil
+	case structs.ACMECertificate:
+		return &ShadowACMECertificateConfigEntry{ACMECertificateConfigEntry: &structs.ACMECertificateConfigEntry{Name: name}}, nil
 	case structs.JWTProvider:
 		return &ShadowJWTProviderConfigEntry{JWTProviderConfigEntry: &structs.JWTProviderConfigEntry{Name: name}}, nil
 	default:
@@ -1012,6 +1022,86 @@ func (s ShadowTCPRouteConfigEntry) GetRealConfigEntry() structs.ConfigEntry {
 	return s.TCPRouteConfigEntry
 }
 
+type ShadowGRPCService struct {
+	ShadowBase
+	*structs.GRPCService
+}
+type ShadowGRPCRouteRule struct {
+	Services []ShadowGRPCService
+	*structs.GRPCRouteRule
+}
+type ShadowGRPCRouteConfigEntry struct {
+	ShadowBase
+	Parents []ShadowResourceReference
+	Rules   []ShadowGRPCRouteRule
+	*structs.GRPCRouteConfigEntry
+}
+
+func (s ShadowGRPCRouteConfigEntry) GetRealConfigEntry() structs.ConfigEntry {
+	for _, parent := range s.Parents {
+		if !IsEnterpriseData(parent.Namespace, parent.Partition) {
+			s.GRPCRouteConfigEntry.Parents = append(s.GRPCRouteConfigEntry.Parents, *parent.ResourceReference)
+		}
+	}
+	for _, rule := range s.Rules {
+		for _, svc := range rule.Services {
+			if !IsEnterpriseData(svc.Namespace, svc.Partition) {
+				rule.GRPCRouteRule.Services = append(rule.GRPCRouteRule.Services, *svc.GRPCService)
+			}
+		}
+		s.GRPCRouteConfigEntry.Rules = append(s.GRPCRouteConfigEntry.Rules, *rule.GRPCRouteRule)
+	}
+	return s.GRPCRouteConfigEntry
+}
+
+type ShadowTLSRouteConfigEntry struct {
+	ShadowBase
+	Parents  []ShadowResourceReference
+	Services []ShadowTCPService
+	*structs.TLSRouteConfigEntry
+}
+
+func (s ShadowTLSRouteConfigEntry) GetRealConfigEntry() structs.ConfigEntry {
+	for _, parent := range s.Parents {
+		if !IsEnterpriseData(parent.Namespace, parent.Partition) {
+			s.TLSRouteConfigEntry.Parents = append(s.TLSRouteConfigEntry.Parents, *parent.ResourceReference)
+		}
+	}
+	for _, svc := range s.Services {
+		if !IsEnterpriseData(svc.Namespace, svc.Partition) {
+			s.TLSRouteConfigEntry.Services = append(s.TLSRouteConfigEntry.Services, *svc.TCPService)
+		}
+	}
+	return s.TLSRouteConfigEntry
+}
+
+type ShadowCanaryRolloutConfigEntry struct {
+	ShadowBase
+	*structs.CanaryRolloutConfigEntry
+}
+
+func (s ShadowCanaryRolloutConfigEntry) GetRealConfigEntry() structs.ConfigEntry {
+	return s.CanaryRolloutConfigEntry
+}
+
+type ShadowTrustBundleConfigEntry struct {
+	ShadowBase
+	*structs.TrustBundleConfigEntry
+}
+
+func (s ShadowTrustBundleConfigEntry) GetRealConfigEntry() structs.ConfigEntry {
+	return s.TrustBundleConfigEntry
+}
+
+type ShadowACMECertificateConfigEntry struct {
+	ShadowBase
+	*structs.ACMECertificateConfigEntry
+}
+
+func (s ShadowACMECertificateConfigEntry) GetRealConfigEntry() structs.ConfigEntry {
+	return s.ACMECertificateConfigEntry
+}
+
 type ShadowJWTProviderConfigEntry struct {
 	ShadowBase
 	*structs.JWTProviderConfigEntry
@@ -44,6 +44,8 @@ func init() {
 	registerRestorer(structs.PeeringWriteType, restorePeering)
 	registerRestorer(structs.PeeringTrustBundleWriteType, restorePeeringTrustBundle)
 	registerRestorer(structs.PeeringSecretsWriteType, restorePeeringSecrets)
+	registerRestorer(structs.ConnectCARevocationRequestType, restoreConnectCARevocation)
+	registerRestorer(structs.ConnectCALeafRequestType, restoreConnectCALeafCert)
 }
 
 func persistCE(s *snapshot, sink raft.SnapshotSink, encoder *codec.Encoder) error {
@@ -83,6 +85,12 @@ func persistCE(s *snapshot, sink raft.SnapshotSink, encoder *codec.Encoder) erro
 	if err := s.persistConnectCAConfig(sink, encoder); err != nil {
 		return err
 	}
+	if err := s.persistConnectCARevocations(sink, encoder); err != nil {
+		return err
+	}
+	if err := s.persistConnectCALeafCerts(sink, encoder); err != nil {
+		return err
+	}
 	if err := s.persistConfigEntries(sink, encoder); err != nil {
 		return err
 	}
@@ -465,6 +473,64 @@ func (s *snapshot) persistConfigEntries(sink raft.SnapshotSink,
 	return nil
 }
 
+func (s *snapshot) persistConnectCARevocations(sink raft.SnapshotSink, encoder *codec.Encoder) error {
+	revocations, err := s.state.CARevocations()
+	if err != nil {
+		return err
+	}
+	lists, err := s.state.CARevocationLists()
+	if err != nil {
+		return err
+	}
+
+	for _, r := range revocations {
+		if _, err := sink.Write([]byte{byte(structs.ConnectCARevocationRequestType)}); err != nil {
+			return err
+		}
+		req := &structs.CARevocationRequest{
+			Op:          structs.CARevocationOpRevoke,
+			Revocations: []*structs.CARevocation{r},
+		}
+		if err := encoder.Encode(req); err != nil {
+			return err
+		}
+	}
+	for _, l := range lists {
+		if _, err := sink.Write([]byte{byte(structs.ConnectCARevocationRequestType)}); err != nil {
+			return err
+		}
+		req := &structs.CARevocationRequest{
+			Op:              structs.CARevocationOpSetList,
+			RevocationLists: []*structs.CARevocationList{l},
+		}
+		if err := encoder.Encode(req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *snapshot) persistConnectCALeafCerts(sink raft.SnapshotSink, encoder *codec.Encoder) error {
+	certs, err := s.state.CALeafCerts()
+	if err != nil {
+		return err
+	}
+
+	for _, cert := range certs {
+		if _, err := sink.Write([]byte{byte(structs.ConnectCALeafRequestType)}); err != nil {
+			return err
+		}
+		req := &structs.CALeafRequest{
+			Op:         structs.CALeafOpIncrementIndex,
+			IssuedCert: cert,
+		}
+		if err := encoder.Encode(req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *snapshot) persistFederationStates(sink raft.SnapshotSink, encoder *codec.Encoder) error {
 	fedStates, err := s.state.FederationStates()
 	if err != nil {
@@ -821,6 +887,35 @@ func restoreAuthMethod(header *SnapshotHeader, restore *state.Restore, decoder *
 	return restore.ACLAuthMethod(&req)
 }
 
+func restoreConnectCARevocation(header *SnapshotHeader, restore *state.Restore, decoder *codec.Decoder) error {
+	var req structs.CARevocationRequest
+	if err := decoder.Decode(&req); err != nil {
+		return err
+	}
+	for _, r := range req.Revocations {
+		if err := restore.CARevocation(r); err != nil {
+			return err
+		}
+	}
+	for _, l := range req.RevocationLists {
+		if err := restore.CARevocationList(l); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func restoreConnectCALeafCert(header *SnapshotHeader, restore *state.Restore, decoder *codec.Decoder) error {
+	var req structs.CALeafRequest
+	if err := decoder.Decode(&req); err != nil {
+		return err
+	}
+	if req.IssuedCert == nil {
+		return nil
+	}
+	return restore.CALeafCert(req.IssuedCert)
+}
+
 func restoreFederationState(header *SnapshotHeader, restore *state.Restore, decoder *codec.Decoder) error {
 	var req structs.FederationStateRequest
 	if err := decoder.Decode(&req); err != nil {
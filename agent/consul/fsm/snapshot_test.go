@@ -249,6 +249,34 @@ func TestFSM_SnapshotRestore_CE(t *testing.T) {
 	err = fsm.state.CASetConfig(17, caConfig)
 	require.NoError(t, err)
 
+	// CA revocations
+	revocation := &structs.CARevocation{
+		ID:             "2d5b9a7e-2b5c-4a2c-9c1f-6d1b1f0c8d7a",
+		SerialNumber:   "0a:1b",
+		AuthorityKeyID: "aa:bb",
+		Reason:         "key compromise",
+		RevokedAt:      time.Now().UTC(),
+		ExpiresAt:      time.Now().UTC().Add(72 * time.Hour),
+	}
+	require.NoError(t, fsm.state.CARevoke(17, []*structs.CARevocation{revocation}))
+	revocationList := &structs.CARevocationList{
+		AuthorityKeyID: "aa:bb",
+		Datacenter:     "dc1",
+		SerialNumbers:  []string{"0a:1b"},
+		PEM:            "crl",
+	}
+	require.NoError(t, fsm.state.CARevocationListSet(17, []*structs.CARevocationList{revocationList}))
+
+	// Issued leaf certificates
+	issuedCert := &structs.IssuedCert{
+		SerialNumber: "0c:2d",
+		Service:      "web",
+		RootID:       "root1",
+		ValidAfter:   time.Now().UTC(),
+		ValidBefore:  time.Now().UTC().Add(72 * time.Hour),
+	}
+	require.NoError(t, fsm.state.CALeafIssueCert(17, issuedCert))
+
 	// Config entries
 	serviceConfig := &structs.ServiceConfigEntry{
 		Kind:     structs.ServiceDefaults,
@@ -811,6 +839,20 @@ func TestFSM_SnapshotRestore_CE(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, caConfig, caConf)
 
+	// Verify CA revocations are restored.
+	_, revocations, revocationLists, err := fsm2.state.CARevocations(nil)
+	require.NoError(t, err)
+	require.Equal(t, structs.CARevocations{revocation}, revocations)
+	require.Equal(t, []*structs.CARevocationList{revocationList}, revocationLists)
+
+	// Verify issued leaf certificates are restored.
+	_, issuedCerts, err := fsm2.state.CALeafCerts(nil)
+	require.NoError(t, err)
+	require.Len(t, issuedCerts, 1)
+	require.Equal(t, issuedCert.SerialNumber, issuedCerts[0].SerialNumber)
+	require.Equal(t, issuedCert.RootID, issuedCerts[0].RootID)
+	require.Equal(t, uint64(17), issuedCerts[0].CreateIndex)
+
 	// Verify config entries are restored
 	_, serviceConfEntry, err := fsm2.state.ConfigEntry(nil, structs.ServiceDefaults, "foo", structs.DefaultEnterpriseMetaInDefaultPartition())
 	require.NoError(t, err)
@@ -61,7 +61,11 @@ func (r *apiGatewayReconciler) Reconcile(ctx context.Context, req controller.Req
 		return reconcileEntry(r.fsm.State(), r.logger, ctx, req, r.reconcileHTTPRoute, r.cleanupRoute)
 	case structs.TCPRoute:
 		return reconcileEntry(r.fsm.State(), r.logger, ctx, req, r.reconcileTCPRoute, r.cleanupRoute)
-	case structs.InlineCertificate, structs.FileSystemCertificate:
+	case structs.GRPCRoute:
+		return reconcileEntry(r.fsm.State(), r.logger, ctx, req, r.reconcileGRPCRoute, r.cleanupRoute)
+	case structs.TLSRoute:
+		return reconcileEntry(r.fsm.State(), r.logger, ctx, req, r.reconcileTLSRoute, r.cleanupRoute)
+	case structs.InlineCertificate, structs.FileSystemCertificate, structs.ACMECertificate:
 		return r.enqueueCertificateReferencedGateways(r.fsm.State(), ctx, req)
 	case structs.JWTProvider:
 		return r.enqueueJWTProviderReferencedGatewaysAndHTTPRoutes(r.fsm.State(), ctx, req)
@@ -477,7 +481,7 @@ func (r *apiGatewayReconciler) reconcileRoute(_ context.Context, req controller.
 			continue
 		}
 
-		if chain.Protocol != string(route.GetProtocol()) {
+		if !routeProtocolMatchesChain(route, chain.Protocol) {
 			updater.SetCondition(routeInvalidDiscoveryChain(errInvalidProtocol))
 			continue
 		}
@@ -533,6 +537,31 @@ func (r *apiGatewayReconciler) reconcileTCPRoute(ctx context.Context, req contro
 	return r.reconcileRoute(ctx, req, store, route)
 }
 
+// reconcileGRPCRoute is a thin wrapper around recnocileRoute for a GRPCRoutes
+func (r *apiGatewayReconciler) reconcileGRPCRoute(ctx context.Context, req controller.Request, store *state.Store, route *structs.GRPCRouteConfigEntry) error {
+	return r.reconcileRoute(ctx, req, store, route)
+}
+
+// reconcileTLSRoute is a thin wrapper around recnocileRoute for a TLSRoutes
+func (r *apiGatewayReconciler) reconcileTLSRoute(ctx context.Context, req controller.Request, store *state.Store, route *structs.TLSRouteConfigEntry) error {
+	return r.reconcileRoute(ctx, req, store, route)
+}
+
+// routeProtocolMatchesChain returns whether the protocol of a compiled discovery
+// chain is one that the given route is able to send traffic to. gRPC routes are
+// served by HTTP listeners but require an HTTP/2 capable upstream, and TLS routes
+// pass the raw TCP stream through to their upstream.
+func routeProtocolMatchesChain(route structs.BoundRoute, protocol string) bool {
+	switch route.(type) {
+	case *structs.GRPCRouteConfigEntry:
+		return protocol == "grpc" || protocol == "http2"
+	case *structs.TLSRouteConfigEntry:
+		return protocol == "tcp"
+	default:
+		return protocol == string(route.GetProtocol())
+	}
+}
+
 // NewAPIGatewayController initializes a controller that reconciles all APIGateway objects
 func NewAPIGatewayController(fsm *fsm.FSM, publisher state.EventPublisher, updater *Updater, logger hclog.Logger) controller.Controller {
 	reconciler := &apiGatewayReconciler{
@@ -557,6 +586,16 @@ func NewAPIGatewayController(fsm *fsm.FSM, publisher state.EventPublisher, updat
 			Topic:   state.EventTopicTCPRoute,
 			Subject: stream.SubjectWildcard,
 		},
+	).Subscribe(
+		&stream.SubscribeRequest{
+			Topic:   state.EventTopicGRPCRoute,
+			Subject: stream.SubjectWildcard,
+		},
+	).Subscribe(
+		&stream.SubscribeRequest{
+			Topic:   state.EventTopicTLSRoute,
+			Subject: stream.SubjectWildcard,
+		},
 	).Subscribe(
 		&stream.SubscribeRequest{
 			Topic:   state.EventTopicBoundAPIGateway,
@@ -567,6 +606,11 @@ func NewAPIGatewayController(fsm *fsm.FSM, publisher state.EventPublisher, updat
 			Topic:   state.EventTopicInlineCertificate,
 			Subject: stream.SubjectWildcard,
 		},
+	).Subscribe(
+		&stream.SubscribeRequest{
+			Topic:   state.EventTopicACMECertificate,
+			Subject: stream.SubjectWildcard,
+		},
 	).Subscribe(
 		&stream.SubscribeRequest{
 			Topic:   state.EventTopicJWTProvider,
@@ -764,7 +808,7 @@ func (g *gatewayMeta) bindRoute(listener *structs.APIGatewayListener, bound *str
 		return false, fmt.Errorf("failed to bind route to gateway %s: route has not been accepted", g.Gateway.Name)
 	}
 
-	if route, ok := route.(*structs.HTTPRouteConfigEntry); ok {
+	if route, ok := route.(hostnameRoute); ok {
 		// check our hostnames
 		hostnames := route.FilteredHostnames(listener.GetHostname())
 		if len(hostnames) == 0 {
@@ -787,6 +831,12 @@ func (g *gatewayMeta) bindRoute(listener *structs.APIGatewayListener, bound *str
 	return false, nil
 }
 
+// hostnameRoute is implemented by routes that match on hostnames and must
+// share at least one hostname with a listener in order to bind to it.
+type hostnameRoute interface {
+	FilteredHostnames(listenerHostname string) []string
+}
+
 // unbindRoute takes a route and unbinds it from all of the listeners on a gateway.
 // It returns true if the route was unbound and false if it was not.
 func (g *gatewayMeta) unbindRoute(route structs.ResourceReference) bool {
@@ -831,6 +881,10 @@ func (g *gatewayMeta) checkCertificates(store *state.Store) (map[structs.Resourc
 			}
 			if certificate == nil {
 				certificateErrors[listenerRef] = fmt.Errorf("certificate %q not found", ref.Name)
+			} else if acme, ok := certificate.(*structs.ACMECertificateConfigEntry); ok && acme.InlineCertificate() == nil {
+				// The listener is bound once the certificate is issued, its
+				// config entry is updated then and the gateway reconciled.
+				certificateErrors[listenerRef] = fmt.Errorf("certificate %q has not been issued yet", ref.Name)
 			} else {
 				bound.Certificates = append(bound.Certificates, ref)
 			}
@@ -1168,7 +1222,7 @@ func requestToResourceRef(req controller.Request) structs.ResourceReference {
 	return ref
 }
 
-// retrieveAllRoutesFromStore retrieves all HTTP and TCP routes from the given store
+// retrieveAllRoutesFromStore retrieves all HTTP, TCP, gRPC, and TLS routes from the given store
 func retrieveAllRoutesFromStore(store *state.Store) ([]structs.BoundRoute, error) {
 	_, httpRoutes, err := store.ConfigEntriesByKind(nil, structs.HTTPRoute, wildcardMeta())
 	if err != nil {
@@ -1180,7 +1234,17 @@ func retrieveAllRoutesFromStore(store *state.Store) ([]structs.BoundRoute, error
 		return nil, err
 	}
 
-	routes := make([]structs.BoundRoute, 0, len(tcpRoutes)+len(httpRoutes))
+	_, grpcRoutes, err := store.ConfigEntriesByKind(nil, structs.GRPCRoute, wildcardMeta())
+	if err != nil {
+		return nil, err
+	}
+
+	_, tlsRoutes, err := store.ConfigEntriesByKind(nil, structs.TLSRoute, wildcardMeta())
+	if err != nil {
+		return nil, err
+	}
+
+	routes := make([]structs.BoundRoute, 0, len(tcpRoutes)+len(httpRoutes)+len(grpcRoutes)+len(tlsRoutes))
 
 	for _, route := range httpRoutes {
 		routes = append(routes, route.(*structs.HTTPRouteConfigEntry))
@@ -1190,6 +1254,14 @@ func retrieveAllRoutesFromStore(store *state.Store) ([]structs.BoundRoute, error
 		routes = append(routes, route.(*structs.TCPRouteConfigEntry))
 	}
 
+	for _, route := range grpcRoutes {
+		routes = append(routes, route.(*structs.GRPCRouteConfigEntry))
+	}
+
+	for _, route := range tlsRoutes {
+		routes = append(routes, route.(*structs.TLSRouteConfigEntry))
+	}
+
 	return routes, nil
 }
 
@@ -95,6 +95,246 @@ func TestBoundAPIGatewayBindRoute(t *testing.T) {
 			},
 			expectedDidBind: true,
 		},
+		"Bind GRPC Route to HTTP Listener on Gateway": {
+			gateway: gatewayMeta{
+				BoundGateway: &structs.BoundAPIGatewayConfigEntry{
+					Kind: structs.BoundAPIGateway,
+					Name: "Gateway",
+					Listeners: []structs.BoundAPIGatewayListener{
+						{
+							Name:   "Listener",
+							Routes: []structs.ResourceReference{},
+						},
+					},
+				},
+				Gateway: &structs.APIGatewayConfigEntry{
+					Kind: structs.APIGateway,
+					Name: "Gateway",
+					Listeners: []structs.APIGatewayListener{
+						{
+							Name:     "Listener",
+							Protocol: structs.ListenerProtocolHTTP,
+						},
+					},
+					Status: structs.Status{
+						Conditions: []structs.Condition{
+							gatewayAccepted(),
+						},
+					},
+				},
+			},
+			route: &structs.GRPCRouteConfigEntry{
+				Kind: structs.GRPCRoute,
+				Name: "Route",
+				Parents: []structs.ResourceReference{
+					{
+						Kind:        structs.APIGateway,
+						Name:        "Gateway",
+						SectionName: "Listener",
+					},
+				},
+				Status: structs.Status{
+					Conditions: []structs.Condition{
+						routeAccepted(),
+					},
+				},
+			},
+			expectedBoundGateway: structs.BoundAPIGatewayConfigEntry{
+				Kind: structs.BoundAPIGateway,
+				Name: "Gateway",
+				Listeners: []structs.BoundAPIGatewayListener{
+					{
+						Name: "Listener",
+						Routes: []structs.ResourceReference{
+							{
+								Kind: structs.GRPCRoute,
+								Name: "Route",
+							},
+						},
+					},
+				},
+			},
+			expectedDidBind: true,
+		},
+		"Bind TLS Route to TLS Listener on Gateway": {
+			gateway: gatewayMeta{
+				BoundGateway: &structs.BoundAPIGatewayConfigEntry{
+					Kind: structs.BoundAPIGateway,
+					Name: "Gateway",
+					Listeners: []structs.BoundAPIGatewayListener{
+						{
+							Name:   "Listener",
+							Routes: []structs.ResourceReference{},
+						},
+					},
+				},
+				Gateway: &structs.APIGatewayConfigEntry{
+					Kind: structs.APIGateway,
+					Name: "Gateway",
+					Listeners: []structs.APIGatewayListener{
+						{
+							Name:     "Listener",
+							Protocol: structs.ListenerProtocolTLS,
+							Hostname: "*.example.com",
+						},
+					},
+					Status: structs.Status{
+						Conditions: []structs.Condition{
+							gatewayAccepted(),
+						},
+					},
+				},
+			},
+			route: &structs.TLSRouteConfigEntry{
+				Kind: structs.TLSRoute,
+				Name: "Route",
+				Parents: []structs.ResourceReference{
+					{
+						Kind:        structs.APIGateway,
+						Name:        "Gateway",
+						SectionName: "Listener",
+					},
+				},
+				Hostnames: []string{"foo.example.com"},
+				Status: structs.Status{
+					Conditions: []structs.Condition{
+						routeAccepted(),
+					},
+				},
+			},
+			expectedBoundGateway: structs.BoundAPIGatewayConfigEntry{
+				Kind: structs.BoundAPIGateway,
+				Name: "Gateway",
+				Listeners: []structs.BoundAPIGatewayListener{
+					{
+						Name: "Listener",
+						Routes: []structs.ResourceReference{
+							{
+								Kind: structs.TLSRoute,
+								Name: "Route",
+							},
+						},
+					},
+				},
+			},
+			expectedDidBind: true,
+		},
+		"TLS Route with no hostnames matching the Listener": {
+			gateway: gatewayMeta{
+				BoundGateway: &structs.BoundAPIGatewayConfigEntry{
+					Kind: structs.BoundAPIGateway,
+					Name: "Gateway",
+					Listeners: []structs.BoundAPIGatewayListener{
+						{
+							Name:   "Listener",
+							Routes: []structs.ResourceReference{},
+						},
+					},
+				},
+				Gateway: &structs.APIGatewayConfigEntry{
+					Kind: structs.APIGateway,
+					Name: "Gateway",
+					Listeners: []structs.APIGatewayListener{
+						{
+							Name:     "Listener",
+							Protocol: structs.ListenerProtocolTLS,
+							Hostname: "*.example.com",
+						},
+					},
+					Status: structs.Status{
+						Conditions: []structs.Condition{
+							gatewayAccepted(),
+						},
+					},
+				},
+			},
+			route: &structs.TLSRouteConfigEntry{
+				Kind: structs.TLSRoute,
+				Name: "Route",
+				Parents: []structs.ResourceReference{
+					{
+						Kind:        structs.APIGateway,
+						Name:        "Gateway",
+						SectionName: "Listener",
+					},
+				},
+				Hostnames: []string{"foo.example.net"},
+				Status: structs.Status{
+					Conditions: []structs.Condition{
+						routeAccepted(),
+					},
+				},
+			},
+			expectedBoundGateway: structs.BoundAPIGatewayConfigEntry{
+				Kind: structs.BoundAPIGateway,
+				Name: "Gateway",
+				Listeners: []structs.BoundAPIGatewayListener{
+					{
+						Name:   "Listener",
+						Routes: []structs.ResourceReference{},
+					},
+				},
+			},
+			expectedDidBind: false,
+			expectedErr:     fmt.Errorf("failed to bind route to gateway Gateway: listener Listener is does not have any hostnames that match the route"),
+		},
+		"TLS Route cannot bind to TCP Listener": {
+			gateway: gatewayMeta{
+				BoundGateway: &structs.BoundAPIGatewayConfigEntry{
+					Kind: structs.BoundAPIGateway,
+					Name: "Gateway",
+					Listeners: []structs.BoundAPIGatewayListener{
+						{
+							Name:   "Listener",
+							Routes: []structs.ResourceReference{},
+						},
+					},
+				},
+				Gateway: &structs.APIGatewayConfigEntry{
+					Kind: structs.APIGateway,
+					Name: "Gateway",
+					Listeners: []structs.APIGatewayListener{
+						{
+							Name:     "Listener",
+							Protocol: structs.ListenerProtocolTCP,
+						},
+					},
+					Status: structs.Status{
+						Conditions: []structs.Condition{
+							gatewayAccepted(),
+						},
+					},
+				},
+			},
+			route: &structs.TLSRouteConfigEntry{
+				Kind: structs.TLSRoute,
+				Name: "Route",
+				Parents: []structs.ResourceReference{
+					{
+						Kind:        structs.APIGateway,
+						Name:        "Gateway",
+						SectionName: "Listener",
+					},
+				},
+				Status: structs.Status{
+					Conditions: []structs.Condition{
+						routeAccepted(),
+					},
+				},
+			},
+			expectedBoundGateway: structs.BoundAPIGatewayConfigEntry{
+				Kind: structs.BoundAPIGateway,
+				Name: "Gateway",
+				Listeners: []structs.BoundAPIGatewayListener{
+					{
+						Name:   "Listener",
+						Routes: []structs.ResourceReference{},
+					},
+				},
+			},
+			expectedDidBind: false,
+			expectedErr:     fmt.Errorf("failed to bind route Route to gateway Gateway: listener Listener is not a tls listener"),
+		},
 		"Bind TCP Route with wildcard section name to all listeners on Gateway": {
 			gateway: gatewayMeta{
 				BoundGateway: &structs.BoundAPIGatewayConfigEntry{
@@ -3500,6 +3740,163 @@ func TestAPIGatewayController(t *testing.T) {
 				},
 			},
 		},
+		"unissued-acme-certificate": {
+			requests: []controller.Request{{
+				Kind: structs.APIGateway,
+				Name: "gateway",
+				Meta: acl.DefaultEnterpriseMeta(),
+			}},
+			initialEntries: []structs.ConfigEntry{
+				&structs.APIGatewayConfigEntry{
+					Kind:           structs.APIGateway,
+					Name:           "gateway",
+					EnterpriseMeta: *defaultMeta,
+					Listeners: []structs.APIGatewayListener{{
+						Name: "http-listener",
+						Port: 80,
+						TLS: structs.APIGatewayTLSConfiguration{
+							Certificates: []structs.ResourceReference{{
+								Kind: structs.ACMECertificate,
+								Name: "certificate",
+							}},
+						},
+					}},
+				},
+				&structs.ACMECertificateConfigEntry{
+					Kind:           structs.ACMECertificate,
+					Name:           "certificate",
+					EnterpriseMeta: *defaultMeta,
+				},
+			},
+			finalEntries: []structs.ConfigEntry{
+				&structs.APIGatewayConfigEntry{
+					Kind:           structs.APIGateway,
+					Name:           "gateway",
+					EnterpriseMeta: *defaultMeta,
+					Listeners: []structs.APIGatewayListener{{
+						Name: "http-listener",
+						Port: 80,
+						TLS: structs.APIGatewayTLSConfiguration{
+							Certificates: []structs.ResourceReference{{
+								Kind: structs.ACMECertificate,
+								Name: "certificate",
+							}},
+						},
+					}},
+					Status: structs.Status{
+						Conditions: []structs.Condition{
+							invalidCertificate(structs.ResourceReference{
+								Kind:        structs.APIGateway,
+								Name:        "gateway",
+								SectionName: "http-listener",
+							}, errors.New("certificate \"certificate\" has not been issued yet")),
+							invalidCertificates(),
+							gatewayListenerNoConflicts(structs.ResourceReference{
+								Kind:        structs.APIGateway,
+								Name:        "gateway",
+								SectionName: "http-listener",
+							}),
+						},
+					},
+				},
+				&structs.BoundAPIGatewayConfigEntry{
+					Kind:           structs.BoundAPIGateway,
+					Name:           "gateway",
+					EnterpriseMeta: *defaultMeta,
+					Listeners: []structs.BoundAPIGatewayListener{{
+						Name: "http-listener",
+					}},
+					Services: make(structs.ServiceRouteReferences),
+				},
+				&structs.ACMECertificateConfigEntry{
+					Kind:           structs.ACMECertificate,
+					Name:           "certificate",
+					EnterpriseMeta: *defaultMeta,
+				},
+			},
+		},
+		"issued-acme-certificate": {
+			requests: []controller.Request{{
+				Kind: structs.APIGateway,
+				Name: "gateway",
+				Meta: acl.DefaultEnterpriseMeta(),
+			}},
+			initialEntries: []structs.ConfigEntry{
+				&structs.APIGatewayConfigEntry{
+					Kind:           structs.APIGateway,
+					Name:           "gateway",
+					EnterpriseMeta: *defaultMeta,
+					Listeners: []structs.APIGatewayListener{{
+						Name: "http-listener",
+						Port: 80,
+						TLS: structs.APIGatewayTLSConfiguration{
+							Certificates: []structs.ResourceReference{{
+								Kind: structs.ACMECertificate,
+								Name: "certificate",
+							}},
+						},
+					}},
+				},
+				&structs.ACMECertificateConfigEntry{
+					Kind:           structs.ACMECertificate,
+					Name:           "certificate",
+					EnterpriseMeta: *defaultMeta,
+					Certificate:    "certificate",
+					PrivateKey:     "key",
+				},
+			},
+			finalEntries: []structs.ConfigEntry{
+				&structs.APIGatewayConfigEntry{
+					Kind:           structs.APIGateway,
+					Name:           "gateway",
+					EnterpriseMeta: *defaultMeta,
+					Listeners: []structs.APIGatewayListener{{
+						Name: "http-listener",
+						Port: 80,
+						TLS: structs.APIGatewayTLSConfiguration{
+							Certificates: []structs.ResourceReference{{
+								Kind: structs.ACMECertificate,
+								Name: "certificate",
+							}},
+						},
+					}},
+					Status: structs.Status{
+						Conditions: []structs.Condition{
+							gatewayAccepted(),
+							gatewayListenerNoConflicts(structs.ResourceReference{
+								Kind:        structs.APIGateway,
+								Name:        "gateway",
+								SectionName: "http-listener",
+							}),
+							resolvedRefs(structs.ResourceReference{
+								Kind:        structs.APIGateway,
+								Name:        "gateway",
+								SectionName: "http-listener",
+							}),
+						},
+					},
+				},
+				&structs.BoundAPIGatewayConfigEntry{
+					Kind:           structs.BoundAPIGateway,
+					Name:           "gateway",
+					EnterpriseMeta: *defaultMeta,
+					Listeners: []structs.BoundAPIGatewayListener{{
+						Name: "http-listener",
+						Certificates: []structs.ResourceReference{{
+							Kind:           structs.ACMECertificate,
+							Name:           "certificate",
+							EnterpriseMeta: *defaultMeta,
+						}},
+					}},
+					Services: make(structs.ServiceRouteReferences),
+				},
+				&structs.ACMECertificateConfigEntry{
+					Kind:           structs.ACMECertificate,
+					Name:           "certificate",
+					EnterpriseMeta: *defaultMeta,
+				},
+			},
+		},
 		"all-listeners-valid-certificate-refs": {
 			requests: []controller.Request{{
 				Kind: structs.APIGateway,
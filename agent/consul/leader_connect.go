@@ -12,7 +12,11 @@ import (
 
 	"github.com/hashicorp/go-version"
 
+	"github.com/hashicorp/consul/agent/consul/acmecerts"
+	"github.com/hashicorp/consul/agent/consul/controller"
 	"github.com/hashicorp/consul/agent/consul/gateways"
+	"github.com/hashicorp/consul/agent/consul/rollouts"
+	"github.com/hashicorp/consul/agent/consul/trustbundles"
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/logging"
 )
@@ -55,8 +59,18 @@ func (s *Server) startConnectLeader(ctx context.Context) error {
 	s.leaderRoutineManager.Start(ctx, caRootPruningRoutineName, s.runCARootPruning)
 	s.leaderRoutineManager.Start(ctx, caRootMetricRoutineName, rootCAExpiryMonitor(s).Monitor)
 	s.leaderRoutineManager.Start(ctx, caSigningMetricRoutineName, signingCAExpiryMonitor(s).Monitor)
+	s.leaderRoutineManager.Start(ctx, caRevocationListRoutineName, s.runCARevocationLists)
+	s.leaderRoutineManager.Start(ctx, caLeafCertPruningRoutineName, s.runCALeafCertPruning)
+	if s.config.Datacenter != s.config.PrimaryDatacenter {
+		s.leaderRoutineManager.Start(ctx, caRevocationReplicationRoutineName, s.runCARevocationReplication)
+	}
 	s.leaderRoutineManager.Start(ctx, virtualIPCheckRoutineName, s.runVirtualIPVersionCheck)
 	s.leaderRoutineManager.Start(ctx, configEntryControllersRoutineName, s.runConfigEntryControllers)
+	s.leaderRoutineManager.Start(ctx, canaryRolloutControllerRoutineName, s.runCanaryRolloutController)
+	if s.config.Datacenter == s.config.PrimaryDatacenter {
+		s.leaderRoutineManager.Start(ctx, trustBundleControllerRoutineName, s.runTrustBundleController)
+		s.leaderRoutineManager.Start(ctx, acmeCertificateControllerRoutineName, s.runACMECertificateController)
+	}
 
 	return s.startIntentionConfigEntryMigration(ctx)
 }
@@ -68,8 +82,14 @@ func (s *Server) stopConnectLeader() {
 	s.leaderRoutineManager.Stop(caRootPruningRoutineName)
 	s.leaderRoutineManager.Stop(caRootMetricRoutineName)
 	s.leaderRoutineManager.Stop(caSigningMetricRoutineName)
+	s.leaderRoutineManager.Stop(caRevocationListRoutineName)
+	s.leaderRoutineManager.Stop(caRevocationReplicationRoutineName)
+	s.leaderRoutineManager.Stop(caLeafCertPruningRoutineName)
 	s.leaderRoutineManager.Stop(virtualIPCheckRoutineName)
 	s.leaderRoutineManager.Stop(configEntryControllersRoutineName)
+	s.leaderRoutineManager.Stop(canaryRolloutControllerRoutineName)
+	s.leaderRoutineManager.Stop(trustBundleControllerRoutineName)
+	s.leaderRoutineManager.Stop(acmeCertificateControllerRoutineName)
 }
 
 func (s *Server) runConfigEntryControllers(ctx context.Context) error {
@@ -100,6 +120,53 @@ func (s *Server) runConfigEntryControllers(ctx context.Context) error {
 	return gateways.NewAPIGatewayController(s.fsm, s.publisher, updater, logger).Run(ctx)
 }
 
+// configEntryUpdater returns the Updater used by the config entry
+// reconcilers, which writes entries through raft with a check-and-set on
+// their modify index.
+func (s *Server) configEntryUpdater() *controller.Updater {
+	apply := func(op structs.ConfigEntryOp, entry structs.ConfigEntry) (bool, error) {
+		resp, err := s.leaderRaftApply("ConfigEntry.Apply", structs.ConfigEntryRequestType, &structs.ConfigEntryRequest{
+			Op:    op,
+			Entry: entry,
+		})
+		if err != nil {
+			return false, err
+		}
+		applied, _ := resp.(bool)
+		return applied, nil
+	}
+	return &controller.Updater{
+		UpdateWithStatus: func(entry structs.ControlledConfigEntry) (bool, error) {
+			return apply(structs.ConfigEntryUpsertWithStatusCAS, entry)
+		},
+		Update: func(entry structs.ConfigEntry) (bool, error) {
+			return apply(structs.ConfigEntryUpsertCAS, entry)
+		},
+	}
+}
+
+func (s *Server) runCanaryRolloutController(ctx context.Context) error {
+	errorRatios := rollouts.NewPrometheusErrorRatioSource(s.config.CanaryRolloutPrometheus)
+	logger := s.logger.Named(logging.CanaryRollout)
+	return rollouts.NewCanaryRolloutController(s.fsm, s.publisher, s.configEntryUpdater(), errorRatios, s.config.Datacenter, logger).Run(ctx)
+}
+
+// runTrustBundleController refreshes the trust bundles imported from SPIFFE
+// bundle endpoints and reports whether proxies trust them. It only runs in the primary datacenter, secondary
+// datacenters receive the refreshed bundles through config entry replication.
+func (s *Server) runTrustBundleController(ctx context.Context) error {
+	logger := s.logger.Named(logging.TrustBundle)
+	return trustbundles.NewTrustBundleController(s.fsm, s.publisher, s.configEntryUpdater(), s.caRevocationListsDistributed, logger).Run(ctx)
+}
+
+// runACMECertificateController orders and renews the certificates of
+// acme-certificate config entries. Like the trust bundle controller it only
+// runs in the primary datacenter.
+func (s *Server) runACMECertificateController(ctx context.Context) error {
+	logger := s.logger.Named(logging.ACMECertificate)
+	return acmecerts.NewACMECertificateController(s.fsm, s.publisher, s.configEntryUpdater(), s.config.ConnectACMEDNSHook, logger).Run(ctx)
+}
+
 func (s *Server) runCARootPruning(ctx context.Context) error {
 	ticker := time.NewTicker(caRootPruneInterval)
 	defer ticker.Stop()
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/agent/connect"
 	"github.com/hashicorp/consul/agent/connect/ca"
+	"github.com/hashicorp/consul/agent/consul/fsm"
 	"github.com/hashicorp/consul/agent/consul/state"
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/lib"
@@ -45,7 +46,7 @@ type caServerDelegate interface {
 
 	State() *state.Store
 	IsLeader() bool
-	ApplyCALeafRequest() (uint64, error)
+	ApplyCALeafRequest(cert *structs.IssuedCert) (uint64, error)
 
 	forwardDC(method, dc string, args interface{}, reply interface{}) error
 	generateCASignRequest(csr string) *structs.CASignRequest
@@ -98,19 +99,15 @@ func (c *caDelegateWithState) ApplyCARequest(req *structs.CARequest) (interface{
 	return c.Server.raftApplyMsgpack(structs.ConnectCARequestType, req)
 }
 
-func (c *caDelegateWithState) ApplyCALeafRequest() (uint64, error) {
-	// TODO(banks): when we implement IssuedCerts table we can use the insert to
-	// that as the raft index to return in response.
-	//
-	// UPDATE(mkeeler): The original implementation relied on updating the CAConfig
-	// and using its index as the ModifyIndex for certs. This was buggy. The long
-	// term goal is still to insert some metadata into raft about the certificates
-	// and use that raft index for the ModifyIndex. This is a partial step in that
-	// direction except that we only are setting an index and not storing the
-	// metadata.
+func (c *caDelegateWithState) ApplyCALeafRequest(cert *structs.IssuedCert) (uint64, error) {
+	// The metadata of the certificate is stored in the leaf certificates
+	// table, and the raft index of the insert is used as the ModifyIndex of
+	// the certificate. Servers that don't know about the table only update
+	// the index.
 	req := structs.CALeafRequest{
 		Op:         structs.CALeafOpIncrementIndex,
 		Datacenter: c.Server.config.Datacenter,
+		IssuedCert: cert,
 	}
 	resp, err := c.Server.raftApplyMsgpack(structs.ConnectCALeafRequestType|structs.IgnoreUnknownTypeFlag, &req)
 	if err != nil {
@@ -309,11 +306,30 @@ func (c *CAManager) getCAProvider() (ca.Provider, *structs.CARoot) {
 
 // setCAProvider is being called while holding the stateLock
 // which means it must never take that lock itself or call anything that does.
+// The provider being replaced is stopped.
 func (c *CAManager) setCAProvider(newProvider ca.Provider, root *structs.CARoot) {
+	if oldProvider := c.swapCAProvider(newProvider, root); oldProvider != newProvider {
+		stopCAProvider(oldProvider)
+	}
+}
+
+// swapCAProvider replaces the local provider instance and returns the previous
+// one without stopping it, so that it can still be cleaned up.
+func (c *CAManager) swapCAProvider(newProvider ca.Provider, root *structs.CARoot) ca.Provider {
 	c.providerLock.Lock()
+	defer c.providerLock.Unlock()
+	oldProvider := c.provider
 	c.provider = newProvider
 	c.providerRoot = root
-	c.providerLock.Unlock()
+	return oldProvider
+}
+
+// stopCAProvider stops a provider instance that is no longer in use, such as
+// one that runs an external plugin process.
+func stopCAProvider(provider ca.Provider) {
+	if needsStop, ok := provider.(ca.NeedsStop); ok {
+		needsStop.Stop()
+	}
 }
 
 func (c *CAManager) Start(ctx context.Context) {
@@ -339,12 +355,6 @@ func (c *CAManager) Stop() {
 	c.leaderRoutineManager.Stop(intermediateCertRenewWatchRoutineName)
 	c.leaderRoutineManager.Stop(backgroundCAInitializationRoutineName)
 
-	if provider, _ := c.getCAProvider(); provider != nil {
-		if needsStop, ok := provider.(ca.NeedsStop); ok {
-			needsStop.Stop()
-		}
-	}
-
 	c.setState(caStateUninitialized, false)
 	c.primaryRoots = structs.IndexedCARoots{}
 	c.setCAProvider(nil, nil)
@@ -463,6 +473,8 @@ func (c *CAManager) newProvider(conf *structs.CAConfiguration) (ca.Provider, err
 		return ca.NewVaultProvider(logger), nil
 	case structs.AWSCAProvider:
 		return ca.NewAWSProvider(logger), nil
+	case structs.PluginCAProvider:
+		return ca.NewPluginProvider(logger, c.serverConf.ConnectCAPlugins), nil
 	default:
 		if c.providerShim != nil {
 			return c.providerShim, nil
@@ -548,6 +560,21 @@ func (c *CAManager) primaryInitialize(provider ca.Provider, conf *structs.CAConf
 			return fmt.Errorf("stored CA root %q is not the active root (%s)", rootCA.ID, activeRoot.ID)
 		}
 
+		// Roots created before JWT-SVIDs were supported do not have a JWT
+		// signing key yet, so add one to the stored root.
+		if activeRoot.JWTSigningKey == "" {
+			if err := ca.GenerateJWTSigningKey(rootCA); err != nil {
+				return err
+			}
+			if err := c.persistActiveRootJWTKey(rootCA); err != nil {
+				return fmt.Errorf("error persisting JWT signing key: %w", err)
+			}
+		} else {
+			rootCA.JWTKeyID = activeRoot.JWTKeyID
+			rootCA.JWTPublicKey = activeRoot.JWTPublicKey
+			rootCA.JWTSigningKey = activeRoot.JWTSigningKey
+		}
+
 		// TODO: why doesn't this c.setCAProvider(provider, activeRoot) ?
 		rootCA.IntermediateCerts = activeRoot.IntermediateCerts
 		c.setCAProvider(provider, rootCA)
@@ -556,6 +583,9 @@ func (c *CAManager) primaryInitialize(provider ca.Provider, conf *structs.CAConf
 		return nil
 	}
 
+	if err := primaryEnsureJWTSigningKey(rootCA, activeRoot); err != nil {
+		return err
+	}
 	if err := c.persistNewRootAndConfig(provider, rootCA, conf); err != nil {
 		return err
 	}
@@ -631,6 +661,17 @@ func (c *CAManager) secondaryInitializeIntermediateCA(provider ca.Provider, conf
 			return err
 		}
 	} else {
+		// The primary adds a JWT signing key to roots created before JWT-SVIDs
+		// were supported, so pick up its public key if it has changed.
+		if newActiveRoot.JWTKeyID != activeRoot.JWTKeyID {
+			if err := c.persistActiveRootJWTKey(newActiveRoot); err != nil {
+				return fmt.Errorf("error persisting JWT public key: %w", err)
+			}
+			activeRoot = activeRoot.Clone()
+			activeRoot.JWTKeyID = newActiveRoot.JWTKeyID
+			activeRoot.JWTPublicKey = newActiveRoot.JWTPublicKey
+		}
+
 		// Discard the primary's representation since our local one is
 		// sufficiently up to date.
 		newActiveRoot = activeRoot
@@ -778,11 +819,57 @@ func (c *CAManager) UpdateConfiguration(args *structs.CARequest) (reterr error)
 		return err
 	}
 
+	newProvider, err := c.configureUpdatedProvider(args, prevConfig, config, c.newProvider)
+	if err != nil {
+		return err
+	}
+	if newProvider == nil {
+		// Exit early if it's a no-op change
+		return nil
+	}
+
+	cleanupNewProvider := func() {
+		if err := newProvider.Cleanup(args.Config.Provider != config.Provider, args.Config.Config); err != nil {
+			c.logger.Warn("failed to clean up CA provider while handling startup failure", "provider", newProvider, "error", err)
+		}
+		c.providerLock.RLock()
+		inUse := c.provider == newProvider
+		c.providerLock.RUnlock()
+		if !inUse {
+			stopCAProvider(newProvider)
+		}
+	}
+
+	// If this is a secondary, just check if the intermediate needs to be regenerated.
+	if c.serverConf.Datacenter != c.serverConf.PrimaryDatacenter {
+		if err := c.secondaryInitializeIntermediateCA(newProvider, args.Config); err != nil {
+			cleanupNewProvider()
+			return fmt.Errorf("Error updating secondary datacenter CA config: %v", err)
+		}
+		c.logger.Info("Secondary CA provider config updated")
+		return nil
+	}
+	if err := c.primaryUpdateRootCA(newProvider, args, config); err != nil {
+		cleanupNewProvider()
+		return err
+	}
+	return nil
+}
+
+// configureUpdatedProvider validates the CA configuration update in args
+// against the current configuration and returns an instance of the new
+// provider created with newProvider and configured. It returns a nil provider
+// if the update is a no-op.
+func (c *CAManager) configureUpdatedProvider(
+	args *structs.CARequest,
+	prevConfig, config *structs.CAConfiguration,
+	newProvider func(*structs.CAConfiguration) (ca.Provider, error),
+) (ca.Provider, error) {
 	// Don't allow state changes. Either it needs to be empty or the same to allow
 	// read-modify-write loops that don't touch the State field.
 	if len(args.Config.State) > 0 &&
 		!reflect.DeepEqual(args.Config.State, config.State) {
-		return ErrStateReadOnly
+		return nil, ErrStateReadOnly
 	}
 
 	// Don't allow users to change the ClusterID.
@@ -790,7 +877,7 @@ func (c *CAManager) UpdateConfiguration(args *structs.CARequest) (reterr error)
 
 	// Exit early if it's a no-op change
 	if args.Config.Provider == config.Provider && reflect.DeepEqual(args.Config.Config, config.Config) {
-		return nil
+		return nil, nil
 	}
 
 	// If the provider hasn't changed, we need to load the current Provider state
@@ -807,9 +894,9 @@ func (c *CAManager) UpdateConfiguration(args *structs.CARequest) (reterr error)
 	// and get the current active root CA. This acts as a good validation
 	// of the config and makes sure the provider is functioning correctly
 	// before we commit any changes to Raft.
-	newProvider, err := c.newProvider(args.Config)
+	provider, err := newProvider(args.Config)
 	if err != nil {
-		return fmt.Errorf("could not initialize provider: %v", err)
+		return nil, fmt.Errorf("could not initialize provider: %v", err)
 	}
 	pCfg := ca.ProviderConfig{
 		ClusterID:  args.Config.ClusterID,
@@ -821,39 +908,308 @@ func (c *CAManager) UpdateConfiguration(args *structs.CARequest) (reterr error)
 	}
 
 	if args.Config.Provider == config.Provider {
-		if validator, ok := newProvider.(ValidateConfigUpdater); ok {
+		if validator, ok := provider.(ValidateConfigUpdater); ok {
 			if err := validator.ValidateConfigUpdate(prevConfig.Config, args.Config.Config); err != nil {
-				return fmt.Errorf("new configuration is incompatible with previous configuration: %w", err)
+				stopCAProvider(provider)
+				return nil, fmt.Errorf("new configuration is incompatible with previous configuration: %w", err)
 			}
 		}
 	}
 
-	if err := newProvider.Configure(pCfg); err != nil {
-		return fmt.Errorf("error configuring provider: %v", err)
+	if err := provider.Configure(pCfg); err != nil {
+		stopCAProvider(provider)
+		return nil, fmt.Errorf("error configuring provider: %v", err)
 	}
+	return provider, nil
+}
 
-	cleanupNewProvider := func() {
-		if err := newProvider.Cleanup(args.Config.Provider != config.Provider, args.Config.Config); err != nil {
-			c.logger.Warn("failed to clean up CA provider while handling startup failure", "provider", newProvider, "error", err)
+// DryRunUpdateConfiguration validates a CA configuration update and reports
+// the effect it would have without applying it. A new Consul provider is
+// configured and, if the root changes, a copy of the current provider
+// cross-signs the new root, but neither the configuration, the roots nor the
+// current provider are changed.
+//
+// Consul providers used by the dry run keep their state in memory and don't
+// create keys in a PKCS#11 token, so they have no side effects. Other
+// providers would create resources in external systems when they are
+// configured, so only their configuration is validated.
+func (c *CAManager) DryRunUpdateConfiguration(args *structs.CARequest) (*structs.CARotationPlan, error) {
+	// Hold the reconfiguring state so that the dry run can't race with an
+	// update.
+	oldState, err := c.setState(caStateReconfig, true)
+	if err != nil {
+		return nil, err
+	}
+	defer c.setState(oldState, false)
+
+	state := c.delegate.State()
+	_, config, err := state.CAConfig(nil)
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		return nil, fmt.Errorf("CA has not finished initializing")
+	}
+	_, activeRoot, err := state.CARootActive(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &structs.CARotationPlan{Provider: args.Config.Provider}
+	if activeRoot != nil {
+		plan.ActiveRootID = activeRoot.ID
+	}
+	isPrimary := c.serverConf.Datacenter == c.serverConf.PrimaryDatacenter
+
+	if args.Config.Provider != structs.ConsulCAProvider {
+		return c.dryRunValidateConfig(plan, args, config)
+	}
+
+	delegate, err := newDryRunCAProviderDelegate(state)
+	if err != nil {
+		return nil, err
+	}
+	newDryRunProvider := func(conf *structs.CAConfiguration) (ca.Provider, error) {
+		return c.newDryRunProvider(conf, delegate)
+	}
+
+	newProvider, err := c.configureUpdatedProvider(args, config, config, newDryRunProvider)
+	if err != nil {
+		return nil, err
+	}
+	if newProvider == nil {
+		if isPrimary {
+			plan.NewRootID = plan.ActiveRootID
 		}
+		return plan, nil
 	}
+	providerChanged := args.Config.Provider != config.Provider
+	defer stopCAProvider(newProvider)
 
-	// If this is a secondary, just check if the intermediate needs to be regenerated.
-	if c.serverConf.Datacenter != c.serverConf.PrimaryDatacenter {
-		if err := c.secondaryInitializeIntermediateCA(newProvider, args.Config); err != nil {
-			cleanupNewProvider()
-			return fmt.Errorf("Error updating secondary datacenter CA config: %v", err)
+	// Secondary datacenters don't have roots of their own, a new provider
+	// only requests a new intermediate from the primary datacenter.
+	if !isPrimary {
+		if providerChanged {
+			plan.Warnings = append(plan.Warnings,
+				"The intermediate CA of the datacenter will be replaced by one generated by the new provider")
 		}
-		c.logger.Info("Secondary CA provider config updated")
-		return nil
+		return plan, nil
 	}
-	if err := c.primaryUpdateRootCA(newProvider, args, config); err != nil {
-		cleanupNewProvider()
-		return err
+
+	caPEM, err := newProvider.GenerateCAChain()
+	if err != nil {
+		return nil, fmt.Errorf("error generating CA root certificate: %v", err)
+	}
+	newActiveRoot, err := newCARoot(caPEM, args.Config.Provider, args.Config.ClusterID)
+	if err != nil {
+		return nil, err
+	}
+	plan.NewRootID = newActiveRoot.ID
+	plan.RootChanged = activeRoot == nil || activeRoot.ID != newActiveRoot.ID
+	if !plan.RootChanged {
+		return plan, nil
+	}
+
+	if activeRoot != nil {
+		if err := c.dryRunCrossSign(plan, args, config, delegate, caPEM); err != nil {
+			return nil, err
+		}
+	}
+
+	plan.LeafCerts, plan.Proxies, err = caRotationImpact(state, c.timeNow())
+	if err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// dryRunValidateConfig is the dry run of an update to a provider other than
+// the Consul provider. Configuring such a provider or generating its root
+// changes external systems, for example by mounting PKI secrets engines in
+// Vault or creating private CAs in AWS, so only the new configuration is
+// parsed and validated.
+func (c *CAManager) dryRunValidateConfig(plan *structs.CARotationPlan, args *structs.CARequest, config *structs.CAConfiguration) (*structs.CARotationPlan, error) {
+	if len(args.Config.State) > 0 && !reflect.DeepEqual(args.Config.State, config.State) {
+		return nil, ErrStateReadOnly
+	}
+	isPrimary := c.serverConf.Datacenter == c.serverConf.PrimaryDatacenter
+	if args.Config.Provider == config.Provider && reflect.DeepEqual(args.Config.Config, config.Config) {
+		if isPrimary {
+			plan.NewRootID = plan.ActiveRootID
+		}
+		return plan, nil
+	}
+
+	var err error
+	switch args.Config.Provider {
+	case structs.VaultCAProvider:
+		_, err = ca.ParseVaultCAConfig(args.Config.Config, isPrimary)
+	case structs.AWSCAProvider:
+		_, err = ca.ParseAWSCAConfig(args.Config.Config)
+	case structs.PluginCAProvider:
+		_, err = ca.ParsePluginCAConfig(args.Config.Config)
+	default:
+		return nil, fmt.Errorf("unknown CA provider %q", args.Config.Provider)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not initialize provider: %v", err)
+	}
+
+	// Creating a provider doesn't contact its backend, only Configure does.
+	if args.Config.Provider == config.Provider {
+		provider, err := c.newProvider(args.Config)
+		if err != nil {
+			return nil, err
+		}
+		if validator, ok := provider.(ValidateConfigUpdater); ok {
+			if err := validator.ValidateConfigUpdate(config.Config, args.Config.Config); err != nil {
+				return nil, fmt.Errorf("new configuration is incompatible with previous configuration: %w", err)
+			}
+		}
+	}
+
+	plan.ConfigOnly = true
+	plan.Warnings = append(plan.Warnings,
+		fmt.Sprintf("The dry run only validated the configuration of the %s provider, "+
+			"the provider was not configured and the new root is unknown", args.Config.Provider))
+	return plan, nil
+}
+
+// dryRunCrossSign checks that the current provider can cross-sign the new
+// root of a dry run. The live provider is never asked to sign, since signing
+// changes its state. Instead, a copy of the current Consul provider that
+// keeps its state in memory cross-signs the new root. Other providers are
+// only asked whether they support cross-signing.
+func (c *CAManager) dryRunCrossSign(
+	plan *structs.CARotationPlan,
+	args *structs.CARequest,
+	config *structs.CAConfiguration,
+	delegate *dryRunCAProviderDelegate,
+	caPEM string,
+) error {
+	var current ca.Provider
+	if config.Provider == structs.ConsulCAProvider {
+		provider, err := c.newDryRunProvider(config, delegate)
+		if err != nil {
+			return err
+		}
+		defer stopCAProvider(provider)
+
+		err = provider.Configure(ca.ProviderConfig{
+			ClusterID:  config.ClusterID,
+			Datacenter: c.serverConf.Datacenter,
+			IsPrimary:  true,
+			RawConfig:  config.Config,
+			State:      config.State,
+		})
+		if err != nil {
+			return fmt.Errorf("error configuring the current provider: %v", err)
+		}
+		current = provider
+	} else {
+		provider, _ := c.getCAProvider()
+		if provider == nil {
+			return fmt.Errorf("internal error: CA provider is nil")
+		}
+		current = provider
+	}
+
+	canXSign, err := current.SupportsCrossSigning()
+	if err != nil {
+		return fmt.Errorf("CA provider error: %s", err)
+	}
+	if !canXSign && !args.Config.ForceWithoutCrossSigning {
+		return errors.New("The current CA Provider does not support cross-signing. " +
+			"You can try again with ForceWithoutCrossSigningSet but this may cause " +
+			"disruption - see documentation for more.")
+	}
+
+	switch {
+	case args.Config.ForceWithoutCrossSigning:
+		plan.Warnings = append(plan.Warnings,
+			"ForceWithoutCrossSigning is set, proxies with leaf certificates signed by different roots "+
+				"can't connect to each other until they all trust the new root")
+	case config.Provider != structs.ConsulCAProvider:
+		plan.Warnings = append(plan.Warnings,
+			fmt.Sprintf("The dry run does not test cross-signing the new root with the current %s provider", config.Provider))
+	default:
+		newRoot, err := connect.ParseCert(caPEM)
+		if err != nil {
+			return err
+		}
+		if _, err := current.CrossSignCA(newRoot); err != nil {
+			return fmt.Errorf("error cross-signing the new root: %w", err)
+		}
+		plan.CrossSigned = true
 	}
 	return nil
 }
 
+// newDryRunProvider returns a Consul provider for a dry run of a
+// configuration update. It keeps its state in the in-memory delegate and
+// generates its keys in memory.
+func (c *CAManager) newDryRunProvider(conf *structs.CAConfiguration, delegate *dryRunCAProviderDelegate) (ca.Provider, error) {
+	if conf.Provider != structs.ConsulCAProvider {
+		return nil, fmt.Errorf("internal error: dry run of the %s provider", conf.Provider)
+	}
+	provider := ca.NewConsulProvider(delegate, c.logger.Named(conf.Provider))
+	provider.EphemeralKeys = true
+	return provider, nil
+}
+
+// dryRunCAProviderDelegate implements ca.ConsulProviderStateDelegate for the
+// Consul providers of a dry run. It starts from a copy of the provider states
+// in the state store, and changes are only applied to the copy.
+type dryRunCAProviderDelegate struct {
+	store *state.Store
+
+	lock  sync.Mutex
+	index uint64
+}
+
+func newDryRunCAProviderDelegate(store *state.Store) (*dryRunCAProviderDelegate, error) {
+	snap := store.Snapshot()
+	defer snap.Close()
+
+	providerStates, err := snap.CAProviderState()
+	if err != nil {
+		return nil, err
+	}
+
+	d := &dryRunCAProviderDelegate{
+		store: state.NewStateStore(nil),
+		index: snap.LastIndex(),
+	}
+	restore := d.store.Restore()
+	defer restore.Abort()
+	for _, providerState := range providerStates {
+		if err := restore.CAProviderState(providerState); err != nil {
+			return nil, err
+		}
+	}
+	if err := restore.Commit(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (d *dryRunCAProviderDelegate) ProviderState(id string) (*structs.CAConsulProviderState, error) {
+	_, providerState, err := d.store.CAProviderState(id)
+	return providerState, err
+}
+
+func (d *dryRunCAProviderDelegate) ApplyCARequest(req *structs.CARequest) (interface{}, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.index++
+	result := fsm.ApplyConnectCAOperationFromRequest(d.store, req, d.index)
+	if err, ok := result.(error); ok && err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 // ValidateConfigUpdater is an optional interface that may be implemented
 // by a ca.Provider. If the provider implements this interface, the
 // ValidateConfigurationUpdate will be called when a user attempts to change the
@@ -922,6 +1278,10 @@ func (c *CAManager) primaryUpdateRootCA(newProvider ca.Provider, args *structs.C
 		}
 	}
 
+	if err := primaryEnsureJWTSigningKey(newActiveRoot, root); err != nil {
+		return err
+	}
+
 	// If the root didn't change, just update the config and return.
 	if root != nil && root.ID == newActiveRoot.ID {
 		args.Op = structs.CAOpSetConfig
@@ -1026,11 +1386,14 @@ func (c *CAManager) primaryUpdateRootCA(newProvider ca.Provider, args *structs.C
 
 	// If the config has been committed, update the local provider instance
 	// and call teardown on the old provider
-	c.setCAProvider(newProvider, newActiveRoot)
+	c.swapCAProvider(newProvider, newActiveRoot)
 
 	if err := oldProvider.Cleanup(args.Config.Provider != config.Provider, args.Config.Config); err != nil {
 		c.logger.Warn("failed to clean up old provider", "provider", config.Provider, "error", err)
 	}
+	if oldProvider != newProvider {
+		stopCAProvider(oldProvider)
+	}
 
 	c.logger.Info("CA rotated to new root under provider", "provider", args.Config.Provider)
 
@@ -1631,11 +1994,6 @@ func (c *CAManager) SignCertificate(csr *x509.CertificateRequest, spiffeID conne
 		pem = pem + lib.EnsureTrailingNewline(p)
 	}
 
-	modIdx, err := c.delegate.ApplyCALeafRequest()
-	if err != nil {
-		return nil, err
-	}
-
 	cert, err := connect.ParseCert(pem)
 	if err != nil {
 		return nil, err
@@ -1647,11 +2005,8 @@ func (c *CAManager) SignCertificate(csr *x509.CertificateRequest, spiffeID conne
 		CertPEM:        pem,
 		ValidAfter:     cert.NotBefore,
 		ValidBefore:    cert.NotAfter,
+		RootID:         caRoot.ID,
 		EnterpriseMeta: entMeta,
-		RaftIndex: structs.RaftIndex{
-			ModifyIndex: modIdx,
-			CreateIndex: modIdx,
-		},
 	}
 
 	switch {
@@ -1673,6 +2028,18 @@ func (c *CAManager) SignCertificate(csr *x509.CertificateRequest, spiffeID conne
 		return nil, errors.New("not possible")
 	}
 
+	// Record the certificate so that it can be revoked along with the other
+	// certificates of its service and the progress of CA rotations can be
+	// reported, without the PEM which is only part of the response.
+	issued := reply
+	issued.CertPEM = ""
+	modIdx, err := c.delegate.ApplyCALeafRequest(&issued)
+	if err != nil {
+		return nil, err
+	}
+	reply.CreateIndex = modIdx
+	reply.ModifyIndex = modIdx
+
 	return &reply, nil
 }
 
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package consul
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/agent/connect/ca"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// primaryEnsureJWTSigningKey sets a JWT signing key on a root that is about
+// to be persisted in the primary datacenter. The key of the stored root is
+// kept if it is the same root, otherwise a new key is generated so that JWT
+// signing keys rotate with the CA.
+func primaryEnsureJWTSigningKey(newRoot, storedRoot *structs.CARoot) error {
+	if storedRoot != nil && storedRoot.ID == newRoot.ID && storedRoot.JWTSigningKey != "" {
+		newRoot.JWTKeyID = storedRoot.JWTKeyID
+		newRoot.JWTPublicKey = storedRoot.JWTPublicKey
+		newRoot.JWTSigningKey = storedRoot.JWTSigningKey
+		return nil
+	}
+	return ca.GenerateJWTSigningKey(newRoot)
+}
+
+// persistActiveRootJWTKey updates the JWT signing key of the active root in
+// place. It is used in the primary datacenter to add a key to a root created
+// before JWT-SVIDs were supported, and in secondary datacenters to pick up
+// the public key of the primary's root.
+//
+// It should only be called while the state lock is held by setting the state
+// to non-ready.
+func (c *CAManager) persistActiveRootJWTKey(key *structs.CARoot) error {
+	idx, roots, err := c.delegate.State().CARoots(nil)
+	if err != nil {
+		return err
+	}
+
+	var newRoots structs.CARoots
+	for _, r := range roots {
+		newRoot := *r
+		if newRoot.Active {
+			newRoot.JWTKeyID = key.JWTKeyID
+			newRoot.JWTPublicKey = key.JWTPublicKey
+			newRoot.JWTSigningKey = key.JWTSigningKey
+		}
+		newRoots = append(newRoots, &newRoot)
+	}
+
+	resp, err := c.delegate.ApplyCARequest(&structs.CARequest{
+		Op:    structs.CAOpSetRoots,
+		Index: idx,
+		Roots: newRoots,
+	})
+	if err != nil {
+		return err
+	}
+	if respOk, ok := resp.(bool); ok && !respOk {
+		return fmt.Errorf("could not atomically update roots")
+	}
+
+	c.logger.Info("updated JWT signing key of the active root", "key_id", key.JWTKeyID)
+	return nil
+}
+
+// AuthorizeAndSignJWTSVID mints a JWT-SVID for the service in the given
+// request after checking that the token has service:write access to it. It
+// must only be called in the primary datacenter, which holds the signing key.
+func (c *CAManager) AuthorizeAndSignJWTSVID(args *structs.JWTSVIDSignRequest, authz acl.Authorizer) (*structs.IssuedJWTSVID, error) {
+	if args.Service == "" {
+		return nil, fmt.Errorf("service name is required")
+	}
+
+	var authzContext acl.AuthorizerContext
+	args.EnterpriseMeta.FillAuthzContext(&authzContext)
+	if err := authz.ToAllowAuthorizer().ServiceWriteAllowed(args.Service, &authzContext); err != nil {
+		return nil, err
+	}
+
+	_, root, err := c.delegate.State().CARootActive(nil)
+	if err != nil {
+		return nil, err
+	}
+	if root == nil {
+		return nil, fmt.Errorf("CA has not finished initializing")
+	}
+
+	spiffeID := connect.SpiffeIDService{
+		Host:       connect.SpiffeIDSigningForCluster(root.ExternalTrustDomain).Host(),
+		Partition:  args.PartitionOrDefault(),
+		Namespace:  args.NamespaceOrDefault(),
+		Datacenter: args.ServiceDatacenter,
+		Service:    args.Service,
+	}
+
+	token, expiresAt, err := ca.SignJWTSVID(root, spiffeID.URI().String(), args.Audience, c.timeNow(), args.TTL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &structs.IssuedJWTSVID{
+		SpiffeID:  spiffeID.URI().String(),
+		Token:     token,
+		KeyID:     root.JWTKeyID,
+		ExpiresAt: expiresAt,
+	}, nil
+}
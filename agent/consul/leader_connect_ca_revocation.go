@@ -0,0 +1,569 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package consul
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-memdb"
+	"github.com/hashicorp/go-uuid"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/agent/connect/ca"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/lib/stringslice"
+	"github.com/hashicorp/consul/logging"
+)
+
+var (
+	// caRevocationListCheckInterval is how often the leader checks whether the
+	// revocation list of its signing CA needs to be resigned, in addition to
+	// checking whenever the revocations change.
+	caRevocationListCheckInterval = time.Hour
+)
+
+// prepareCARevocation sets the server managed fields of a new revocation.
+func (s *Server) prepareCARevocation(revocation *structs.CARevocation) error {
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return err
+	}
+	revocation.ID = id
+	revocation.RevokedAt = time.Now().UTC()
+
+	if revocation.ExpiresAt.IsZero() {
+		// Without the revoked certificate we don't know when it expires, so
+		// keep the revocation until every certificate issued before now has
+		// expired.
+		_, config, err := s.fsm.State().CAConfig(nil)
+		if err != nil {
+			return err
+		}
+		if config == nil {
+			return fmt.Errorf("CA has not finished initializing")
+		}
+		common, err := config.GetCommonConfig()
+		if err != nil {
+			return err
+		}
+		revocation.ExpiresAt = revocation.RevokedAt.Add(common.LeafCertTTL)
+	}
+	return nil
+}
+
+// revokeReplacedLeafCert revokes a leaf certificate that an agent replaced
+// because the service identity of the certificate was revoked. This lets
+// proxies reject the replaced certificate even though the revocation only
+// names the service identity.
+func (s *Server) revokeReplacedLeafCert(replacedPEM string, csr *x509.CertificateRequest) error {
+	replaced, err := connect.ParseCert(replacedPEM)
+	if err != nil {
+		return err
+	}
+	if len(replaced.URIs) != 1 || len(csr.URIs) != 1 || replaced.URIs[0].String() != csr.URIs[0].String() {
+		return fmt.Errorf("replaced certificate identity does not match the CSR")
+	}
+	now := time.Now()
+	if now.After(replaced.NotAfter) {
+		// Nothing to revoke.
+		return nil
+	}
+
+	state := s.fsm.State()
+
+	// Only certificates issued by one of our CAs can be revoked.
+	_, roots, err := state.CARoots(nil)
+	if err != nil {
+		return err
+	}
+	rootPool := x509.NewCertPool()
+	intermediatePool := x509.NewCertPool()
+	for _, root := range roots {
+		rootPool.AppendCertsFromPEM([]byte(root.RootCert))
+		for _, intermediate := range root.IntermediateCerts {
+			intermediatePool.AppendCertsFromPEM([]byte(intermediate))
+		}
+	}
+	_, err = replaced.Verify(x509.VerifyOptions{
+		Roots:         rootPool,
+		Intermediates: intermediatePool,
+		CurrentTime:   now,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return fmt.Errorf("replaced certificate was not issued by the Connect CA: %w", err)
+	}
+
+	// Only certificates covered by a service identity revocation are revoked.
+	uri, err := connect.ParseCertURI(replaced.URIs[0])
+	if err != nil {
+		return err
+	}
+	svc, ok := uri.(*connect.SpiffeIDService)
+	if !ok {
+		return fmt.Errorf("replaced certificate is not a service certificate")
+	}
+	entMeta := acl.NewEnterpriseMetaWithPartition(svc.PartitionOrDefault(), svc.NamespaceOrDefault())
+
+	serial := connect.EncodeSerialNumber(replaced.SerialNumber)
+	authorityKeyID := connect.EncodeSigningKeyID(replaced.AuthorityKeyId)
+
+	_, revocations, _, err := state.CARevocations(nil)
+	if err != nil {
+		return err
+	}
+	covered := false
+	for _, r := range revocations {
+		if !r.RevokesCert(serial, authorityKeyID, svc.Service, &entMeta, replaced.NotBefore) {
+			continue
+		}
+		if r.SerialNumber != "" {
+			// The leader already revoked the serial number.
+			return nil
+		}
+		covered = true
+	}
+	if !covered {
+		return nil
+	}
+
+	args := structs.CARevocationRequest{
+		Op: structs.CARevocationOpRevoke,
+		Revocations: []*structs.CARevocation{{
+			SerialNumber:   serial,
+			AuthorityKeyID: authorityKeyID,
+			Reason:         fmt.Sprintf("replaced after the service identity %q was revoked", svc.Service),
+			ExpiresAt:      replaced.NotAfter,
+		}},
+	}
+	return s.applyCARevocation(&args)
+}
+
+// runCARevocationLists keeps the revocation list signed by the local leaf
+// signing CA up to date and revokes the certificates issued to revoked
+// service identities. The primary datacenter also removes revocations and
+// revocation lists once every certificate they cover has expired.
+func (s *Server) runCARevocationLists(ctx context.Context) error {
+	logger := s.loggers.Named(logging.Connect)
+
+	var notEnforced string
+	retryLoopBackoff(ctx, func() error {
+		state := s.fsm.State()
+		ws := memdb.NewWatchSet()
+		ws.Add(state.AbandonCh())
+
+		// Watch the roots so that a new signing CA signs its list right away.
+		_, roots, err := state.CARoots(ws)
+		if err != nil {
+			return err
+		}
+		_, revocations, lists, err := state.CARevocations(ws)
+		if err != nil {
+			return err
+		}
+
+		if err := s.updateCARevocationLists(revocations, lists); err != nil {
+			return err
+		}
+		if err := s.revokeIssuedLeafCerts(revocations); err != nil {
+			return err
+		}
+
+		// Proxies don't load the revocation lists while a signing CA has no
+		// current list, report it once per change.
+		reason := ""
+		if active := roots.Active(); active != nil && hasActiveCARevocations(revocations) {
+			if _, err := s.caRevocationListCoverage(lists, active.SigningKeyID); err != nil {
+				reason = err.Error()
+			}
+		}
+		if reason != notEnforced && reason != "" {
+			logger.Warn("CA revocations are not enforced by proxies", "reason", reason)
+		}
+		notEnforced = reason
+
+		waitCtx, cancel := context.WithTimeout(ctx, caRevocationListCheckInterval)
+		defer cancel()
+		ws.WatchCtx(waitCtx)
+		return nil
+	}, func(err error) {
+		logger.Error("error updating CA revocation list",
+			"routine", caRevocationListRoutineName,
+			"error", err,
+		)
+	})
+
+	return nil
+}
+
+func (s *Server) updateCARevocationLists(revocations structs.CARevocations, lists []*structs.CARevocationList) error {
+	now := time.Now().UTC()
+	isPrimary := s.config.Datacenter == s.config.PrimaryDatacenter
+
+	var active structs.CARevocations
+	var expired []*structs.CARevocation
+	for _, r := range revocations {
+		if now.After(r.ExpiresAt) {
+			expired = append(expired, &structs.CARevocation{ID: r.ID})
+			continue
+		}
+		active = append(active, r)
+	}
+
+	if isPrimary {
+		// Lists are kept until they expire, even without revocations, so that
+		// the list of a rotated out CA covers the certificates it issued.
+		var expiredLists []*structs.CARevocationList
+		for _, l := range lists {
+			if now.After(l.NextUpdate) {
+				expiredLists = append(expiredLists, &structs.CARevocationList{AuthorityKeyID: l.AuthorityKeyID})
+			}
+		}
+		if len(expired) > 0 || len(expiredLists) > 0 {
+			args := structs.CARevocationRequest{
+				Op:              structs.CARevocationOpDelete,
+				Revocations:     expired,
+				RevocationLists: expiredLists,
+			}
+			if _, err := s.leaderRaftApply("ConnectCA.Revoke", structs.ConnectCARevocationRequestType, &args); err != nil {
+				return fmt.Errorf("failed to remove expired CA revocations: %w", err)
+			}
+		}
+	}
+
+	provider, activeRoot := s.caManager.getCAProvider()
+	if provider == nil || activeRoot == nil {
+		// The CA isn't initialized yet, the roots watch triggers another
+		// attempt once it is.
+		return nil
+	}
+	signer, supported := provider.(ca.RevocationListSigner)
+	authorityKeyID := activeRoot.SigningKeyID
+
+	var serialNumbers []string
+	seen := make(map[string]struct{})
+	for _, r := range active {
+		if r.SerialNumber == "" {
+			continue
+		}
+		if r.AuthorityKeyID != "" && !strings.EqualFold(r.AuthorityKeyID, authorityKeyID) {
+			continue
+		}
+		if _, ok := seen[r.SerialNumber]; ok {
+			continue
+		}
+		seen[r.SerialNumber] = struct{}{}
+		serialNumbers = append(serialNumbers, r.SerialNumber)
+	}
+	sort.Strings(serialNumbers)
+
+	_, config, err := s.fsm.State().CAConfig(nil)
+	if err != nil {
+		return err
+	}
+	if config == nil {
+		return nil
+	}
+	common, err := config.GetCommonConfig()
+	if err != nil {
+		return err
+	}
+
+	for _, l := range lists {
+		if !strings.EqualFold(l.AuthorityKeyID, authorityKeyID) {
+			continue
+		}
+		// Resign lists once half of their validity has passed so that a list
+		// signed by a rotated out CA stays valid until every certificate it
+		// issued has expired.
+		if l.Unsupported == !supported && stringslice.Equal(l.SerialNumbers, serialNumbers) && now.Before(l.NextUpdate.Add(-common.LeafCertTTL)) {
+			return nil
+		}
+	}
+
+	list := &structs.CARevocationList{
+		AuthorityKeyID: authorityKeyID,
+		Datacenter:     s.config.Datacenter,
+		ThisUpdate:     now,
+		NextUpdate:     now.Add(2 * common.LeafCertTTL),
+	}
+	if supported {
+		serials := make([]*big.Int, 0, len(serialNumbers))
+		for _, sn := range serialNumbers {
+			serial, err := connect.DecodeSerialNumber(sn)
+			if err != nil {
+				return err
+			}
+			serials = append(serials, serial)
+		}
+
+		list.SerialNumbers = serialNumbers
+		list.PEM, err = signer.SignRevocationList(serials, list.ThisUpdate, list.NextUpdate)
+		if err != nil {
+			return fmt.Errorf("failed to sign CA revocation list: %w", err)
+		}
+	} else {
+		// Record that the certificates of this CA can't be revoked so that
+		// no datacenter distributes revocation lists until they expired.
+		list.Unsupported = true
+	}
+
+	args := structs.CARevocationRequest{
+		Op:              structs.CARevocationOpSetList,
+		RevocationLists: []*structs.CARevocationList{list},
+	}
+	if isPrimary {
+		_, err := s.leaderRaftApply("ConnectCA.RevocationListSet", structs.ConnectCARevocationRequestType, &args)
+		return err
+	}
+
+	args.WriteRequest = structs.WriteRequest{Token: s.tokens.ReplicationToken()}
+	var ignored bool
+	return s.forwardDC("ConnectCA.RevocationListSet", s.config.PrimaryDatacenter, &args, &ignored)
+}
+
+// revokeIssuedLeafCerts revokes the serial numbers of the unexpired leaf
+// certificates signed by the local datacenter for a revoked service identity.
+// Proxies then reject those certificates right away instead of waiting for
+// their agents to replace them.
+func (s *Server) revokeIssuedLeafCerts(revocations structs.CARevocations) error {
+	provider, _ := s.caManager.getCAProvider()
+	if _, ok := provider.(ca.RevocationListSigner); !ok {
+		return nil
+	}
+
+	now := time.Now()
+	var services structs.CARevocations
+	revoked := make(map[string]struct{})
+	for _, r := range revocations {
+		if now.After(r.ExpiresAt) {
+			continue
+		}
+		if r.Service != "" {
+			services = append(services, r)
+		}
+		if r.SerialNumber != "" {
+			revoked[strings.ToLower(r.SerialNumber)] = struct{}{}
+		}
+	}
+	if len(services) == 0 {
+		return nil
+	}
+
+	_, certs, err := s.fsm.State().CALeafCerts(nil)
+	if err != nil {
+		return err
+	}
+
+	for _, cert := range certs {
+		if cert.Service == "" || now.After(cert.ValidBefore) {
+			continue
+		}
+		if _, ok := revoked[strings.ToLower(cert.SerialNumber)]; ok {
+			continue
+		}
+
+		var revocation *structs.CARevocation
+		for _, r := range services {
+			if r.RevokesCert(cert.SerialNumber, "", cert.Service, &cert.EnterpriseMeta, cert.ValidAfter) {
+				revocation = r
+				break
+			}
+		}
+		if revocation == nil {
+			continue
+		}
+
+		args := structs.CARevocationRequest{
+			Op: structs.CARevocationOpRevoke,
+			Revocations: []*structs.CARevocation{{
+				SerialNumber: cert.SerialNumber,
+				Reason:       fmt.Sprintf("issued before the service identity %q was revoked", cert.Service),
+				ExpiresAt:    cert.ValidBefore,
+			}},
+		}
+		if err := s.applyCARevocation(&args); err != nil {
+			return fmt.Errorf("failed to revoke leaf certificate %s: %w", cert.SerialNumber, err)
+		}
+	}
+	return nil
+}
+
+// applyCARevocation stores a revocation created by the leader. Secondary
+// datacenters forward it to the primary.
+func (s *Server) applyCARevocation(args *structs.CARevocationRequest) error {
+	if s.config.Datacenter == s.config.PrimaryDatacenter {
+		// We are the primary, so we can't do an RPC as we don't have a
+		// replication token.
+		if err := s.prepareCARevocation(args.Revocations[0]); err != nil {
+			return err
+		}
+		_, err := s.leaderRaftApply("ConnectCA.Revoke", structs.ConnectCARevocationRequestType, args)
+		return err
+	}
+
+	args.WriteRequest = structs.WriteRequest{Token: s.tokens.ReplicationToken()}
+	var reply structs.CARevocation
+	return s.forwardDC("ConnectCA.Revoke", s.config.PrimaryDatacenter, args, &reply)
+}
+
+// distributedCARevocationLists returns the revocation lists that proxies
+// should load. Lists are only distributed while there are revocations and
+// every signing CA that can still have valid certificates has a current list.
+func (s *Server) distributedCARevocationLists(revocations structs.CARevocations, lists []*structs.CARevocationList, signingKeyID string) []*structs.CARevocationList {
+	if !hasActiveCARevocations(revocations) {
+		return nil
+	}
+	current, err := s.caRevocationListCoverage(lists, signingKeyID)
+	if err != nil {
+		return nil
+	}
+	return current
+}
+
+// caRevocationListsDistributed reports whether proxies are sent revocation
+// lists. Connect proxies then validate with the lists instead of the SPIFFE
+// validator, so they stop trusting federated trust domains.
+func (s *Server) caRevocationListsDistributed(ws memdb.WatchSet) (bool, error) {
+	state := s.fsm.State()
+	_, activeRoot, err := state.CARootActive(ws)
+	if err != nil || activeRoot == nil {
+		return false, err
+	}
+	_, revocations, lists, err := state.CARevocations(ws)
+	if err != nil {
+		return false, err
+	}
+	return len(s.distributedCARevocationLists(revocations, lists, activeRoot.SigningKeyID)) > 0, nil
+}
+
+func hasActiveCARevocations(revocations structs.CARevocations) bool {
+	now := time.Now()
+	for _, r := range revocations {
+		if now.Before(r.ExpiresAt) {
+			return true
+		}
+	}
+	return false
+}
+
+// caRevocationListCoverage returns the current revocation lists if every
+// signing CA that can still have valid certificates has one. Once a proxy
+// loads a revocation list it rejects every certificate whose issuer has no
+// list, so otherwise the lists can't be enforced and the returned error
+// explains which list is missing.
+func (s *Server) caRevocationListCoverage(lists []*structs.CARevocationList, signingKeyID string) ([]*structs.CARevocationList, error) {
+	now := time.Now()
+
+	var current []*structs.CARevocationList
+	covered := make(map[string]bool)
+	localCovered := false
+	for _, l := range lists {
+		if now.After(l.NextUpdate) {
+			continue
+		}
+		if l.Unsupported || l.PEM == "" {
+			return nil, fmt.Errorf("the CA provider of datacenter %q does not support revocation lists", l.Datacenter)
+		}
+		if strings.EqualFold(l.AuthorityKeyID, signingKeyID) {
+			localCovered = true
+		}
+		covered[l.Datacenter] = true
+		current = append(current, l)
+	}
+
+	// The signing CA of the local datacenter may have just been rotated. The
+	// lists of other datacenters are only known once they reported them.
+	if !localCovered {
+		return nil, fmt.Errorf("the signing CA of datacenter %q has not signed a revocation list yet", s.config.Datacenter)
+	}
+	for _, dc := range s.router.GetDatacenters() {
+		if !covered[dc] {
+			return nil, fmt.Errorf("datacenter %q has not reported a revocation list", dc)
+		}
+	}
+	return current, nil
+}
+
+// checkCARevocationSupport returns an error if a revocation can't be enforced
+// right away, because a signing CA can't sign revocation lists or has not
+// signed one yet. Revocations are rejected rather than stored without proxies
+// enforcing them.
+func (s *Server) checkCARevocationSupport() error {
+	provider, activeRoot := s.caManager.getCAProvider()
+	if provider == nil || activeRoot == nil {
+		return fmt.Errorf("CA has not finished initializing")
+	}
+	if _, ok := provider.(ca.RevocationListSigner); !ok {
+		return fmt.Errorf("the CA provider of datacenter %q does not support revocation lists", s.config.Datacenter)
+	}
+
+	_, _, lists, err := s.fsm.State().CARevocations(nil)
+	if err != nil {
+		return err
+	}
+	if _, err := s.caRevocationListCoverage(lists, activeRoot.SigningKeyID); err != nil {
+		return fmt.Errorf("revocations can't be enforced: %w", err)
+	}
+	return nil
+}
+
+// runCARevocationReplication replicates the revocations and revocation lists
+// from the primary datacenter.
+func (s *Server) runCARevocationReplication(ctx context.Context) error {
+	logger := s.loggers.Named(logging.Connect)
+
+	args := structs.DCSpecificRequest{
+		Datacenter: s.config.PrimaryDatacenter,
+		QueryOptions: structs.QueryOptions{
+			MaxQueryTime: s.config.MaxQueryTime,
+		},
+	}
+
+	logger.Debug("starting CA revocation replication from primary datacenter", "primary", s.config.PrimaryDatacenter)
+
+	retryLoopBackoff(ctx, func() error {
+		args.Token = s.tokens.ReplicationToken()
+
+		var reply structs.IndexedCARevocations
+		if err := s.forwardDC("ConnectCA.Revocations", s.config.PrimaryDatacenter, &args, &reply); err != nil {
+			return fmt.Errorf("error retrieving the primary datacenter's CA revocations: %w", err)
+		}
+
+		// Return if the context has been canceled while waiting on the RPC.
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		if reply.Index != args.MinQueryIndex {
+			req := structs.CARevocationRequest{
+				Op:              structs.CARevocationOpReplace,
+				Revocations:     reply.Revocations,
+				RevocationLists: reply.RevocationLists,
+			}
+			if _, err := s.leaderRaftApply("ConnectCA.Revoke", structs.ConnectCARevocationRequestType, &req); err != nil {
+				return fmt.Errorf("failed to replicate CA revocations: %w", err)
+			}
+		}
+
+		args.MinQueryIndex = nextIndexVal(args.MinQueryIndex, reply.Index)
+		return nil
+	}, func(err error) {
+		logger.Error("CA revocation replication failed, will retry",
+			"routine", caRevocationReplicationRoutineName,
+			"error", err,
+		)
+	})
+
+	return nil
+}
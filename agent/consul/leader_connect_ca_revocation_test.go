@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package consul
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	msgpackrpc "github.com/hashicorp/consul-net-rpc/net-rpc-msgpackrpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/sdk/testutil"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+// waitForCARevocationLists waits until the server stores a current
+// revocation list for each datacenter, which is required for revocations to
+// be accepted.
+func waitForCARevocationLists(t *testing.T, s *Server, datacenters ...string) {
+	t.Helper()
+	retry.Run(t, func(r *retry.R) {
+		_, _, lists, err := s.fsm.State().CARevocations(nil)
+		require.NoError(r, err)
+		covered := make(map[string]bool)
+		for _, l := range lists {
+			covered[l.Datacenter] = true
+		}
+		for _, dc := range datacenters {
+			require.True(r, covered[dc], "no revocation list for datacenter %q", dc)
+		}
+	})
+}
+
+func TestLeader_CARevocationReplication(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	dir1, s1 := testServerWithConfig(t, func(c *Config) {
+		c.Datacenter = "primary"
+		c.PrimaryDatacenter = "primary"
+	})
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+
+	testrpc.WaitForLeader(t, s1.RPC, "primary")
+
+	dir2, s2 := testServerWithConfig(t, func(c *Config) {
+		c.Datacenter = "secondary"
+		c.PrimaryDatacenter = "primary"
+	})
+	defer os.RemoveAll(dir2)
+	defer s2.Shutdown()
+	codec := rpcClient(t, s2)
+	defer codec.Close()
+
+	joinWAN(t, s2, s1)
+	testrpc.WaitForLeader(t, s2.RPC, "secondary")
+
+	_, primaryRoot, err := getTestRoots(s1, "primary")
+	require.NoError(t, err)
+	testrpc.WaitForActiveCARoot(t, s2.RPC, "secondary", primaryRoot)
+
+	// Revocations are only accepted once every datacenter signed a list.
+	waitForCARevocationLists(t, s1, "primary", "secondary")
+
+	// Revocations written in the secondary are forwarded to the primary.
+	var revocation structs.CARevocation
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.Revoke", &structs.CARevocationRequest{
+		Datacenter:  "secondary",
+		Revocations: []*structs.CARevocation{{SerialNumber: "0a"}},
+	}, &revocation))
+
+	_, primaryRevocations, _, err := s1.fsm.State().CARevocations(nil)
+	require.NoError(t, err)
+	require.Len(t, primaryRevocations, 1)
+
+	var secondarySigningKeyID string
+	retry.Run(t, func(r *retry.R) {
+		_, secondaryRoot, err := s2.fsm.State().CARootActive(nil)
+		require.NoError(r, err)
+		require.NotNil(r, secondaryRoot)
+		secondarySigningKeyID = secondaryRoot.SigningKeyID
+	})
+	require.NotEqual(t, primaryRoot.SigningKeyID, secondarySigningKeyID)
+
+	// Both datacenters sign a revocation list with their signing CA and the
+	// secondary replicates the revocations and both lists.
+	retry.Run(t, func(r *retry.R) {
+		_, revocations, lists, err := s2.fsm.State().CARevocations(nil)
+		require.NoError(r, err)
+		require.Len(r, revocations, 1)
+		require.Equal(r, revocation.ID, revocations[0].ID)
+		require.Len(r, lists, 2)
+
+		datacenters := map[string]string{}
+		for _, l := range lists {
+			require.Equal(r, []string{"0a"}, l.SerialNumbers)
+			datacenters[l.Datacenter] = l.AuthorityKeyID
+		}
+		require.Equal(r, map[string]string{
+			"primary":   primaryRoot.SigningKeyID,
+			"secondary": secondarySigningKeyID,
+		}, datacenters)
+	})
+
+	// Proxies receive the lists of both datacenters.
+	retry.Run(t, func(r *retry.R) {
+		roots, err := s2.getCARoots(nil, s2.fsm.State())
+		require.NoError(r, err)
+		require.Len(r, roots.RevocationLists, 2)
+	})
+}
+
+func TestLeader_CARevokeIssuedLeafCerts(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	sign := func(service string) structs.IssuedCert {
+		csr, _ := connect.TestCSR(t, connect.TestSpiffeIDService(t, service))
+		var issued structs.IssuedCert
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.Sign", &structs.CASignRequest{
+			Datacenter: "dc1",
+			CSR:        csr,
+		}, &issued))
+		return issued
+	}
+	web := sign("web")
+	db := sign("db")
+	waitForCARevocationLists(t, s1, "dc1")
+
+	var revocation structs.CARevocation
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.Revoke", &structs.CARevocationRequest{
+		Revocations: []*structs.CARevocation{{Service: "web"}},
+	}, &revocation))
+
+	// Certificates issued after the revocation stay valid.
+	replacement := sign("web")
+
+	// The leader revokes the serial number of the certificate issued before
+	// the revocation without waiting for the agent to replace it.
+	retry.Run(t, func(r *retry.R) {
+		_, revocations, lists, err := s1.fsm.State().CARevocations(nil)
+		require.NoError(r, err)
+
+		var serials []string
+		for _, rev := range revocations {
+			if rev.SerialNumber != "" {
+				serials = append(serials, rev.SerialNumber)
+				require.True(r, web.ValidBefore.Equal(rev.ExpiresAt))
+			}
+		}
+		require.Equal(r, []string{web.SerialNumber}, serials)
+
+		require.Len(r, lists, 1)
+		require.Equal(r, []string{web.SerialNumber}, lists[0].SerialNumbers)
+	})
+
+	_, revocations, _, err := s1.fsm.State().CARevocations(nil)
+	require.NoError(t, err)
+	for _, rev := range revocations {
+		require.NotEqual(t, db.SerialNumber, rev.SerialNumber)
+		require.NotEqual(t, replacement.SerialNumber, rev.SerialNumber)
+	}
+}
+
+func TestServer_distributedCARevocationLists(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+
+	now := time.Now()
+	revocations := structs.CARevocations{{SerialNumber: "0a", ExpiresAt: now.Add(time.Hour)}}
+	list := func(authorityKeyID, dc string, unsupported bool) *structs.CARevocationList {
+		l := &structs.CARevocationList{
+			AuthorityKeyID: authorityKeyID,
+			Datacenter:     dc,
+			Unsupported:    unsupported,
+			NextUpdate:     now.Add(time.Hour),
+		}
+		if !unsupported {
+			l.PEM = "crl"
+		}
+		return l
+	}
+
+	cases := map[string]struct {
+		revocations structs.CARevocations
+		lists       []*structs.CARevocationList
+		expected    int
+		notEnforced string
+	}{
+		"no revocations": {
+			lists: []*structs.CARevocationList{list("aa", "dc1", false)},
+		},
+		"no lists": {
+			revocations: revocations,
+			notEnforced: `the signing CA of datacenter "dc1" has not signed a revocation list yet`,
+		},
+		"expired revocations": {
+			revocations: structs.CARevocations{{SerialNumber: "0a", ExpiresAt: now.Add(-time.Hour)}},
+			lists:       []*structs.CARevocationList{list("aa", "dc1", false)},
+		},
+		"every signing CA covered": {
+			revocations: revocations,
+			lists:       []*structs.CARevocationList{list("aa", "dc1", false), list("bb", "dc1", false)},
+			expected:    2,
+		},
+		"rotated signing CA without a list": {
+			revocations: revocations,
+			lists:       []*structs.CARevocationList{list("bb", "dc1", false)},
+			notEnforced: `the signing CA of datacenter "dc1" has not signed a revocation list yet`,
+		},
+		"signing CA without revocation lists": {
+			revocations: revocations,
+			lists:       []*structs.CARevocationList{list("aa", "dc1", false), list("bb", "dc1", true)},
+			notEnforced: `the CA provider of datacenter "dc1" does not support revocation lists`,
+		},
+		"expired list": {
+			revocations: revocations,
+			lists: []*structs.CARevocationList{
+				list("aa", "dc1", false),
+				{AuthorityKeyID: "bb", Datacenter: "dc1", PEM: "crl", NextUpdate: now.Add(-time.Hour)},
+			},
+			expected: 1,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			lists := s1.distributedCARevocationLists(tc.revocations, tc.lists, "AA")
+			require.Len(t, lists, tc.expected)
+
+			_, err := s1.caRevocationListCoverage(tc.lists, "AA")
+			if tc.notEnforced == "" {
+				require.NoError(t, err)
+			} else {
+				testutil.RequireErrorContains(t, err, tc.notEnforced)
+			}
+		})
+	}
+}
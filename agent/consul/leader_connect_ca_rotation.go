@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package consul
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-memdb"
+
+	"github.com/hashicorp/consul/agent/consul/state"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/logging"
+)
+
+var (
+	// caLeafCertPruneInterval is how often the leader removes the records of
+	// expired leaf certificates.
+	caLeafCertPruneInterval = time.Hour
+
+	// caLeafCertPruneBatchSize is the maximum number of leaf certificate
+	// records removed in a single raft log entry.
+	caLeafCertPruneBatchSize = 1024
+)
+
+// rotationProxyKinds are the kinds of services that use leaf certificates
+// signed by the Connect CA.
+var rotationProxyKinds = []structs.ServiceKind{
+	structs.ServiceKindConnectProxy,
+	structs.ServiceKindMeshGateway,
+	structs.ServiceKindTerminatingGateway,
+	structs.ServiceKindIngressGateway,
+	structs.ServiceKindAPIGateway,
+}
+
+// leafIdentity identifies the service identity of a leaf certificate. Every
+// instance of a service shares the identity.
+type leafIdentity struct {
+	kind    structs.ServiceKind
+	service structs.ServiceName
+}
+
+// issuedCertIdentity returns the identity of an issued leaf certificate. It
+// returns false for certificates that are not used by proxies.
+func issuedCertIdentity(cert *structs.IssuedCert) (leafIdentity, bool) {
+	switch {
+	case cert.Service != "":
+		return leafIdentity{service: structs.NewServiceName(cert.Service, &cert.EnterpriseMeta)}, true
+	case cert.Kind == structs.ServiceKindMeshGateway:
+		entMeta := structs.DefaultEnterpriseMetaInPartition(cert.EnterpriseMeta.PartitionOrDefault())
+		return leafIdentity{kind: structs.ServiceKindMeshGateway, service: structs.NewServiceName("", entMeta)}, true
+	}
+	return leafIdentity{}, false
+}
+
+// proxyIdentity returns the identity of the leaf certificate used by a proxy
+// or gateway.
+func proxyIdentity(svc *structs.NodeService) leafIdentity {
+	switch svc.Kind {
+	case structs.ServiceKindConnectProxy:
+		return leafIdentity{service: structs.NewServiceName(svc.Proxy.DestinationServiceName, &svc.EnterpriseMeta)}
+	case structs.ServiceKindMeshGateway:
+		entMeta := structs.DefaultEnterpriseMetaInPartition(svc.EnterpriseMeta.PartitionOrDefault())
+		return leafIdentity{kind: structs.ServiceKindMeshGateway, service: structs.NewServiceName("", entMeta)}
+	default:
+		return leafIdentity{service: structs.NewServiceName(svc.Service, &svc.EnterpriseMeta)}
+	}
+}
+
+// caRotationLeafCerts returns the unexpired leaf certificates signed by the
+// local datacenter along with the latest one of each service identity.
+func caRotationLeafCerts(ws memdb.WatchSet, store *state.Store, now time.Time) ([]*structs.IssuedCert, map[leafIdentity]*structs.IssuedCert, error) {
+	_, certs, err := store.CALeafCerts(ws)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var live []*structs.IssuedCert
+	latest := make(map[leafIdentity]*structs.IssuedCert)
+	for _, cert := range certs {
+		if now.After(cert.ValidBefore) {
+			continue
+		}
+		live = append(live, cert)
+
+		id, ok := issuedCertIdentity(cert)
+		if !ok {
+			continue
+		}
+		if prev, ok := latest[id]; !ok || cert.CreateIndex > prev.CreateIndex {
+			latest[id] = cert
+		}
+	}
+	return live, latest, nil
+}
+
+// caRotationProxies returns the proxies and gateways registered in the local
+// datacenter.
+func caRotationProxies(ws memdb.WatchSet, store *state.Store) ([]*structs.NodeService, error) {
+	var proxies []*structs.NodeService
+	for _, kind := range rotationProxyKinds {
+		_, nodes, err := store.ServiceDump(ws, kind, true, structs.WildcardEnterpriseMetaInPartition(structs.WildcardSpecifier), structs.DefaultPeerKeyword)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range nodes {
+			proxies = append(proxies, n.Service)
+		}
+	}
+	return proxies, nil
+}
+
+// caRotationImpact returns the number of unexpired leaf certificates and the
+// number of proxies that are affected by a rotation to a new root.
+func caRotationImpact(store *state.Store, now time.Time) (int, int, error) {
+	live, _, err := caRotationLeafCerts(nil, store, now)
+	if err != nil {
+		return 0, 0, err
+	}
+	proxies, err := caRotationProxies(nil, store)
+	if err != nil {
+		return 0, 0, err
+	}
+	return len(live), len(proxies), nil
+}
+
+// caRotationStatus reports how far the local datacenter has progressed in
+// rotating to the active root. Leaf certificates are attributed to the root
+// that was active when they were signed, and proxies to the root of the
+// latest leaf certificate of their service identity.
+func (s *Server) caRotationStatus(ws memdb.WatchSet, store *state.Store) (*structs.CARotationStatus, error) {
+	status := &structs.CARotationStatus{
+		Datacenter: s.config.Datacenter,
+	}
+
+	_, roots, err := store.CARoots(ws)
+	if err != nil {
+		return nil, err
+	}
+	activeRoot := roots.Active()
+	if activeRoot == nil {
+		return status, nil
+	}
+	status.ActiveRootID = activeRoot.ID
+
+	now := time.Now()
+	live, latest, err := caRotationLeafCerts(ws, store, now)
+	if err != nil {
+		return nil, err
+	}
+
+	leafCerts := make(map[string]int)
+	for _, cert := range live {
+		leafCerts[cert.RootID]++
+		if cert.RootID == activeRoot.ID {
+			status.LeafCerts.Active++
+		} else {
+			status.LeafCerts.Previous++
+		}
+	}
+	for _, root := range roots {
+		status.Roots = append(status.Roots, structs.CARotationRootStatus{
+			ID:           root.ID,
+			Name:         root.Name,
+			Active:       root.Active,
+			RotatedOutAt: root.RotatedOutAt,
+			LeafCerts:    leafCerts[root.ID],
+		})
+	}
+
+	proxies, err := caRotationProxies(ws, store)
+	if err != nil {
+		return nil, err
+	}
+	for _, svc := range proxies {
+		cert, ok := latest[proxyIdentity(svc)]
+		switch {
+		case !ok:
+			status.Proxies.Unknown++
+		case cert.RootID == activeRoot.ID:
+			status.Proxies.Active++
+		default:
+			status.Proxies.Previous++
+		}
+	}
+
+	_, peerings, err := store.PeeringList(ws, *structs.NodeEnterpriseMetaInPartition(structs.WildcardSpecifier))
+	if err != nil {
+		return nil, err
+	}
+	activePEM := strings.TrimSpace(activeRoot.RootCert)
+	for _, peering := range peerings {
+		if !peering.IsActive() {
+			continue
+		}
+		peer := structs.CARotationPeerStatus{
+			Peer:      peering.Name,
+			Partition: peering.Partition,
+		}
+		if streamStatus, found := s.peerStreamServer.StreamStatus(peering.ID); found {
+			peer.Connected = streamStatus.Connected
+			peer.LastTrustBundleSent = streamStatus.LastTrustBundleSent
+			peer.TrustBundleAcknowledged = streamStatus.LastTrustBundleAck != nil
+			for _, pem := range streamStatus.TrustBundleRootPEMs {
+				if strings.TrimSpace(pem) == activePEM {
+					peer.TrustBundleUpdated = true
+					break
+				}
+			}
+		}
+		status.Peers = append(status.Peers, peer)
+	}
+
+	return status, nil
+}
+
+// runCALeafCertPruning periodically removes the records of expired leaf
+// certificates.
+func (s *Server) runCALeafCertPruning(ctx context.Context) error {
+	ticker := time.NewTicker(caLeafCertPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := s.pruneCALeafCerts(); err != nil {
+				s.loggers.Named(logging.Connect).Error("error pruning expired leaf certificates", "error", err)
+			}
+		}
+	}
+}
+
+// pruneCALeafCerts removes the records of expired leaf certificates.
+func (s *Server) pruneCALeafCerts() error {
+	_, certs, err := s.fsm.State().CALeafCerts(nil)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	var expired []string
+	for _, cert := range certs {
+		if now.After(cert.ValidBefore) {
+			expired = append(expired, cert.SerialNumber)
+		}
+	}
+
+	for len(expired) > 0 {
+		batch := expired
+		if len(batch) > caLeafCertPruneBatchSize {
+			batch = batch[:caLeafCertPruneBatchSize]
+		}
+		expired = expired[len(batch):]
+
+		req := structs.CALeafRequest{
+			Op:            structs.CALeafOpDeleteCerts,
+			Datacenter:    s.config.Datacenter,
+			SerialNumbers: batch,
+		}
+		if _, err := s.raftApplyMsgpack(structs.ConnectCALeafRequestType, &req); err != nil {
+			return fmt.Errorf("failed to remove expired leaf certificates: %w", err)
+		}
+	}
+	return nil
+}
@@ -202,7 +202,7 @@ func (m *mockCAServerDelegate) ServersSupportMultiDCConnectCA() error {
 	return nil
 }
 
-func (m *mockCAServerDelegate) ApplyCALeafRequest() (uint64, error) {
+func (m *mockCAServerDelegate) ApplyCALeafRequest(_ *structs.IssuedCert) (uint64, error) {
 	return 3, nil
 }
 
@@ -972,6 +972,116 @@ func TestCAManager_Initialize_FixesSigningKeyID_Secondary(t *testing.T) {
 	})
 }
 
+func TestCAManager_Initialize_AddsJWTSigningKey_Primary(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	dir1pre, s1pre := testServerWithConfig(t, func(c *Config) {
+		c.PrimaryDatacenter = "dc1"
+	})
+	defer os.RemoveAll(dir1pre)
+	defer s1pre.Shutdown()
+
+	testrpc.WaitForLeader(t, s1pre.RPC, "dc1")
+
+	// Remove the JWT signing key to simulate a root created before JWT-SVIDs
+	// were supported.
+	{
+		state := s1pre.fsm.State()
+		idx, activeRoot, err := state.CARootActive(nil)
+		require.NoError(t, err)
+		require.NotNil(t, activeRoot)
+		require.NotEmpty(t, activeRoot.JWTSigningKey)
+
+		root := activeRoot.Clone()
+		root.JWTKeyID = ""
+		root.JWTPublicKey = ""
+		root.JWTSigningKey = ""
+		_, err = s1pre.raftApply(structs.ConnectCARequestType, &structs.CARequest{
+			Op:    structs.CAOpSetRoots,
+			Index: idx,
+			Roots: []*structs.CARoot{root},
+		})
+		require.NoError(t, err)
+	}
+
+	// Restart the server to trigger the primary CA init, which should add a
+	// key to the existing root.
+	s1pre.Shutdown()
+
+	dir1, s1 := testServerWithConfig(t, func(c *Config) {
+		c.DataDir = s1pre.config.DataDir
+		c.PrimaryDatacenter = "dc1"
+		c.NodeName = s1pre.config.NodeName
+		c.NodeID = s1pre.config.NodeID
+	})
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	retry.Run(t, func(r *retry.R) {
+		_, roots, err := s1.fsm.State().CARoots(nil)
+		require.NoError(r, err)
+		require.Len(r, roots, 1)
+		require.True(r, roots[0].Active)
+		require.NotEmpty(r, roots[0].JWTKeyID)
+		require.NotEmpty(r, roots[0].JWTSigningKey)
+	})
+}
+
+func TestCAManager_JWTSVID_Secondary(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	_, s1 := testServerWithConfig(t, func(c *Config) {
+		c.PrimaryDatacenter = "dc1"
+	})
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	_, s2 := testServerWithConfig(t, func(c *Config) {
+		c.Datacenter = "dc2"
+		c.PrimaryDatacenter = "dc1"
+	})
+	joinWAN(t, s2, s1)
+	testrpc.WaitForLeader(t, s2.RPC, "dc2")
+
+	_, primaryRoot, err := getTestRoots(s1, "dc1")
+	require.NoError(t, err)
+	testrpc.WaitForActiveCARoot(t, s2.RPC, "dc2", primaryRoot)
+
+	// The secondary only stores the public key of the primary's root.
+	roots, activeRoot, err := getTestRoots(s2, "dc2")
+	require.NoError(t, err)
+	require.Equal(t, primaryRoot.JWTKeyID, activeRoot.JWTKeyID)
+	_, storedRoot, err := s2.fsm.State().CARootActive(nil)
+	require.NoError(t, err)
+	require.Equal(t, primaryRoot.JWTPublicKey, storedRoot.JWTPublicKey)
+	require.Empty(t, storedRoot.JWTSigningKey)
+
+	// Requests in the secondary are signed by the primary for a service in
+	// the secondary.
+	codec := rpcClient(t, s2)
+	defer codec.Close()
+
+	var jwtSVID structs.IssuedJWTSVID
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConnectCA.SignJWTSVID", &structs.JWTSVIDSignRequest{
+		Datacenter: "dc2",
+		Service:    "web",
+		Audience:   []string{"api"},
+	}, &jwtSVID))
+	require.Equal(t, "spiffe://"+roots.TrustDomain+"/ns/default/dc/dc2/svc/web", jwtSVID.SpiffeID)
+
+	_, _, err = connect.ValidateJWTSVID(jwtSVID.Token, "api", roots.Roots, time.Now())
+	require.NoError(t, err)
+}
+
 func TestCAManager_Initialize_TransitionFromPrimaryToSecondary(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -0,0 +1,675 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package consul
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/raft"
+	raftboltdb "github.com/hashicorp/raft-boltdb/v2"
+	raftwal "github.com/hashicorp/raft-wal"
+	walmetrics "github.com/hashicorp/raft-wal/metrics"
+	"github.com/hashicorp/raft-wal/verifier"
+	"go.etcd.io/bbolt"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/lib"
+)
+
+const (
+	// logStoreMigrationDir is the directory in the raft directory where the
+	// log store is staged while it is migrated to a different backend.
+	logStoreMigrationDir = "logstore-migration"
+
+	// logStoreBackupDir is the directory in the raft directory where the
+	// previous log store is kept after a migration completed, as a last
+	// resort to roll back to.
+	logStoreBackupDir = "logstore-backup"
+
+	// logStoreMigrationStateFile records the state of a migration in the
+	// staging and backup directories.
+	logStoreMigrationStateFile = "state.json"
+
+	// logStoreMigrationBatchSize is the number of entries that are copied at
+	// once.
+	logStoreMigrationBatchSize = 1024
+
+	// logStoreMigrationReportTimeout bounds how long the copy waits for the
+	// verification of a checkpoint.
+	logStoreMigrationReportTimeout = 1 * time.Minute
+)
+
+// logStoreMigrator tracks the log store migration that runs in the
+// background of a server.
+type logStoreMigrator struct {
+	lock   sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+	state  *structs.LogStoreMigration
+}
+
+// migrationStore is a log store that can be migrated. Both the BoltDB and the
+// WAL backends keep the stable store in the same place as the log.
+type migrationStore interface {
+	raft.LogStore
+	raft.StableStore
+	Close() error
+}
+
+// logStoreFileName returns the name of the file or directory of the backend
+// in the raft directory.
+func logStoreFileName(backend string) string {
+	if backend == LogStoreBackendWAL {
+		return "wal"
+	}
+	return "raft.db"
+}
+
+// openMigrationStore opens the log store of the given backend in dir, with
+// the same options the server uses for its own log store.
+func openMigrationStore(conf RaftLogStoreConfig, backend, dir string) (migrationStore, error) {
+	switch backend {
+	case LogStoreBackendBoltDB:
+		return raftboltdb.New(raftboltdb.Options{
+			BoltOptions: &bbolt.Options{
+				NoFreelistSync: conf.BoltDB.NoFreelistSync,
+			},
+			Path: filepath.Join(dir, logStoreFileName(backend)),
+		})
+	case LogStoreBackendWAL:
+		walDir := filepath.Join(dir, logStoreFileName(backend))
+		if err := os.MkdirAll(walDir, 0755); err != nil {
+			return nil, err
+		}
+		return raftwal.Open(walDir, raftwal.WithSegmentSize(conf.WAL.SegmentSize))
+	default:
+		return nil, fmt.Errorf("unknown log store backend %q", backend)
+	}
+}
+
+// StartLogStoreMigration starts to copy the log store of the server into a
+// staged log store of the given backend. The copy runs in the background and
+// the migration completes when the server is restarted with the backend
+// configured.
+func (s *Server) StartLogStoreMigration(backend string) error {
+	if s.config.DevMode {
+		return errors.New("log store migrations are not supported in dev mode")
+	}
+	if backend != LogStoreBackendBoltDB && backend != LogStoreBackendWAL {
+		return fmt.Errorf("backend must be %q or %q", LogStoreBackendBoltDB, LogStoreBackendWAL)
+	}
+	source := s.config.LogStoreConfig.Backend
+	if backend == source {
+		return fmt.Errorf("the log store already uses the %s backend", backend)
+	}
+
+	raftDir := filepath.Join(s.config.DataDir, raftState)
+	if exists, err := fileExists(filepath.Join(raftDir, logStoreFileName(backend))); err != nil {
+		return err
+	} else if exists {
+		return fmt.Errorf("%s already exists in %s, remove it before migrating", logStoreFileName(backend), raftDir)
+	}
+
+	m := &s.logStoreMigrator
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.done != nil {
+		return errors.New("a log store migration is already running")
+	}
+
+	dir := filepath.Join(raftDir, logStoreMigrationDir)
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("failed to remove previous staged log store: %w", err)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	m.state = &structs.LogStoreMigration{
+		Backend:       backend,
+		SourceBackend: source,
+		Status:        structs.LogStoreMigrationCopying,
+		StartedAt:     now,
+		UpdatedAt:     now,
+	}
+	if err := writeLogStoreMigrationState(dir, m.state); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(&lib.StopChannelContext{StopCh: s.shutdownCh})
+	m.cancel = cancel
+	m.done = make(chan struct{})
+	go s.runLogStoreMigration(ctx, dir, m.state)
+	return nil
+}
+
+// runLogStoreMigration copies the live log store into the staged one.
+func (s *Server) runLogStoreMigration(ctx context.Context, dir string, state *structs.LogStoreMigration) {
+	logger := s.logger.Named("raft.logstore.migration")
+	m := &s.logStoreMigrator
+	backend := state.Backend
+
+	// The state is only updated while the migration was not cancelled.
+	progress := func(copied, total uint64) {
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		if m.state != state {
+			return
+		}
+		m.state.CopiedEntries = copied
+		m.state.TotalEntries = total
+		m.state.UpdatedAt = time.Now().UTC()
+	}
+
+	first, last, checkpoints, err := func() (uint64, uint64, int, error) {
+		dst, err := openMigrationStore(s.config.LogStoreConfig, backend, dir)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		defer dst.Close()
+
+		first, err := s.raftStore.FirstIndex()
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		last, err := s.raftStore.LastIndex()
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		logger.Info("copying log store", "backend", backend, "firstIndex", first, "lastIndex", last)
+
+		checkpoints, err := copyLogs(ctx, dst, s.raftStore, first, last, progress)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		if err := compareLogs(ctx, dst, s.raftStore, first, last); err != nil {
+			return 0, 0, 0, err
+		}
+		return first, last, checkpoints, nil
+	}()
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	close(m.done)
+	m.done = nil
+	m.cancel = nil
+	if m.state != state {
+		return
+	}
+	m.state.UpdatedAt = time.Now().UTC()
+
+	if err != nil {
+		if errors.Is(err, raft.ErrLogNotFound) {
+			err = fmt.Errorf("%w: the log was compacted during the copy, start the migration again", err)
+		}
+		logger.Error("failed to copy log store", "error", err)
+		m.state.Status = structs.LogStoreMigrationFailed
+		m.state.Error = err.Error()
+	} else {
+		logger.Info("log store copied, set raft_logstore.backend and restart the server to complete the migration",
+			"backend", backend,
+			"firstIndex", first,
+			"lastIndex", last,
+			"verifiedCheckpoints", checkpoints,
+		)
+		m.state.Status = structs.LogStoreMigrationReady
+		m.state.FirstIndex = first
+		m.state.LastIndex = last
+		m.state.VerifiedCheckpoints = checkpoints
+	}
+
+	if err := writeLogStoreMigrationState(dir, m.state); err != nil {
+		logger.Error("failed to record log store migration state", "error", err)
+	}
+}
+
+// CancelLogStoreMigration stops a running migration and removes the staged
+// log store.
+func (s *Server) CancelLogStoreMigration() error {
+	m := &s.logStoreMigrator
+	m.lock.Lock()
+	cancel, done := m.cancel, m.done
+	m.state = nil
+	m.lock.Unlock()
+
+	// Wait for the copy to stop before removing its files.
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.done != nil {
+		return errors.New("a new log store migration was started")
+	}
+	return os.RemoveAll(filepath.Join(s.config.DataDir, raftState, logStoreMigrationDir))
+}
+
+// LogStoreMigration returns the state of the current or last log store
+// migration of the server, or nil if there is none.
+func (s *Server) LogStoreMigration() (*structs.LogStoreMigration, error) {
+	m := &s.logStoreMigrator
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.state != nil {
+		state := *m.state
+		return &state, nil
+	}
+	if s.config.DevMode {
+		return nil, nil
+	}
+
+	raftDir := filepath.Join(s.config.DataDir, raftState)
+	for _, dir := range []string{logStoreMigrationDir, logStoreBackupDir} {
+		state, err := readLogStoreMigrationState(filepath.Join(raftDir, dir))
+		if err != nil || state != nil {
+			return state, err
+		}
+	}
+	return nil, nil
+}
+
+// completeLogStoreMigration replaces the log store in raftDir with the staged
+// one before Raft is started, if a migration to the configured backend is
+// ready. Entries that were appended since the copy are copied first. The
+// previous log store is kept in the backup directory.
+func completeLogStoreMigration(logger hclog.Logger, conf RaftLogStoreConfig, raftDir string) error {
+	dir := filepath.Join(raftDir, logStoreMigrationDir)
+	state, err := readLogStoreMigrationState(dir)
+	if err != nil || state == nil {
+		return err
+	}
+	logger = logger.With("backend", state.Backend)
+
+	switch state.Status {
+	case structs.LogStoreMigrationSwapping:
+		// The server stopped while replacing the log store, finish it.
+		return swapLogStore(logger, raftDir, state)
+	case structs.LogStoreMigrationReady:
+	default:
+		logger.Warn("ignoring incomplete log store migration", "status", state.Status)
+		return nil
+	}
+
+	configured := conf.Backend
+	if configured == LogStoreBackendDefault {
+		configured = LogStoreBackendBoltDB
+	}
+	if configured != state.Backend {
+		logger.Warn("log store migration is ready but not configured, set raft_logstore.backend to complete it",
+			"configured", configured,
+		)
+		return nil
+	}
+
+	logger.Info("completing log store migration")
+	if err := catchUpLogStore(logger, conf, raftDir, state); err != nil {
+		return fmt.Errorf("failed to complete log store migration, remove %s to start with the previous log store: %w", dir, err)
+	}
+	return swapLogStore(logger, raftDir, state)
+}
+
+// catchUpLogStore copies the entries that were appended to the log store
+// since the staged log store was copied, and the stable store.
+func catchUpLogStore(logger hclog.Logger, conf RaftLogStoreConfig, raftDir string, state *structs.LogStoreMigration) error {
+	dir := filepath.Join(raftDir, logStoreMigrationDir)
+	ctx := context.Background()
+
+	src, err := openMigrationStore(conf, state.SourceBackend, raftDir)
+	if err != nil {
+		return fmt.Errorf("failed to open %s log store: %w", state.SourceBackend, err)
+	}
+	defer src.Close()
+
+	dst, err := openMigrationStore(conf, state.Backend, dir)
+	if err != nil {
+		return fmt.Errorf("failed to open staged log store: %w", err)
+	}
+	defer func() { dst.Close() }()
+
+	first, err := src.FirstIndex()
+	if err != nil {
+		return err
+	}
+	last, err := src.LastIndex()
+	if err != nil {
+		return err
+	}
+
+	from, err := stagedLogPrefix(dst, src, first, last)
+	if err != nil {
+		return err
+	}
+	if from == 0 {
+		// The staged log store can't be extended, for example because the
+		// log was truncated after it was copied. Copy everything again.
+		logger.Info("copying log store again")
+		dst.Close()
+		if err := os.RemoveAll(filepath.Join(dir, logStoreFileName(state.Backend))); err != nil {
+			return err
+		}
+		if dst, err = openMigrationStore(conf, state.Backend, dir); err != nil {
+			return err
+		}
+		from = first
+	}
+
+	checkpoints, err := copyLogs(ctx, dst, src, from, last, nil)
+	if err != nil {
+		return err
+	}
+	if err := compareLogs(ctx, dst, src, first, last); err != nil {
+		return err
+	}
+	if err := copyStable(dst, src); err != nil {
+		return err
+	}
+
+	logger.Info("copied log store",
+		"firstIndex", first,
+		"lastIndex", last,
+		"caughtUp", last-from+1,
+		"verifiedCheckpoints", state.VerifiedCheckpoints+checkpoints,
+	)
+	state.FirstIndex = first
+	state.LastIndex = last
+	state.VerifiedCheckpoints += checkpoints
+	return nil
+}
+
+// stagedLogPrefix trims the entries of the staged log store that were
+// compacted from the source since the copy, and returns the index from which
+// the source still needs to be copied. It returns zero if the staged log
+// store is not a prefix of the source.
+func stagedLogPrefix(dst, src raft.LogStore, first, last uint64) (uint64, error) {
+	dstFirst, err := dst.FirstIndex()
+	if err != nil {
+		return 0, err
+	}
+	dstLast, err := dst.LastIndex()
+	if err != nil {
+		return 0, err
+	}
+	if first == 0 || dstLast < first || dstLast > last || dstFirst > first {
+		return 0, nil
+	}
+
+	var staged, current raft.Log
+	if err := dst.GetLog(dstLast, &staged); err != nil {
+		return 0, err
+	}
+	if err := src.GetLog(dstLast, &current); err != nil {
+		return 0, err
+	}
+	if !logsEqual(&staged, &current) {
+		return 0, nil
+	}
+
+	if dstFirst < first {
+		if err := dst.DeleteRange(dstFirst, first-1); err != nil {
+			return 0, err
+		}
+	}
+	return dstLast + 1, nil
+}
+
+// swapLogStore moves the current log store into the backup directory and the
+// staged log store in its place. Each step is safe to repeat, so that a
+// server that stopped halfway through finishes the swap on the next start.
+func swapLogStore(logger hclog.Logger, raftDir string, state *structs.LogStoreMigration) error {
+	dir := filepath.Join(raftDir, logStoreMigrationDir)
+	backupDir := filepath.Join(raftDir, logStoreBackupDir)
+
+	if state.Status != structs.LogStoreMigrationSwapping {
+		if err := os.RemoveAll(backupDir); err != nil {
+			return fmt.Errorf("failed to remove previous log store backup: %w", err)
+		}
+		if err := os.MkdirAll(backupDir, 0755); err != nil {
+			return err
+		}
+		state.Status = structs.LogStoreMigrationSwapping
+		state.UpdatedAt = time.Now().UTC()
+		if err := writeLogStoreMigrationState(dir, state); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range []string{logStoreFileName(state.SourceBackend), logStoreFileName(state.Backend)} {
+		from, to := filepath.Join(raftDir, name), filepath.Join(backupDir, name)
+		if name == logStoreFileName(state.Backend) {
+			from, to = filepath.Join(dir, name), filepath.Join(raftDir, name)
+		}
+		exists, err := fileExists(from)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			continue
+		}
+		if err := os.Rename(from, to); err != nil {
+			return fmt.Errorf("failed to move %s to %s: %w", from, to, err)
+		}
+	}
+	if err := syncDir(raftDir); err != nil {
+		return err
+	}
+
+	state.Status = structs.LogStoreMigrationComplete
+	state.BackupPath = filepath.Join(backupDir, logStoreFileName(state.SourceBackend))
+	state.UpdatedAt = time.Now().UTC()
+	if err := writeLogStoreMigrationState(backupDir, state); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+
+	logger.Info("log store migration complete, the previous log store was kept as a backup",
+		"path", state.BackupPath,
+	)
+	return nil
+}
+
+// copyLogs copies the entries in [first, last] from src to dst. The copy goes
+// through the log verifier, so that the checksums recorded in the
+// verification checkpoints of the log are checked against the entries read
+// back from dst. It returns the number of verified checkpoints.
+func copyLogs(ctx context.Context, dst, src raft.LogStore, first, last uint64, progress func(copied, total uint64)) (int, error) {
+	if first == 0 || last < first {
+		return 0, nil
+	}
+
+	reports := make(chan verifier.VerificationReport, 1)
+	// The verifier closes the store it wraps if it is an io.Closer, which dst
+	// must outlive.
+	store := verifier.NewLogStore(struct{ raft.LogStore }{dst}, isMigrationCheckpoint,
+		func(r verifier.VerificationReport) { reports <- r }, &walmetrics.NoOpCollector{})
+	defer store.Close()
+
+	total := last - first + 1
+	verified := 0
+	batch := make([]*raft.Log, 0, logStoreMigrationBatchSize)
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := store.StoreLogs(batch); err != nil {
+			return fmt.Errorf("failed to store logs ending at %d: %w", batch[len(batch)-1].Index, err)
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	for index := first; index <= last; index++ {
+		if err := ctx.Err(); err != nil {
+			return verified, err
+		}
+
+		log := new(raft.Log)
+		if err := src.GetLog(index, log); err != nil {
+			return verified, fmt.Errorf("failed to read log at index %d: %w", index, err)
+		}
+		batch = append(batch, log)
+
+		isCheckpoint, _ := isMigrationCheckpoint(log)
+		if !isCheckpoint && len(batch) < logStoreMigrationBatchSize && index != last {
+			continue
+		}
+		if err := flush(); err != nil {
+			return verified, err
+		}
+		if progress != nil {
+			progress(index-first+1, total)
+		}
+		if !isCheckpoint {
+			continue
+		}
+
+		// Wait for the verification of the range that ends at the checkpoint
+		// before copying more, so that no report is skipped.
+		select {
+		case r := <-reports:
+			switch {
+			case r.Err == nil:
+				verified++
+			case errors.Is(r.Err, verifier.ErrRangeMismatch):
+				// The range of the first checkpoint starts before the copy.
+			default:
+				return verified, fmt.Errorf("failed to verify logs: %w", r.Err)
+			}
+		case <-time.After(logStoreMigrationReportTimeout):
+			return verified, fmt.Errorf("timed out verifying the checkpoint at index %d", index)
+		case <-ctx.Done():
+			return verified, ctx.Err()
+		}
+	}
+	return verified, nil
+}
+
+// isMigrationCheckpoint reports whether the entry is a verification
+// checkpoint whose checksum was recorded by the leader. Checkpoints without
+// it would have one added by the verifier, which must not change the copy.
+func isMigrationCheckpoint(log *raft.Log) (bool, error) {
+	if len(log.Extensions) < 8 || binary.LittleEndian.Uint64(log.Extensions) != verifier.ExtensionMagicPrefix {
+		return false, nil
+	}
+	return isLogVerifyCheckpoint(log)
+}
+
+// compareLogs checks that dst holds the same entries as src in [first, last].
+func compareLogs(ctx context.Context, dst, src raft.LogStore, first, last uint64) error {
+	if first == 0 || last < first {
+		return nil
+	}
+	var want, got raft.Log
+	for index := first; index <= last; index++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := src.GetLog(index, &want); err != nil {
+			return fmt.Errorf("failed to read log at index %d: %w", index, err)
+		}
+		if err := dst.GetLog(index, &got); err != nil {
+			return fmt.Errorf("failed to read copied log at index %d: %w", index, err)
+		}
+		if !logsEqual(&want, &got) {
+			return fmt.Errorf("copied log at index %d does not match", index)
+		}
+	}
+	return nil
+}
+
+// copyStable copies the keys that Raft keeps in the stable store. Keys that
+// were never set, like the vote of a server that never voted, are skipped.
+func copyStable(dst, src raft.StableStore) error {
+	for _, key := range []string{"CurrentTerm", "LastVoteTerm"} {
+		val, err := src.GetUint64([]byte(key))
+		if errors.Is(err, raftboltdb.ErrKeyNotFound) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", key, err)
+		}
+		if err := dst.SetUint64([]byte(key), val); err != nil {
+			return fmt.Errorf("failed to write %s: %w", key, err)
+		}
+	}
+
+	val, err := src.Get([]byte("LastVoteCand"))
+	if errors.Is(err, raftboltdb.ErrKeyNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read LastVoteCand: %w", err)
+	}
+	if err := dst.Set([]byte("LastVoteCand"), val); err != nil {
+		return fmt.Errorf("failed to write LastVoteCand: %w", err)
+	}
+	return nil
+}
+
+func logsEqual(a, b *raft.Log) bool {
+	return a.Index == b.Index &&
+		a.Term == b.Term &&
+		a.Type == b.Type &&
+		a.AppendedAt.Equal(b.AppendedAt) &&
+		bytes.Equal(a.Data, b.Data) &&
+		bytes.Equal(a.Extensions, b.Extensions)
+}
+
+func readLogStoreMigrationState(dir string) (*structs.LogStoreMigration, error) {
+	raw, err := os.ReadFile(filepath.Join(dir, logStoreMigrationStateFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var state structs.LogStoreMigration
+	if err := json.Unmarshal(raw, &state); err != nil {
+		return nil, fmt.Errorf("failed to parse log store migration state: %w", err)
+	}
+	return &state, nil
+}
+
+// writeLogStoreMigrationState replaces the state file in dir atomically.
+func writeLogStoreMigrationState(dir string, state *structs.LogStoreMigration) error {
+	raw, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	tmp := filepath.Join(dir, logStoreMigrationStateFile+".tmp")
+	if err := os.WriteFile(tmp, raw, 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, filepath.Join(dir, logStoreMigrationStateFile)); err != nil {
+		return err
+	}
+	return syncDir(dir)
+}
+
+// syncDir flushes renames in dir to disk.
+func syncDir(dir string) error {
+	f, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return f.Sync()
+}
@@ -0,0 +1,281 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package consul
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/raft"
+	walmetrics "github.com/hashicorp/raft-wal/metrics"
+	"github.com/hashicorp/raft-wal/verifier"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+// appendTestLogs appends entries to the store through a log verifier, as the
+// leader does, with a verification checkpoint every 10 entries.
+func appendTestLogs(t *testing.T, store raft.LogStore, from, to uint64) {
+	t.Helper()
+
+	leader := verifier.NewLogStore(struct{ raft.LogStore }{store}, isLogVerifyCheckpoint, nil, &walmetrics.NoOpCollector{})
+	defer leader.Close()
+
+	for index := from; index <= to; index++ {
+		data := []byte{byte(structs.KVSRequestType)}
+		if index%10 == 0 {
+			data = []byte{byte(structs.RaftLogVerifierCheckpoint)}
+		}
+		data = append(data, []byte(fmt.Sprintf("entry %d", index))...)
+		require.NoError(t, leader.StoreLog(&raft.Log{
+			Index: index,
+			Term:  1,
+			Type:  raft.LogCommand,
+			Data:  data,
+		}))
+	}
+}
+
+// corruptingStore changes the data of one entry when it is stored.
+type corruptingStore struct {
+	raft.LogStore
+	index uint64
+}
+
+func (s *corruptingStore) StoreLogs(logs []*raft.Log) error {
+	for i, log := range logs {
+		if log.Index == s.index {
+			corrupt := *log
+			corrupt.Data = append([]byte(nil), log.Data...)
+			corrupt.Data[len(corrupt.Data)-1] ^= 0xff
+			logs[i] = &corrupt
+		}
+	}
+	return s.LogStore.StoreLogs(logs)
+}
+
+func TestCopyLogs(t *testing.T) {
+	conf := RaftLogStoreConfig{WAL: WALConfig{SegmentSize: 64 * 1024}}
+
+	src, err := openMigrationStore(conf, LogStoreBackendBoltDB, t.TempDir())
+	require.NoError(t, err)
+	defer src.Close()
+	appendTestLogs(t, src, 1, 55)
+
+	t.Run("verifies checkpoints", func(t *testing.T) {
+		dst, err := openMigrationStore(conf, LogStoreBackendWAL, t.TempDir())
+		require.NoError(t, err)
+		defer dst.Close()
+
+		var copied uint64
+		verified, err := copyLogs(context.Background(), dst, src, 1, 55, func(n, total uint64) {
+			require.Equal(t, uint64(55), total)
+			copied = n
+		})
+		require.NoError(t, err)
+		require.Equal(t, 5, verified)
+		require.Equal(t, uint64(55), copied)
+		require.NoError(t, compareLogs(context.Background(), dst, src, 1, 55))
+	})
+
+	t.Run("detects corruption", func(t *testing.T) {
+		dst, err := openMigrationStore(conf, LogStoreBackendWAL, t.TempDir())
+		require.NoError(t, err)
+		defer dst.Close()
+
+		_, err = copyLogs(context.Background(), &corruptingStore{LogStore: dst, index: 25}, src, 1, 55, nil)
+		require.ErrorContains(t, err, "storage corruption")
+	})
+
+	t.Run("compare detects corruption after the last checkpoint", func(t *testing.T) {
+		dst, err := openMigrationStore(conf, LogStoreBackendWAL, t.TempDir())
+		require.NoError(t, err)
+		defer dst.Close()
+
+		_, err = copyLogs(context.Background(), &corruptingStore{LogStore: dst, index: 52}, src, 1, 55, nil)
+		require.NoError(t, err)
+		require.ErrorContains(t, compareLogs(context.Background(), dst, src, 1, 55), "index 52 does not match")
+	})
+}
+
+// stageTestMigration copies the BoltDB log store in raftDir into a staged WAL
+// log store, as StartLogStoreMigration does.
+func stageTestMigration(t *testing.T, conf RaftLogStoreConfig, raftDir string) {
+	t.Helper()
+
+	dir := filepath.Join(raftDir, logStoreMigrationDir)
+	require.NoError(t, os.MkdirAll(dir, 0755))
+
+	src, err := openMigrationStore(conf, LogStoreBackendBoltDB, raftDir)
+	require.NoError(t, err)
+	defer src.Close()
+	dst, err := openMigrationStore(conf, LogStoreBackendWAL, dir)
+	require.NoError(t, err)
+	defer dst.Close()
+
+	first, err := src.FirstIndex()
+	require.NoError(t, err)
+	last, err := src.LastIndex()
+	require.NoError(t, err)
+	_, err = copyLogs(context.Background(), dst, src, first, last, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, writeLogStoreMigrationState(dir, &structs.LogStoreMigration{
+		Backend:       LogStoreBackendWAL,
+		SourceBackend: LogStoreBackendBoltDB,
+		Status:        structs.LogStoreMigrationReady,
+		FirstIndex:    first,
+		LastIndex:     last,
+	}))
+}
+
+func TestCompleteLogStoreMigration(t *testing.T) {
+	conf := RaftLogStoreConfig{Backend: LogStoreBackendWAL, WAL: WALConfig{SegmentSize: 64 * 1024}}
+	logger := hclog.NewNullLogger()
+
+	setup := func(t *testing.T) string {
+		raftDir := t.TempDir()
+		src, err := openMigrationStore(conf, LogStoreBackendBoltDB, raftDir)
+		require.NoError(t, err)
+		appendTestLogs(t, src, 1, 30)
+		require.NoError(t, src.Close())
+
+		stageTestMigration(t, conf, raftDir)
+
+		// Compact and extend the log after the copy.
+		src, err = openMigrationStore(conf, LogStoreBackendBoltDB, raftDir)
+		require.NoError(t, err)
+		require.NoError(t, src.DeleteRange(1, 5))
+		appendTestLogs(t, src, 31, 42)
+		require.NoError(t, src.SetUint64([]byte("CurrentTerm"), 3))
+		require.NoError(t, src.Close())
+		return raftDir
+	}
+
+	requireMigrated := func(t *testing.T, raftDir string) {
+		t.Helper()
+
+		_, err := os.Stat(filepath.Join(raftDir, "raft.db"))
+		require.True(t, os.IsNotExist(err))
+		_, err = os.Stat(filepath.Join(raftDir, logStoreMigrationDir))
+		require.True(t, os.IsNotExist(err))
+
+		backup, err := openMigrationStore(conf, LogStoreBackendBoltDB, filepath.Join(raftDir, logStoreBackupDir))
+		require.NoError(t, err)
+		defer backup.Close()
+		store, err := openMigrationStore(conf, LogStoreBackendWAL, raftDir)
+		require.NoError(t, err)
+		defer store.Close()
+
+		first, err := store.FirstIndex()
+		require.NoError(t, err)
+		require.Equal(t, uint64(6), first)
+		last, err := store.LastIndex()
+		require.NoError(t, err)
+		require.Equal(t, uint64(42), last)
+		require.NoError(t, compareLogs(context.Background(), store, backup, 6, 42))
+
+		term, err := store.GetUint64([]byte("CurrentTerm"))
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), term)
+
+		state, err := readLogStoreMigrationState(filepath.Join(raftDir, logStoreBackupDir))
+		require.NoError(t, err)
+		require.Equal(t, structs.LogStoreMigrationComplete, state.Status)
+		require.Equal(t, uint64(6), state.FirstIndex)
+		require.Equal(t, uint64(42), state.LastIndex)
+		require.Equal(t, filepath.Join(raftDir, logStoreBackupDir, "raft.db"), state.BackupPath)
+	}
+
+	t.Run("not configured", func(t *testing.T) {
+		raftDir := setup(t)
+		conf := conf
+		conf.Backend = LogStoreBackendBoltDB
+		require.NoError(t, completeLogStoreMigration(logger, conf, raftDir))
+
+		_, err := os.Stat(filepath.Join(raftDir, "raft.db"))
+		require.NoError(t, err)
+		state, err := readLogStoreMigrationState(filepath.Join(raftDir, logStoreMigrationDir))
+		require.NoError(t, err)
+		require.Equal(t, structs.LogStoreMigrationReady, state.Status)
+	})
+
+	t.Run("catches up and swaps", func(t *testing.T) {
+		raftDir := setup(t)
+		require.NoError(t, completeLogStoreMigration(logger, conf, raftDir))
+		requireMigrated(t, raftDir)
+	})
+
+	t.Run("copies again after truncation", func(t *testing.T) {
+		raftDir := setup(t)
+
+		// Replace the tail of the log with entries of a later term.
+		src, err := openMigrationStore(conf, LogStoreBackendBoltDB, raftDir)
+		require.NoError(t, err)
+		require.NoError(t, src.DeleteRange(20, 42))
+		for index := uint64(20); index <= 42; index++ {
+			require.NoError(t, src.StoreLog(&raft.Log{Index: index, Term: 2, Type: raft.LogNoop}))
+		}
+		require.NoError(t, src.Close())
+
+		require.NoError(t, completeLogStoreMigration(logger, conf, raftDir))
+		requireMigrated(t, raftDir)
+	})
+
+	t.Run("resumes swap", func(t *testing.T) {
+		raftDir := setup(t)
+		require.NoError(t, completeLogStoreMigration(logger, conf, raftDir))
+
+		// Undo the last step of the swap, as if the server stopped before it.
+		dir := filepath.Join(raftDir, logStoreMigrationDir)
+		require.NoError(t, os.MkdirAll(dir, 0755))
+		require.NoError(t, os.Rename(filepath.Join(raftDir, "wal"), filepath.Join(dir, "wal")))
+		state, err := readLogStoreMigrationState(filepath.Join(raftDir, logStoreBackupDir))
+		require.NoError(t, err)
+		state.Status = structs.LogStoreMigrationSwapping
+		require.NoError(t, writeLogStoreMigrationState(dir, state))
+
+		require.NoError(t, completeLogStoreMigration(logger, conf, raftDir))
+		requireMigrated(t, raftDir)
+	})
+}
+
+func TestServer_LogStoreMigration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	_, s := testServer(t)
+	testrpc.WaitForLeader(t, s.RPC, "dc1")
+
+	require.Error(t, s.StartLogStoreMigration(LogStoreBackendBoltDB))
+	require.NoError(t, s.StartLogStoreMigration(LogStoreBackendWAL))
+
+	retry.Run(t, func(r *retry.R) {
+		state, err := s.LogStoreMigration()
+		require.NoError(r, err)
+		require.NotNil(r, state)
+		require.Equal(r, structs.LogStoreMigrationReady, state.Status, state.Error)
+		require.NotZero(r, state.LastIndex)
+		require.Equal(r, state.TotalEntries, state.CopiedEntries)
+	})
+
+	state, err := readLogStoreMigrationState(filepath.Join(s.config.DataDir, raftState, logStoreMigrationDir))
+	require.NoError(t, err)
+	require.Equal(t, structs.LogStoreMigrationReady, state.Status)
+	require.WithinDuration(t, time.Now(), state.UpdatedAt, time.Minute)
+
+	require.NoError(t, s.CancelLogStoreMigration())
+	state, err = s.LogStoreMigration()
+	require.NoError(t, err)
+	require.Nil(t, state)
+}
@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package consul
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-memdb"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/agent/consul/state"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// CertInventory lists the certificates used in the datacenter along with
+// their expiry: the roots and intermediates of the Connect CA, the latest leaf
+// certificate of each service, the inline-certificate and
+// file-system-certificate config entries, and the TLS certificates of the
+// server answering the request.
+func (op *Operator) CertInventory(args *structs.DCSpecificRequest, reply *structs.CertInventory) error {
+	if done, err := op.srv.ForwardRPC("Operator.CertInventory", args, reply); done {
+		return err
+	}
+
+	// This action requires operator read access.
+	authz, err := op.srv.ResolveToken(args.Token)
+	if err != nil {
+		return err
+	}
+	if err := authz.ToAllowAuthorizer().OperatorReadAllowed(nil); err != nil {
+		return err
+	}
+
+	return op.srv.blockingQuery(
+		&args.QueryOptions, &reply.QueryMeta,
+		func(ws memdb.WatchSet, state *state.Store) error {
+			certs, err := op.srv.certInventory(ws, state)
+			if err != nil {
+				return err
+			}
+
+			reply.Datacenter = op.srv.config.Datacenter
+			reply.Certs = certs
+			return nil
+		},
+	)
+}
+
+func (s *Server) certInventory(ws memdb.WatchSet, store *state.Store) ([]*structs.CertInventoryEntry, error) {
+	var certs []*structs.CertInventoryEntry
+
+	// Roots and intermediates of the Connect CA. Secondary datacenters list
+	// the roots of the primary datacenter along with their own intermediate.
+	_, roots, err := store.CARoots(ws)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]struct{})
+	addCA := func(kind, name, pem string) {
+		cert, err := connect.ParseCert(pem)
+		if err != nil {
+			certs = append(certs, &structs.CertInventoryEntry{Kind: kind, Name: name, Error: err.Error()})
+			return
+		}
+		key := string(cert.Raw)
+		if _, ok := seen[key]; ok {
+			return
+		}
+		seen[key] = struct{}{}
+		certs = append(certs, structs.NewCertInventoryEntry(kind, name, cert))
+	}
+	for _, root := range roots {
+		addCA(structs.CertInventoryKindCARoot, root.Name, root.RootCert)
+		for _, intermediate := range root.IntermediateCerts {
+			addCA(structs.CertInventoryKindCAIntermediate, root.Name, intermediate)
+		}
+	}
+
+	// The latest leaf certificate of each service identity. Previous
+	// certificates have been replaced, so their expiry doesn't matter.
+	_, issued, err := store.CALeafCerts(ws)
+	if err != nil {
+		return nil, err
+	}
+	latest := make(map[leafIdentity]*structs.IssuedCert)
+	for _, cert := range issued {
+		id, ok := issuedCertIdentity(cert)
+		if !ok {
+			continue
+		}
+		if prev, ok := latest[id]; !ok || cert.CreateIndex > prev.CreateIndex {
+			latest[id] = cert
+		}
+	}
+	for _, cert := range latest {
+		name := cert.Service
+		if name == "" {
+			name = string(cert.Kind)
+		}
+		certs = append(certs, &structs.CertInventoryEntry{
+			Kind:         structs.CertInventoryKindLeaf,
+			Name:         name,
+			Partition:    cert.EnterpriseMeta.PartitionOrEmpty(),
+			Namespace:    cert.EnterpriseMeta.NamespaceOrEmpty(),
+			SerialNumber: cert.SerialNumber,
+			NotBefore:    cert.ValidAfter,
+			NotAfter:     cert.ValidBefore,
+		})
+	}
+
+	// Gateway certificates. The files of file-system-certificate config
+	// entries are read by the gateways, so only their paths are known here.
+	wildcard := acl.WildcardEnterpriseMeta()
+	_, entries, err := store.ConfigEntriesByKind(ws, structs.InlineCertificate, wildcard)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		inline, ok := entry.(*structs.InlineCertificateConfigEntry)
+		if !ok {
+			continue
+		}
+		item := &structs.CertInventoryEntry{
+			Kind:      structs.InlineCertificate,
+			Name:      inline.Name,
+			Partition: inline.EnterpriseMeta.PartitionOrEmpty(),
+			Namespace: inline.EnterpriseMeta.NamespaceOrEmpty(),
+		}
+		if cert, err := connect.ParseCert(inline.Certificate); err != nil {
+			item.Error = err.Error()
+		} else {
+			item.SetCert(cert)
+		}
+		certs = append(certs, item)
+	}
+	_, entries, err = store.ConfigEntriesByKind(ws, structs.ACMECertificate, wildcard)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		acmeCert, ok := entry.(*structs.ACMECertificateConfigEntry)
+		if !ok {
+			continue
+		}
+		item := &structs.CertInventoryEntry{
+			Kind:      structs.ACMECertificate,
+			Name:      acmeCert.Name,
+			Partition: acmeCert.EnterpriseMeta.PartitionOrEmpty(),
+			Namespace: acmeCert.EnterpriseMeta.NamespaceOrEmpty(),
+		}
+		if cert, err := acmeCert.IssuedCertificate(); err != nil {
+			item.Error = err.Error()
+		} else if cert == nil {
+			item.Error = "certificate has not been issued yet"
+		} else {
+			item.SetCert(cert)
+		}
+		certs = append(certs, item)
+	}
+	_, entries, err = store.ConfigEntriesByKind(ws, structs.FileSystemCertificate, wildcard)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		fsCert, ok := entry.(*structs.FileSystemCertificateConfigEntry)
+		if !ok {
+			continue
+		}
+		certs = append(certs, &structs.CertInventoryEntry{
+			Kind:      structs.FileSystemCertificate,
+			Name:      fsCert.Name,
+			Partition: fsCert.EnterpriseMeta.PartitionOrEmpty(),
+			Namespace: fsCert.EnterpriseMeta.NamespaceOrEmpty(),
+			Path:      fsCert.Certificate,
+		})
+	}
+
+	// The TLS certificates of this server.
+	if s.tlsConfigurator != nil {
+		tlsCerts, err := s.tlsConfigurator.Certificates()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the TLS certificates: %w", err)
+		}
+		for _, tlsCert := range tlsCerts {
+			item := structs.NewCertInventoryEntry(structs.CertInventoryKindAgentTLS, s.config.NodeName, tlsCert.Cert)
+			item.Source = tlsCert.Source
+			certs = append(certs, item)
+		}
+	}
+
+	return certs, nil
+}
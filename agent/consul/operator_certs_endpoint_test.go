@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package consul
+
+import (
+	"os"
+	"testing"
+
+	msgpackrpc "github.com/hashicorp/consul-net-rpc/net-rpc-msgpackrpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestOperator_CertInventory(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	dir1, s1 := testServerWithConfig(t, func(c *Config) {
+		c.TLSConfig.InternalRPC.CAFile = "../../test/ca/root.cer"
+		c.TLSConfig.InternalRPC.CertFile = "../../test/key/ourdomain.cer"
+		c.TLSConfig.InternalRPC.KeyFile = "../../test/key/ourdomain.key"
+	})
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	// Only the latest leaf certificate of a service is listed.
+	testConnectCASignService(t, codec, "web")
+	latest := testConnectCASignService(t, codec, "web")
+
+	gatewayCA := connect.TestCA(t, nil)
+	store := s1.fsm.State()
+	require.NoError(t, store.EnsureConfigEntry(100, &structs.InlineCertificateConfigEntry{
+		Kind:        structs.InlineCertificate,
+		Name:        "inline",
+		Certificate: gatewayCA.RootCert,
+	}))
+	require.NoError(t, store.EnsureConfigEntry(101, &structs.InlineCertificateConfigEntry{
+		Kind:        structs.InlineCertificate,
+		Name:        "broken",
+		Certificate: "not a certificate",
+	}))
+	require.NoError(t, store.EnsureConfigEntry(102, &structs.FileSystemCertificateConfigEntry{
+		Kind:        structs.FileSystemCertificate,
+		Name:        "file",
+		Certificate: "/etc/gateway/cert.pem",
+		PrivateKey:  "/etc/gateway/key.pem",
+	}))
+	require.NoError(t, store.EnsureConfigEntry(103, &structs.ACMECertificateConfigEntry{
+		Kind:      structs.ACMECertificate,
+		Name:      "acme",
+		Directory: "https://acme.invalid/directory",
+		Domains:   []string{"example.com"},
+	}))
+
+	args := structs.DCSpecificRequest{Datacenter: "dc1"}
+	var reply structs.CertInventory
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Operator.CertInventory", &args, &reply))
+	require.Equal(t, "dc1", reply.Datacenter)
+
+	byKind := make(map[string][]*structs.CertInventoryEntry)
+	for _, cert := range reply.Certs {
+		byKind[cert.Kind] = append(byKind[cert.Kind], cert)
+	}
+
+	require.Len(t, byKind[structs.CertInventoryKindCARoot], 1)
+	require.False(t, byKind[structs.CertInventoryKindCARoot][0].NotAfter.IsZero())
+
+	require.Len(t, byKind[structs.CertInventoryKindLeaf], 1)
+	leaf := byKind[structs.CertInventoryKindLeaf][0]
+	require.Equal(t, "web", leaf.Name)
+	require.Equal(t, latest.SerialNumber, leaf.SerialNumber)
+	require.Equal(t, latest.ValidBefore, leaf.NotAfter)
+
+	inline := byKind[structs.InlineCertificate]
+	require.Len(t, inline, 2)
+	for _, entry := range inline {
+		switch entry.Name {
+		case "inline":
+			require.Empty(t, entry.Error)
+			require.Equal(t, gatewayCA.SerialNumber, mustDecodeSerial(t, entry.SerialNumber))
+			require.Equal(t, gatewayCA.NotAfter.Unix(), entry.NotAfter.Unix())
+		case "broken":
+			require.NotEmpty(t, entry.Error)
+			require.True(t, entry.NotAfter.IsZero())
+		}
+	}
+
+	require.Len(t, byKind[structs.FileSystemCertificate], 1)
+	fsCert := byKind[structs.FileSystemCertificate][0]
+	require.Equal(t, "/etc/gateway/cert.pem", fsCert.Path)
+	require.True(t, fsCert.NotAfter.IsZero())
+
+	require.Len(t, byKind[structs.ACMECertificate], 1)
+	acmeCert := byKind[structs.ACMECertificate][0]
+	require.Equal(t, "certificate has not been issued yet", acmeCert.Error)
+	require.True(t, acmeCert.NotAfter.IsZero())
+
+	require.Len(t, byKind[structs.CertInventoryKindAgentTLS], 1)
+	agentTLS := byKind[structs.CertInventoryKindAgentTLS][0]
+	require.Equal(t, s1.config.NodeName, agentTLS.Name)
+	require.Equal(t, "internal_rpc", agentTLS.Source)
+	require.Equal(t, int64(4852545616), agentTLS.NotAfter.Unix())
+}
+
+func mustDecodeSerial(t *testing.T, serial string) uint64 {
+	t.Helper()
+	n, err := connect.DecodeSerialNumber(serial)
+	require.NoError(t, err)
+	return n.Uint64()
+}
+
+func TestOperator_CertInventory_ACLDeny(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	dir1, s1 := testServerWithConfig(t, func(c *Config) {
+		c.PrimaryDatacenter = "dc1"
+		c.ACLsEnabled = true
+		c.ACLInitialManagementToken = TestDefaultInitialManagementToken
+		c.ACLResolverSettings.ACLDefaultPolicy = "deny"
+	})
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1", testrpc.WithToken(TestDefaultInitialManagementToken))
+
+	// Without a token the request is denied.
+	args := structs.DCSpecificRequest{Datacenter: "dc1"}
+	var reply structs.CertInventory
+	err := msgpackrpc.CallWithCodec(codec, "Operator.CertInventory", &args, &reply)
+	require.True(t, acl.IsErrPermissionDenied(err), "unexpected error: %v", err)
+
+	token, err := upsertTestTokenWithPolicyRules(codec, TestDefaultInitialManagementToken, "dc1", `operator = "read"`)
+	require.NoError(t, err)
+	args.Token = token.SecretID
+	require.NoError(t, msgpackrpc.CallWithCodec(codec, "Operator.CertInventory", &args, &reply))
+	require.NotEmpty(t, reply.Certs)
+}
@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package consul
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-memdb"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// readIndexBatcher lets concurrent consistent reads share a single round of
+// leadership confirmation. A read joins the next round that starts after it
+// arrived, so the round confirms leadership at a later point in time than the
+// read and the state it returns is at least as recent as the read.
+//
+// The zero value is ready to use.
+type readIndexBatcher struct {
+	lock    sync.Mutex
+	running bool
+	next    *readIndexRound
+}
+
+// readIndexRound is a round of leadership confirmation shared by the reads
+// that joined it.
+type readIndexRound struct {
+	done  chan struct{}
+	reads int
+	index uint64
+	err   error
+}
+
+// wait joins the next round and returns its read index once confirm ran for
+// it. confirm is called from a single goroutine, one round at a time.
+func (b *readIndexBatcher) wait(ctx context.Context, confirm func() (uint64, error)) (uint64, error) {
+	b.lock.Lock()
+	round := b.next
+	if round == nil {
+		round = &readIndexRound{done: make(chan struct{})}
+		b.next = round
+	}
+	round.reads++
+	if !b.running {
+		b.running = true
+		go b.run(confirm)
+	}
+	b.lock.Unlock()
+
+	select {
+	case <-round.done:
+		return round.index, round.err
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
+
+// run confirms the pending rounds until no read is waiting.
+func (b *readIndexBatcher) run(confirm func() (uint64, error)) {
+	for {
+		b.lock.Lock()
+		round := b.next
+		b.next = nil
+		if round == nil {
+			b.running = false
+			b.lock.Unlock()
+			return
+		}
+		b.lock.Unlock()
+
+		metrics.AddSample([]string{"rpc", "consistentRead", "batch"}, float32(round.reads))
+		round.index, round.err = confirm()
+		close(round.done)
+	}
+}
+
+// confirmLeadership returns the latest index of the state store once the
+// leadership of the server has been verified with a round of heartbeats. The
+// index is read before the heartbeats are sent, which makes it a lower bound
+// of the state a consistent read has to see.
+func (s *Server) confirmLeadership() (uint64, error) {
+	index, err := s.fsm.State().LatestIndex(nil)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.raft.VerifyLeader().Error(); err != nil {
+		return 0, err
+	}
+	return index, nil
+}
+
+// readIndex verifies the leadership of the server and returns the index of
+// the state a consistent read has to see. Concurrent calls share a single
+// verification.
+func (s *Server) readIndex(ctx context.Context) (uint64, error) {
+	defer metrics.MeasureSince([]string{"rpc", "consistentRead"}, time.Now())
+	index, err := s.leaderReads.wait(ctx, s.confirmLeadership)
+	if err != nil {
+		return 0, err // fail fast if leader verification fails
+	}
+
+	if s.isReadyForConsistentReads() {
+		return index, nil
+	}
+
+	// Poll until the context reaches its deadline, or for RPCHoldTimeout if the
+	// context has no deadline.
+	pollFor := s.config.RPCHoldTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		pollFor = time.Until(deadline)
+	}
+
+	interval := pollFor / structs.JitterFraction
+	if interval <= 0 {
+		return 0, structs.ErrNotReadyForConsistentReads
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if s.isReadyForConsistentReads() {
+				// The entries of previous terms were applied since the
+				// leadership was verified.
+				return s.fsm.State().LatestIndex(nil)
+			}
+		case <-ctx.Done():
+			return 0, structs.ErrNotReadyForConsistentReads
+		case <-s.shutdownCh:
+			return 0, fmt.Errorf("shutdown waiting for leader")
+		}
+	}
+}
+
+// fetchReadIndex asks the leader for its read index on behalf of the
+// follower-consistent reads of a follower.
+func (s *Server) fetchReadIndex() (uint64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.config.RPCHoldTimeout)
+	defer cancel()
+
+	isLeader, leader, err := s.getLeader()
+	if err != nil {
+		return 0, err
+	}
+	if isLeader {
+		return s.readIndex(ctx)
+	}
+
+	var reply structs.ReadIndexResponse
+	err = s.connPool.RPC(s.config.Datacenter, leader.ShortName, leader.Addr, "Status.ReadIndex", EmptyReadRequest{}, &reply)
+	if err != nil {
+		return 0, err
+	}
+	return reply.Index, nil
+}
+
+// FollowerConsistentRead is used to ensure a follower does not perform a stale
+// read. The follower waits until its state store has caught up with the read
+// index of the leader, which concurrent reads share. On the leader it is the
+// same as ConsistentRead.
+func (s *Server) FollowerConsistentRead() error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.config.RPCHoldTimeout)
+	defer cancel()
+
+	if s.IsLeader() {
+		return s.consistentReadWithContext(ctx)
+	}
+
+	defer metrics.MeasureSince([]string{"rpc", "followerConsistentRead"}, time.Now())
+	index, err := s.followerReads.wait(ctx, s.fetchReadIndex)
+	if err != nil {
+		return err
+	}
+	return s.waitForStateIndex(ctx, index)
+}
+
+// waitForStateIndex blocks until the state store reaches the given index.
+func (s *Server) waitForStateIndex(ctx context.Context, index uint64) error {
+	for {
+		store := s.fsm.State()
+		ws := memdb.NewWatchSet()
+		ws.Add(store.AbandonCh())
+
+		latest, err := store.LatestIndex(ws)
+		if err != nil {
+			return err
+		}
+		if latest >= index {
+			return nil
+		}
+
+		if err := ws.WatchCtx(ctx); err != nil {
+			return fmt.Errorf("timed out waiting for the state store to reach index %d, currently at %d", index, latest)
+		}
+	}
+}
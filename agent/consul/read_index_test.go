@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package consul
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	msgpackrpc "github.com/hashicorp/consul-net-rpc/net-rpc-msgpackrpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestReadIndexBatcher_SharesRounds(t *testing.T) {
+	t.Parallel()
+
+	var (
+		b       readIndexBatcher
+		lock    sync.Mutex
+		rounds  uint64
+		started = make(chan struct{}, 10)
+		release = make(chan struct{})
+	)
+	confirm := func() (uint64, error) {
+		started <- struct{}{}
+		<-release
+		lock.Lock()
+		defer lock.Unlock()
+		rounds++
+		return rounds, nil
+	}
+
+	type result struct {
+		index uint64
+		err   error
+	}
+	wait := func() chan result {
+		ch := make(chan result, 1)
+		go func() {
+			index, err := b.wait(context.Background(), confirm)
+			ch <- result{index, err}
+		}()
+		return ch
+	}
+
+	// The first read starts a round right away.
+	first := wait()
+	<-started
+
+	// Reads that arrive while the round is running must not join it, they
+	// share the next round instead.
+	var later []chan result
+	for i := 0; i < 5; i++ {
+		later = append(later, wait())
+	}
+	retry.Run(t, func(r *retry.R) {
+		b.lock.Lock()
+		defer b.lock.Unlock()
+		require.NotNil(r, b.next)
+		require.Equal(r, 5, b.next.reads)
+	})
+
+	release <- struct{}{}
+	res := <-first
+	require.NoError(t, res.err)
+	require.Equal(t, uint64(1), res.index)
+
+	<-started
+	release <- struct{}{}
+	for _, ch := range later {
+		res := <-ch
+		require.NoError(t, res.err)
+		require.Equal(t, uint64(2), res.index)
+	}
+
+	// The batcher stops once no read is waiting.
+	retry.Run(t, func(r *retry.R) {
+		b.lock.Lock()
+		defer b.lock.Unlock()
+		require.False(r, b.running)
+	})
+}
+
+func TestReadIndexBatcher_Error(t *testing.T) {
+	t.Parallel()
+
+	var b readIndexBatcher
+	errLost := errors.New("leadership lost")
+	_, err := b.wait(context.Background(), func() (uint64, error) {
+		return 0, errLost
+	})
+	require.ErrorIs(t, err, errLost)
+
+	// A canceled read returns without waiting for its round.
+	release := make(chan struct{})
+	defer close(release)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = b.wait(ctx, func() (uint64, error) {
+		<-release
+		return 1, nil
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestServer_FollowerConsistentRead(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+
+	dir2, s2 := testServerDCBootstrap(t, "dc1", false)
+	defer os.RemoveAll(dir2)
+	defer s2.Shutdown()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+	joinLAN(t, s2, s1)
+	retry.Run(t, func(r *retry.R) {
+		r.Check(wantPeers(s1, 2))
+		r.Check(wantPeers(s2, 2))
+	})
+
+	leader, follower := s1, s2
+	if !leader.IsLeader() {
+		leader, follower = s2, s1
+	}
+	leaderCodec := rpcClient(t, leader)
+	followerCodec := rpcClient(t, follower)
+
+	t.Run("read index is only served by the leader", func(t *testing.T) {
+		var reply structs.ReadIndexResponse
+		err := msgpackrpc.CallWithCodec(followerCodec, "Status.ReadIndex", EmptyReadRequest{}, &reply)
+		require.ErrorContains(t, err, structs.ErrNoLeader.Error())
+
+		require.NoError(t, msgpackrpc.CallWithCodec(leaderCodec, "Status.ReadIndex", EmptyReadRequest{}, &reply))
+		latest, err := leader.fsm.State().LatestIndex(nil)
+		require.NoError(t, err)
+		require.Equal(t, latest, reply.Index)
+	})
+
+	t.Run("follower sees the writes acknowledged by the leader", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			arg := structs.KVSRequest{
+				Datacenter: "dc1",
+				Op:         api.KVSet,
+				DirEnt: structs.DirEntry{
+					Key:   "test",
+					Value: []byte{byte(i)},
+				},
+			}
+			var out bool
+			require.NoError(t, msgpackrpc.CallWithCodec(leaderCodec, "KVS.Apply", &arg, &out))
+
+			getR := structs.KeyRequest{
+				Datacenter:   "dc1",
+				Key:          "test",
+				QueryOptions: structs.QueryOptions{FollowerConsistent: true},
+			}
+			var dirent structs.IndexedDirEntries
+			require.NoError(t, msgpackrpc.CallWithCodec(followerCodec, "KVS.Get", &getR, &dirent))
+			require.Len(t, dirent.Entries, 1)
+			require.Equal(t, []byte{byte(i)}, dirent.Entries[0].Value)
+			require.True(t, dirent.KnownLeader)
+		}
+	})
+
+	t.Run("endpoints without a blocking query catch up with the leader", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			arg := structs.AutopilotSetConfigRequest{
+				Datacenter: "dc1",
+				Config: structs.AutopilotConfig{
+					CleanupDeadServers: true,
+					MaxTrailingLogs:    uint64(100 + i),
+				},
+			}
+			var out bool
+			require.NoError(t, msgpackrpc.CallWithCodec(leaderCodec, "Operator.AutopilotSetConfiguration", &arg, &out))
+
+			getR := structs.DCSpecificRequest{
+				Datacenter:   "dc1",
+				QueryOptions: structs.QueryOptions{FollowerConsistent: true},
+			}
+			var reply structs.AutopilotConfig
+			require.NoError(t, msgpackrpc.CallWithCodec(followerCodec, "Operator.AutopilotGetConfiguration", &getR, &reply))
+			require.Equal(t, uint64(100+i), reply.MaxTrailingLogs)
+		}
+	})
+}
@@ -0,0 +1,439 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package rollouts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-bexpr"
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/hashicorp/consul/agent/consul/controller"
+	"github.com/hashicorp/consul/agent/consul/fsm"
+	"github.com/hashicorp/consul/agent/consul/state"
+	"github.com/hashicorp/consul/agent/consul/stream"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+)
+
+// evaluationInterval is how often an in-progress rollout is re-evaluated.
+// Catalog health changes do not trigger reconciliation, so rollouts are
+// requeued on this interval until they complete or are rolled back.
+const evaluationInterval = 10 * time.Second
+
+// errConcurrentModification is returned when a config entry written by the
+// controller was modified since it was read. The rollout is evaluated again
+// against the current entries.
+var errConcurrentModification = errors.New("config entry was modified concurrently")
+
+// canaryRolloutReconciler drives canary-rollout config entries by writing the
+// service-splitter of the rolled out service.
+type canaryRolloutReconciler struct {
+	fsm         *fsm.FSM
+	logger      hclog.Logger
+	updater     *controller.Updater
+	errorRatios ErrorRatioSource
+	datacenter  string
+	interval    time.Duration
+	now         func() time.Time
+}
+
+// NewCanaryRolloutController initializes a controller that reconciles all
+// canary-rollout config entries. The error ratio source may be nil, in which
+// case rollouts with an error ratio gate do not progress.
+func NewCanaryRolloutController(fsm *fsm.FSM, publisher state.EventPublisher, updater *controller.Updater, errorRatios ErrorRatioSource, datacenter string, logger hclog.Logger) controller.Controller {
+	reconciler := &canaryRolloutReconciler{
+		fsm:         fsm,
+		logger:      logger,
+		updater:     updater,
+		errorRatios: errorRatios,
+		datacenter:  datacenter,
+		interval:    evaluationInterval,
+		now:         time.Now,
+	}
+	return controller.New(publisher, reconciler).
+		WithLogger(logger.With("controller", "canaryRolloutController")).
+		Subscribe(&stream.SubscribeRequest{
+			Topic:   state.EventTopicCanaryRollout,
+			Subject: stream.SubjectWildcard,
+		})
+}
+
+// Reconcile evaluates a single rollout. If the rollout is still in progress
+// a RequeueAfterError is returned so that it is evaluated again once its
+// current step's pause or the health gate's grace period may have elapsed.
+func (r *canaryRolloutReconciler) Reconcile(ctx context.Context, req controller.Request) error {
+	if req.Kind != structs.CanaryRollout {
+		return nil
+	}
+
+	logger := r.logger.With("canary-rollout", req.Name, "namespace", req.Meta.NamespaceOrDefault(), "partition", req.Meta.PartitionOrDefault())
+
+	store := r.fsm.State()
+	_, entry, err := store.ConfigEntry(nil, req.Kind, req.Name, req.Meta)
+	if err != nil {
+		logger.Warn("error fetching config entry for reconciliation request", "error", err)
+		return err
+	}
+	if entry == nil {
+		// The rollout was deleted, the service-splitter is left with the
+		// weights of the last applied step.
+		return nil
+	}
+
+	rollout := entry.(*structs.CanaryRolloutConfigEntry).DeepCopy()
+	updater := structs.NewStatusUpdater(rollout)
+
+	requeue, done, err := r.reconcileRollout(ctx, logger, store, rollout, updater)
+	if err != nil {
+		logger.Warn("error reconciling canary rollout", "error", err)
+		return err
+	}
+
+	if modified, shouldUpdate := updater.UpdateEntry(); shouldUpdate {
+		logger.Trace("persisting canary rollout status")
+		applied, err := r.updater.UpdateWithStatus(modified)
+		if err != nil {
+			logger.Warn("error persisting canary rollout status", "error", err)
+			return err
+		}
+		if !applied {
+			logger.Debug("canary rollout was modified while it was reconciled, retrying")
+			return errConcurrentModification
+		}
+	}
+
+	if done {
+		return nil
+	}
+	return controller.RequeueAfter(requeue)
+}
+
+// reconcileRollout evaluates the health gate of the rollout and advances or
+// rolls back its service-splitter, recording the outcome as conditions on the
+// updater. It returns how long to wait before evaluating the rollout again,
+// or true once the rollout has finished.
+func (r *canaryRolloutReconciler) reconcileRollout(ctx context.Context, logger hclog.Logger, store *state.Store, rollout *structs.CanaryRolloutConfigEntry, updater *structs.StatusUpdater) (time.Duration, bool, error) {
+	now := r.now().UTC()
+
+	if progressing := findCondition(rollout.Status, api.CanaryRolloutConditionProgressing); progressing != nil &&
+		progressing.Status == string(api.ConditionStatusFalse) {
+		// Completed and rolled back rollouts are final, they must be
+		// recreated to start over.
+		return 0, true, nil
+	}
+
+	_, resolverEntry, err := store.ConfigEntry(nil, structs.ServiceResolver, rollout.Name, &rollout.EnterpriseMeta)
+	if err != nil {
+		return 0, false, err
+	}
+	resolver, _ := resolverEntry.(*structs.ServiceResolverConfigEntry)
+
+	canarySubset, ok := lookupSubset(resolver, rollout.CanarySubset)
+	if !ok {
+		updater.SetCondition(r.condition(rollout, api.CanaryRolloutConditionAccepted, api.ConditionStatusFalse, api.CanaryRolloutReasonSubsetNotFound,
+			fmt.Sprintf("subset %q is not defined by the service-resolver for %q", rollout.CanarySubset, rollout.Name), now))
+		return r.interval, false, nil
+	}
+	if rollout.StableSubset != "" {
+		if _, ok := lookupSubset(resolver, rollout.StableSubset); !ok {
+			updater.SetCondition(r.condition(rollout, api.CanaryRolloutConditionAccepted, api.ConditionStatusFalse, api.CanaryRolloutReasonSubsetNotFound,
+				fmt.Sprintf("subset %q is not defined by the service-resolver for %q", rollout.StableSubset, rollout.Name), now))
+			return r.interval, false, nil
+		}
+	}
+	updater.SetCondition(r.condition(rollout, api.CanaryRolloutConditionAccepted, api.ConditionStatusTrue, api.CanaryRolloutReasonAccepted, "canary rollout accepted", now))
+
+	_, splitterEntry, err := store.ConfigEntry(nil, structs.ServiceSplitter, rollout.Name, &rollout.EnterpriseMeta)
+	if err != nil {
+		return 0, false, err
+	}
+	splitter, _ := splitterEntry.(*structs.ServiceSplitterConfigEntry)
+	current := currentStep(rollout, splitter)
+
+	_, nodes, err := store.CheckServiceNodes(nil, rollout.Name, &rollout.EnterpriseMeta, structs.DefaultPeerKeyword)
+	if err != nil {
+		return 0, false, err
+	}
+	nodes, err = filterSubsetNodes(canarySubset, nodes)
+	if err != nil {
+		return 0, false, err
+	}
+	total := len(nodes)
+	passing := len(nodes.ShallowClone().Filter(true))
+
+	gate := rollout.HealthGate
+	healthy := passing >= gate.RequiredPassingInstances() &&
+		float32(passing) >= gate.RequiredPassingRatio()*float32(total)
+	message := fmt.Sprintf("%d of %d instances in subset %q are passing", passing, total, rollout.CanarySubset)
+
+	if !healthy && current < 0 {
+		// Nothing has been shifted yet, so there is nothing to roll back.
+		updater.SetCondition(r.condition(rollout, api.CanaryRolloutConditionHealthy, api.ConditionStatusUnknown, api.CanaryRolloutReasonWaitingForInstances,
+			message+", waiting for the health gate to pass", now))
+		return r.interval, false, nil
+	}
+
+	if healthy && gate.MaxErrorRatio > 0 {
+		ratio, observed, err := r.errorRatio(ctx, rollout)
+		if err != nil {
+			// Without an error ratio the gate can neither pass nor fail, so
+			// the rollout is held at its current step.
+			logger.Warn("error querying canary error ratio", "error", err)
+			updater.SetCondition(r.condition(rollout, api.CanaryRolloutConditionHealthy, api.ConditionStatusUnknown, api.CanaryRolloutReasonErrorRatioUnavailable,
+				fmt.Sprintf("error ratio of subset %q is unavailable: %v", rollout.CanarySubset, err), now))
+			return r.interval, false, nil
+		}
+		if observed {
+			message += fmt.Sprintf(" and %.2f%% of requests failed", ratio*100)
+			healthy = ratio <= float64(gate.MaxErrorRatio)
+		} else {
+			message += " and no requests were observed"
+		}
+	}
+
+	if !healthy {
+		failing := r.condition(rollout, api.CanaryRolloutConditionHealthy, api.ConditionStatusFalse, api.CanaryRolloutReasonHealthGateFailed, message, now)
+		updater.SetCondition(failing)
+
+		if current < 0 {
+			// Nothing has been shifted yet, so there is nothing to roll back.
+			return r.interval, false, nil
+		}
+
+		failingFor := now.Sub(*failing.LastTransitionTime)
+		if failingFor < gate.GracePeriod {
+			return minDuration(r.interval, gate.GracePeriod-failingFor), false, nil
+		}
+
+		logger.Info("canary health gate failed, rolling back", "passing", passing, "total", total)
+		if err := r.applyWeight(rollout, splitter, 0); err != nil {
+			if errors.Is(err, errConcurrentModification) {
+				return 0, false, err
+			}
+			updater.SetCondition(r.condition(rollout, api.CanaryRolloutConditionAccepted, api.ConditionStatusFalse, api.CanaryRolloutReasonSplitterUpdateFailed, err.Error(), now))
+			return r.interval, false, nil
+		}
+		updater.SetCondition(r.condition(rollout, api.CanaryRolloutConditionProgressing, api.ConditionStatusFalse, api.CanaryRolloutReasonRolledBack,
+			fmt.Sprintf("health gate failed at step %d of %d, all traffic returned to the stable subset", current+1, len(rollout.Steps)), now))
+		return 0, true, nil
+	}
+
+	updater.SetCondition(r.condition(rollout, api.CanaryRolloutConditionHealthy, api.ConditionStatusTrue, api.CanaryRolloutReasonHealthGatePassed, message, now))
+
+	// The splitter is the source of truth for the current step. If it does
+	// not match a step exactly, for example because it was edited while the
+	// rollout was in progress, the current step is applied again.
+	next := current
+	if current >= 0 && splitMatches(rollout, splitter, rollout.Steps[current].Weight) {
+		started := now
+		if progressing := findCondition(rollout.Status, api.CanaryRolloutConditionProgressing); progressing != nil &&
+			progressing.Message == stepMessage(rollout, current) && progressing.LastTransitionTime != nil {
+			started = *progressing.LastTransitionTime
+		}
+
+		paused := now.Sub(started)
+		if pause := rollout.Steps[current].Pause; paused < pause {
+			updater.SetCondition(r.condition(rollout, api.CanaryRolloutConditionProgressing, api.ConditionStatusTrue, api.CanaryRolloutReasonStepApplied, stepMessage(rollout, current), started))
+			return minDuration(r.interval, pause-paused), false, nil
+		}
+
+		if current == len(rollout.Steps)-1 {
+			logger.Info("canary rollout completed")
+			updater.SetCondition(r.condition(rollout, api.CanaryRolloutConditionProgressing, api.ConditionStatusFalse, api.CanaryRolloutReasonCompleted,
+				fmt.Sprintf("all %d steps completed", len(rollout.Steps)), now))
+			return 0, true, nil
+		}
+		next = current + 1
+	} else if current < 0 {
+		next = 0
+	}
+
+	logger.Debug("applying canary rollout step", "step", next+1, "weight", rollout.Steps[next].Weight)
+	if err := r.applyWeight(rollout, splitter, rollout.Steps[next].Weight); err != nil {
+		if errors.Is(err, errConcurrentModification) {
+			return 0, false, err
+		}
+		updater.SetCondition(r.condition(rollout, api.CanaryRolloutConditionAccepted, api.ConditionStatusFalse, api.CanaryRolloutReasonSplitterUpdateFailed, err.Error(), now))
+		return r.interval, false, nil
+	}
+	updater.SetCondition(r.condition(rollout, api.CanaryRolloutConditionProgressing, api.ConditionStatusTrue, api.CanaryRolloutReasonStepApplied, stepMessage(rollout, next), now))
+
+	return minDuration(r.interval, rollout.Steps[next].Pause), false, nil
+}
+
+// applyWeight writes the service-splitter for the rollout so that the given
+// weight is sent to the canary subset and the remainder to the stable subset.
+// It returns errConcurrentModification if the splitter was modified since it
+// was read.
+func (r *canaryRolloutReconciler) applyWeight(rollout *structs.CanaryRolloutConfigEntry, splitter *structs.ServiceSplitterConfigEntry, weight float32) error {
+	if splitter == nil {
+		splitter = &structs.ServiceSplitterConfigEntry{
+			Kind:           structs.ServiceSplitter,
+			Name:           rollout.Name,
+			EnterpriseMeta: rollout.EnterpriseMeta,
+		}
+	} else {
+		// make a copy so we don't mutate the entry in the state store.
+		copied := *splitter
+		splitter = &copied
+	}
+
+	splitter.Splits = nil
+	if stable := 100 - weight; stable > 0 {
+		splitter.Splits = append(splitter.Splits, structs.ServiceSplit{
+			Weight:        stable,
+			ServiceSubset: rollout.StableSubset,
+		})
+	}
+	if weight > 0 {
+		splitter.Splits = append(splitter.Splits, structs.ServiceSplit{
+			Weight:        weight,
+			ServiceSubset: rollout.CanarySubset,
+		})
+	}
+
+	if err := splitter.Normalize(); err != nil {
+		return err
+	}
+	if err := splitter.Validate(); err != nil {
+		return err
+	}
+	applied, err := r.updater.Update(splitter)
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return errConcurrentModification
+	}
+	return nil
+}
+
+// errorRatio returns the fraction of requests to the canary subset that
+// failed, or false if no requests were observed.
+func (r *canaryRolloutReconciler) errorRatio(ctx context.Context, rollout *structs.CanaryRolloutConfigEntry) (float64, bool, error) {
+	if r.errorRatios == nil {
+		return 0, false, errors.New("connect.canary_rollout_prometheus is not configured on the servers")
+	}
+	return r.errorRatios.ErrorRatio(ctx, ErrorRatioTarget{
+		Service:    rollout.Name,
+		Subset:     rollout.CanarySubset,
+		Namespace:  rollout.NamespaceOrDefault(),
+		Datacenter: r.datacenter,
+	})
+}
+
+// condition builds a condition for the rollout, keeping the transition time
+// of an existing condition of the same type if its status is unchanged.
+func (r *canaryRolloutReconciler) condition(rollout *structs.CanaryRolloutConfigEntry, name api.CanaryRolloutConditionType, status api.ConditionStatus, reason api.CanaryRolloutConditionReason, message string, now time.Time) structs.Condition {
+	condition := structs.NewCanaryRolloutCondition(name, status, reason, message)
+	condition.LastTransitionTime = &now
+
+	if existing := findCondition(rollout.Status, name); existing != nil &&
+		existing.Status == string(status) && existing.LastTransitionTime != nil &&
+		name != api.CanaryRolloutConditionProgressing {
+		condition.LastTransitionTime = existing.LastTransitionTime
+	}
+	return condition
+}
+
+// currentStep returns the index of the last step whose weight has been
+// applied to the splitter, or -1 if the rollout has not started.
+func currentStep(rollout *structs.CanaryRolloutConfigEntry, splitter *structs.ServiceSplitterConfigEntry) int {
+	weight := canaryWeight(rollout, splitter)
+	current := -1
+	for i, step := range rollout.Steps {
+		if step.Weight > weight {
+			break
+		}
+		current = i
+	}
+	return current
+}
+
+// canaryWeight returns the weight the splitter sends to the canary subset.
+func canaryWeight(rollout *structs.CanaryRolloutConfigEntry, splitter *structs.ServiceSplitterConfigEntry) float32 {
+	if splitter == nil {
+		return 0
+	}
+	for _, split := range splitter.Splits {
+		if isLocalSubset(rollout, split, rollout.CanarySubset) {
+			return split.Weight
+		}
+	}
+	return 0
+}
+
+// splitMatches returns whether the splitter sends exactly the given weight to
+// the canary subset and the rest to the stable subset.
+func splitMatches(rollout *structs.CanaryRolloutConfigEntry, splitter *structs.ServiceSplitterConfigEntry, weight float32) bool {
+	if splitter == nil {
+		return false
+	}
+	var canary, stable float32
+	for _, split := range splitter.Splits {
+		switch {
+		case isLocalSubset(rollout, split, rollout.CanarySubset):
+			canary = split.Weight
+		case isLocalSubset(rollout, split, rollout.StableSubset):
+			stable = split.Weight
+		default:
+			return false
+		}
+	}
+	return canary == weight && stable == structs.NormalizeServiceSplitWeight(100-weight)
+}
+
+func isLocalSubset(rollout *structs.CanaryRolloutConfigEntry, split structs.ServiceSplit, subset string) bool {
+	return (split.Service == "" || split.Service == rollout.Name) && split.ServiceSubset == subset
+}
+
+func lookupSubset(resolver *structs.ServiceResolverConfigEntry, name string) (structs.ServiceResolverSubset, bool) {
+	if resolver == nil {
+		return structs.ServiceResolverSubset{}, false
+	}
+	subset, ok := resolver.Subsets[name]
+	return subset, ok
+}
+
+// filterSubsetNodes returns the instances that are part of the given subset.
+func filterSubsetNodes(subset structs.ServiceResolverSubset, nodes structs.CheckServiceNodes) (structs.CheckServiceNodes, error) {
+	if subset.Filter == "" {
+		return nodes, nil
+	}
+
+	filter, err := bexpr.CreateFilter(subset.Filter, nil, nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := filter.Execute(nodes)
+	if err != nil {
+		return nil, err
+	}
+	return raw.(structs.CheckServiceNodes), nil
+}
+
+func findCondition(status structs.Status, name api.CanaryRolloutConditionType) *structs.Condition {
+	for _, condition := range status.Conditions {
+		if condition.Type == string(name) {
+			return &condition
+		}
+	}
+	return nil
+}
+
+func stepMessage(rollout *structs.CanaryRolloutConfigEntry, step int) string {
+	return fmt.Sprintf("step %d of %d: %g%% of traffic sent to subset %q", step+1, len(rollout.Steps), rollout.Steps[step].Weight, rollout.CanarySubset)
+}
+
+func minDuration(a, b time.Duration) time.Duration {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,446 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package rollouts
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/consul/controller"
+	"github.com/hashicorp/consul/agent/consul/fsm"
+	"github.com/hashicorp/consul/agent/consul/state"
+	"github.com/hashicorp/consul/agent/consul/stream"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/types"
+)
+
+func TestCanaryRolloutReconciler_Progression(t *testing.T) {
+	t.Parallel()
+
+	h := newRolloutHarness(t, &structs.CanaryRolloutConfigEntry{
+		Kind:         structs.CanaryRollout,
+		Name:         "web",
+		StableSubset: "v1",
+		CanarySubset: "v2",
+		Steps: []structs.CanaryRolloutStep{
+			{Weight: 10, Pause: time.Minute},
+			{Weight: 50, Pause: time.Minute},
+			{Weight: 100},
+		},
+	})
+	h.registerInstance("web-v2-1", "v2", api.HealthPassing)
+
+	// The first step is applied straight away.
+	h.requireRequeue(10 * time.Second)
+	h.requireSplits(map[string]float32{"v1": 90, "v2": 10})
+	h.requireCondition(api.CanaryRolloutConditionAccepted, api.ConditionStatusTrue, api.CanaryRolloutReasonAccepted)
+	h.requireCondition(api.CanaryRolloutConditionHealthy, api.ConditionStatusTrue, api.CanaryRolloutReasonHealthGatePassed)
+	h.requireCondition(api.CanaryRolloutConditionProgressing, api.ConditionStatusTrue, api.CanaryRolloutReasonStepApplied)
+
+	// The step is held until its pause has elapsed.
+	h.Advance(50 * time.Second)
+	h.requireRequeue(10 * time.Second)
+	h.requireSplits(map[string]float32{"v1": 90, "v2": 10})
+
+	h.Advance(10 * time.Second)
+	h.requireRequeue(10 * time.Second)
+	h.requireSplits(map[string]float32{"v1": 50, "v2": 50})
+
+	h.Advance(time.Minute)
+	h.requireRequeue(0)
+	h.requireSplits(map[string]float32{"v2": 100})
+
+	// The last step has no pause so the rollout completes.
+	h.requireDone()
+	h.requireSplits(map[string]float32{"v2": 100})
+	h.requireCondition(api.CanaryRolloutConditionProgressing, api.ConditionStatusFalse, api.CanaryRolloutReasonCompleted)
+
+	// Completed rollouts are not evaluated again.
+	h.registerInstance("web-v2-2", "v2", api.HealthCritical)
+	h.Advance(time.Hour)
+	h.requireDone()
+	h.requireSplits(map[string]float32{"v2": 100})
+}
+
+func TestCanaryRolloutReconciler_Rollback(t *testing.T) {
+	t.Parallel()
+
+	h := newRolloutHarness(t, &structs.CanaryRolloutConfigEntry{
+		Kind:         structs.CanaryRollout,
+		Name:         "web",
+		StableSubset: "v1",
+		CanarySubset: "v2",
+		Steps: []structs.CanaryRolloutStep{
+			{Weight: 25, Pause: time.Hour},
+			{Weight: 100},
+		},
+		HealthGate: structs.CanaryRolloutHealthGate{
+			MinPassingRatio: 0.5,
+			GracePeriod:     30 * time.Second,
+		},
+	})
+	h.registerInstance("web-v2-1", "v2", api.HealthPassing)
+	h.registerInstance("web-v2-2", "v2", api.HealthPassing)
+
+	h.requireRequeue(10 * time.Second)
+	h.requireSplits(map[string]float32{"v1": 75, "v2": 25})
+
+	// Half of the instances passing still satisfies the gate.
+	h.registerInstance("web-v2-2", "v2", api.HealthCritical)
+	h.requireRequeue(10 * time.Second)
+	h.requireCondition(api.CanaryRolloutConditionHealthy, api.ConditionStatusTrue, api.CanaryRolloutReasonHealthGatePassed)
+
+	// The gate may fail for the grace period before rolling back.
+	h.registerInstance("web-v2-1", "v2", api.HealthCritical)
+	h.requireRequeue(10 * time.Second)
+	h.requireCondition(api.CanaryRolloutConditionHealthy, api.ConditionStatusFalse, api.CanaryRolloutReasonHealthGateFailed)
+	h.requireSplits(map[string]float32{"v1": 75, "v2": 25})
+
+	h.Advance(25 * time.Second)
+	h.requireRequeue(5 * time.Second)
+	h.requireSplits(map[string]float32{"v1": 75, "v2": 25})
+
+	h.Advance(5 * time.Second)
+	h.requireDone()
+	h.requireSplits(map[string]float32{"v1": 100})
+	h.requireCondition(api.CanaryRolloutConditionProgressing, api.ConditionStatusFalse, api.CanaryRolloutReasonRolledBack)
+
+	// Rolled back rollouts are not evaluated again.
+	h.registerInstance("web-v2-1", "v2", api.HealthPassing)
+	h.registerInstance("web-v2-2", "v2", api.HealthPassing)
+	h.Advance(time.Hour)
+	h.requireDone()
+	h.requireSplits(map[string]float32{"v1": 100})
+}
+
+func TestCanaryRolloutReconciler_WaitingForInstances(t *testing.T) {
+	t.Parallel()
+
+	h := newRolloutHarness(t, &structs.CanaryRolloutConfigEntry{
+		Kind:         structs.CanaryRollout,
+		Name:         "web",
+		StableSubset: "v1",
+		CanarySubset: "v2",
+		Steps:        []structs.CanaryRolloutStep{{Weight: 100}},
+		HealthGate: structs.CanaryRolloutHealthGate{
+			MinPassingInstances: 2,
+		},
+	})
+	h.registerInstance("web-v1-1", "v1", api.HealthPassing)
+	h.registerInstance("web-v2-1", "v2", api.HealthPassing)
+
+	h.requireRequeue(10 * time.Second)
+	h.requireCondition(api.CanaryRolloutConditionHealthy, api.ConditionStatusUnknown, api.CanaryRolloutReasonWaitingForInstances)
+	h.requireNoSplitter()
+
+	h.registerInstance("web-v2-2", "v2", api.HealthPassing)
+	h.requireRequeue(0)
+	h.requireSplits(map[string]float32{"v2": 100})
+}
+
+func TestCanaryRolloutReconciler_SubsetNotFound(t *testing.T) {
+	t.Parallel()
+
+	h := newRolloutHarness(t, &structs.CanaryRolloutConfigEntry{
+		Kind:         structs.CanaryRollout,
+		Name:         "web",
+		StableSubset: "v1",
+		CanarySubset: "v3",
+		Steps:        []structs.CanaryRolloutStep{{Weight: 100}},
+	})
+
+	h.requireRequeue(10 * time.Second)
+	h.requireCondition(api.CanaryRolloutConditionAccepted, api.ConditionStatusFalse, api.CanaryRolloutReasonSubsetNotFound)
+	h.requireNoSplitter()
+}
+
+func TestCanaryRolloutReconciler_SplitterUpdateFailed(t *testing.T) {
+	t.Parallel()
+
+	h := newRolloutHarness(t, &structs.CanaryRolloutConfigEntry{
+		Kind:         structs.CanaryRollout,
+		Name:         "web",
+		StableSubset: "v1",
+		CanarySubset: "v2",
+		Steps:        []structs.CanaryRolloutStep{{Weight: 100}},
+	})
+	// Splitters require an http-based protocol.
+	h.Write(&structs.ServiceConfigEntry{
+		Kind:     structs.ServiceDefaults,
+		Name:     "web",
+		Protocol: "tcp",
+	})
+	h.registerInstance("web-v2-1", "v2", api.HealthPassing)
+
+	h.requireRequeue(10 * time.Second)
+	h.requireCondition(api.CanaryRolloutConditionAccepted, api.ConditionStatusFalse, api.CanaryRolloutReasonSplitterUpdateFailed)
+	h.requireNoSplitter()
+}
+
+func TestCanaryRolloutReconciler_ErrorRatio(t *testing.T) {
+	t.Parallel()
+
+	h := newRolloutHarness(t, &structs.CanaryRolloutConfigEntry{
+		Kind:         structs.CanaryRollout,
+		Name:         "web",
+		StableSubset: "v1",
+		CanarySubset: "v2",
+		Steps: []structs.CanaryRolloutStep{
+			{Weight: 25, Pause: time.Hour},
+			{Weight: 100},
+		},
+		HealthGate: structs.CanaryRolloutHealthGate{
+			MaxErrorRatio: 0.05,
+			GracePeriod:   30 * time.Second,
+		},
+	})
+	h.registerInstance("web-v2-1", "v2", api.HealthPassing)
+
+	errorRatios := &fakeErrorRatioSource{}
+	h.reconciler.errorRatios = errorRatios
+
+	// No requests have been sent to the canary before the first step.
+	h.requireRequeue(10 * time.Second)
+	h.requireSplits(map[string]float32{"v1": 75, "v2": 25})
+	h.requireCondition(api.CanaryRolloutConditionHealthy, api.ConditionStatusTrue, api.CanaryRolloutReasonHealthGatePassed)
+	require.Equal(t, ErrorRatioTarget{Service: "web", Subset: "v2", Namespace: "default", Datacenter: "dc1"}, errorRatios.target)
+
+	errorRatios.ratio, errorRatios.observed = 0.01, true
+	h.requireRequeue(10 * time.Second)
+	h.requireCondition(api.CanaryRolloutConditionHealthy, api.ConditionStatusTrue, api.CanaryRolloutReasonHealthGatePassed)
+
+	// The gate fails once too many requests fail, even though every instance
+	// is passing its health checks.
+	errorRatios.ratio = 0.2
+	h.requireRequeue(10 * time.Second)
+	h.requireCondition(api.CanaryRolloutConditionHealthy, api.ConditionStatusFalse, api.CanaryRolloutReasonHealthGateFailed)
+	h.requireSplits(map[string]float32{"v1": 75, "v2": 25})
+
+	h.Advance(30 * time.Second)
+	h.requireDone()
+	h.requireSplits(map[string]float32{"v1": 100})
+	h.requireCondition(api.CanaryRolloutConditionProgressing, api.ConditionStatusFalse, api.CanaryRolloutReasonRolledBack)
+}
+
+func TestCanaryRolloutReconciler_ErrorRatioUnavailable(t *testing.T) {
+	t.Parallel()
+
+	h := newRolloutHarness(t, &structs.CanaryRolloutConfigEntry{
+		Kind:         structs.CanaryRollout,
+		Name:         "web",
+		StableSubset: "v1",
+		CanarySubset: "v2",
+		Steps:        []structs.CanaryRolloutStep{{Weight: 100}},
+		HealthGate: structs.CanaryRolloutHealthGate{
+			MaxErrorRatio: 0.05,
+		},
+	})
+	h.registerInstance("web-v2-1", "v2", api.HealthPassing)
+
+	// Without a source for the error ratio the rollout does not progress.
+	h.requireRequeue(10 * time.Second)
+	h.requireCondition(api.CanaryRolloutConditionHealthy, api.ConditionStatusUnknown, api.CanaryRolloutReasonErrorRatioUnavailable)
+	h.requireNoSplitter()
+
+	// Neither does it when the source fails.
+	h.reconciler.errorRatios = &fakeErrorRatioSource{err: errors.New("connection refused")}
+	h.requireRequeue(10 * time.Second)
+	h.requireCondition(api.CanaryRolloutConditionHealthy, api.ConditionStatusUnknown, api.CanaryRolloutReasonErrorRatioUnavailable)
+	h.requireNoSplitter()
+
+	h.reconciler.errorRatios = &fakeErrorRatioSource{}
+	h.requireRequeue(0)
+	h.requireSplits(map[string]float32{"v2": 100})
+}
+
+func TestCanaryRolloutReconciler_ConcurrentModification(t *testing.T) {
+	t.Parallel()
+
+	h := newRolloutHarness(t, &structs.CanaryRolloutConfigEntry{
+		Kind:         structs.CanaryRollout,
+		Name:         "web",
+		StableSubset: "v1",
+		CanarySubset: "v2",
+		Steps: []structs.CanaryRolloutStep{
+			{Weight: 10, Pause: time.Minute},
+			{Weight: 100},
+		},
+	})
+	h.registerInstance("web-v2-1", "v2", api.HealthPassing)
+
+	h.requireRequeue(10 * time.Second)
+	h.requireSplits(map[string]float32{"v1": 90, "v2": 10})
+
+	// The splitter is edited between the controller reading and writing it.
+	update := h.reconciler.updater.Update
+	h.reconciler.updater.Update = func(entry structs.ConfigEntry) (bool, error) {
+		h.Write(&structs.ServiceSplitterConfigEntry{
+			Kind: structs.ServiceSplitter,
+			Name: "web",
+			Splits: []structs.ServiceSplit{
+				{Weight: 80, ServiceSubset: "v1"},
+				{Weight: 20, ServiceSubset: "v2"},
+			},
+		})
+		return update(entry)
+	}
+	h.Advance(time.Minute)
+	require.ErrorIs(t, h.reconcile(), errConcurrentModification)
+	h.requireSplits(map[string]float32{"v1": 80, "v2": 20})
+	h.requireCondition(api.CanaryRolloutConditionProgressing, api.ConditionStatusTrue, api.CanaryRolloutReasonStepApplied)
+
+	// The rollout is evaluated again against the edited splitter.
+	h.reconciler.updater.Update = update
+	h.requireRequeue(10 * time.Second)
+	h.requireSplits(map[string]float32{"v1": 90, "v2": 10})
+
+	// The status is not written if the rollout was edited concurrently.
+	updateWithStatus := h.reconciler.updater.UpdateWithStatus
+	h.reconciler.updater.UpdateWithStatus = func(entry structs.ControlledConfigEntry) (bool, error) {
+		h.Write(h.Entry(structs.CanaryRollout, "web"))
+		return updateWithStatus(entry)
+	}
+	h.Advance(time.Minute)
+	require.ErrorIs(t, h.reconcile(), errConcurrentModification)
+}
+
+func TestNewCanaryRolloutController(t *testing.T) {
+	t.Parallel()
+
+	publisher := stream.NewEventPublisher(1 * time.Millisecond)
+	fsm := fsm.NewFromDeps(fsm.Deps{
+		Logger: hclog.New(nil),
+		NewStateStore: func() *state.Store {
+			return state.NewStateStoreWithEventPublisher(nil, publisher)
+		},
+		Publisher:      publisher,
+		StorageBackend: fsm.NullStorageBackend,
+	})
+
+	updater := &controller.Updater{
+		UpdateWithStatus: func(entry structs.ControlledConfigEntry) (bool, error) { return true, nil },
+		Update:           func(entry structs.ConfigEntry) (bool, error) { return true, nil },
+	}
+
+	require.NotNil(t, NewCanaryRolloutController(fsm, publisher, updater, nil, "dc1", hclog.Default()))
+}
+
+type fakeErrorRatioSource struct {
+	ratio    float64
+	observed bool
+	err      error
+	target   ErrorRatioTarget
+}
+
+func (f *fakeErrorRatioSource) ErrorRatio(_ context.Context, target ErrorRatioTarget) (float64, bool, error) {
+	f.target = target
+	return f.ratio, f.observed, f.err
+}
+
+type rolloutHarness struct {
+	*controller.TestHarness
+	t          *testing.T
+	reconciler *canaryRolloutReconciler
+}
+
+func newRolloutHarness(t *testing.T, rollout *structs.CanaryRolloutConfigEntry) *rolloutHarness {
+	t.Helper()
+
+	h := &rolloutHarness{TestHarness: controller.NewTestHarness(t), t: t}
+	h.reconciler = &canaryRolloutReconciler{
+		fsm:        h.FSM(),
+		logger:     hclog.New(nil),
+		updater:    h.Updater(),
+		datacenter: "dc1",
+		interval:   10 * time.Second,
+		now:        h.Now,
+	}
+
+	h.Write(&structs.ServiceConfigEntry{
+		Kind:     structs.ServiceDefaults,
+		Name:     "web",
+		Protocol: "http",
+	})
+	h.Write(&structs.ServiceResolverConfigEntry{
+		Kind: structs.ServiceResolver,
+		Name: "web",
+		Subsets: map[string]structs.ServiceResolverSubset{
+			"v1": {Filter: `Service.Meta.version == v1`},
+			"v2": {Filter: `Service.Meta.version == v2`},
+		},
+	})
+	h.Write(rollout)
+
+	return h
+}
+
+func (h *rolloutHarness) registerInstance(id, version, health string) {
+	h.t.Helper()
+	require.NoError(h.t, h.FSM().State().EnsureRegistration(h.NextIndex(), &structs.RegisterRequest{
+		Node:    "node-" + id,
+		Address: "127.0.0.1",
+		Service: &structs.NodeService{
+			ID:      id,
+			Service: "web",
+			Port:    8080,
+			Meta:    map[string]string{"version": version},
+		},
+		Checks: structs.HealthChecks{{
+			Node:      "node-" + id,
+			CheckID:   types.CheckID("check-" + id),
+			Status:    health,
+			ServiceID: id,
+		}},
+	}))
+}
+
+func (h *rolloutHarness) reconcile() error {
+	return h.Reconcile(h.reconciler, structs.CanaryRollout, "web")
+}
+
+func (h *rolloutHarness) requireRequeue(after time.Duration) {
+	h.t.Helper()
+	h.RequireRequeue(h.reconciler, structs.CanaryRollout, "web", after)
+}
+
+func (h *rolloutHarness) requireDone() {
+	h.t.Helper()
+	require.NoError(h.t, h.reconcile())
+}
+
+func (h *rolloutHarness) requireSplits(expected map[string]float32) {
+	h.t.Helper()
+	entry := h.Entry(structs.ServiceSplitter, "web")
+
+	actual := make(map[string]float32)
+	for _, split := range entry.(*structs.ServiceSplitterConfigEntry).Splits {
+		actual[split.ServiceSubset] = split.Weight
+	}
+	require.Equal(h.t, expected, actual)
+}
+
+func (h *rolloutHarness) requireNoSplitter() {
+	h.t.Helper()
+	_, entry, err := h.FSM().State().ConfigEntry(nil, structs.ServiceSplitter, "web", acl.DefaultEnterpriseMeta())
+	require.NoError(h.t, err)
+	require.Nil(h.t, entry)
+}
+
+func (h *rolloutHarness) requireCondition(name api.CanaryRolloutConditionType, status api.ConditionStatus, reason api.CanaryRolloutConditionReason) {
+	h.t.Helper()
+	entry := h.Entry(structs.CanaryRollout, "web")
+
+	condition := findCondition(entry.(*structs.CanaryRolloutConfigEntry).Status, name)
+	require.NotNil(h.t, condition, "condition %s not found", name)
+	require.Equal(h.t, string(status), condition.Status, condition.Message)
+	require.Equal(h.t, string(reason), condition.Reason, condition.Message)
+}
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package rollouts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+// errorRatioWindow is the window over which the error ratio of a canary
+// subset is measured.
+const errorRatioWindow = time.Minute
+
+// ErrorRatioTarget identifies the subset of a service whose error ratio is
+// queried.
+type ErrorRatioTarget struct {
+	Service    string
+	Subset     string
+	Namespace  string
+	Datacenter string
+}
+
+// ErrorRatioSource reports the fraction of requests to a subset of a service
+// that failed over a recent window, as observed by the calling proxies.
+type ErrorRatioSource interface {
+	// ErrorRatio returns the error ratio of the target, or false if no
+	// requests to it were observed during the window.
+	ErrorRatio(ctx context.Context, target ErrorRatioTarget) (float64, bool, error)
+}
+
+// PrometheusConfig configures the Prometheus server that collects the Envoy
+// metrics of the proxies in the mesh.
+type PrometheusConfig struct {
+	// BaseURL is the URL of the Prometheus server. If empty then no error
+	// ratio source is configured.
+	BaseURL string
+
+	// Headers are added to every query, for example to authenticate with
+	// the Prometheus server.
+	Headers map[string]string
+}
+
+// NewPrometheusErrorRatioSource returns an ErrorRatioSource that queries the
+// upstream cluster metrics Envoy reports to Prometheus, or nil if no
+// Prometheus server is configured.
+func NewPrometheusErrorRatioSource(config PrometheusConfig) ErrorRatioSource {
+	if config.BaseURL == "" {
+		return nil
+	}
+	client := cleanhttp.DefaultClient()
+	client.Timeout = 10 * time.Second
+	return &prometheusErrorRatioSource{config: config, client: client}
+}
+
+type prometheusErrorRatioSource struct {
+	config PrometheusConfig
+	client *http.Client
+}
+
+func (p *prometheusErrorRatioSource) ErrorRatio(ctx context.Context, target ErrorRatioTarget) (float64, bool, error) {
+	u, err := url.Parse(strings.TrimSuffix(p.config.BaseURL, "/") + "/api/v1/query")
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid Prometheus URL: %w", err)
+	}
+	u.RawQuery = url.Values{"query": []string{errorRatioQuery(target)}}.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return 0, false, err
+	}
+	for name, value := range p.config.Headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return 0, false, fmt.Errorf("error querying Prometheus: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		Status string
+		Error  string
+		Data   struct {
+			Result []struct {
+				Value []interface{}
+			}
+		}
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return 0, false, fmt.Errorf("error decoding Prometheus response with status %d: %w", resp.StatusCode, err)
+	}
+	if body.Status != "success" {
+		return 0, false, fmt.Errorf("Prometheus query failed with status %d: %s", resp.StatusCode, body.Error)
+	}
+
+	// An empty result means that no requests were observed.
+	if len(body.Data.Result) == 0 {
+		return 0, false, nil
+	}
+	sample := body.Data.Result[0].Value
+	if len(sample) != 2 {
+		return 0, false, fmt.Errorf("unexpected Prometheus sample %v", sample)
+	}
+	raw, ok := sample[1].(string)
+	if !ok {
+		return 0, false, fmt.Errorf("unexpected Prometheus sample value %v", sample[1])
+	}
+	ratio, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("unexpected Prometheus sample value %q: %w", raw, err)
+	}
+	if math.IsNaN(ratio) {
+		return 0, false, nil
+	}
+	return ratio, true, nil
+}
+
+// errorRatioQuery returns the PromQL query for the fraction of requests to
+// the target that received a 5xx response, as measured by the upstream
+// clusters of the calling proxies.
+func errorRatioQuery(target ErrorRatioTarget) string {
+	selector := fmt.Sprintf(`consul_destination_service=%q,consul_destination_service_subset=%q,consul_destination_namespace=%q,consul_destination_datacenter=%q`,
+		target.Service, target.Subset, target.Namespace, target.Datacenter)
+	window := fmt.Sprintf("%ds", int(errorRatioWindow.Seconds()))
+	return fmt.Sprintf(`(sum(rate(envoy_cluster_upstream_rq_xx{%s,envoy_response_code_class="5"}[%s])) or vector(0)) / sum(rate(envoy_cluster_upstream_rq_xx{%s}[%s]))`,
+		selector, window, selector, window)
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package rollouts
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrometheusErrorRatioSource(t *testing.T) {
+	t.Parallel()
+
+	target := ErrorRatioTarget{Service: "web", Subset: "v2", Namespace: "default", Datacenter: "dc1"}
+
+	cases := map[string]struct {
+		status       int
+		body         string
+		expectRatio  float64
+		expectFound  bool
+		expectErrStr string
+	}{
+		"ratio": {
+			status:      http.StatusOK,
+			body:        `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000,"0.125"]}]}}`,
+			expectRatio: 0.125,
+			expectFound: true,
+		},
+		"no requests": {
+			status: http.StatusOK,
+			body:   `{"status":"success","data":{"resultType":"vector","result":[]}}`,
+		},
+		"not a number": {
+			status: http.StatusOK,
+			body:   `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000,"NaN"]}]}}`,
+		},
+		"query error": {
+			status:       http.StatusBadRequest,
+			body:         `{"status":"error","errorType":"bad_data","error":"parse error"}`,
+			expectErrStr: "Prometheus query failed with status 400: parse error",
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/prometheus/api/v1/query", r.URL.Path)
+				require.Equal(t, errorRatioQuery(target), r.URL.Query().Get("query"))
+				require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+
+				w.WriteHeader(tc.status)
+				w.Write([]byte(tc.body))
+			}))
+			t.Cleanup(srv.Close)
+
+			source := NewPrometheusErrorRatioSource(PrometheusConfig{
+				BaseURL: srv.URL + "/prometheus/",
+				Headers: map[string]string{"Authorization": "Bearer secret"},
+			})
+
+			ratio, found, err := source.ErrorRatio(context.Background(), target)
+			if tc.expectErrStr != "" {
+				require.EqualError(t, err, tc.expectErrStr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectFound, found)
+			require.Equal(t, tc.expectRatio, ratio)
+		})
+	}
+}
+
+func TestNewPrometheusErrorRatioSource_NotConfigured(t *testing.T) {
+	require.Nil(t, NewPrometheusErrorRatioSource(PrometheusConfig{}))
+}
+
+func TestErrorRatioQuery(t *testing.T) {
+	query := errorRatioQuery(ErrorRatioTarget{Service: "web", Subset: "v2", Namespace: "default", Datacenter: "dc1"})
+	selector := `consul_destination_service="web",consul_destination_service_subset="v2",consul_destination_namespace="default",consul_destination_datacenter="dc1"`
+	require.Equal(t,
+		`(sum(rate(envoy_cluster_upstream_rq_xx{`+selector+`,envoy_response_code_class="5"}[60s])) or vector(0)) / sum(rate(envoy_cluster_upstream_rq_xx{`+selector+`}[60s]))`,
+		query)
+}
@@ -80,6 +80,14 @@ var RPCSummaries = []prometheus.SummaryDefinition{
 		Name: []string{"rpc", "consistentRead"},
 		Help: "Measures the time spent confirming that a consistent read can be performed.",
 	},
+	{
+		Name: []string{"rpc", "consistentRead", "batch"},
+		Help: "Measures the number of consistent reads that share a single leadership verification.",
+	},
+	{
+		Name: []string{"rpc", "followerConsistentRead"},
+		Help: "Measures the time spent by a follower catching up with the leader to perform a follower-consistent read.",
+	},
 }
 
 const (
@@ -677,7 +685,8 @@ func (s *Server) ForwardGRPC(connPool GRPCClientConner, info structs.RPCInfo, f
 //
 // Stale read requests will be handled locally if the current node has an
 // initialized raft database, otherwise requests will be forwarded to the local
-// leader using forwardToLeader.
+// leader using forwardToLeader. Follower-consistent reads are handled locally
+// once the current node has caught up with the leader.
 //
 // Returns a bool of if forwarding was performed, as well as any error. If
 // false is returned (with no error) it is assumed that the current server
@@ -694,11 +703,20 @@ func (s *Server) forwardRPC(
 
 	// See if we should let this server handle the read request without
 	// shipping the request to the leader.
-	if s.canServeReadRequest(info) {
-		return false, nil
+	if !s.canServeReadRequest(info) {
+		if handled, err := s.forwardRequestToLeader(info, forwardToLeader); handled || err != nil {
+			return handled, err
+		}
 	}
 
-	return s.forwardRequestToLeader(info, forwardToLeader)
+	// The request is handled by this server. A follower-consistent read must
+	// wait until this server has caught up with the leader, and a failure is
+	// reported as handled so that the endpoint returns it instead of serving
+	// the read.
+	if err := s.followerConsistentRead(info); err != nil {
+		return true, err
+	}
+	return false, nil
 }
 
 // forwardRequestToOtherDatacenter is an implementation detail of forwardRPC.
@@ -748,6 +766,24 @@ func (s *Server) canServeReadRequest(info structs.RPCInfo) bool {
 	return info.IsRead() && info.AllowStaleRead() && !s.raft.LastContact().IsZero()
 }
 
+// followerConsistentRequest is implemented by requests that may ask for a
+// follower-consistent read.
+type followerConsistentRequest interface {
+	GetFollowerConsistent() bool
+}
+
+// followerConsistentRead waits until this server has caught up with the
+// leader if the request asks for a follower-consistent read. It is called for
+// every read that is handled locally so that no endpoint serves a stale
+// result for such a request.
+func (s *Server) followerConsistentRead(info structs.RPCInfo) error {
+	req, ok := info.(followerConsistentRequest)
+	if !ok || !info.IsRead() || !req.GetFollowerConsistent() {
+		return nil
+	}
+	return s.FollowerConsistentRead()
+}
+
 // forwardRequestToLeader is an implementation detail of forwardRPC.
 // See the comment for forwardRPC for more details.
 func (s *Server) forwardRequestToLeader(info structs.RPCInfo, forwardToLeader func(leader *metadata.Server) error) (handled bool, err error) {
@@ -1060,47 +1096,13 @@ func (s *Server) SetQueryMeta(m blockingquery.ResponseMeta, token string) {
 }
 
 func (s *Server) consistentReadWithContext(ctx context.Context) error {
-	defer metrics.MeasureSince([]string{"rpc", "consistentRead"}, time.Now())
-	future := s.raft.VerifyLeader()
-	if err := future.Error(); err != nil {
-		return err // fail fast if leader verification fails
-	}
-
-	if s.isReadyForConsistentReads() {
-		return nil
-	}
-
-	// Poll until the context reaches its deadline, or for RPCHoldTimeout if the
-	// context has no deadline.
-	pollFor := s.config.RPCHoldTimeout
-	if deadline, ok := ctx.Deadline(); ok {
-		pollFor = time.Until(deadline)
-	}
-
-	interval := pollFor / structs.JitterFraction
-	if interval <= 0 {
-		return structs.ErrNotReadyForConsistentReads
-	}
-
-	ticker := time.NewTicker(interval)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			if s.isReadyForConsistentReads() {
-				return nil
-			}
-		case <-ctx.Done():
-			return structs.ErrNotReadyForConsistentReads
-		case <-s.shutdownCh:
-			return fmt.Errorf("shutdown waiting for leader")
-		}
-	}
+	_, err := s.readIndex(ctx)
+	return err
 }
 
 // ConsistentRead is used to ensure we do not perform a stale
-// read. This is done by verifying leadership before the read.
+// read. This is done by verifying leadership before the read. Concurrent
+// reads share a single verification.
 func (s *Server) ConsistentRead() error {
 	ctx, cancel := context.WithTimeout(context.Background(), s.config.RPCHoldTimeout)
 	defer cancel()
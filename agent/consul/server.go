@@ -158,6 +158,12 @@ const (
 	caRootPruningRoutineName              = "CA root pruning"
 	caRootMetricRoutineName               = "CA root expiration metric"
 	caSigningMetricRoutineName            = "CA signing expiration metric"
+	caRevocationListRoutineName           = "CA revocation list signing"
+	caRevocationReplicationRoutineName    = "CA revocation replication"
+	caLeafCertPruningRoutineName          = "CA leaf certificate pruning"
+	canaryRolloutControllerRoutineName    = "canary rollout controller"
+	trustBundleControllerRoutineName      = "trust bundle controller"
+	acmeCertificateControllerRoutineName  = "acme certificate controller"
 	configEntryControllersRoutineName     = "config entry controllers"
 	configReplicationRoutineName          = "config entry replication"
 	federationStateReplicationRoutineName = "federation state replication"
@@ -279,6 +285,10 @@ type Server struct {
 	raftTransport *raft.NetworkTransport
 	raftInmem     *raft.InmemStore
 
+	// logStoreMigrator tracks the migration of the Raft log store to a
+	// different backend.
+	logStoreMigrator logStoreMigrator
+
 	// raftNotifyCh is set up by setupRaft() and ensures that we get reliable leader
 	// transition notifications from the Raft layer.
 	raftNotifyCh <-chan bool
@@ -299,6 +309,12 @@ type Server struct {
 	// barrier. This is updated atomically.
 	readyForConsistentReads int32
 
+	// leaderReads batches the leadership verifications of consistent reads,
+	// and followerReads the read index requests of follower-consistent reads
+	// sent to the leader.
+	leaderReads   readIndexBatcher
+	followerReads readIndexBatcher
+
 	// leaveCh is used to signal that the server is leaving the cluster
 	// and trying to shed its RPC traffic onto other Consul servers. This
 	// is only ever closed.
@@ -1162,6 +1178,11 @@ func (s *Server) setupRaft(isCatalogResourceExperiment bool) error {
 			return err
 		}
 
+		// Swap in a migrated log store before deciding which one to open.
+		if err := completeLogStoreMigration(s.logger.Named("raft.logstore.migration"), s.config.LogStoreConfig, path); err != nil {
+			return err
+		}
+
 		boltDBFile := filepath.Join(path, "raft.db")
 		boltFileExists, err := fileExists(boltDBFile)
 		if err != nil {
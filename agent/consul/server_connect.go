@@ -62,6 +62,8 @@ func (s *Server) getCARoots(ws memdb.WatchSet, state *state.Store) (*structs.Ind
 			Active:              r.Active,
 			PrivateKeyType:      r.PrivateKeyType,
 			PrivateKeyBits:      r.PrivateKeyBits,
+			JWTKeyID:            r.JWTKeyID,
+			JWTPublicKey:        r.JWTPublicKey,
 		}
 
 		if r.Active {
@@ -69,6 +71,38 @@ func (s *Server) getCARoots(ws memdb.WatchSet, state *state.Store) (*structs.Ind
 		}
 	}
 
+	// Include the revocations so that agents replace revoked certificates
+	// and proxies can load the revocation lists alongside the roots.
+	revocationsIndex, revocations, lists, err := state.CARevocations(ws)
+	if err != nil {
+		return nil, err
+	}
+	if revocationsIndex > indexedRoots.Index {
+		indexedRoots.Index = revocationsIndex
+	}
+	for _, r := range revocations {
+		indexedRoots.Revocations = append(indexedRoots.Revocations, &structs.CARevocation{
+			ID:             r.ID,
+			SerialNumber:   r.SerialNumber,
+			AuthorityKeyID: r.AuthorityKeyID,
+			Service:        r.Service,
+			EnterpriseMeta: r.EnterpriseMeta,
+			Reason:         r.Reason,
+			RevokedAt:      r.RevokedAt,
+			ExpiresAt:      r.ExpiresAt,
+			RaftIndex:      r.RaftIndex,
+		})
+	}
+	var signingKeyID string
+	for _, r := range roots {
+		if r.Active {
+			signingKeyID = r.SigningKeyID
+		}
+	}
+	for _, l := range s.distributedCARevocationLists(revocations, lists, signingKeyID) {
+		indexedRoots.RevocationLists = append(indexedRoots.RevocationLists, lib.EnsureTrailingNewline(l.PEM))
+	}
+
 	return indexedRoots, nil
 }
 
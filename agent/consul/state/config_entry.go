@@ -650,6 +650,11 @@ func validateProposedConfigEntryInGraph(
 	case structs.InlineCertificate:
 	case structs.HTTPRoute:
 	case structs.TCPRoute:
+	case structs.GRPCRoute:
+	case structs.TLSRoute:
+	case structs.CanaryRollout:
+	case structs.TrustBundle:
+	case structs.ACMECertificate:
 	case structs.RateLimitIPConfig:
 	case structs.JWTProvider:
 		if newEntry == nil && existingEntry != nil {
@@ -23,8 +23,13 @@ var configEntryKindToTopic = map[string]stream.Topic{
 	structs.APIGateway:            EventTopicAPIGateway,
 	structs.TCPRoute:              EventTopicTCPRoute,
 	structs.HTTPRoute:             EventTopicHTTPRoute,
+	structs.GRPCRoute:             EventTopicGRPCRoute,
+	structs.TLSRoute:              EventTopicTLSRoute,
+	structs.CanaryRollout:         EventTopicCanaryRollout,
+	structs.TrustBundle:           EventTopicTrustBundle,
 	structs.FileSystemCertificate: EventTopicFileSystemCertificate,
 	structs.InlineCertificate:     EventTopicInlineCertificate,
+	structs.ACMECertificate:       EventTopicACMECertificate,
 	structs.BoundAPIGateway:       EventTopicBoundAPIGateway,
 	structs.RateLimitIPConfig:     EventTopicIPRateLimit,
 	structs.SamenessGroup:         EventTopicSamenessGroup,
@@ -148,6 +153,30 @@ func (s *Store) HTTPRouteSnapshot(req stream.SubscribeRequest, buf stream.Snapsh
 	return s.configEntrySnapshot(structs.HTTPRoute, req, buf)
 }
 
+// GRPCRouteSnapshot is a stream.SnapshotFunc that returns a snapshot of
+// grpc-route config entries.
+func (s *Store) GRPCRouteSnapshot(req stream.SubscribeRequest, buf stream.SnapshotAppender) (uint64, error) {
+	return s.configEntrySnapshot(structs.GRPCRoute, req, buf)
+}
+
+// TLSRouteSnapshot is a stream.SnapshotFunc that returns a snapshot of
+// tls-route config entries.
+func (s *Store) TLSRouteSnapshot(req stream.SubscribeRequest, buf stream.SnapshotAppender) (uint64, error) {
+	return s.configEntrySnapshot(structs.TLSRoute, req, buf)
+}
+
+// CanaryRolloutSnapshot is a stream.SnapshotFunc that returns a snapshot of
+// canary-rollout config entries.
+func (s *Store) CanaryRolloutSnapshot(req stream.SubscribeRequest, buf stream.SnapshotAppender) (uint64, error) {
+	return s.configEntrySnapshot(structs.CanaryRollout, req, buf)
+}
+
+// TrustBundleSnapshot is a stream.SnapshotFunc that returns a snapshot of
+// trust-bundle config entries.
+func (s *Store) TrustBundleSnapshot(req stream.SubscribeRequest, buf stream.SnapshotAppender) (uint64, error) {
+	return s.configEntrySnapshot(structs.TrustBundle, req, buf)
+}
+
 // FileSystemCertificateSnapshot is a stream.SnapshotFunc that returns a snapshot of
 // inline-certificate config entries.
 func (s *Store) FileSystemCertificateSnapshot(req stream.SubscribeRequest, buf stream.SnapshotAppender) (uint64, error) {
@@ -160,6 +189,12 @@ func (s *Store) InlineCertificateSnapshot(req stream.SubscribeRequest, buf strea
 	return s.configEntrySnapshot(structs.InlineCertificate, req, buf)
 }
 
+// ACMECertificateSnapshot is a stream.SnapshotFunc that returns a snapshot of
+// acme-certificate config entries.
+func (s *Store) ACMECertificateSnapshot(req stream.SubscribeRequest, buf stream.SnapshotAppender) (uint64, error) {
+	return s.configEntrySnapshot(structs.ACMECertificate, req, buf)
+}
+
 // BoundAPIGatewaySnapshot is a stream.SnapshotFunc that returns a snapshot of
 // bound-api-gateway config entries.
 func (s *Store) BoundAPIGatewaySnapshot(req stream.SubscribeRequest, buf stream.SnapshotAppender) (uint64, error) {
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-memdb"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// caLeafCertsTableSchema returns a new table schema used for storing the
+// metadata of the leaf certificates signed by the local datacenter.
+func caLeafCertsTableSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: tableConnectCALeafCerts,
+		Indexes: map[string]*memdb.IndexSchema{
+			indexID: {
+				Name:         indexID,
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: &memdb.StringFieldIndex{
+					Field:     "SerialNumber",
+					Lowercase: true,
+				},
+			},
+		},
+	}
+}
+
+// CALeafCerts is used to pull all the issued leaf certificates for the
+// snapshot.
+func (s *Snapshot) CALeafCerts() ([]*structs.IssuedCert, error) {
+	iter, err := s.tx.Get(tableConnectCALeafCerts, indexID)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []*structs.IssuedCert
+	for wrapped := iter.Next(); wrapped != nil; wrapped = iter.Next() {
+		ret = append(ret, wrapped.(*structs.IssuedCert))
+	}
+
+	return ret, nil
+}
+
+// CALeafCert is used when restoring from a snapshot.
+func (s *Restore) CALeafCert(cert *structs.IssuedCert) error {
+	if err := s.tx.Insert(tableConnectCALeafCerts, cert); err != nil {
+		return fmt.Errorf("failed restoring issued leaf certificate: %s", err)
+	}
+	if err := indexUpdateMaxTxn(s.tx, cert.ModifyIndex, tableConnectCALeafCerts); err != nil {
+		return fmt.Errorf("failed updating index: %s", err)
+	}
+
+	return nil
+}
+
+// CALeafCerts returns the metadata of the leaf certificates signed by the
+// local datacenter that have not been removed yet.
+func (s *Store) CALeafCerts(ws memdb.WatchSet) (uint64, []*structs.IssuedCert, error) {
+	tx := s.db.Txn(false)
+	defer tx.Abort()
+
+	idx := maxIndexTxn(tx, tableConnectCALeafCerts)
+
+	iter, err := tx.Get(tableConnectCALeafCerts, indexID)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed issued leaf certificate lookup: %s", err)
+	}
+	ws.Add(iter.WatchCh())
+
+	var certs []*structs.IssuedCert
+	for v := iter.Next(); v != nil; v = iter.Next() {
+		certs = append(certs, v.(*structs.IssuedCert))
+	}
+	return idx, certs, nil
+}
+
+// CALeafIssueCert records the metadata of a signed leaf certificate. The
+// index is used as the index of the certificate.
+func (s *Store) CALeafIssueCert(idx uint64, cert *structs.IssuedCert) error {
+	tx := s.db.WriteTxn(idx)
+	defer tx.Abort()
+
+	if cert.SerialNumber == "" {
+		return fmt.Errorf("missing serial number on issued leaf certificate")
+	}
+
+	// Only the metadata is kept.
+	stored := *cert
+	stored.CertPEM = ""
+	stored.PrivateKeyPEM = ""
+	stored.CreateIndex = idx
+	stored.ModifyIndex = idx
+
+	if err := tx.Insert(tableConnectCALeafCerts, &stored); err != nil {
+		return fmt.Errorf("failed inserting issued leaf certificate: %s", err)
+	}
+	if err := indexUpdateMaxTxn(tx, idx, tableConnectCALeafCerts); err != nil {
+		return fmt.Errorf("failed updating index: %s", err)
+	}
+
+	return tx.Commit()
+}
+
+// CALeafCertsDelete removes the issued leaf certificates with the given
+// serial numbers.
+func (s *Store) CALeafCertsDelete(idx uint64, serialNumbers []string) error {
+	tx := s.db.WriteTxn(idx)
+	defer tx.Abort()
+
+	for _, sn := range serialNumbers {
+		existing, err := tx.First(tableConnectCALeafCerts, indexID, sn)
+		if err != nil {
+			return fmt.Errorf("failed issued leaf certificate lookup: %s", err)
+		}
+		if existing == nil {
+			continue
+		}
+		if err := tx.Delete(tableConnectCALeafCerts, existing); err != nil {
+			return fmt.Errorf("failed removing issued leaf certificate: %s", err)
+		}
+	}
+	if err := tx.Insert(tableIndex, &IndexEntry{tableConnectCALeafCerts, idx}); err != nil {
+		return fmt.Errorf("failed updating index: %s", err)
+	}
+
+	return tx.Commit()
+}
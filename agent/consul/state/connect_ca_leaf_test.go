@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-memdb"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+func TestStore_CALeafCerts(t *testing.T) {
+	s := testStateStore(t)
+
+	ws := memdb.NewWatchSet()
+	idx, certs, err := s.CALeafCerts(ws)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), idx)
+	require.Empty(t, certs)
+
+	// A certificate without a serial number is rejected.
+	require.Error(t, s.CALeafIssueCert(1, &structs.IssuedCert{Service: "web"}))
+
+	now := time.Now().UTC()
+	web := &structs.IssuedCert{
+		SerialNumber:  "0A:0B",
+		CertPEM:       "cert",
+		PrivateKeyPEM: "key",
+		Service:       "web",
+		RootID:        "root1",
+		ValidAfter:    now,
+		ValidBefore:   now.Add(time.Hour),
+	}
+	require.NoError(t, s.CALeafIssueCert(2, web))
+	require.True(t, watchFired(ws))
+
+	ws = memdb.NewWatchSet()
+	idx, certs, err = s.CALeafCerts(ws)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), idx)
+	require.Len(t, certs, 1)
+
+	// Only the metadata of the certificate is stored.
+	require.Empty(t, certs[0].CertPEM)
+	require.Empty(t, certs[0].PrivateKeyPEM)
+	require.Equal(t, "root1", certs[0].RootID)
+	require.Equal(t, uint64(2), certs[0].CreateIndex)
+	require.Equal(t, "cert", web.CertPEM)
+
+	api := &structs.IssuedCert{
+		SerialNumber: "0c",
+		Service:      "api",
+		RootID:       "root2",
+		ValidBefore:  now.Add(time.Hour),
+	}
+	require.NoError(t, s.CALeafIssueCert(3, api))
+	require.True(t, watchFired(ws))
+
+	ws = memdb.NewWatchSet()
+	_, _, err = s.CALeafCerts(ws)
+	require.NoError(t, err)
+
+	// Serial numbers are matched case insensitively.
+	require.NoError(t, s.CALeafCertsDelete(4, []string{"0a:0b", "unknown"}))
+	require.True(t, watchFired(ws))
+
+	idx, certs, err = s.CALeafCerts(nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), idx)
+	require.Len(t, certs, 1)
+	require.Equal(t, "api", certs[0].Service)
+
+	// Snapshot and restore the certificates.
+	snap := s.Snapshot()
+	defer snap.Close()
+	dump, err := snap.CALeafCerts()
+	require.NoError(t, err)
+	require.Equal(t, certs, dump)
+
+	s2 := testStateStore(t)
+	restore := s2.Restore()
+	for _, cert := range dump {
+		require.NoError(t, restore.CALeafCert(cert))
+	}
+	require.NoError(t, restore.Commit())
+
+	idx, restored, err := s2.CALeafCerts(nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), idx)
+	require.Equal(t, certs, restored)
+}
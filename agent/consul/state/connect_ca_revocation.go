@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-memdb"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+const (
+	tableConnectCARevocations     = "connect-ca-revocations"
+	tableConnectCARevocationLists = "connect-ca-revocation-lists"
+)
+
+// caRevocationsTableSchema returns a new table schema used for storing leaf
+// certificate revocations.
+func caRevocationsTableSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: tableConnectCARevocations,
+		Indexes: map[string]*memdb.IndexSchema{
+			indexID: {
+				Name:         indexID,
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: &memdb.StringFieldIndex{
+					Field: "ID",
+				},
+			},
+		},
+	}
+}
+
+// caRevocationListsTableSchema returns a new table schema used for storing the
+// revocation lists signed by the leaf signing CA of each datacenter.
+func caRevocationListsTableSchema() *memdb.TableSchema {
+	return &memdb.TableSchema{
+		Name: tableConnectCARevocationLists,
+		Indexes: map[string]*memdb.IndexSchema{
+			indexID: {
+				Name:         indexID,
+				AllowMissing: false,
+				Unique:       true,
+				Indexer: &memdb.StringFieldIndex{
+					Field:     "AuthorityKeyID",
+					Lowercase: true,
+				},
+			},
+		},
+	}
+}
+
+// CARevocations is used to pull all the revocations for the snapshot.
+func (s *Snapshot) CARevocations() (structs.CARevocations, error) {
+	iter, err := s.tx.Get(tableConnectCARevocations, indexID)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret structs.CARevocations
+	for wrapped := iter.Next(); wrapped != nil; wrapped = iter.Next() {
+		ret = append(ret, wrapped.(*structs.CARevocation))
+	}
+
+	return ret, nil
+}
+
+// CARevocationLists is used to pull all the revocation lists for the snapshot.
+func (s *Snapshot) CARevocationLists() ([]*structs.CARevocationList, error) {
+	iter, err := s.tx.Get(tableConnectCARevocationLists, indexID)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []*structs.CARevocationList
+	for wrapped := iter.Next(); wrapped != nil; wrapped = iter.Next() {
+		ret = append(ret, wrapped.(*structs.CARevocationList))
+	}
+
+	return ret, nil
+}
+
+// CARevocation is used when restoring from a snapshot.
+func (s *Restore) CARevocation(r *structs.CARevocation) error {
+	if err := s.tx.Insert(tableConnectCARevocations, r); err != nil {
+		return fmt.Errorf("failed restoring CA revocation: %s", err)
+	}
+	if err := indexUpdateMaxTxn(s.tx, r.ModifyIndex, tableConnectCARevocations); err != nil {
+		return fmt.Errorf("failed updating index: %s", err)
+	}
+
+	return nil
+}
+
+// CARevocationList is used when restoring from a snapshot.
+func (s *Restore) CARevocationList(l *structs.CARevocationList) error {
+	if err := s.tx.Insert(tableConnectCARevocationLists, l); err != nil {
+		return fmt.Errorf("failed restoring CA revocation list: %s", err)
+	}
+	if err := indexUpdateMaxTxn(s.tx, l.ModifyIndex, tableConnectCARevocationLists); err != nil {
+		return fmt.Errorf("failed updating index: %s", err)
+	}
+
+	return nil
+}
+
+// CARevocations returns all the leaf certificate revocations and the
+// revocation lists signed for them.
+func (s *Store) CARevocations(ws memdb.WatchSet) (uint64, structs.CARevocations, []*structs.CARevocationList, error) {
+	tx := s.db.Txn(false)
+	defer tx.Abort()
+
+	return caRevocationsTxn(tx, ws)
+}
+
+func caRevocationsTxn(tx ReadTxn, ws memdb.WatchSet) (uint64, structs.CARevocations, []*structs.CARevocationList, error) {
+	idx := maxIndexTxn(tx, tableConnectCARevocations, tableConnectCARevocationLists)
+
+	iter, err := tx.Get(tableConnectCARevocations, indexID)
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("failed CA revocation lookup: %s", err)
+	}
+	ws.Add(iter.WatchCh())
+
+	var revocations structs.CARevocations
+	for v := iter.Next(); v != nil; v = iter.Next() {
+		revocations = append(revocations, v.(*structs.CARevocation))
+	}
+	revocations.Sort()
+
+	iter, err = tx.Get(tableConnectCARevocationLists, indexID)
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("failed CA revocation list lookup: %s", err)
+	}
+	ws.Add(iter.WatchCh())
+
+	var lists []*structs.CARevocationList
+	for v := iter.Next(); v != nil; v = iter.Next() {
+		lists = append(lists, v.(*structs.CARevocationList))
+	}
+
+	return idx, revocations, lists, nil
+}
+
+// CARevoke stores the given revocations. Existing revocations with the same ID
+// are replaced.
+func (s *Store) CARevoke(idx uint64, revocations []*structs.CARevocation) error {
+	tx := s.db.WriteTxn(idx)
+	defer tx.Abort()
+
+	for _, r := range revocations {
+		if err := caRevocationSetTxn(tx, idx, r); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func caRevocationSetTxn(tx WriteTxn, idx uint64, r *structs.CARevocation) error {
+	if r.ID == "" {
+		return fmt.Errorf("missing ID on CA revocation")
+	}
+
+	existing, err := tx.First(tableConnectCARevocations, indexID, r.ID)
+	if err != nil {
+		return fmt.Errorf("failed CA revocation lookup: %s", err)
+	}
+	if existing != nil {
+		r.CreateIndex = existing.(*structs.CARevocation).CreateIndex
+	} else {
+		r.CreateIndex = idx
+	}
+	r.ModifyIndex = idx
+
+	if err := tx.Insert(tableConnectCARevocations, r); err != nil {
+		return fmt.Errorf("failed inserting CA revocation: %s", err)
+	}
+	if err := tx.Insert(tableIndex, &IndexEntry{tableConnectCARevocations, idx}); err != nil {
+		return fmt.Errorf("failed updating index: %v", err)
+	}
+	return nil
+}
+
+// CARevocationListSet upserts the given revocation lists.
+func (s *Store) CARevocationListSet(idx uint64, lists []*structs.CARevocationList) error {
+	tx := s.db.WriteTxn(idx)
+	defer tx.Abort()
+
+	for _, l := range lists {
+		if err := caRevocationListSetTxn(tx, idx, l); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func caRevocationListSetTxn(tx WriteTxn, idx uint64, l *structs.CARevocationList) error {
+	if l.AuthorityKeyID == "" {
+		return fmt.Errorf("missing authority key ID on CA revocation list")
+	}
+
+	existing, err := tx.First(tableConnectCARevocationLists, indexID, l.AuthorityKeyID)
+	if err != nil {
+		return fmt.Errorf("failed CA revocation list lookup: %s", err)
+	}
+	if existing != nil {
+		l.CreateIndex = existing.(*structs.CARevocationList).CreateIndex
+	} else {
+		l.CreateIndex = idx
+	}
+	l.ModifyIndex = idx
+
+	if err := tx.Insert(tableConnectCARevocationLists, l); err != nil {
+		return fmt.Errorf("failed inserting CA revocation list: %s", err)
+	}
+	if err := tx.Insert(tableIndex, &IndexEntry{tableConnectCARevocationLists, idx}); err != nil {
+		return fmt.Errorf("failed updating index: %v", err)
+	}
+	return nil
+}
+
+// CARevocationsDelete removes the given revocations and revocation lists.
+// Only the ID of the revocations and the AuthorityKeyID of the lists are used.
+func (s *Store) CARevocationsDelete(idx uint64, revocations []*structs.CARevocation, lists []*structs.CARevocationList) error {
+	tx := s.db.WriteTxn(idx)
+	defer tx.Abort()
+
+	for _, r := range revocations {
+		if err := caRevocationsDeleteTxn(tx, idx, tableConnectCARevocations, r.ID); err != nil {
+			return err
+		}
+	}
+	for _, l := range lists {
+		if err := caRevocationsDeleteTxn(tx, idx, tableConnectCARevocationLists, l.AuthorityKeyID); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func caRevocationsDeleteTxn(tx WriteTxn, idx uint64, table, id string) error {
+	existing, err := tx.First(table, indexID, id)
+	if err != nil {
+		return fmt.Errorf("failed %s lookup: %s", table, err)
+	}
+	if existing == nil {
+		return nil
+	}
+
+	if err := tx.Delete(table, existing); err != nil {
+		return fmt.Errorf("failed removing %s entry: %s", table, err)
+	}
+	if err := tx.Insert(tableIndex, &IndexEntry{table, idx}); err != nil {
+		return fmt.Errorf("failed updating index: %s", err)
+	}
+	return nil
+}
+
+// CARevocationsReplace replaces all revocations and revocation lists with the
+// given ones. Secondary datacenters use it to replicate the primary's state.
+func (s *Store) CARevocationsReplace(idx uint64, revocations []*structs.CARevocation, lists []*structs.CARevocationList) error {
+	tx := s.db.WriteTxn(idx)
+	defer tx.Abort()
+
+	if _, err := tx.DeleteAll(tableConnectCARevocations, indexID); err != nil {
+		return fmt.Errorf("failed removing CA revocations: %s", err)
+	}
+	if _, err := tx.DeleteAll(tableConnectCARevocationLists, indexID); err != nil {
+		return fmt.Errorf("failed removing CA revocation lists: %s", err)
+	}
+
+	for _, r := range revocations {
+		if err := caRevocationSetTxn(tx, idx, r); err != nil {
+			return err
+		}
+	}
+	for _, l := range lists {
+		if err := caRevocationListSetTxn(tx, idx, l); err != nil {
+			return err
+		}
+	}
+
+	// Bump both indexes so that watchers notice removals as well.
+	if err := tx.Insert(tableIndex, &IndexEntry{tableConnectCARevocations, idx}); err != nil {
+		return fmt.Errorf("failed updating index: %s", err)
+	}
+	if err := tx.Insert(tableIndex, &IndexEntry{tableConnectCARevocationLists, idx}); err != nil {
+		return fmt.Errorf("failed updating index: %s", err)
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-memdb"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+func TestStore_CARevocations(t *testing.T) {
+	s := testStateStore(t)
+
+	now := time.Now().UTC()
+	serial := &structs.CARevocation{
+		ID:           "serial",
+		SerialNumber: "0a",
+		RevokedAt:    now,
+		ExpiresAt:    now.Add(time.Hour),
+	}
+	service := &structs.CARevocation{
+		ID:        "service",
+		Service:   "web",
+		RevokedAt: now.Add(time.Second),
+		ExpiresAt: now.Add(time.Hour),
+	}
+
+	ws := memdb.NewWatchSet()
+	idx, revocations, lists, err := s.CARevocations(ws)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), idx)
+	require.Empty(t, revocations)
+	require.Empty(t, lists)
+
+	require.NoError(t, s.CARevoke(1, []*structs.CARevocation{service, serial}))
+	require.True(t, watchFired(ws))
+
+	ws = memdb.NewWatchSet()
+	idx, revocations, _, err = s.CARevocations(ws)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), idx)
+	// Revocations are sorted by the time they were created.
+	require.Equal(t, structs.CARevocations{serial, service}, revocations)
+	require.Equal(t, uint64(1), revocations[0].CreateIndex)
+
+	list := &structs.CARevocationList{
+		AuthorityKeyID: "AA:BB",
+		Datacenter:     "dc1",
+		SerialNumbers:  []string{"0a"},
+		PEM:            "crl",
+	}
+	require.NoError(t, s.CARevocationListSet(2, []*structs.CARevocationList{list}))
+	require.True(t, watchFired(ws))
+
+	// Lists are keyed by the case insensitive authority key ID.
+	updated := &structs.CARevocationList{
+		AuthorityKeyID: "aa:bb",
+		Datacenter:     "dc1",
+		PEM:            "crl2",
+	}
+	require.NoError(t, s.CARevocationListSet(3, []*structs.CARevocationList{updated}))
+
+	idx, _, lists, err = s.CARevocations(nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), idx)
+	require.Len(t, lists, 1)
+	require.Equal(t, "crl2", lists[0].PEM)
+	require.Equal(t, uint64(2), lists[0].CreateIndex)
+	require.Equal(t, uint64(3), lists[0].ModifyIndex)
+
+	ws = memdb.NewWatchSet()
+	_, _, _, err = s.CARevocations(ws)
+	require.NoError(t, err)
+
+	require.NoError(t, s.CARevocationsDelete(4,
+		[]*structs.CARevocation{{ID: "serial"}, {ID: "unknown"}},
+		[]*structs.CARevocationList{{AuthorityKeyID: "AA:BB"}},
+	))
+	require.True(t, watchFired(ws))
+
+	idx, revocations, lists, err = s.CARevocations(nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), idx)
+	require.Equal(t, structs.CARevocations{service}, revocations)
+	require.Empty(t, lists)
+}
+
+func TestStore_CARevocationsReplace(t *testing.T) {
+	s := testStateStore(t)
+
+	require.NoError(t, s.CARevoke(1, []*structs.CARevocation{
+		{ID: "a", SerialNumber: "0a"},
+		{ID: "b", SerialNumber: "0b"},
+	}))
+	require.NoError(t, s.CARevocationListSet(2, []*structs.CARevocationList{
+		{AuthorityKeyID: "aa", PEM: "crl"},
+	}))
+
+	ws := memdb.NewWatchSet()
+	_, _, _, err := s.CARevocations(ws)
+	require.NoError(t, err)
+
+	require.NoError(t, s.CARevocationsReplace(3,
+		[]*structs.CARevocation{{ID: "b", SerialNumber: "0b"}, {ID: "c", Service: "web"}},
+		nil,
+	))
+	require.True(t, watchFired(ws))
+
+	idx, revocations, lists, err := s.CARevocations(nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), idx)
+	require.Len(t, revocations, 2)
+	ids := []string{revocations[0].ID, revocations[1].ID}
+	require.ElementsMatch(t, []string{"b", "c"}, ids)
+	require.Empty(t, lists)
+
+	require.Error(t, s.CARevoke(4, []*structs.CARevocation{{SerialNumber: "0d"}}))
+	require.Error(t, s.CARevocationListSet(4, []*structs.CARevocationList{{PEM: "crl"}}))
+}
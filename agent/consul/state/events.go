@@ -47,7 +47,8 @@ func PBToStreamSubscribeRequest(req *pbsubscribe.SubscribeRequest, entMeta acl.E
 			EventTopicServiceIntentions, EventTopicServiceDefaults, EventTopicAPIGateway,
 			EventTopicTCPRoute, EventTopicHTTPRoute, EventTopicJWTProvider, EventTopicInlineCertificate,
 			EventTopicBoundAPIGateway, EventTopicSamenessGroup, EventTopicExportedServices,
-			EventTopicFileSystemCertificate:
+			EventTopicFileSystemCertificate, EventTopicGRPCRoute, EventTopicTLSRoute,
+			EventTopicCanaryRollout, EventTopicTrustBundle, EventTopicACMECertificate:
 			subject = EventSubjectConfigEntry{
 				Name:           named.Key,
 				EnterpriseMeta: &entMeta,
@@ -56,6 +56,42 @@ type changeTrackerDB struct {
 	db             *memdb.MemDB
 	publisher      EventPublisher
 	processChanges func(ReadTxn, Changes) ([]stream.Event, error)
+
+	// latest is the index of the last write transaction that committed
+	// changes.
+	latest latestIndex
+}
+
+// latestIndex records the raft index of the last committed change. Unlike the
+// index table it also covers the tables that do not record their own index.
+type latestIndex struct {
+	lock  sync.Mutex
+	index uint64
+	ch    chan struct{}
+}
+
+// get returns the latest index and a channel that is closed once it changes.
+func (l *latestIndex) get() (uint64, <-chan struct{}) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.ch == nil {
+		l.ch = make(chan struct{})
+	}
+	return l.index, l.ch
+}
+
+// set records a committed change at idx.
+func (l *latestIndex) set(idx uint64) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if idx <= l.index {
+		return
+	}
+	l.index = idx
+	if l.ch != nil {
+		close(l.ch)
+		l.ch = nil
+	}
 }
 
 type EventPublisher interface {
@@ -97,6 +133,7 @@ func (c *changeTrackerDB) WriteTxn(idx uint64) *txn {
 		Index:      idx,
 		publish:    c.publisher.Publish,
 		prePublish: c.processChanges,
+		committed:  c.latest.set,
 	}
 	t.Txn.TrackChanges()
 	return t
@@ -141,6 +178,10 @@ type txn struct {
 
 	prePublish prePublishFuncType
 
+	// committed may be nil if this is a read-only or WriteTxnRestore
+	// transaction.
+	committed func(idx uint64)
+
 	commitLock sync.Mutex
 }
 
@@ -180,6 +221,10 @@ func (tx *txn) Commit() error {
 
 	tx.Txn.Commit()
 
+	if tx.committed != nil && len(changes.Changes) > 0 {
+		tx.committed(tx.Index)
+	}
+
 	// publish may be nil if this is a read-only or WriteTxnRestore transaction.
 	// In those cases events should also be empty, and there will be nothing
 	// to publish.
@@ -207,8 +252,13 @@ var (
 	EventTopicAPIGateway            = pbsubscribe.Topic_APIGateway
 	EventTopicTCPRoute              = pbsubscribe.Topic_TCPRoute
 	EventTopicHTTPRoute             = pbsubscribe.Topic_HTTPRoute
+	EventTopicGRPCRoute             = pbsubscribe.Topic_GRPCRoute
+	EventTopicTLSRoute              = pbsubscribe.Topic_TLSRoute
+	EventTopicCanaryRollout         = pbsubscribe.Topic_CanaryRollout
+	EventTopicTrustBundle           = pbsubscribe.Topic_TrustBundle
 	EventTopicFileSystemCertificate = pbsubscribe.Topic_FileSystemCertificate
 	EventTopicInlineCertificate     = pbsubscribe.Topic_InlineCertificate
+	EventTopicACMECertificate       = pbsubscribe.Topic_ACMECertificate
 	EventTopicBoundAPIGateway       = pbsubscribe.Topic_BoundAPIGateway
 	EventTopicIPRateLimit           = pbsubscribe.Topic_IPRateLimit
 	EventTopicSamenessGroup         = pbsubscribe.Topic_SamenessGroup
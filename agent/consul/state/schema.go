@@ -20,6 +20,9 @@ func newDBSchema() *memdb.DBSchema {
 		bindingRulesTableSchema,
 		caBuiltinProviderTableSchema,
 		caConfigTableSchema,
+		caLeafCertsTableSchema,
+		caRevocationListsTableSchema,
+		caRevocationsTableSchema,
 		caRootTableSchema,
 		checksTableSchema,
 		configTableSchema,
@@ -273,6 +273,33 @@ func (s *Store) maxIndex(keys ...string) uint64 {
 	return maxIndexTxn(tx, keys...)
 }
 
+// LatestIndex returns the index of the last applied change, including changes
+// to tables that do not record their own index. The watch set is notified
+// when it changes.
+func (s *Store) LatestIndex(ws memdb.WatchSet) (uint64, error) {
+	latest, ch := s.db.latest.get()
+	ws.Add(ch)
+
+	tx := s.db.Txn(false)
+	defer tx.Abort()
+
+	iter, err := tx.Get(tableIndex, indexID)
+	if err != nil {
+		return 0, fmt.Errorf("failed listing table indexes: %s", err)
+	}
+	ws.Add(iter.WatchCh())
+
+	// The index table covers the changes restored from a snapshot, which are
+	// not committed at their own index.
+	lindex := latest
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		if idx := raw.(*IndexEntry); idx.Value > lindex {
+			lindex = idx.Value
+		}
+	}
+	return lindex, nil
+}
+
 // maxIndexTxn is a helper used to retrieve the highest known index
 // amongst a set of index keys (e.g. table names) in the db.
 func maxIndexTxn(tx ReadTxn, keys ...string) uint64 {
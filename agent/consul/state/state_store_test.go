@@ -450,6 +450,37 @@ func TestStateStore_maxIndex(t *testing.T) {
 	}
 }
 
+func TestStateStore_LatestIndex(t *testing.T) {
+	s := testStateStore(t)
+
+	testRegisterNode(t, s, 1, "foo")
+	testRegisterService(t, s, 2, "foo", "consul")
+
+	ws := memdb.NewWatchSet()
+	idx, err := s.LatestIndex(ws)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), idx)
+	require.False(t, watchFired(ws))
+
+	require.NoError(t, s.KVSSet(3, &structs.DirEntry{Key: "foo", Value: []byte("bar")}))
+	require.True(t, watchFired(ws))
+
+	idx, err = s.LatestIndex(nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), idx)
+
+	// The autopilot config does not record its index in the index table.
+	ws = memdb.NewWatchSet()
+	_, err = s.LatestIndex(ws)
+	require.NoError(t, err)
+	require.NoError(t, s.AutopilotSetConfig(4, &structs.AutopilotConfig{MaxTrailingLogs: 10}))
+	require.True(t, watchFired(ws))
+
+	idx, err = s.LatestIndex(nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), idx)
+}
+
 func TestStateStore_indexUpdateMaxTxn(t *testing.T) {
 	s := testStateStore(t)
 
@@ -4,6 +4,7 @@
 package consul
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
@@ -83,3 +84,22 @@ func (s *Status) RaftStats(args EmptyReadRequest, reply *structs.RaftStats) erro
 
 	return nil
 }
+
+// ReadIndex is used by followers to service follower-consistent reads. It
+// returns the index of the state the read has to see once the leadership of
+// the local server has been verified.
+func (s *Status) ReadIndex(args EmptyReadRequest, reply *structs.ReadIndexResponse) error {
+	if !s.server.IsLeader() {
+		return structs.ErrNoLeader
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.server.config.RPCHoldTimeout)
+	defer cancel()
+
+	index, err := s.server.readIndex(ctx)
+	if err != nil {
+		return err
+	}
+	reply.Index = index
+	return nil
+}
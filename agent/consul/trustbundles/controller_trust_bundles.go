@@ -0,0 +1,365 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package trustbundles
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-memdb"
+
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/agent/consul/controller"
+	"github.com/hashicorp/consul/agent/consul/fsm"
+	"github.com/hashicorp/consul/agent/consul/state"
+	"github.com/hashicorp/consul/agent/consul/stream"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+)
+
+const (
+	// retryInterval is how long to wait before fetching a bundle again after
+	// a failed attempt.
+	retryInterval = 30 * time.Second
+
+	// fetchTimeout bounds a single request to a bundle endpoint.
+	fetchTimeout = 10 * time.Second
+
+	// maxBundleSize is the largest bundle document that will be read from a
+	// bundle endpoint.
+	maxBundleSize = 1 << 20
+)
+
+// RevocationListsDistributed reports whether the Connect CA distributes
+// revocation lists to proxies, adding the state it depends on to the watch
+// set.
+type RevocationListsDistributed func(ws memdb.WatchSet) (bool, error)
+
+// fetchState is what the reconciler remembers about a bundle between
+// reconciliations. It is not persisted, so a new leader fetches every bundle
+// once when it takes over.
+type fetchState struct {
+	lastAttempt time.Time
+	refreshHint time.Duration
+}
+
+// trustBundleReconciler fetches the bundles of trust-bundle config entries
+// from their SPIFFE bundle endpoints and stores them in the entries. It also
+// reports whether proxies trust the entries.
+type trustBundleReconciler struct {
+	fsm        *fsm.FSM
+	logger     hclog.Logger
+	updater    *controller.Updater
+	controller controller.Controller
+	now        func() time.Time
+
+	// revocationLists reports whether revocation lists are distributed, in
+	// which case connect proxies don't trust federated trust domains.
+	revocationLists RevocationListsDistributed
+
+	// transport is used to make requests to bundle endpoints, the TLS
+	// configuration is set per request.
+	transport *http.Transport
+
+	lock  sync.Mutex
+	state map[string]fetchState
+}
+
+// NewTrustBundleController initializes a controller that refreshes all
+// trust-bundle config entries that have a bundle endpoint and reports
+// whether proxies trust them. It must only be run in the primary datacenter,
+// the updated entries are replicated to secondary datacenters.
+func NewTrustBundleController(fsm *fsm.FSM, publisher state.EventPublisher, updater *controller.Updater, revocationLists RevocationListsDistributed, logger hclog.Logger) controller.Controller {
+	reconciler := &trustBundleReconciler{
+		fsm:             fsm,
+		logger:          logger,
+		updater:         updater,
+		now:             time.Now,
+		revocationLists: revocationLists,
+		transport:       http.DefaultTransport.(*http.Transport).Clone(),
+		state:           make(map[string]fetchState),
+	}
+	reconciler.controller = controller.New(publisher, reconciler)
+	return reconciler.controller.
+		WithLogger(logger.With("controller", "trustBundleController")).
+		Subscribe(&stream.SubscribeRequest{
+			Topic:   state.EventTopicTrustBundle,
+			Subject: stream.SubjectWildcard,
+		})
+}
+
+// Reconcile reports whether proxies trust a single trust-bundle config entry
+// and fetches its bundle if it is due to be refreshed. A RequeueAfterError is returned so that the entry
+// is reconciled again at its next refresh.
+func (r *trustBundleReconciler) Reconcile(ctx context.Context, req controller.Request) error {
+	if req.Kind != structs.TrustBundle {
+		return nil
+	}
+
+	logger := r.logger.With("trust-bundle", req.Name, "partition", req.Meta.PartitionOrDefault())
+	key := req.Meta.PartitionOrDefault() + "/" + req.Name
+
+	_, entry, err := r.fsm.State().ConfigEntry(nil, req.Kind, req.Name, req.Meta)
+	if err != nil {
+		logger.Warn("error fetching config entry for reconciliation request", "error", err)
+		return err
+	}
+	bundle, _ := entry.(*structs.TrustBundleConfigEntry)
+	if bundle == nil {
+		r.lock.Lock()
+		delete(r.state, key)
+		r.lock.Unlock()
+		r.controller.RemoveTrigger(req)
+		return nil
+	}
+
+	now := r.now().UTC()
+	bundle = bundle.DeepCopy()
+	updater := structs.NewStatusUpdater(bundle)
+
+	// Reconcile the entry again whenever the distribution of revocation
+	// lists may have changed.
+	ws := memdb.NewWatchSet()
+	distributed, err := r.revocationLists(ws)
+	if err != nil {
+		logger.Warn("error checking CA revocation lists", "error", err)
+		return err
+	}
+	r.controller.AddTrigger(req, ws.WatchCtx)
+	if distributed {
+		if !hasCondition(bundle, api.TrustBundleConditionTrusted, api.ConditionStatusFalse) {
+			logger.Warn("connect proxies don't trust the federated trust domain while the Connect CA distributes revocation lists")
+		}
+		updater.SetCondition(condition(bundle, api.TrustBundleConditionTrusted, api.ConditionStatusFalse, api.TrustBundleReasonRevocationListsDistributed,
+			"not trusted by connect proxies while the Connect CA distributes revocation lists, because the SPIFFE validator doesn't check them", now))
+	} else {
+		updater.SetCondition(condition(bundle, api.TrustBundleConditionTrusted, api.ConditionStatusTrue, api.TrustBundleReasonTrusted,
+			"trusted by connect proxies whose intentions reference the trust domain", now))
+	}
+
+	if !bundle.IsFetched() {
+		r.lock.Lock()
+		delete(r.state, key)
+		r.lock.Unlock()
+		return r.persistStatus(updater)
+	}
+
+	r.lock.Lock()
+	st := r.state[key]
+	r.lock.Unlock()
+
+	if bundle.LastRefresh != nil {
+		if next := bundle.NextRefresh(st.refreshHint); now.Before(next) {
+			if err := r.persistStatus(updater); err != nil {
+				logger.Warn("error persisting trust bundle status", "error", err)
+				return err
+			}
+			return controller.RequeueAfter(next.Sub(now))
+		}
+	}
+	if retry := st.lastAttempt.Add(retryInterval); now.Before(retry) {
+		if err := r.persistStatus(updater); err != nil {
+			logger.Warn("error persisting trust bundle status", "error", err)
+			return err
+		}
+		return controller.RequeueAfter(retry.Sub(now))
+	}
+
+	st.lastAttempt = now
+	fetched, reason, err := r.fetch(ctx, bundle)
+	if err != nil {
+		logger.Warn("error refreshing trust bundle", "error", err)
+		updater.SetCondition(condition(bundle, api.TrustBundleConditionRefreshed, api.ConditionStatusFalse, reason, err.Error(), now))
+
+		r.lock.Lock()
+		r.state[key] = st
+		r.lock.Unlock()
+
+		if err := r.persistStatus(updater); err != nil {
+			logger.Warn("error persisting trust bundle status", "error", err)
+			return err
+		}
+		return controller.RequeueAfter(retryInterval)
+	}
+
+	st.refreshHint = time.Duration(fetched.RefreshHint) * time.Second
+	r.lock.Lock()
+	r.state[key] = st
+	r.lock.Unlock()
+
+	bundle.RootPEMs = fetched.X509Authorities()
+	bundle.Sequence = fetched.Sequence
+	bundle.LastRefresh = &now
+	updater.SetCondition(condition(bundle, api.TrustBundleConditionRefreshed, api.ConditionStatusTrue, api.TrustBundleReasonRefreshed,
+		fmt.Sprintf("fetched %d X.509 authorities", len(bundle.RootPEMs)), now))
+	updater.UpdateEntry()
+
+	logger.Trace("persisting refreshed trust bundle", "sequence", bundle.Sequence)
+	if err := r.persist(bundle); err != nil {
+		logger.Warn("error persisting refreshed trust bundle", "error", err)
+		return err
+	}
+	return controller.RequeueAfter(bundle.NextRefresh(st.refreshHint).Sub(now))
+}
+
+// persist writes the entry after recomputing its hash, so that the change is
+// picked up by config entry replication.
+func (r *trustBundleReconciler) persist(entry structs.ControlledConfigEntry) error {
+	if err := entry.Normalize(); err != nil {
+		return err
+	}
+	_, err := r.updater.UpdateWithStatus(entry)
+	return err
+}
+
+// persistStatus writes the entry if its status changed.
+func (r *trustBundleReconciler) persistStatus(updater *structs.StatusUpdater) error {
+	if modified, shouldUpdate := updater.UpdateEntry(); shouldUpdate {
+		return r.persist(modified)
+	}
+	return nil
+}
+
+// fetch retrieves and decodes the bundle from the entry's bundle endpoint.
+// On error it also returns the reason to record on the entry's status.
+func (r *trustBundleReconciler) fetch(ctx context.Context, entry *structs.TrustBundleConfigEntry) (*connect.SPIFFEBundle, api.TrustBundleConditionReason, error) {
+	transport := r.transport.Clone()
+	transport.TLSClientConfig = endpointTLSConfig(entry)
+	defer transport.CloseIdleConnections()
+	client := &http.Client{Transport: transport, Timeout: fetchTimeout}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, entry.BundleEndpoint.URL, nil)
+	if err != nil {
+		return nil, api.TrustBundleReasonFetchFailed, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, api.TrustBundleReasonFetchFailed, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, api.TrustBundleReasonFetchFailed, fmt.Errorf("bundle endpoint returned status %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxBundleSize))
+	if err != nil {
+		return nil, api.TrustBundleReasonFetchFailed, err
+	}
+
+	bundle, err := connect.ParseSPIFFEBundle(data)
+	if err != nil {
+		return nil, api.TrustBundleReasonInvalidBundle, err
+	}
+	if len(bundle.X509Authorities()) == 0 {
+		return nil, api.TrustBundleReasonInvalidBundle, errors.New("bundle does not contain any X.509 authorities")
+	}
+	return bundle, "", nil
+}
+
+// endpointTLSConfig returns the TLS configuration used to authenticate the
+// entry's bundle endpoint according to its profile.
+func endpointTLSConfig(entry *structs.TrustBundleConfigEntry) *tls.Config {
+	ep := entry.BundleEndpoint
+
+	if ep.Profile != structs.TrustBundleProfileHTTPSSPIFFE {
+		cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+		if ep.CACert != "" {
+			cfg.RootCAs = x509.NewCertPool()
+			cfg.RootCAs.AppendCertsFromPEM([]byte(ep.CACert))
+		}
+		return cfg
+	}
+
+	// With the https_spiffe profile the endpoint presents an X.509-SVID. If
+	// the endpoint belongs to the trust domain being fetched it is verified
+	// with the last fetched bundle, which lets the bundle roll over its
+	// authorities without configuration changes.
+	pool := x509.NewCertPool()
+	pool.AppendCertsFromPEM([]byte(ep.CACert))
+	if id, err := url.Parse(ep.SpiffeID); err == nil && id.Host == entry.Name {
+		for _, root := range entry.RootPEMs {
+			pool.AppendCertsFromPEM([]byte(root))
+		}
+	}
+
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// The endpoint is authenticated by its SPIFFE ID rather than its
+		// host name, which is verified below.
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			return verifySVID(rawCerts, pool, ep.SpiffeID)
+		},
+	}
+}
+
+// verifySVID verifies that the given certificate chain is an X.509-SVID of
+// the expected SPIFFE ID, issued by one of the authorities in the pool.
+func verifySVID(rawCerts [][]byte, pool *x509.CertPool, spiffeID string) error {
+	if len(rawCerts) == 0 {
+		return errors.New("bundle endpoint did not present a certificate")
+	}
+	certs := make([]*x509.Certificate, 0, len(rawCerts))
+	for _, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return err
+		}
+		certs = append(certs, cert)
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         pool,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return fmt.Errorf("error verifying bundle endpoint certificate: %w", err)
+	}
+
+	for _, uri := range certs[0].URIs {
+		if uri.String() == spiffeID {
+			return nil
+		}
+	}
+	return fmt.Errorf("bundle endpoint certificate is not an X.509-SVID of %q", spiffeID)
+}
+
+// condition builds a condition of the bundle, keeping the transition time
+// of the existing condition of that type if its status is unchanged.
+func condition(entry *structs.TrustBundleConfigEntry, name api.TrustBundleConditionType, status api.ConditionStatus, reason api.TrustBundleConditionReason, message string, now time.Time) structs.Condition {
+	condition := structs.NewTrustBundleCondition(name, status, reason, message)
+	condition.LastTransitionTime = &now
+
+	for _, existing := range entry.Status.Conditions {
+		if existing.Type == string(name) &&
+			existing.Status == string(status) && existing.LastTransitionTime != nil {
+			condition.LastTransitionTime = existing.LastTransitionTime
+		}
+	}
+	return condition
+}
+
+// hasCondition returns whether the entry has the condition with the status.
+func hasCondition(entry *structs.TrustBundleConfigEntry, name api.TrustBundleConditionType, status api.ConditionStatus) bool {
+	for _, existing := range entry.Status.Conditions {
+		if existing.Type == string(name) && existing.Status == string(status) {
+			return true
+		}
+	}
+	return false
+}
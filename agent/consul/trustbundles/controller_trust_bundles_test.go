@@ -0,0 +1,353 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package trustbundles
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-memdb"
+
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/agent/consul/controller"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+)
+
+const testTrustDomain = connect.TestClusterID + ".consul"
+
+func TestTrustBundleReconciler_HTTPSWeb(t *testing.T) {
+	t.Parallel()
+
+	root := connect.TestCA(t, nil)
+	srv := newBundleServer(t, root, nil)
+
+	h := newBundleHarness(t, &structs.TrustBundleConfigEntry{
+		Kind: structs.TrustBundle,
+		Name: "example.org",
+		BundleEndpoint: structs.TrustBundleEndpoint{
+			URL:    srv.URL,
+			CACert: serverCertPEM(srv.srv),
+		},
+	})
+
+	// The bundle is fetched straight away and refreshed according to the
+	// refresh hint of the bundle.
+	h.requireRequeue(connect.DefaultSPIFFEBundleRefreshHint)
+	entry := h.entry()
+	require.Len(t, entry.RootPEMs, 1)
+	require.Equal(t, strings.TrimSpace(root.RootCert), strings.TrimSpace(entry.RootPEMs[0]))
+	require.Equal(t, uint64(7), entry.Sequence)
+	require.Equal(t, h.Now(), *entry.LastRefresh)
+	h.requireCondition(api.TrustBundleConditionRefreshed, api.ConditionStatusTrue, api.TrustBundleReasonRefreshed)
+	require.Equal(t, int32(1), srv.requests.Load())
+
+	// The bundle is not fetched again until it is due.
+	h.Advance(time.Minute)
+	h.requireRequeue(connect.DefaultSPIFFEBundleRefreshHint - time.Minute)
+	require.Equal(t, int32(1), srv.requests.Load())
+
+	h.Advance(connect.DefaultSPIFFEBundleRefreshHint)
+	h.requireRequeue(connect.DefaultSPIFFEBundleRefreshHint)
+	require.Equal(t, int32(2), srv.requests.Load())
+	require.Equal(t, h.Now(), *h.entry().LastRefresh)
+
+	// The refresh interval of the entry overrides the hint of the bundle.
+	entry = h.entry()
+	entry.RefreshInterval = time.Hour
+	h.Write(entry)
+	h.requireRequeue(time.Hour)
+	require.Equal(t, int32(2), srv.requests.Load())
+}
+
+func TestTrustBundleReconciler_FetchFailed(t *testing.T) {
+	t.Parallel()
+
+	root := connect.TestCA(t, nil)
+	srv := newBundleServer(t, root, nil)
+
+	h := newBundleHarness(t, &structs.TrustBundleConfigEntry{
+		Kind: structs.TrustBundle,
+		Name: "example.org",
+		BundleEndpoint: structs.TrustBundleEndpoint{
+			URL:    srv.URL,
+			CACert: serverCertPEM(srv.srv),
+		},
+	})
+	h.requireRequeue(connect.DefaultSPIFFEBundleRefreshHint)
+	roots := h.entry().RootPEMs
+
+	srv.status.Store(http.StatusServiceUnavailable)
+	h.Advance(connect.DefaultSPIFFEBundleRefreshHint)
+	h.requireRequeue(retryInterval)
+	h.requireCondition(api.TrustBundleConditionRefreshed, api.ConditionStatusFalse, api.TrustBundleReasonFetchFailed)
+	require.Equal(t, int32(2), srv.requests.Load())
+
+	// The previously fetched bundle is kept until the endpoint recovers.
+	require.Equal(t, roots, h.entry().RootPEMs)
+
+	// Writing the status does not cause the endpoint to be hammered.
+	h.Advance(time.Second)
+	h.requireRequeue(retryInterval - time.Second)
+	require.Equal(t, int32(2), srv.requests.Load())
+
+	srv.status.Store(http.StatusOK)
+	h.Advance(retryInterval)
+	h.requireRequeue(connect.DefaultSPIFFEBundleRefreshHint)
+	h.requireCondition(api.TrustBundleConditionRefreshed, api.ConditionStatusTrue, api.TrustBundleReasonRefreshed)
+}
+
+func TestTrustBundleReconciler_InvalidBundle(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"keys":[]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	h := newBundleHarness(t, &structs.TrustBundleConfigEntry{
+		Kind: structs.TrustBundle,
+		Name: "example.org",
+		BundleEndpoint: structs.TrustBundleEndpoint{
+			URL:    srv.URL,
+			CACert: serverCertPEM(srv),
+		},
+	})
+	h.requireRequeue(retryInterval)
+	h.requireCondition(api.TrustBundleConditionRefreshed, api.ConditionStatusFalse, api.TrustBundleReasonInvalidBundle)
+	require.Empty(t, h.entry().RootPEMs)
+}
+
+func TestTrustBundleReconciler_UntrustedEndpoint(t *testing.T) {
+	t.Parallel()
+
+	root := connect.TestCA(t, nil)
+	srv := newBundleServer(t, root, nil)
+
+	h := newBundleHarness(t, &structs.TrustBundleConfigEntry{
+		Kind:           structs.TrustBundle,
+		Name:           "example.org",
+		BundleEndpoint: structs.TrustBundleEndpoint{URL: srv.URL},
+	})
+	h.requireRequeue(retryInterval)
+	h.requireCondition(api.TrustBundleConditionRefreshed, api.ConditionStatusFalse, api.TrustBundleReasonFetchFailed)
+}
+
+func TestTrustBundleReconciler_HTTPSSPIFFE(t *testing.T) {
+	t.Parallel()
+
+	root := connect.TestCA(t, nil)
+	certPEM, keyPEM := connect.TestLeaf(t, "bundle-server", root)
+	cert, err := tls.X509KeyPair([]byte(certPEM), []byte(keyPEM))
+	require.NoError(t, err)
+	srv := newBundleServer(t, root, &cert)
+
+	spiffeID := "spiffe://" + testTrustDomain + "/ns/default/dc/dc1/svc/bundle-server"
+
+	t.Run("bootstrap and roll over", func(t *testing.T) {
+		h := newBundleHarness(t, &structs.TrustBundleConfigEntry{
+			Kind: structs.TrustBundle,
+			Name: testTrustDomain,
+			BundleEndpoint: structs.TrustBundleEndpoint{
+				URL:      srv.URL,
+				Profile:  structs.TrustBundleProfileHTTPSSPIFFE,
+				SpiffeID: spiffeID,
+				CACert:   root.RootCert,
+			},
+		})
+		h.requireRequeue(connect.DefaultSPIFFEBundleRefreshHint)
+		h.requireCondition(api.TrustBundleConditionRefreshed, api.ConditionStatusTrue, api.TrustBundleReasonRefreshed)
+
+		// Once fetched, the bundle itself authenticates the endpoint.
+		entry := h.entry()
+		entry.BundleEndpoint.CACert = ""
+		h.Write(entry)
+		require.NotEmpty(t, h.entry().RootPEMs)
+
+		h.Advance(connect.DefaultSPIFFEBundleRefreshHint)
+		h.requireRequeue(connect.DefaultSPIFFEBundleRefreshHint)
+		h.requireCondition(api.TrustBundleConditionRefreshed, api.ConditionStatusTrue, api.TrustBundleReasonRefreshed)
+	})
+
+	t.Run("wrong SPIFFE ID", func(t *testing.T) {
+		h := newBundleHarness(t, &structs.TrustBundleConfigEntry{
+			Kind: structs.TrustBundle,
+			Name: testTrustDomain,
+			BundleEndpoint: structs.TrustBundleEndpoint{
+				URL:      srv.URL,
+				Profile:  structs.TrustBundleProfileHTTPSSPIFFE,
+				SpiffeID: "spiffe://" + testTrustDomain + "/ns/default/dc/dc1/svc/other",
+				CACert:   root.RootCert,
+			},
+		})
+		h.requireRequeue(retryInterval)
+		h.requireCondition(api.TrustBundleConditionRefreshed, api.ConditionStatusFalse, api.TrustBundleReasonFetchFailed)
+	})
+
+	t.Run("untrusted authority", func(t *testing.T) {
+		other := connect.TestCA(t, nil)
+		h := newBundleHarness(t, &structs.TrustBundleConfigEntry{
+			Kind: structs.TrustBundle,
+			Name: testTrustDomain,
+			BundleEndpoint: structs.TrustBundleEndpoint{
+				URL:      srv.URL,
+				Profile:  structs.TrustBundleProfileHTTPSSPIFFE,
+				SpiffeID: spiffeID,
+				CACert:   other.RootCert,
+			},
+		})
+		h.requireRequeue(retryInterval)
+		h.requireCondition(api.TrustBundleConditionRefreshed, api.ConditionStatusFalse, api.TrustBundleReasonFetchFailed)
+	})
+}
+
+func TestTrustBundleReconciler_StaticBundle(t *testing.T) {
+	t.Parallel()
+
+	root := connect.TestCA(t, nil)
+	h := newBundleHarness(t, &structs.TrustBundleConfigEntry{
+		Kind:     structs.TrustBundle,
+		Name:     "example.org",
+		RootPEMs: []string{root.RootCert},
+	})
+	require.NoError(t, h.reconcile())
+	require.Len(t, h.entry().Status.Conditions, 1)
+	h.requireCondition(api.TrustBundleConditionTrusted, api.ConditionStatusTrue, api.TrustBundleReasonTrusted)
+}
+
+func TestTrustBundleReconciler_RevocationListsDistributed(t *testing.T) {
+	t.Parallel()
+
+	root := connect.TestCA(t, nil)
+	h := newBundleHarness(t, &structs.TrustBundleConfigEntry{
+		Kind:     structs.TrustBundle,
+		Name:     "example.org",
+		RootPEMs: []string{root.RootCert},
+	})
+	require.NoError(t, h.reconcile())
+	h.requireCondition(api.TrustBundleConditionTrusted, api.ConditionStatusTrue, api.TrustBundleReasonTrusted)
+	require.Len(t, h.controller.Triggers, 1)
+
+	h.revocationLists = true
+	require.NoError(t, h.reconcile())
+	h.requireCondition(api.TrustBundleConditionTrusted, api.ConditionStatusFalse, api.TrustBundleReasonRevocationListsDistributed)
+
+	h.revocationLists = false
+	require.NoError(t, h.reconcile())
+	h.requireCondition(api.TrustBundleConditionTrusted, api.ConditionStatusTrue, api.TrustBundleReasonTrusted)
+
+	require.NoError(t, h.FSM().State().DeleteConfigEntry(h.NextIndex(), structs.TrustBundle, "example.org", nil))
+	require.NoError(t, h.reconcile())
+	require.Empty(t, h.controller.Triggers)
+}
+
+type bundleServer struct {
+	URL      string
+	srv      *httptest.Server
+	requests atomic.Int32
+	status   atomic.Int32
+}
+
+func newBundleServer(t *testing.T, root *structs.CARoot, cert *tls.Certificate) *bundleServer {
+	t.Helper()
+
+	bundle, err := connect.SPIFFEBundleFromRoots([]*structs.CARoot{root}, 7, connect.DefaultSPIFFEBundleRefreshHint)
+	require.NoError(t, err)
+	data, err := json.Marshal(bundle)
+	require.NoError(t, err)
+
+	s := &bundleServer{}
+	s.status.Store(http.StatusOK)
+	s.srv = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.requests.Add(1)
+		if status := int(s.status.Load()); status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+		w.Write(data)
+	}))
+	if cert != nil {
+		s.srv.TLS = &tls.Config{Certificates: []tls.Certificate{*cert}}
+	}
+	s.srv.StartTLS()
+	t.Cleanup(s.srv.Close)
+	s.URL = s.srv.URL
+	return s
+}
+
+func serverCertPEM(srv *httptest.Server) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+}
+
+type bundleHarness struct {
+	*controller.TestHarness
+	t          *testing.T
+	reconciler *trustBundleReconciler
+	controller *controller.TestController
+	name       string
+
+	revocationLists bool
+}
+
+func newBundleHarness(t *testing.T, entry *structs.TrustBundleConfigEntry) *bundleHarness {
+	t.Helper()
+
+	h := &bundleHarness{
+		TestHarness: controller.NewTestHarness(t),
+		t:           t,
+		controller:  controller.NewTestController(),
+		name:        entry.Name,
+	}
+	h.reconciler = &trustBundleReconciler{
+		fsm:        h.FSM(),
+		logger:     hclog.New(nil),
+		updater:    h.Updater(),
+		controller: h.controller,
+		now:        h.Now,
+		revocationLists: func(ws memdb.WatchSet) (bool, error) {
+			return h.revocationLists, nil
+		},
+		transport: http.DefaultTransport.(*http.Transport).Clone(),
+		state:     make(map[string]fetchState),
+	}
+
+	h.Write(entry)
+	return h
+}
+
+func (h *bundleHarness) reconcile() error {
+	return h.Reconcile(h.reconciler, structs.TrustBundle, h.name)
+}
+
+func (h *bundleHarness) requireRequeue(after time.Duration) {
+	h.t.Helper()
+	h.RequireRequeue(h.reconciler, structs.TrustBundle, h.name, after)
+}
+
+func (h *bundleHarness) entry() *structs.TrustBundleConfigEntry {
+	h.t.Helper()
+	return h.Entry(structs.TrustBundle, h.name).(*structs.TrustBundleConfigEntry).DeepCopy()
+}
+
+func (h *bundleHarness) requireCondition(name api.TrustBundleConditionType, status api.ConditionStatus, reason api.TrustBundleConditionReason) {
+	h.t.Helper()
+	for _, condition := range h.entry().Status.Conditions {
+		if condition.Type == string(name) {
+			require.Equal(h.t, string(status), condition.Status, condition.Message)
+			require.Equal(h.t, string(reason), condition.Reason, condition.Message)
+			return
+		}
+	}
+	h.t.Fatalf("entry has no %s condition", name)
+}
@@ -366,6 +366,46 @@ var baseCases = map[string]testCase{
 					{Name: "kind", Value: "tcp-route"},
 				},
 			},
+			"consul.usage.test.state.config_entries;datacenter=dc1;kind=grpc-route": {
+				Name:  "consul.usage.test.state.config_entries",
+				Value: 0,
+				Labels: []metrics.Label{
+					{Name: "datacenter", Value: "dc1"},
+					{Name: "kind", Value: "grpc-route"},
+				},
+			},
+			"consul.usage.test.state.config_entries;datacenter=dc1;kind=tls-route": {
+				Name:  "consul.usage.test.state.config_entries",
+				Value: 0,
+				Labels: []metrics.Label{
+					{Name: "datacenter", Value: "dc1"},
+					{Name: "kind", Value: "tls-route"},
+				},
+			},
+			"consul.usage.test.state.config_entries;datacenter=dc1;kind=canary-rollout": {
+				Name:  "consul.usage.test.state.config_entries",
+				Value: 0,
+				Labels: []metrics.Label{
+					{Name: "datacenter", Value: "dc1"},
+					{Name: "kind", Value: "canary-rollout"},
+				},
+			},
+			"consul.usage.test.state.config_entries;datacenter=dc1;kind=trust-bundle": {
+				Name:  "consul.usage.test.state.config_entries",
+				Value: 0,
+				Labels: []metrics.Label{
+					{Name: "datacenter", Value: "dc1"},
+					{Name: "kind", Value: "trust-bundle"},
+				},
+			},
+			"consul.usage.test.state.config_entries;datacenter=dc1;kind=acme-certificate": {
+				Name:  "consul.usage.test.state.config_entries",
+				Value: 0,
+				Labels: []metrics.Label{
+					{Name: "datacenter", Value: "dc1"},
+					{Name: "kind", Value: "acme-certificate"},
+				},
+			},
 			"consul.usage.test.state.config_entries;datacenter=dc1;kind=jwt-provider": {
 				Name:  "consul.usage.test.state.config_entries",
 				Value: 0,
@@ -647,6 +687,46 @@ var baseCases = map[string]testCase{
 					{Name: "kind", Value: "tcp-route"},
 				},
 			},
+			"consul.usage.test.state.config_entries;datacenter=dc1;kind=grpc-route": {
+				Name:  "consul.usage.test.state.config_entries",
+				Value: 0,
+				Labels: []metrics.Label{
+					{Name: "datacenter", Value: "dc1"},
+					{Name: "kind", Value: "grpc-route"},
+				},
+			},
+			"consul.usage.test.state.config_entries;datacenter=dc1;kind=tls-route": {
+				Name:  "consul.usage.test.state.config_entries",
+				Value: 0,
+				Labels: []metrics.Label{
+					{Name: "datacenter", Value: "dc1"},
+					{Name: "kind", Value: "tls-route"},
+				},
+			},
+			"consul.usage.test.state.config_entries;datacenter=dc1;kind=canary-rollout": {
+				Name:  "consul.usage.test.state.config_entries",
+				Value: 0,
+				Labels: []metrics.Label{
+					{Name: "datacenter", Value: "dc1"},
+					{Name: "kind", Value: "canary-rollout"},
+				},
+			},
+			"consul.usage.test.state.config_entries;datacenter=dc1;kind=trust-bundle": {
+				Name:  "consul.usage.test.state.config_entries",
+				Value: 0,
+				Labels: []metrics.Label{
+					{Name: "datacenter", Value: "dc1"},
+					{Name: "kind", Value: "trust-bundle"},
+				},
+			},
+			"consul.usage.test.state.config_entries;datacenter=dc1;kind=acme-certificate": {
+				Name:  "consul.usage.test.state.config_entries",
+				Value: 0,
+				Labels: []metrics.Label{
+					{Name: "datacenter", Value: "dc1"},
+					{Name: "kind", Value: "acme-certificate"},
+				},
+			},
 			"consul.usage.test.state.config_entries;datacenter=dc1;kind=jwt-provider": {
 				Name:  "consul.usage.test.state.config_entries",
 				Value: 0,
@@ -48,6 +48,8 @@ func (s *HTTPHandlers) DiscoveryChainRead(resp http.ResponseWriter, req *http.Re
 
 		args.OverrideProtocol = apiReq.OverrideProtocol
 		args.OverrideConnectTimeout = apiReq.OverrideConnectTimeout
+		args.OverrideLimits = apiReq.OverrideLimits
+		args.OverridePassiveHealthCheck = apiReq.OverridePassiveHealthCheck
 
 		if apiReq.OverrideMeshGateway.Mode != "" {
 			_, err := structs.ValidateMeshGatewayMode(string(apiReq.OverrideMeshGateway.Mode))
@@ -93,9 +95,11 @@ func (s *HTTPHandlers) DiscoveryChainRead(resp http.ResponseWriter, req *http.Re
 
 // discoveryChainReadRequest is the API variation of structs.DiscoveryChainRequest
 type discoveryChainReadRequest struct {
-	OverrideMeshGateway    structs.MeshGatewayConfig `alias:"override_mesh_gateway"`
-	OverrideProtocol       string                    `alias:"override_protocol"`
-	OverrideConnectTimeout time.Duration             `alias:"override_connect_timeout"`
+	OverrideMeshGateway        structs.MeshGatewayConfig   `alias:"override_mesh_gateway"`
+	OverrideProtocol           string                      `alias:"override_protocol"`
+	OverrideConnectTimeout     time.Duration               `alias:"override_connect_timeout"`
+	OverrideLimits             *structs.UpstreamLimits     `alias:"override_limits"`
+	OverridePassiveHealthCheck *structs.PassiveHealthCheck `alias:"override_passive_health_check"`
 }
 
 // discoveryChainReadResponse is the API variation of structs.DiscoveryChainResponse
@@ -378,4 +378,29 @@ func TestDiscoveryChainRead(t *testing.T) {
 
 		require.Equal(t, expectModifiedWithOverrides, value.Chain)
 	}))
+
+	require.True(t, t.Run("POST: read chain with limits and passive health check overrides", func(t *testing.T) {
+		body := ` {
+			"override_limits": {
+				"max_connections": 10
+			},
+			"override_passive_health_check": {
+				"interval": "5s",
+				"max_failures": 3
+			}
+		} `
+		req, err := http.NewRequest("POST", "/v1/discovery-chain/web", strings.NewReader(body))
+		require.NoError(t, err)
+
+		resp := httptest.NewRecorder()
+		obj, err := a.srv.DiscoveryChainRead(resp, req)
+		require.NoError(t, err)
+
+		value := obj.(discoveryChainReadResponse)
+
+		resolver := value.Chain.Nodes["resolver:web.default.default.dc1"].Resolver
+		maxConnections := 10
+		require.Equal(t, &structs.UpstreamLimits{MaxConnections: &maxConnections}, resolver.Limits)
+		require.Equal(t, &structs.PassiveHealthCheck{Interval: 5 * time.Second, MaxFailures: 3}, resolver.PassiveHealthCheck)
+	}))
 }
@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package localratelimit
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_local_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	envoy_http_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	envoy_resource_v3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/hashicorp/go-multierror"
+	"github.com/mitchellh/mapstructure"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/envoyextensions/extensioncommon"
+)
+
+const (
+	filterName = "envoy.filters.http.local_ratelimit"
+	statPrefix = "local_ratelimit"
+)
+
+var _ extensioncommon.BasicExtension = (*localRatelimit)(nil)
+
+// localRatelimit limits the rate of the requests received by the local
+// service on the public listener of its sidecar proxy, using token buckets
+// local to each proxy instance.
+type localRatelimit struct {
+	extensioncommon.BasicExtensionAdapter
+
+	ProxyType string
+
+	// RequestsPerSecond and RequestsMaxBurst limit all requests that don't
+	// match any of the Routes. If RequestsPerSecond is zero they are not
+	// limited.
+	RequestsPerSecond int
+	RequestsMaxBurst  int
+
+	// Routes are the limits applied to the requests matching a path. The
+	// first matching route applies.
+	Routes []route
+}
+
+type route struct {
+	PathExact  string
+	PathPrefix string
+	PathRegex  string
+
+	RequestsPerSecond int
+	RequestsMaxBurst  int
+}
+
+// Constructor follows a specific function signature required for the extension registration.
+func Constructor(ext api.EnvoyExtension) (extensioncommon.EnvoyExtender, error) {
+	var r localRatelimit
+	if name := ext.Name; name != api.BuiltinLocalRatelimitExtension {
+		return nil, fmt.Errorf("expected extension name %q but got %q", api.BuiltinLocalRatelimitExtension, name)
+	}
+	if err := r.fromArguments(ext.Arguments); err != nil {
+		return nil, err
+	}
+	return &extensioncommon.BasicEnvoyExtender{
+		Extension: &r,
+	}, nil
+}
+
+func (r *localRatelimit) fromArguments(args map[string]interface{}) error {
+	if err := mapstructure.Decode(args, r); err != nil {
+		return fmt.Errorf("error decoding extension arguments: %v", err)
+	}
+	if r.ProxyType == "" {
+		r.ProxyType = string(api.ServiceKindConnectProxy)
+	}
+	return r.validate()
+}
+
+func (r *localRatelimit) validate() error {
+	var resultErr error
+	if r.ProxyType != string(api.ServiceKindConnectProxy) {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("unexpected ProxyType %q", r.ProxyType))
+	}
+	if r.RequestsPerSecond == 0 && len(r.Routes) == 0 {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("at least one of RequestsPerSecond or Routes is required"))
+	}
+	if err := validateLimit(r.RequestsPerSecond, r.RequestsMaxBurst, false); err != nil {
+		resultErr = multierror.Append(resultErr, err)
+	}
+	for i, rt := range r.Routes {
+		if err := rt.validate(); err != nil {
+			resultErr = multierror.Append(resultErr, fmt.Errorf("Routes[%d]: %w", i, err))
+		}
+	}
+	return resultErr
+}
+
+func (rt route) validate() error {
+	paths := 0
+	if rt.PathExact != "" {
+		paths++
+		if !strings.HasPrefix(rt.PathExact, "/") {
+			return fmt.Errorf("PathExact doesn't start with '/': %q", rt.PathExact)
+		}
+	}
+	if rt.PathPrefix != "" {
+		paths++
+		if !strings.HasPrefix(rt.PathPrefix, "/") {
+			return fmt.Errorf("PathPrefix doesn't start with '/': %q", rt.PathPrefix)
+		}
+	}
+	if rt.PathRegex != "" {
+		paths++
+	}
+	if paths != 1 {
+		return fmt.Errorf("exactly one of PathExact, PathPrefix, or PathRegex is required")
+	}
+	return validateLimit(rt.RequestsPerSecond, rt.RequestsMaxBurst, true)
+}
+
+func validateLimit(rps, burst int, required bool) error {
+	switch {
+	case rps < 0:
+		return fmt.Errorf("RequestsPerSecond must be greater than or equal to zero")
+	case required && rps == 0:
+		return fmt.Errorf("RequestsPerSecond is required")
+	case burst < 0:
+		return fmt.Errorf("RequestsMaxBurst must be greater than or equal to zero")
+	case burst > 0 && burst < rps:
+		return fmt.Errorf("RequestsMaxBurst must be greater than or equal to RequestsPerSecond")
+	}
+	return nil
+}
+
+// CanApply determines if the extension can apply to the given extension configuration.
+func (r *localRatelimit) CanApply(config *extensioncommon.RuntimeConfig) bool {
+	return string(config.Kind) == r.ProxyType
+}
+
+// PatchFilter inserts a local rate limit filter directly prior to
+// envoy.filters.http.router on the public listener, and prepends a route
+// carrying the limits of each of the configured Routes.
+func (r *localRatelimit) PatchFilter(p extensioncommon.FilterPayload) (*envoy_listener_v3.Filter, bool, error) {
+	filter := p.Message
+	if !p.IsInbound() {
+		return filter, false, nil
+	}
+
+	if filter.Name != "envoy.filters.network.http_connection_manager" {
+		return filter, false, nil
+	}
+	if typedConfig := filter.GetTypedConfig(); typedConfig == nil {
+		return filter, false, errors.New("error getting typed config for http filter")
+	}
+
+	config := envoy_resource_v3.GetHTTPConnectionManager(filter)
+	if config == nil {
+		return filter, false, errors.New("error unmarshalling filter")
+	}
+
+	if len(r.Routes) > 0 {
+		routeConfig := config.GetRouteConfig()
+		if routeConfig == nil {
+			return filter, false, errors.New("per-route rate limits require an inline route configuration")
+		}
+		for _, vh := range routeConfig.VirtualHosts {
+			routes, err := r.makeRoutes(vh.Routes)
+			if err != nil {
+				return filter, false, err
+			}
+			vh.Routes = routes
+		}
+	}
+
+	ratelimitHttpFilter, err := extensioncommon.MakeEnvoyHTTPFilter(
+		filterName,
+		makeLocalRateLimit(r.RequestsPerSecond, r.RequestsMaxBurst),
+	)
+	if err != nil {
+		return filter, false, err
+	}
+
+	var (
+		changedFilters = make([]*envoy_http_v3.HttpFilter, 0, len(config.HttpFilters)+1)
+		changed        bool
+	)
+
+	// Insert the rate limit filter before envoy.filters.http.router, after
+	// the RBAC filter so that unauthorized requests don't consume tokens.
+	for _, httpFilter := range config.HttpFilters {
+		if httpFilter.Name == "envoy.filters.http.router" {
+			changedFilters = append(changedFilters, ratelimitHttpFilter)
+			changed = true
+		}
+		changedFilters = append(changedFilters, httpFilter)
+	}
+	if changed {
+		config.HttpFilters = changedFilters
+	}
+
+	newFilter, err := extensioncommon.MakeFilter("envoy.filters.network.http_connection_manager", config)
+	if err != nil {
+		return filter, false, errors.New("error making new filter")
+	}
+
+	return newFilter, true, nil
+}
+
+// makeRoutes returns the given routes preceded by a copy of the catch-all
+// route for each of the configured Routes, matching its path and overriding
+// the rate limit.
+func (r *localRatelimit) makeRoutes(existing []*envoy_route_v3.Route) ([]*envoy_route_v3.Route, error) {
+	if len(existing) == 0 {
+		return existing, nil
+	}
+	template := existing[len(existing)-1]
+
+	routes := make([]*envoy_route_v3.Route, 0, len(r.Routes)+len(existing))
+	for _, rt := range r.Routes {
+		er, ok := proto.Clone(template).(*envoy_route_v3.Route)
+		if !ok {
+			return nil, errors.New("error cloning route")
+		}
+		if er.Match == nil {
+			er.Match = &envoy_route_v3.RouteMatch{}
+		}
+		switch {
+		case rt.PathExact != "":
+			er.Match.PathSpecifier = &envoy_route_v3.RouteMatch_Path{Path: rt.PathExact}
+		case rt.PathPrefix != "":
+			er.Match.PathSpecifier = &envoy_route_v3.RouteMatch_Prefix{Prefix: rt.PathPrefix}
+		case rt.PathRegex != "":
+			er.Match.PathSpecifier = &envoy_route_v3.RouteMatch_SafeRegex{
+				SafeRegex: &envoy_matcher_v3.RegexMatcher{Regex: rt.PathRegex},
+			}
+		}
+
+		cfg, err := anypb.New(makeLocalRateLimit(rt.RequestsPerSecond, rt.RequestsMaxBurst))
+		if err != nil {
+			return nil, err
+		}
+		if er.TypedPerFilterConfig == nil {
+			er.TypedPerFilterConfig = make(map[string]*anypb.Any)
+		}
+		er.TypedPerFilterConfig[filterName] = cfg
+
+		routes = append(routes, er)
+	}
+	return append(routes, existing...), nil
+}
+
+// makeLocalRateLimit returns the configuration of a token bucket refilled
+// with rps tokens every second. If rps is zero the returned configuration
+// doesn't limit any requests.
+func makeLocalRateLimit(rps, burst int) *envoy_local_ratelimit_v3.LocalRateLimit {
+	cfg := &envoy_local_ratelimit_v3.LocalRateLimit{
+		StatPrefix: statPrefix,
+	}
+	if rps <= 0 {
+		return cfg
+	}
+	if burst < rps {
+		burst = rps
+	}
+
+	cfg.TokenBucket = &envoy_type_v3.TokenBucket{
+		MaxTokens:     uint32(burst),
+		TokensPerFill: wrapperspb.UInt32(uint32(rps)),
+		FillInterval:  durationpb.New(time.Second),
+	}
+	cfg.FilterEnabled = makeAllRequests("local_rate_limit_enabled")
+	cfg.FilterEnforced = makeAllRequests("local_rate_limit_enforced")
+	return cfg
+}
+
+func makeAllRequests(runtimeKey string) *envoy_core_v3.RuntimeFractionalPercent {
+	return &envoy_core_v3.RuntimeFractionalPercent{
+		DefaultValue: &envoy_type_v3.FractionalPercent{
+			Numerator:   100,
+			Denominator: envoy_type_v3.FractionalPercent_HUNDRED,
+		},
+		RuntimeKey: runtimeKey,
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package localratelimit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/envoyextensions/extensioncommon"
+)
+
+func TestConstructor(t *testing.T) {
+	makeArguments := func(overrides map[string]interface{}) map[string]interface{} {
+		m := map[string]interface{}{
+			"ProxyType":         "connect-proxy",
+			"RequestsPerSecond": 10,
+			"RequestsMaxBurst":  20,
+		}
+
+		for k, v := range overrides {
+			m[k] = v
+		}
+
+		return m
+	}
+
+	cases := map[string]struct {
+		extensionName string
+		arguments     map[string]interface{}
+		expected      localRatelimit
+		ok            bool
+	}{
+		"with no arguments": {
+			arguments: nil,
+			ok:        false,
+		},
+		"with an invalid name": {
+			arguments:     makeArguments(map[string]interface{}{}),
+			extensionName: "bad",
+			ok:            false,
+		},
+		"invalid proxy type": {
+			arguments: makeArguments(map[string]interface{}{"ProxyType": "terminating-gateway"}),
+			ok:        false,
+		},
+		"burst lower than requests per second": {
+			arguments: makeArguments(map[string]interface{}{"RequestsMaxBurst": 5}),
+			ok:        false,
+		},
+		"route without path": {
+			arguments: makeArguments(map[string]interface{}{
+				"Routes": []interface{}{
+					map[string]interface{}{"RequestsPerSecond": 1},
+				},
+			}),
+			ok: false,
+		},
+		"route without requests per second": {
+			arguments: makeArguments(map[string]interface{}{
+				"Routes": []interface{}{
+					map[string]interface{}{"PathExact": "/admin"},
+				},
+			}),
+			ok: false,
+		},
+		"default proxy type": {
+			arguments: makeArguments(map[string]interface{}{"ProxyType": ""}),
+			expected: localRatelimit{
+				ProxyType:         "connect-proxy",
+				RequestsPerSecond: 10,
+				RequestsMaxBurst:  20,
+			},
+			ok: true,
+		},
+		"valid everything": {
+			arguments: makeArguments(map[string]interface{}{
+				// Arguments decoded from JSON hold floats.
+				"RequestsPerSecond": float64(10),
+				"Routes": []interface{}{
+					map[string]interface{}{"PathPrefix": "/api/", "RequestsPerSecond": float64(1)},
+				},
+			}),
+			expected: localRatelimit{
+				ProxyType:         "connect-proxy",
+				RequestsPerSecond: 10,
+				RequestsMaxBurst:  20,
+				Routes: []route{
+					{PathPrefix: "/api/", RequestsPerSecond: 1},
+				},
+			},
+			ok: true,
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+
+			extensionName := api.BuiltinLocalRatelimitExtension
+			if tc.extensionName != "" {
+				extensionName = tc.extensionName
+			}
+
+			svc := api.CompoundServiceName{Name: "svc"}
+			ext := extensioncommon.RuntimeConfig{
+				ServiceName: svc,
+				EnvoyExtension: api.EnvoyExtension{
+					Name:      extensionName,
+					Arguments: tc.arguments,
+				},
+			}
+
+			e, err := Constructor(ext.EnvoyExtension)
+
+			if tc.ok {
+				require.NoError(t, err)
+				require.Equal(t, &extensioncommon.BasicEnvoyExtender{Extension: &tc.expected}, e)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	awslambda "github.com/hashicorp/consul/agent/envoyextensions/builtin/aws-lambda"
 	extauthz "github.com/hashicorp/consul/agent/envoyextensions/builtin/ext-authz"
+	localratelimit "github.com/hashicorp/consul/agent/envoyextensions/builtin/local-ratelimit"
 	"github.com/hashicorp/consul/agent/envoyextensions/builtin/lua"
 	otelaccesslogging "github.com/hashicorp/consul/agent/envoyextensions/builtin/otel-access-logging"
 	propertyoverride "github.com/hashicorp/consul/agent/envoyextensions/builtin/property-override"
@@ -28,6 +29,7 @@ var extensionConstructors = map[string]extensionConstructor{
 	api.BuiltinPropertyOverrideExtension:  propertyoverride.Constructor,
 	api.BuiltinWasmExtension:              wasm.Constructor,
 	api.BuiltinExtAuthzExtension:          extauthz.Constructor,
+	api.BuiltinLocalRatelimitExtension:    localratelimit.Constructor,
 }
 
 // ConstructExtension attempts to lookup and build an extension from the registry with the
@@ -378,6 +378,7 @@ func (s *Server) realHandleStream(streamReq HandleStreamRequest) error {
 				status.TrackSendError(err.Error())
 			} else {
 				status.TrackSendSuccess()
+				trackTrustBundleSent(status, msg.GetResponse())
 			}
 		}
 		return err
@@ -597,6 +598,9 @@ func (s *Server) realHandleStream(streamReq HandleStreamRequest) error {
 				case req.Error == nil: // ACK
 					// TODO(peering): handle ACK fully
 					status.TrackAck()
+					if req.ResourceURL == pbpeerstream.TypeURLPeeringTrustBundle {
+						status.TrackTrustBundleAck(req.ResponseNonce)
+					}
 
 				case req.Error != nil: // NACK
 					// TODO(peering): handle NACK fully
@@ -709,6 +713,19 @@ func (s *Server) realHandleStream(streamReq HandleStreamRequest) error {
 	}
 }
 
+// trackTrustBundleSent records the roots of a trust bundle sent to the peer so
+// that the progress of CA rotations can be reported.
+func trackTrustBundleSent(status *MutableStatus, resp *pbpeerstream.ReplicationMessage_Response) {
+	if resp.GetResourceURL() != pbpeerstream.TypeURLPeeringTrustBundle || resp.Resource == nil {
+		return
+	}
+	var bundle pbpeering.PeeringTrustBundle
+	if err := resp.Resource.UnmarshalTo(&bundle); err != nil {
+		return
+	}
+	status.TrackTrustBundleSent(bundle.RootPEMs, resp.Nonce)
+}
+
 func getTrustDomain(store StateStore, logger hclog.Logger) (string, error) {
 	_, cfg, err := store.CAConfig(nil)
 	switch {
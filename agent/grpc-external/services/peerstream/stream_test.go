@@ -586,6 +586,12 @@ func TestStreamResources_Server_StreamTracker(t *testing.T) {
 	require.True(t, ok)
 	lastSendSuccess = status.LastSendSuccess
 
+	// The trust bundle is tracked with the roots that were sent.
+	trustBundleRootPEMs := status.TrustBundleRootPEMs
+	lastTrustBundleSent := status.LastTrustBundleSent
+	require.Len(t, trustBundleRootPEMs, 1)
+	require.NotNil(t, lastTrustBundleSent)
+
 	testutil.RunStep(t, "ack tracked as success", func(t *testing.T) {
 		ack := &pbpeerstream.ReplicationMessage{
 			Payload: &pbpeerstream.ReplicationMessage_Request_{
@@ -605,10 +611,12 @@ func TestStreamResources_Server_StreamTracker(t *testing.T) {
 		require.NoError(t, err)
 
 		expect := Status{
-			Connected:        true,
-			LastSendSuccess:  lastSendSuccess,
-			LastAck:          &lastSendAck,
-			ExportedServices: []string{},
+			Connected:           true,
+			LastSendSuccess:     lastSendSuccess,
+			TrustBundleRootPEMs: trustBundleRootPEMs,
+			LastTrustBundleSent: lastTrustBundleSent,
+			LastAck:             &lastSendAck,
+			ExportedServices:    []string{},
 		}
 		retry.Run(t, func(r *retry.R) {
 			rStatus, ok := srv.StreamStatus(testPeerID)
@@ -642,12 +650,14 @@ func TestStreamResources_Server_StreamTracker(t *testing.T) {
 		lastNackMsg = "client peer was unable to apply resource: bad bad not good"
 
 		expect := Status{
-			Connected:        true,
-			LastSendSuccess:  lastSendSuccess,
-			LastAck:          &lastSendAck,
-			LastNack:         &lastNack,
-			LastNackMessage:  lastNackMsg,
-			ExportedServices: []string{},
+			Connected:           true,
+			LastSendSuccess:     lastSendSuccess,
+			TrustBundleRootPEMs: trustBundleRootPEMs,
+			LastTrustBundleSent: lastTrustBundleSent,
+			LastAck:             &lastSendAck,
+			LastNack:            &lastNack,
+			LastNackMessage:     lastNackMsg,
+			ExportedServices:    []string{},
 		}
 
 		retry.Run(t, func(r *retry.R) {
@@ -696,6 +706,8 @@ func TestStreamResources_Server_StreamTracker(t *testing.T) {
 		expect := Status{
 			Connected:               true,
 			LastSendSuccess:         lastSendSuccess,
+			TrustBundleRootPEMs:     trustBundleRootPEMs,
+			LastTrustBundleSent:     lastTrustBundleSent,
 			LastAck:                 &lastSendAck,
 			LastNack:                &lastNack,
 			LastNackMessage:         lastNackMsg,
@@ -752,6 +764,8 @@ func TestStreamResources_Server_StreamTracker(t *testing.T) {
 		expect := Status{
 			Connected:               true,
 			LastSendSuccess:         lastSendSuccess,
+			TrustBundleRootPEMs:     trustBundleRootPEMs,
+			LastTrustBundleSent:     lastTrustBundleSent,
 			LastAck:                 &lastSendAck,
 			LastNack:                &lastNack,
 			LastNackMessage:         lastNackMsg,
@@ -782,6 +796,8 @@ func TestStreamResources_Server_StreamTracker(t *testing.T) {
 		expect := Status{
 			Connected:               true,
 			LastSendSuccess:         lastSendSuccess,
+			TrustBundleRootPEMs:     trustBundleRootPEMs,
+			LastTrustBundleSent:     lastTrustBundleSent,
 			LastAck:                 &lastSendAck,
 			LastNack:                &lastNack,
 			LastNackMessage:         lastNackMsg,
@@ -810,6 +826,8 @@ func TestStreamResources_Server_StreamTracker(t *testing.T) {
 			Connected:               false,
 			DisconnectErrorMessage:  lastRecvErrorMsg,
 			LastSendSuccess:         lastSendSuccess,
+			TrustBundleRootPEMs:     trustBundleRootPEMs,
+			LastTrustBundleSent:     lastTrustBundleSent,
 			LastAck:                 &lastSendAck,
 			LastNack:                &lastNack,
 			LastNackMessage:         lastNackMsg,
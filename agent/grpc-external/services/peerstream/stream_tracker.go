@@ -193,6 +193,9 @@ type MutableStatus struct {
 	// to the peer before the stream's context is cancelled.
 	doneCh chan struct{}
 
+	// trustBundleNonce is the nonce of the last trust bundle sent to the peer.
+	trustBundleNonce string
+
 	Status
 }
 
@@ -244,6 +247,16 @@ type Status struct {
 	// LastRecvErrorMessage tracks the last error message when receiving from the stream.
 	LastRecvErrorMessage string
 
+	// TrustBundleRootPEMs are the root certificates of the last trust bundle sent TO the peer.
+	TrustBundleRootPEMs []string
+
+	// LastTrustBundleSent tracks the time we last sent a trust bundle TO the peer.
+	LastTrustBundleSent *time.Time
+
+	// LastTrustBundleAck tracks the time the peer acknowledged the last trust bundle sent to it.
+	// It is nil if the last trust bundle was not acknowledged yet.
+	LastTrustBundleAck *time.Time
+
 	// TODO(peering): consider keeping track of imported and exported services thru raft
 	// ImportedServices keeps track of which service names are imported for the peer
 	ImportedServices []string
@@ -293,6 +306,29 @@ func (s *MutableStatus) TrackSendSuccess() {
 	s.mu.Unlock()
 }
 
+// TrackTrustBundleSent tracks sending a trust bundle with the given root
+// certificates in the response with the given nonce. It must be called after
+// TrackSendSuccess, whose time is used as the time the bundle was sent.
+func (s *MutableStatus) TrackTrustBundleSent(rootPEMs []string, nonce string) {
+	s.mu.Lock()
+	s.TrustBundleRootPEMs = rootPEMs
+	s.LastTrustBundleSent = s.LastSendSuccess
+	s.LastTrustBundleAck = nil
+	s.trustBundleNonce = nonce
+	s.mu.Unlock()
+}
+
+// TrackTrustBundleAck tracks the peer acknowledging the trust bundle sent in
+// the response with the given nonce. It must be called after TrackAck, whose
+// time is used as the time the bundle was acknowledged.
+func (s *MutableStatus) TrackTrustBundleAck(nonce string) {
+	s.mu.Lock()
+	if s.trustBundleNonce != "" && s.trustBundleNonce == nonce {
+		s.LastTrustBundleAck = s.LastAck
+	}
+	s.mu.Unlock()
+}
+
 // TrackRecvResourceSuccess tracks receiving a replicated resource.
 func (s *MutableStatus) TrackRecvResourceSuccess() {
 	s.mu.Lock()
@@ -327,3 +327,35 @@ func TestMutableStatus_TrackDisconnectedDueToError(t *testing.T) {
 	require.Equal(t, disconnectTime, s.DisconnectTime)
 	require.Equal(t, "disconnect err", s.DisconnectErrorMessage)
 }
+
+func TestMutableStatus_TrackTrustBundle(t *testing.T) {
+	it := incrementalTime{
+		base: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	s := MutableStatus{
+		timeNow: it.Now,
+	}
+
+	s.TrackSendSuccess()
+	s.TrackTrustBundleSent([]string{"root-1"}, "00000001")
+	require.Equal(t, []string{"root-1"}, s.TrustBundleRootPEMs)
+	require.Equal(t, s.LastSendSuccess, s.LastTrustBundleSent)
+	require.Nil(t, s.LastTrustBundleAck)
+
+	// Acks for other responses are ignored.
+	s.TrackAck()
+	s.TrackTrustBundleAck("00000002")
+	require.Nil(t, s.LastTrustBundleAck)
+
+	s.TrackAck()
+	s.TrackTrustBundleAck("00000001")
+	require.Equal(t, ptr(it.StaticNow()), s.LastTrustBundleAck)
+
+	// Sending a new bundle resets the ack.
+	s.TrackSendSuccess()
+	s.TrackTrustBundleSent([]string{"root-1", "root-2"}, "00000003")
+	require.Equal(t, []string{"root-1", "root-2"}, s.TrustBundleRootPEMs)
+	require.Equal(t, ptr(it.StaticNow()), s.LastTrustBundleSent)
+	require.Nil(t, s.LastTrustBundleAck)
+}
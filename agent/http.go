@@ -415,6 +415,8 @@ func (s *HTTPHandlers) wrap(handler endpoint, methods []string) http.HandlerFunc
 			return
 		}
 
+		req = s.authenticateClientCert(req, httpLogger, logURL)
+
 		isForbidden := func(err error) bool {
 			if acl.IsErrPermissionDenied(err) || acl.IsErrNotFound(err) {
 				return true
@@ -1000,7 +1002,15 @@ func parseCacheControl(resp http.ResponseWriter, req *http.Request, b QueryOptio
 	return false
 }
 
-// parseConsistency is used to parse the ?stale, ?consistent, and ?leader query params.
+// followerConsistentQueryOptions is implemented by the query options of the
+// endpoints that support the follower-consistent mode.
+type followerConsistentQueryOptions interface {
+	GetFollowerConsistent() bool
+	SetFollowerConsistent(bool)
+}
+
+// parseConsistency is used to parse the ?stale, ?consistent, ?follower-consistent,
+// and ?leader query params.
 // Returns true on error
 func (s *HTTPHandlers) parseConsistency(resp http.ResponseWriter, req *http.Request, b QueryOptionsCompat) bool {
 	query := req.URL.Query()
@@ -1013,6 +1023,18 @@ func (s *HTTPHandlers) parseConsistency(resp http.ResponseWriter, req *http.Requ
 		b.SetRequireConsistent(true)
 		defaults = false
 	}
+	followerConsistent := false
+	if _, ok := query["follower-consistent"]; ok {
+		fc, ok := b.(followerConsistentQueryOptions)
+		if !ok {
+			resp.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(resp, "?follower-consistent is not supported by this endpoint.")
+			return true
+		}
+		fc.SetFollowerConsistent(true)
+		followerConsistent = true
+		defaults = false
+	}
 	if _, ok := query["leader"]; ok {
 		// The leader query param forces use of the "default" consistency mode.
 		// This allows the "default" consistency mode to be used even the consistency mode is
@@ -1056,6 +1078,11 @@ func (s *HTTPHandlers) parseConsistency(resp http.ResponseWriter, req *http.Requ
 		fmt.Fprint(resp, "Cannot specify ?cached with ?consistent, conflicting semantics.")
 		return true
 	}
+	if followerConsistent && (b.GetAllowStale() || b.GetRequireConsistent() || b.GetUseCache()) {
+		resp.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(resp, "Cannot specify ?follower-consistent with ?stale, ?consistent or ?cached, conflicting semantics.")
+		return true
+	}
 	return false
 }
 
@@ -1080,7 +1107,8 @@ func (s *HTTPHandlers) parseDC(req *http.Request, dc *string) {
 }
 
 // parseTokenInternal is used to parse the ?token query param or the X-Consul-Token header or
-// Authorization Bearer token (RFC6750).
+// Authorization Bearer token (RFC6750). Otherwise the token bound to the client certificate
+// of the request is used.
 func (s *HTTPHandlers) parseTokenInternal(req *http.Request, token *string) {
 	if other := req.URL.Query().Get("token"); other != "" {
 		*token = other
@@ -1091,6 +1119,13 @@ func (s *HTTPHandlers) parseTokenInternal(req *http.Request, token *string) {
 		return
 	}
 
+	// Requests without a token use the token bound to their verified client
+	// certificate, if any.
+	if other, ok := req.Context().Value(clientCertTokenKey{}).(string); ok {
+		*token = other
+		return
+	}
+
 	*token = ""
 	return
 }
@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package agent
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/config"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// clientCertTokenKey is the request context key under which the token for
+// the client certificate binding of a request is stored.
+type clientCertTokenKey struct{}
+
+// clientCertTokens caches the accessor ID of the agent token, which client
+// certificate tokens name so that the servers can verify them.
+type clientCertTokens struct {
+	lock                 sync.Mutex
+	agentTokenSecret     string
+	agentTokenAccessorID string
+}
+
+// authenticateClientCert looks up the client certificate binding for
+// requests that do not provide a token themselves. When one matches, a token
+// for the roles and policies of the binding is attached to the request
+// context for parseTokenInternal and the identity that was used is logged.
+func (s *HTTPHandlers) authenticateClientCert(req *http.Request, logger hclog.Logger, logURL string) *http.Request {
+	bindings := s.agent.config.HTTPClientCertBindings
+	if len(bindings) == 0 || !s.agent.config.ACLsEnabled {
+		return req
+	}
+
+	var token string
+	s.parseTokenInternal(req, &token)
+	if token != "" {
+		return req
+	}
+
+	binding, identity := clientCertBinding(bindings, req)
+	if binding == nil {
+		return req
+	}
+
+	bound, err := s.agent.clientCertToken(req.Context(), binding)
+	if err != nil {
+		logger.Error("Failed to authenticate client certificate",
+			"method", req.Method,
+			"url", logURL,
+			"from", req.RemoteAddr,
+			"binding", binding.Name,
+			"identity", identity,
+			"error", err,
+		)
+		return req
+	}
+
+	logger.Info("Request authenticated with client certificate",
+		"method", req.Method,
+		"url", logURL,
+		"from", req.RemoteAddr,
+		"binding", binding.Name,
+		"identity", identity,
+		"accessorID", structs.ACLClientCertAccessorID(bound),
+	)
+	return req.WithContext(context.WithValue(req.Context(), clientCertTokenKey{}, bound))
+}
+
+// clientCertToken returns the token for the roles and policies of the
+// binding. Nothing is written to the state store: the token names the
+// binding and is signed with the agent token, and the servers synthesize an
+// identity with the roles and policies when they resolve it.
+func (a *Agent) clientCertToken(ctx context.Context, binding *config.HTTPClientCertBinding) (string, error) {
+	agentToken := a.tokens.AgentToken()
+	accessorID, err := a.agentTokenAccessorID(ctx, agentToken)
+	if err != nil {
+		return "", err
+	}
+
+	identity := structs.ACLClientCertIdentity{
+		AgentTokenAccessorID: accessorID,
+		Node:                 a.config.NodeName,
+		Binding:              binding.Name,
+		Roles:                binding.Roles,
+		Policies:             binding.Policies,
+	}
+	return identity.Token(agentToken)
+}
+
+// agentTokenAccessorID returns the accessor ID of the agent token. It is read
+// again whenever the agent token changes.
+func (a *Agent) agentTokenAccessorID(ctx context.Context, agentToken string) (string, error) {
+	a.clientCertTokens.lock.Lock()
+	defer a.clientCertTokens.lock.Unlock()
+
+	if a.clientCertTokens.agentTokenAccessorID != "" && a.clientCertTokens.agentTokenSecret == agentToken {
+		return a.clientCertTokens.agentTokenAccessorID, nil
+	}
+
+	args := structs.ACLTokenGetRequest{
+		Datacenter:     a.config.Datacenter,
+		TokenID:        agentToken,
+		TokenIDType:    structs.ACLTokenSecret,
+		EnterpriseMeta: *a.AgentEnterpriseMeta(),
+		QueryOptions:   structs.QueryOptions{Token: agentToken},
+	}
+	var out structs.ACLTokenResponse
+	if err := a.RPC(ctx, "ACL.TokenRead", &args, &out); err != nil {
+		return "", err
+	}
+	if out.Token == nil {
+		return "", fmt.Errorf("agent token not found")
+	}
+	if out.Token.AccessorID == acl.AnonymousTokenID {
+		return "", fmt.Errorf("client certificate bindings require an agent token")
+	}
+
+	a.clientCertTokens.agentTokenSecret = agentToken
+	a.clientCertTokens.agentTokenAccessorID = out.Token.AccessorID
+	return out.Token.AccessorID, nil
+}
+
+// clientCertBinding returns the first binding that matches the verified
+// client certificate of the request, along with the certificate identity it
+// matched. Certificates that were not verified against the CA, which is only
+// the case when tls.https.verify_incoming is disabled, never match.
+func clientCertBinding(bindings []config.HTTPClientCertBinding, req *http.Request) (*config.HTTPClientCertBinding, string) {
+	if req.TLS == nil || len(req.TLS.VerifiedChains) == 0 || len(req.TLS.VerifiedChains[0]) == 0 {
+		return nil, ""
+	}
+	cert := req.TLS.VerifiedChains[0][0]
+
+	for i := range bindings {
+		if identity, ok := matchClientCert(bindings[i], cert); ok {
+			return &bindings[i], identity
+		}
+	}
+	return nil, ""
+}
+
+// matchClientCert reports whether the certificate satisfies every selector
+// that is set on the binding, and returns the most specific identity that
+// matched.
+func matchClientCert(binding config.HTTPClientCertBinding, cert *x509.Certificate) (string, bool) {
+	var identities []string
+
+	if binding.SubjectCN != "" {
+		if cert.Subject.CommonName != binding.SubjectCN {
+			return "", false
+		}
+		identities = append(identities, "cn:"+cert.Subject.CommonName)
+	}
+
+	if len(binding.EmailSANs) > 0 {
+		email, ok := matchAny(binding.EmailSANs, cert.EmailAddresses, strings.EqualFold)
+		if !ok {
+			return "", false
+		}
+		identities = append(identities, "email:"+email)
+	}
+
+	if len(binding.DNSSANs) > 0 {
+		name, ok := matchAny(binding.DNSSANs, cert.DNSNames, strings.EqualFold)
+		if !ok {
+			return "", false
+		}
+		identities = append(identities, "dns:"+name)
+	}
+
+	if len(binding.URISANs) > 0 {
+		uris := make([]string, 0, len(cert.URIs))
+		for _, uri := range cert.URIs {
+			uris = append(uris, uri.String())
+		}
+		uri, ok := matchAny(binding.URISANs, uris, func(a, b string) bool { return a == b })
+		if !ok {
+			return "", false
+		}
+		identities = append(identities, "uri:"+uri)
+	}
+
+	if len(identities) == 0 {
+		return "", false
+	}
+	return identities[len(identities)-1], true
+}
+
+// matchAny returns the first value that equals one of the wanted values.
+func matchAny(want, values []string, equal func(a, b string) bool) (string, bool) {
+	for _, value := range values {
+		for _, w := range want {
+			if equal(w, value) {
+				return value, true
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package agent
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/config"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestClientCertBinding(t *testing.T) {
+	spiffeID, err := url.Parse("spiffe://example.com/ops")
+	require.NoError(t, err)
+
+	cert := &x509.Certificate{
+		Subject:        pkix.Name{CommonName: "ops"},
+		DNSNames:       []string{"ops.example.com"},
+		EmailAddresses: []string{"ops@example.com"},
+		URIs:           []*url.URL{spiffeID},
+	}
+
+	requestWithCert := func(cert *x509.Certificate) *http.Request {
+		req := httptest.NewRequest("GET", "/v1/agent/self", nil)
+		req.TLS = &tls.ConnectionState{
+			PeerCertificates: []*x509.Certificate{cert},
+			VerifiedChains:   [][]*x509.Certificate{{cert}},
+		}
+		return req
+	}
+
+	cases := map[string]struct {
+		bindings []config.HTTPClientCertBinding
+		req      *http.Request
+		binding  string
+		identity string
+	}{
+		"subject cn": {
+			bindings: []config.HTTPClientCertBinding{{Name: "ops", SubjectCN: "ops", Roles: []string{"a"}}},
+			req:      requestWithCert(cert),
+			binding:  "ops",
+			identity: "cn:ops",
+		},
+		"dns san is case insensitive": {
+			bindings: []config.HTTPClientCertBinding{{Name: "ops", DNSSANs: []string{"OPS.example.com"}, Roles: []string{"a"}}},
+			req:      requestWithCert(cert),
+			binding:  "ops",
+			identity: "dns:ops.example.com",
+		},
+		"all selectors must match": {
+			bindings: []config.HTTPClientCertBinding{
+				{Name: "web", SubjectCN: "ops", URISANs: []string{"spiffe://example.com/web"}, Roles: []string{"a"}},
+				{Name: "ops", SubjectCN: "ops", URISANs: []string{"spiffe://example.com/ops"}, Roles: []string{"b"}},
+			},
+			req:      requestWithCert(cert),
+			binding:  "ops",
+			identity: "uri:spiffe://example.com/ops",
+		},
+		"first match wins": {
+			bindings: []config.HTTPClientCertBinding{
+				{Name: "email", EmailSANs: []string{"ops@example.com"}, Roles: []string{"a"}},
+				{Name: "cn", SubjectCN: "ops", Roles: []string{"b"}},
+			},
+			req:      requestWithCert(cert),
+			binding:  "email",
+			identity: "email:ops@example.com",
+		},
+		"no match": {
+			bindings: []config.HTTPClientCertBinding{{Name: "web", SubjectCN: "web", Roles: []string{"a"}}},
+			req:      requestWithCert(cert),
+		},
+		"no tls": {
+			bindings: []config.HTTPClientCertBinding{{Name: "ops", SubjectCN: "ops", Roles: []string{"a"}}},
+			req:      httptest.NewRequest("GET", "/v1/agent/self", nil),
+		},
+		"unverified certificate": {
+			bindings: []config.HTTPClientCertBinding{{Name: "ops", SubjectCN: "ops", Roles: []string{"a"}}},
+			req: func() *http.Request {
+				req := requestWithCert(cert)
+				req.TLS.VerifiedChains = nil
+				return req
+			}(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			binding, identity := clientCertBinding(tc.bindings, tc.req)
+			if tc.binding == "" {
+				require.Nil(t, binding)
+				return
+			}
+			require.NotNil(t, binding)
+			require.Equal(t, tc.binding, binding.Name)
+			require.Equal(t, tc.identity, identity)
+		})
+	}
+}
+
+func TestHTTPHandlers_ClientCertACLIdentity(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := NewTestAgent(t, TestACLConfig()+`
+		tls {
+			defaults {
+				ca_file = "../test/client_certs/rootca.crt"
+				cert_file = "../test/client_certs/server.crt"
+				key_file = "../test/client_certs/server.key"
+			}
+			https {
+				verify_incoming = true
+			}
+		}
+		http_config {
+			client_cert_bindings = [
+				{
+					name = "operators"
+					subject_cn = "operator"
+					policies = ["agent-read"]
+				}
+			]
+		}
+	`)
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1", testrpc.WithToken("root"))
+
+	policyReq := structs.ACLPolicySetRequest{
+		Policy: structs.ACLPolicy{
+			Name:  "agent-read",
+			Rules: `agent_prefix "" { policy = "read" }`,
+		},
+		Datacenter:   "dc1",
+		WriteRequest: structs.WriteRequest{Token: "root"},
+	}
+	require.NoError(t, a.RPC(context.Background(), "ACL.PolicySet", &policyReq, &structs.ACLPolicy{}))
+
+	request := func(commonName, token string) int {
+		req := httptest.NewRequest("GET", "/v1/agent/self", nil)
+		if token != "" {
+			req.Header.Set("X-Consul-Token", token)
+		}
+		if commonName != "" {
+			cert := &x509.Certificate{Subject: pkix.Name{CommonName: commonName}}
+			req.TLS = &tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{cert},
+				VerifiedChains:   [][]*x509.Certificate{{cert}},
+			}
+		}
+		resp := httptest.NewRecorder()
+		a.srv.handler().ServeHTTP(resp, req)
+		return resp.Code
+	}
+
+	t.Run("bound certificate", func(t *testing.T) {
+		require.Equal(t, http.StatusOK, request("operator", ""))
+
+		// The servers synthesize the identity of the binding, no token is
+		// created for it.
+		bound, err := a.clientCertToken(context.Background(), &a.config.HTTPClientCertBindings[0])
+		require.NoError(t, err)
+
+		var out structs.ACLTokenResponse
+		err = a.RPC(context.Background(), "ACL.TokenRead", &structs.ACLTokenGetRequest{
+			TokenID:      bound,
+			TokenIDType:  structs.ACLTokenSecret,
+			Datacenter:   "dc1",
+			QueryOptions: structs.QueryOptions{Token: bound},
+		}, &out)
+		require.NoError(t, err)
+		require.NotNil(t, out.Token)
+		token := out.Token
+		require.Equal(t, structs.ACLClientCertAccessorID(bound), token.AccessorID)
+		require.Equal(t, []structs.ACLTokenPolicyLink{{ID: token.Policies[0].ID, Name: "agent-read"}}, token.Policies)
+
+		var tokens structs.ACLTokenListResponse
+		err = a.RPC(context.Background(), "ACL.TokenList", &structs.ACLTokenListRequest{
+			Datacenter:   "dc1",
+			IncludeLocal: true,
+			QueryOptions: structs.QueryOptions{Token: "root"},
+		}, &tokens)
+		require.NoError(t, err)
+		for _, stub := range tokens.Tokens {
+			require.NotEqual(t, token.AccessorID, stub.AccessorID)
+		}
+	})
+
+	t.Run("unbound certificate", func(t *testing.T) {
+		require.Equal(t, http.StatusForbidden, request("guest", ""))
+	})
+
+	t.Run("no certificate", func(t *testing.T) {
+		require.Equal(t, http.StatusForbidden, request("", ""))
+	})
+
+	t.Run("token takes precedence", func(t *testing.T) {
+		require.Equal(t, http.StatusForbidden, request("operator", "anonymous"))
+	})
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/hashicorp/consul/agent/structs"
 	tokenStore "github.com/hashicorp/consul/agent/token"
 	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/proto/private/pbcommon"
 	"github.com/hashicorp/consul/sdk/testutil"
 	"github.com/hashicorp/consul/sdk/testutil/retry"
 	"github.com/hashicorp/consul/testrpc"
@@ -1336,6 +1337,39 @@ func TestParseConsistency_Invalid(t *testing.T) {
 	}
 }
 
+func TestParseConsistency_FollowerConsistent(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := NewTestAgent(t, "")
+	a.config.DiscoveryMaxStale = 7 * time.Second
+
+	// The discovery_max_stale default does not apply.
+	var b structs.QueryOptions
+	req, _ := http.NewRequest("GET", "/v1/catalog/nodes?follower-consistent", nil)
+	require.False(t, a.srv.parseConsistency(httptest.NewRecorder(), req, &b))
+	require.True(t, b.FollowerConsistent)
+	require.False(t, b.AllowStale)
+	require.False(t, b.RequireConsistent)
+	require.Equal(t, "follower-consistent", b.ConsistencyLevel())
+
+	for _, query := range []string{"stale", "consistent", "max_stale=3s"} {
+		b = structs.QueryOptions{}
+		resp := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/v1/catalog/nodes?follower-consistent&"+query, nil)
+		require.True(t, a.srv.parseConsistency(resp, req, &b), query)
+		require.Equal(t, http.StatusBadRequest, resp.Code, query)
+	}
+
+	// Endpoints backed by protobuf request types do not support it.
+	resp := httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/v1/catalog/nodes?follower-consistent", nil)
+	require.True(t, a.srv.parseConsistency(resp, req, &pbcommon.QueryOptions{}))
+	require.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
 // Test ACL token is resolved in correct order
 func TestACLResolution(t *testing.T) {
 	if testing.Short() {
@@ -133,4 +133,13 @@ type fetchState struct {
 	// use this to choose a new window for the next retry. See comment on
 	// const caChangeJitterWindow above for more.
 	consecutiveRateLimitErrs int
+
+	// replacedCertPEM is set when the current cert must be replaced because
+	// its service identity was revoked. It is sent along with the next CSR so
+	// that the servers add the replaced cert to the revocation lists.
+	replacedCertPEM string
+
+	// handledRevocations are the IDs of the service identity revocations that
+	// existed when the current cert was issued. They don't apply to it.
+	handledRevocations map[string]struct{}
 }
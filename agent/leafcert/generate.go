@@ -85,11 +85,16 @@ func (m *Manager) attemptLeafRefresh(
 		} else if roots == nil {
 			return nil, state, errors.New("no CA roots")
 		}
-		if activeRootHasKey(roots, state.authorityKeyID) {
+		revocation := revokedBy(roots, existing, state)
+		if activeRootHasKey(roots, state.authorityKeyID) && revocation == nil {
 			return nil, state, nil
 		}
+		if revocation != nil && revocation.Service != "" {
+			state.replacedCertPEM = existing.CertPEM
+		}
 
-		// if we reach here then the current leaf was not signed by the same CAs, just regen
+		// if we reach here then the current leaf was not signed by the same CAs
+		// or was revoked, just regen
 		return m.generateNewLeaf(req, state, false)
 	}
 
@@ -153,14 +158,20 @@ func (m *Manager) attemptLeafRefresh(
 			// rootsWatcher didn't know about the CA we were signed by. We also rely
 			// on this on every request to do the initial check that the current roots
 			// are the same ones the current cert was signed by.
-			if activeRootHasKey(roots, state.authorityKeyID) {
-				// Current active CA is the same one that signed our current cert so
-				// keep waiting for a change.
+			revocation := revokedBy(roots, existing, state)
+			if activeRootHasKey(roots, state.authorityKeyID) && revocation == nil {
+				// Current active CA is the same one that signed our current cert
+				// and the cert wasn't revoked so keep waiting for a change.
 				continue
 			}
+			if revocation != nil && revocation.Service != "" {
+				// Report the replaced cert so that it is revoked as well.
+				state.replacedCertPEM = existing.CertPEM
+			}
 			state.activeRootRotationStart = time.Now()
 
-			// CA root changed. We add some jitter here to avoid a thundering herd.
+			// CA root changed or the cert was revoked. We add some jitter here to
+			// avoid a thundering herd.
 			// See docs on caChangeJitterWindow const.
 			delay := m.getJitteredCAChangeDelay()
 
@@ -193,6 +204,36 @@ func (m *Manager) getJitteredCAChangeDelay() time.Duration {
 	return lib.RandomStagger(caChangeJitterWindow)
 }
 
+// revokedBy returns the revocation that applies to the existing cert, or nil if
+// the cert wasn't revoked.
+func revokedBy(roots *structs.IndexedCARoots, existing *structs.IssuedCert, state fetchState) *structs.CARevocation {
+	for _, r := range roots.Revocations {
+		if _, ok := state.handledRevocations[r.ID]; ok {
+			continue
+		}
+		if r.RevokesCert(existing.SerialNumber, state.authorityKeyID, existing.Service, &existing.EnterpriseMeta, existing.ValidAfter) {
+			return r
+		}
+	}
+	return nil
+}
+
+// identityRevocationIDs returns the IDs of all service identity revocations in
+// roots.
+func identityRevocationIDs(roots *structs.IndexedCARoots) map[string]struct{} {
+	var ids map[string]struct{}
+	for _, r := range roots.Revocations {
+		if r.Service == "" {
+			continue
+		}
+		if ids == nil {
+			ids = make(map[string]struct{})
+		}
+		ids[r.ID] = struct{}{}
+	}
+	return ids
+}
+
 func activeRootHasKey(roots *structs.IndexedCARoots, currentSigningKeyID string) bool {
 	for _, ca := range roots.Roots {
 		if ca.Active {
@@ -312,6 +353,7 @@ func (m *Manager) generateNewLeaf(
 		WriteRequest: structs.WriteRequest{Token: req.Token},
 		Datacenter:   req.Datacenter,
 		CSR:          csr,
+		ReplacedCert: newState.replacedCertPEM,
 	}
 
 	reply, err := m.certSigner.SignCert(context.Background(), &args)
@@ -361,6 +403,8 @@ func (m *Manager) generateNewLeaf(
 	newState.forceExpireAfter = time.Time{}
 	newState.consecutiveRateLimitErrs = 0
 	newState.activeRootRotationStart = time.Time{}
+	newState.replacedCertPEM = ""
+	newState.handledRevocations = identityRevocationIDs(roots)
 
 	cert, err := connect.ParseCert(reply.CertPEM)
 	if err != nil {
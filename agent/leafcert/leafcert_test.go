@@ -1040,3 +1040,84 @@ func testAsyncGet(t *testing.T, m *Manager, req *ConnectCALeafRequest) <-chan te
 	}()
 	return ch
 }
+
+// Test that a revoked leaf cert is replaced and that replacing a cert because
+// its service identity was revoked reports the replaced cert.
+func TestManager_revokedLeaf(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	m, signer := NewTestManager(t, nil)
+
+	caRoot := signer.UpdateCA(t, nil)
+
+	req := &ConnectCALeafRequest{
+		Datacenter: "dc1", Service: "web",
+		MinQueryIndex: 0, MaxQueryTime: 10 * time.Second,
+	}
+
+	// First fetch should return immediately
+	getCh := testAsyncGet(t, m, req)
+	var first *structs.IssuedCert
+	select {
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("shouldn't block waiting for fetch")
+	case result := <-getCh:
+		require.NoError(t, result.Err)
+		requireLeafValidUnderCA(t, result.Value, caRoot)
+		first = result.Value
+	}
+
+	// Revoking the service identity replaces the cert.
+	identityRevocation := &structs.CARevocation{
+		ID:        "identity",
+		Service:   "web",
+		RevokedAt: time.Now().Add(time.Second),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	req.MinQueryIndex = first.ModifyIndex
+	getCh = testAsyncGet(t, m, req)
+	signer.UpdateRevocations(t, identityRevocation)
+
+	var second *structs.IssuedCert
+	select {
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("shouldn't block waiting for fetch")
+	case result := <-getCh:
+		require.NoError(t, result.Err)
+		require.NotEqual(t, first.SerialNumber, result.Value.SerialNumber)
+		requireLeafValidUnderCA(t, result.Value, caRoot)
+		second = result.Value
+	}
+	require.Equal(t, first.CertPEM, signer.GetCapture(1).ReplacedCert)
+
+	// The new cert isn't replaced again even though it was issued before the
+	// revocation.
+	req.MinQueryIndex = second.ModifyIndex
+	getCh = testAsyncGet(t, m, req)
+	select {
+	case result := <-getCh:
+		t.Fatalf("should not return: %#v", result)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Revoking the serial number of the new cert replaces it as well, without
+	// reporting it since it is revoked already.
+	signer.UpdateRevocations(t, identityRevocation, &structs.CARevocation{
+		ID:           "serial",
+		SerialNumber: second.SerialNumber,
+		RevokedAt:    time.Now(),
+		ExpiresAt:    time.Now().Add(time.Hour),
+	})
+	select {
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("shouldn't block waiting for fetch")
+	case result := <-getCh:
+		require.NoError(t, result.Err)
+		require.NotEqual(t, second.SerialNumber, result.Value.SerialNumber)
+	}
+	require.Empty(t, signer.GetCapture(2).ReplacedCert)
+}
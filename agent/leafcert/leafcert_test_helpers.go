@@ -125,6 +125,20 @@ func (s *TestSigner) UpdateCA(t *testing.T, ca *structs.CARoot) *structs.CARoot
 	return ca
 }
 
+// UpdateRevocations publishes the current roots along with the given
+// revocations.
+func (s *TestSigner) UpdateRevocations(t *testing.T, revocations ...*structs.CARevocation) {
+	roots, _ := s.RootsReader.Get()
+	if roots == nil {
+		t.Fatal("must call UpdateCA at least once")
+	}
+
+	updated := *roots
+	updated.Revocations = revocations
+	updated.QueryMeta = structs.QueryMeta{Index: s.nextIndex()}
+	s.RootsReader.Set(&updated)
+}
+
 func (s *TestSigner) nextIndex() uint64 {
 	return s.IDGenerator.Add(1)
 }
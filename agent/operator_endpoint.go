@@ -6,6 +6,7 @@ package agent
 import (
 	"fmt"
 	"net/http"
+	"os"
 	"strconv"
 	"time"
 
@@ -18,6 +19,8 @@ import (
 	autopilot "github.com/hashicorp/raft-autopilot"
 
 	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/agent/consul"
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/api"
 )
@@ -114,6 +117,54 @@ func (s *HTTPHandlers) OperatorRaftPeer(resp http.ResponseWriter, req *http.Requ
 	return nil, nil
 }
 
+// OperatorRaftLogStoreMigration reports, starts or cancels the migration of
+// the Raft log store of the server that answers the request to a different
+// backend. The migration completes once the server is restarted with the
+// target backend configured.
+func (s *HTTPHandlers) OperatorRaftLogStoreMigration(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var token string
+	s.parseToken(req, &token)
+	authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if req.Method == "GET" {
+		err = authz.ToAllowAuthorizer().OperatorReadAllowed(nil)
+	} else {
+		err = authz.ToAllowAuthorizer().OperatorWriteAllowed(nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	srv, ok := s.agent.delegate.(*consul.Server)
+	if !ok {
+		return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: "Log store migrations can only be run on servers"}
+	}
+
+	switch req.Method {
+	case "PUT":
+		backend := req.URL.Query().Get("backend")
+		if backend == "" {
+			return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: "Must specify the ?backend to migrate to"}
+		}
+		if err := srv.StartLogStoreMigration(backend); err != nil {
+			return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: err.Error()}
+		}
+	case "DELETE":
+		return nil, srv.CancelLogStoreMigration()
+	}
+
+	state, err := srv.LogStoreMigration()
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		return nil, HTTPError{StatusCode: http.StatusNotFound, Reason: "No log store migration found"}
+	}
+	return state, nil
+}
+
 type keyringArgs struct {
 	Key         string
 	Token       string
@@ -407,6 +458,90 @@ RETRY_ONCE:
 	return out, nil
 }
 
+// OperatorCerts lists the certificates used in a datacenter along with their
+// expiry. The inventory of the servers is completed with the TLS certificates
+// of the local agent and the gateway certificate files it can read.
+func (s *HTTPHandlers) OperatorCerts(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var args structs.DCSpecificRequest
+	if done := s.parse(resp, req, &args.Datacenter, &args.QueryOptions); done {
+		return nil, nil
+	}
+
+	var expiringWithin time.Duration
+	if raw := req.URL.Query().Get("expiring-within"); raw != "" {
+		d, err := time.ParseDuration(raw)
+		if err != nil || d < 0 {
+			return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: fmt.Sprintf("Invalid expiring-within duration %q", raw)}
+		}
+		expiringWithin = d
+	}
+
+	var reply structs.CertInventory
+	defer setMeta(resp, &reply.QueryMeta)
+	if err := s.agent.RPC(req.Context(), "Operator.CertInventory", &args, &reply); err != nil {
+		return nil, err
+	}
+
+	if reply.Datacenter == s.agent.config.Datacenter {
+		if err := s.addLocalCerts(&reply); err != nil {
+			return nil, err
+		}
+	}
+	if expiringWithin > 0 {
+		reply.ExpiringWithin(time.Now(), expiringWithin)
+	}
+	reply.Sort()
+	if reply.Certs == nil {
+		reply.Certs = make([]*structs.CertInventoryEntry, 0)
+	}
+	return reply, nil
+}
+
+// addLocalCerts adds the TLS certificates of the local agent to the inventory,
+// and reads the certificate files of file-system-certificate config entries
+// that are available to the agent.
+func (s *HTTPHandlers) addLocalCerts(inventory *structs.CertInventory) error {
+	tlsCerts, err := s.agent.tlsConfigurator.Certificates()
+	if err != nil {
+		return err
+	}
+	nodeName := s.agent.config.NodeName
+	for _, tlsCert := range tlsCerts {
+		entry := structs.NewCertInventoryEntry(structs.CertInventoryKindAgentTLS, nodeName, tlsCert.Cert)
+		entry.Source = tlsCert.Source
+
+		// A server reports its own certificates if it answered the request.
+		duplicate := false
+		for _, existing := range inventory.Certs {
+			if existing.Kind == entry.Kind && existing.Name == entry.Name && existing.SerialNumber == entry.SerialNumber {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			inventory.Certs = append(inventory.Certs, entry)
+		}
+	}
+
+	for _, entry := range inventory.Certs {
+		if entry.Kind != structs.FileSystemCertificate || entry.Path == "" || !entry.NotAfter.IsZero() {
+			continue
+		}
+		raw, err := os.ReadFile(entry.Path)
+		if err != nil {
+			// The file is only available on the gateway nodes.
+			continue
+		}
+		cert, err := connect.ParseCert(string(raw))
+		if err != nil {
+			entry.Error = err.Error()
+			continue
+		}
+		entry.SetCert(cert)
+	}
+	return nil
+}
+
 func stringIDs(ids []raft.ServerID) []string {
 	out := make([]string, len(ids))
 	for i, id := range ids {
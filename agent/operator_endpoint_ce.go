@@ -6,16 +6,51 @@
 package agent
 
 import (
-	"github.com/hashicorp/consul/api"
 	autopilot "github.com/hashicorp/raft-autopilot"
+
+	"github.com/hashicorp/consul/agent/consul"
+	"github.com/hashicorp/consul/api"
 )
 
-func autopilotToAPIServerEnterprise(_ *autopilot.ServerState, _ *api.AutopilotServer) {
-	// noop in ce
+func autopilotToAPIServerEnterprise(srv *autopilot.ServerState, apiSrv *api.AutopilotServer) {
+	ext, ok := srv.Server.Ext.(*consul.AutopilotServerExt)
+	if !ok || ext == nil {
+		return
+	}
+	apiSrv.RedundancyZone = ext.RedundancyZone
+	apiSrv.UpgradeVersion = ext.UpgradeVersion
 }
 
 func autopilotToAPIStateEnterprise(state *autopilot.State, apiState *api.AutopilotState) {
-	// without the enterprise features there is no different between these two and we don't want to
-	// alarm anyone by leaving this as the zero value.
-	apiState.OptimisticFailureTolerance = state.FailureTolerance
+	ext, ok := state.Ext.(*consul.AutopilotStateExt)
+	if !ok || ext == nil {
+		// without redundancy zones there is no different between these two and we don't want to
+		// alarm anyone by leaving this as the zero value.
+		apiState.OptimisticFailureTolerance = state.FailureTolerance
+		return
+	}
+
+	apiState.OptimisticFailureTolerance = ext.OptimisticFailureTolerance
+
+	if len(ext.RedundancyZones) > 0 {
+		apiState.RedundancyZones = make(map[string]api.AutopilotZone, len(ext.RedundancyZones))
+		for name, zone := range ext.RedundancyZones {
+			apiState.RedundancyZones[name] = api.AutopilotZone{
+				Servers:          stringIDs(zone.Servers),
+				Voters:           stringIDs(zone.Voters),
+				FailureTolerance: zone.FailureTolerance,
+			}
+		}
+	}
+
+	if ext.Upgrade != nil {
+		apiState.Upgrade = &api.AutopilotUpgrade{
+			Status:                 api.AutopilotUpgradeStatus(ext.Upgrade.Status),
+			TargetVersion:          ext.Upgrade.TargetVersion,
+			TargetVersionVoters:    stringIDs(ext.Upgrade.TargetVersionVoters),
+			TargetVersionNonVoters: stringIDs(ext.Upgrade.TargetVersionNonVoters),
+			OtherVersionVoters:     stringIDs(ext.Upgrade.OtherVersionVoters),
+			OtherVersionNonVoters:  stringIDs(ext.Upgrade.OtherVersionNonVoters),
+		}
+	}
 }
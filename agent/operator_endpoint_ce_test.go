@@ -11,8 +11,13 @@ import (
 	"net/http/httptest"
 	"testing"
 
-	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/raft"
+	autopilot "github.com/hashicorp/raft-autopilot"
 	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/consul"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
 )
 
 func TestOperator_Usage(t *testing.T) {
@@ -90,3 +95,42 @@ func upsertTestService(rpc rpcFn, secret, datacenter, name, node, partition stri
 	var out struct{}
 	return rpc(context.Background(), "Catalog.Register", &req, &out)
 }
+
+func TestAutopilotStateToAPIConversion_RedundancyZones(t *testing.T) {
+	state := &autopilot.State{
+		Healthy:          true,
+		FailureTolerance: 1,
+		Leader:           "a1",
+		Voters:           []raft.ServerID{"a1", "b1", "c1"},
+		Servers: map[raft.ServerID]*autopilot.ServerState{
+			"a1": {
+				Server: autopilot.Server{
+					ID:  "a1",
+					Ext: &consul.AutopilotServerExt{RedundancyZone: "a", UpgradeVersion: "1.1.0"},
+				},
+				State: autopilot.RaftLeader,
+			},
+		},
+		Ext: &consul.AutopilotStateExt{
+			OptimisticFailureTolerance: 3,
+			RedundancyZones: map[string]consul.AutopilotZone{
+				"a": {Servers: []raft.ServerID{"a1", "a2"}, Voters: []raft.ServerID{"a1"}, FailureTolerance: 1},
+			},
+			Upgrade: &consul.AutopilotUpgrade{
+				Status:              consul.AutopilotUpgradeIdle,
+				TargetVersion:       "1.1.0",
+				TargetVersionVoters: []raft.ServerID{"a1", "b1", "c1"},
+			},
+		},
+	}
+
+	out := autopilotToAPIState(state)
+	require.Equal(t, 3, out.OptimisticFailureTolerance)
+	require.Equal(t, map[string]api.AutopilotZone{
+		"a": {Servers: []string{"a1", "a2"}, Voters: []string{"a1"}, FailureTolerance: 1},
+	}, out.RedundancyZones)
+	require.Equal(t, api.AutopilotUpgradeIdle, out.Upgrade.Status)
+	require.Equal(t, []string{"a1", "b1", "c1"}, out.Upgrade.TargetVersionVoters)
+	require.Equal(t, "a", out.Servers["a1"].RedundancyZone)
+	require.Equal(t, "1.1.0", out.Servers["a1"].UpgradeVersion)
+}
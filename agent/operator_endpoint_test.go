@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -18,6 +20,7 @@ import (
 	autopilot "github.com/hashicorp/raft-autopilot"
 	"github.com/stretchr/testify/require"
 
+	"github.com/hashicorp/consul/agent/connect"
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/consul/sdk/testutil/retry"
@@ -92,6 +95,47 @@ func TestOperator_RaftPeer(t *testing.T) {
 	})
 }
 
+func TestOperator_RaftLogStoreMigration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	req, _ := http.NewRequest("GET", "/v1/operator/raft/logstore/migration", nil)
+	_, err := a.srv.OperatorRaftLogStoreMigration(httptest.NewRecorder(), req)
+	require.Equal(t, HTTPError{StatusCode: http.StatusNotFound, Reason: "No log store migration found"}, err)
+
+	req, _ = http.NewRequest("PUT", "/v1/operator/raft/logstore/migration?backend=boltdb", nil)
+	_, err = a.srv.OperatorRaftLogStoreMigration(httptest.NewRecorder(), req)
+	require.Error(t, err)
+	require.Equal(t, http.StatusBadRequest, err.(HTTPError).StatusCode)
+
+	req, _ = http.NewRequest("PUT", "/v1/operator/raft/logstore/migration?backend=wal", nil)
+	obj, err := a.srv.OperatorRaftLogStoreMigration(httptest.NewRecorder(), req)
+	require.NoError(t, err)
+	require.Equal(t, "wal", obj.(*structs.LogStoreMigration).Backend)
+
+	retry.Run(t, func(r *retry.R) {
+		req, _ := http.NewRequest("GET", "/v1/operator/raft/logstore/migration", nil)
+		obj, err := a.srv.OperatorRaftLogStoreMigration(httptest.NewRecorder(), req)
+		require.NoError(r, err)
+		state := obj.(*structs.LogStoreMigration)
+		require.Equal(r, structs.LogStoreMigrationReady, state.Status, state.Error)
+	})
+
+	req, _ = http.NewRequest("DELETE", "/v1/operator/raft/logstore/migration", nil)
+	_, err = a.srv.OperatorRaftLogStoreMigration(httptest.NewRecorder(), req)
+	require.NoError(t, err)
+
+	req, _ = http.NewRequest("GET", "/v1/operator/raft/logstore/migration", nil)
+	_, err = a.srv.OperatorRaftLogStoreMigration(httptest.NewRecorder(), req)
+	require.Error(t, err)
+}
+
 func TestOperator_KeyringInstall(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -401,6 +445,86 @@ func TestOperator_Keyring_LocalOnly(t *testing.T) {
 	}
 }
 
+func TestOperator_Certs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := NewTestAgent(t, `
+		tls {
+			defaults {
+				ca_file = "../test/ca/root.cer"
+				cert_file = "../test/key/ourdomain.cer"
+				key_file = "../test/key/ourdomain.key"
+			}
+		}
+	`)
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	// The certificate of a file-system-certificate is read if the agent can
+	// access the file.
+	ca := connect.TestCA(t, nil)
+	certFile := filepath.Join(t.TempDir(), "cert.pem")
+	require.NoError(t, os.WriteFile(certFile, []byte(ca.RootCert), 0600))
+	args := &structs.ConfigEntryRequest{
+		Datacenter: "dc1",
+		Entry: &structs.FileSystemCertificateConfigEntry{
+			Kind:        structs.FileSystemCertificate,
+			Name:        "gateway",
+			Certificate: certFile,
+			PrivateKey:  "/etc/gateway/key.pem",
+		},
+	}
+	var applied bool
+	require.NoError(t, a.RPC(context.Background(), "ConfigEntry.Apply", args, &applied))
+
+	req, _ := http.NewRequest("GET", "/v1/operator/certs", nil)
+	resp := httptest.NewRecorder()
+	obj, err := a.srv.OperatorCerts(resp, req)
+	require.NoError(t, err)
+	assertIndex(t, resp)
+
+	inventory := obj.(structs.CertInventory)
+	require.Equal(t, "dc1", inventory.Datacenter)
+
+	var agentTLS, fsCerts []*structs.CertInventoryEntry
+	for _, cert := range inventory.Certs {
+		switch cert.Kind {
+		case structs.CertInventoryKindAgentTLS:
+			agentTLS = append(agentTLS, cert)
+		case structs.FileSystemCertificate:
+			fsCerts = append(fsCerts, cert)
+		}
+	}
+	// The agent is also the server that answered the request, so its
+	// certificates are only listed once.
+	sources := make(map[string]int)
+	for _, cert := range agentTLS {
+		require.Equal(t, a.Config.NodeName, cert.Name)
+		sources[cert.Source]++
+	}
+	require.Equal(t, 1, sources["internal_rpc"])
+	require.Len(t, agentTLS, len(sources))
+	require.Len(t, fsCerts, 1)
+	require.Equal(t, certFile, fsCerts[0].Path)
+	require.Equal(t, ca.NotAfter.Unix(), fsCerts[0].NotAfter.Unix())
+
+	// Nothing expires within an hour.
+	req, _ = http.NewRequest("GET", "/v1/operator/certs?expiring-within=1h", nil)
+	resp = httptest.NewRecorder()
+	obj, err = a.srv.OperatorCerts(resp, req)
+	require.NoError(t, err)
+	require.Empty(t, obj.(structs.CertInventory).Certs)
+
+	req, _ = http.NewRequest("GET", "/v1/operator/certs?expiring-within=soon", nil)
+	resp = httptest.NewRecorder()
+	_, err = a.srv.OperatorCerts(resp, req)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Invalid expiring-within duration")
+}
+
 func TestOperator_AutopilotGetConfiguration(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
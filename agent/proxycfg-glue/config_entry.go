@@ -69,6 +69,10 @@ func newConfigEntryRequest(req *structs.ConfigEntryQuery, deps ServerDataSourceD
 		topic = pbsubscribe.Topic_HTTPRoute
 	case structs.TCPRoute:
 		topic = pbsubscribe.Topic_TCPRoute
+	case structs.GRPCRoute:
+		topic = pbsubscribe.Topic_GRPCRoute
+	case structs.TLSRoute:
+		topic = pbsubscribe.Topic_TLSRoute
 	case structs.FileSystemCertificate:
 		topic = pbsubscribe.Topic_FileSystemCertificate
 	case structs.InlineCertificate:
@@ -79,6 +83,10 @@ func newConfigEntryRequest(req *structs.ConfigEntryQuery, deps ServerDataSourceD
 		topic = pbsubscribe.Topic_IPRateLimit
 	case structs.JWTProvider:
 		topic = pbsubscribe.Topic_JWTProvider
+	case structs.TrustBundle:
+		topic = pbsubscribe.Topic_TrustBundle
+	case structs.ACMECertificate:
+		topic = pbsubscribe.Topic_ACMECertificate
 	default:
 		return nil, fmt.Errorf("cannot map config entry kind: %q to a topic", req.Kind)
 	}
@@ -51,13 +51,15 @@ func (s serverCompiledDiscoveryChain) Notify(ctx context.Context, req *structs.D
 	}
 
 	compileReq := discoverychain.CompileRequest{
-		ServiceName:            req.Name,
-		EvaluateInNamespace:    entMeta.NamespaceOrDefault(),
-		EvaluateInPartition:    entMeta.PartitionOrDefault(),
-		EvaluateInDatacenter:   evalDC,
-		OverrideMeshGateway:    req.OverrideMeshGateway,
-		OverrideProtocol:       req.OverrideProtocol,
-		OverrideConnectTimeout: req.OverrideConnectTimeout,
+		ServiceName:                req.Name,
+		EvaluateInNamespace:        entMeta.NamespaceOrDefault(),
+		EvaluateInPartition:        entMeta.PartitionOrDefault(),
+		EvaluateInDatacenter:       evalDC,
+		OverrideMeshGateway:        req.OverrideMeshGateway,
+		OverrideProtocol:           req.OverrideProtocol,
+		OverrideConnectTimeout:     req.OverrideConnectTimeout,
+		OverrideLimits:             req.OverrideLimits,
+		OverridePassiveHealthCheck: req.OverridePassiveHealthCheck,
 	}
 
 	return watch.ServerLocalNotify(ctx, correlationID, s.deps.GetStore,
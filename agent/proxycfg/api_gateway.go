@@ -63,8 +63,11 @@ func (h *handlerAPIGateway) initialize(ctx context.Context) (ConfigSnapshot, err
 	snap.APIGateway.BoundListeners = make(map[string]structs.BoundAPIGatewayListener)
 	snap.APIGateway.HTTPRoutes = watch.NewMap[structs.ResourceReference, *structs.HTTPRouteConfigEntry]()
 	snap.APIGateway.TCPRoutes = watch.NewMap[structs.ResourceReference, *structs.TCPRouteConfigEntry]()
+	snap.APIGateway.GRPCRoutes = watch.NewMap[structs.ResourceReference, *structs.GRPCRouteConfigEntry]()
+	snap.APIGateway.TLSRoutes = watch.NewMap[structs.ResourceReference, *structs.TLSRouteConfigEntry]()
 	snap.APIGateway.InlineCertificates = watch.NewMap[structs.ResourceReference, *structs.InlineCertificateConfigEntry]()
 	snap.APIGateway.FileSystemCertificates = watch.NewMap[structs.ResourceReference, *structs.FileSystemCertificateConfigEntry]()
+	snap.APIGateway.ACMECertificates = watch.NewMap[structs.ResourceReference, *structs.ACMECertificateConfigEntry]()
 
 	snap.APIGateway.Upstreams = make(listenerRouteUpstreams)
 	snap.APIGateway.UpstreamsSet = make(routeUpstreamSet)
@@ -98,7 +101,7 @@ func (h *handlerAPIGateway) subscribeToConfigEntry(ctx context.Context, kind, na
 // to crawl the various resources related to or attached to the gateway and
 // collect the list of things need to generate xDS.  This list of resources
 // includes the bound-api-gateway, http-routes, tcp-routes,
-// file-system-certificates and inline-certificates.
+// file-system-certificates, inline-certificates and acme-certificates.
 func (h *handlerAPIGateway) handleUpdate(ctx context.Context, u UpdateEvent, snap *ConfigSnapshot) error {
 	if u.Err != nil {
 		return fmt.Errorf("error filling agent cache: %v", u.Err)
@@ -125,6 +128,11 @@ func (h *handlerAPIGateway) handleUpdate(ctx context.Context, u UpdateEvent, sna
 		if err := h.handleInlineCertConfigUpdate(ctx, u, snap); err != nil {
 			return err
 		}
+	case acmeCertificateConfigWatchID:
+		// Handle change in a referenced acme-certificate config entry
+		if err := h.handleACMECertConfigUpdate(ctx, u, snap); err != nil {
+			return err
+		}
 	case routeConfigWatchID:
 		// Handle change in an attached http-route or tcp-route config entry
 		if err := h.handleRouteConfigUpdate(ctx, u, snap); err != nil {
@@ -196,6 +204,10 @@ func (h *handlerAPIGateway) handleGatewayConfigUpdate(ctx context.Context, u Upd
 					snap.APIGateway.HTTPRoutes.InitWatch(ref, cancel)
 				case structs.TCPRoute:
 					snap.APIGateway.TCPRoutes.InitWatch(ref, cancel)
+				case structs.GRPCRoute:
+					snap.APIGateway.GRPCRoutes.InitWatch(ref, cancel)
+				case structs.TLSRoute:
+					snap.APIGateway.TLSRoutes.InitWatch(ref, cancel)
 				default:
 					cancel()
 					return fmt.Errorf("unexpected route kind on gateway: %s", ref.Kind)
@@ -210,6 +222,12 @@ func (h *handlerAPIGateway) handleGatewayConfigUpdate(ctx context.Context, u Upd
 
 			// Subscribe to changes in each attached inline-certificate config entry
 			for _, ref := range listener.Certificates {
+				if ref.Kind == structs.ACMECertificate {
+					// acme-certificates are watched for every listener of
+					// the api-gateway, see below.
+					continue
+				}
+
 				ctx, cancel := context.WithCancel(ctx)
 				seenRefs[ref] = struct{}{}
 
@@ -250,6 +268,24 @@ func (h *handlerAPIGateway) handleGatewayConfigUpdate(ctx context.Context, u Upd
 			return true
 		})
 
+		snap.APIGateway.GRPCRoutes.ForEachKey(func(ref structs.ResourceReference) bool {
+			if _, ok := seenRefs[ref]; !ok {
+				snap.APIGateway.Upstreams.delete(ref)
+				snap.APIGateway.UpstreamsSet.delete(ref)
+				snap.APIGateway.GRPCRoutes.CancelWatch(ref)
+			}
+			return true
+		})
+
+		snap.APIGateway.TLSRoutes.ForEachKey(func(ref structs.ResourceReference) bool {
+			if _, ok := seenRefs[ref]; !ok {
+				snap.APIGateway.Upstreams.delete(ref)
+				snap.APIGateway.UpstreamsSet.delete(ref)
+				snap.APIGateway.TLSRoutes.CancelWatch(ref)
+			}
+			return true
+		})
+
 		snap.APIGateway.InlineCertificates.ForEachKey(func(ref structs.ResourceReference) bool {
 			if _, ok := seenRefs[ref]; !ok {
 				snap.APIGateway.InlineCertificates.CancelWatch(ref)
@@ -273,6 +309,36 @@ func (h *handlerAPIGateway) handleGatewayConfigUpdate(ctx context.Context, u Upd
 			snap.APIGateway.Listeners[listener.Name] = listener
 		}
 
+		// Subscribe to the acme-certificates referenced by any listener, even
+		// those that are not bound yet, since the gateway answers the
+		// HTTP-01 challenges of a certificate before it is first issued.
+		seenRefs := make(map[structs.ResourceReference]struct{})
+		for _, listener := range gwConf.Listeners {
+			for _, ref := range listener.TLS.Certificates {
+				if ref.Kind != structs.ACMECertificate {
+					continue
+				}
+				seenRefs[ref] = struct{}{}
+				if snap.APIGateway.ACMECertificates.IsWatched(ref) {
+					continue
+				}
+
+				ctx, cancel := context.WithCancel(ctx)
+				snap.APIGateway.ACMECertificates.InitWatch(ref, cancel)
+
+				err := h.subscribeToConfigEntry(ctx, ref.Kind, ref.Name, ref.EnterpriseMeta, acmeCertificateConfigWatchID)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		snap.APIGateway.ACMECertificates.ForEachKey(func(ref structs.ResourceReference) bool {
+			if _, ok := seenRefs[ref]; !ok {
+				snap.APIGateway.ACMECertificates.CancelWatch(ref)
+			}
+			return true
+		})
+
 		snap.APIGateway.GatewayConfigLoaded = true
 
 		// Watch the corresponding bound-api-gateway config entry
@@ -337,6 +403,32 @@ func (h *handlerAPIGateway) handleInlineCertConfigUpdate(_ context.Context, u Up
 	return nil
 }
 
+// handleACMECertConfigUpdate stores the certificate and the pending
+// challenges of an acme-certificate for the gateway
+func (h *handlerAPIGateway) handleACMECertConfigUpdate(_ context.Context, u UpdateEvent, snap *ConfigSnapshot) error {
+	resp, ok := u.Result.(*structs.ConfigEntryResponse)
+	if !ok {
+		return fmt.Errorf("invalid type for response: %T", u.Result)
+	} else if resp == nil || resp.Entry == nil {
+		return nil
+	}
+
+	cfg, ok := resp.Entry.(*structs.ACMECertificateConfigEntry)
+	if !ok || cfg == nil {
+		return fmt.Errorf("invalid type for config entry: %T", resp.Entry)
+	}
+
+	ref := structs.ResourceReference{
+		Kind:           cfg.GetKind(),
+		Name:           cfg.GetName(),
+		EnterpriseMeta: *cfg.GetEnterpriseMeta(),
+	}
+
+	snap.APIGateway.ACMECertificates.Set(ref, cfg)
+
+	return nil
+}
+
 // handleRouteConfigUpdate builds the list of upstreams for services on
 // the route and watches the related discovery chains.
 func (h *handlerAPIGateway) handleRouteConfigUpdate(ctx context.Context, u UpdateEvent, snap *ConfigSnapshot) error {
@@ -452,6 +544,106 @@ func (h *handlerAPIGateway) handleRouteConfigUpdate(ctx context.Context, u Updat
 				datacenter: h.stateConfig.source.Datacenter,
 			}
 
+			handler := &handlerUpstreams{handlerState: h.handlerState}
+			if err := handler.watchDiscoveryChain(ctx, snap, watchOpts); err != nil {
+				return fmt.Errorf("failed to watch discovery chain for %s: %w", upstreamID, err)
+			}
+		}
+	case *structs.GRPCRouteConfigEntry:
+		snap.APIGateway.GRPCRoutes.Set(ref, route)
+
+		for _, rule := range route.Rules {
+			for _, service := range rule.Services {
+				for _, listener := range snap.APIGateway.Listeners {
+					shouldBind := false
+					for _, parent := range route.Parents {
+						if h.referenceIsForListener(parent, listener, snap) {
+							shouldBind = true
+							break
+						}
+					}
+					if !shouldBind {
+						continue
+					}
+
+					upstream := structs.Upstream{
+						DestinationName:      service.Name,
+						DestinationNamespace: service.NamespaceOrDefault(),
+						DestinationPartition: service.PartitionOrDefault(),
+						LocalBindPort:        listener.Port,
+						// gRPC services must be reached over HTTP/2, so force the
+						// grpc protocol on the upstream cluster.
+						Config: map[string]interface{}{
+							"protocol": "grpc",
+						},
+					}
+
+					listenerKey := APIGatewayListenerKeyFromListener(listener)
+					upstreams[listenerKey] = append(upstreams[listenerKey], upstream)
+				}
+
+				upstreamID := NewUpstreamIDFromServiceName(service.ServiceName())
+				seenUpstreamIDs[upstreamID] = struct{}{}
+
+				watchOpts := discoveryChainWatchOpts{
+					id:         upstreamID,
+					name:       service.Name,
+					namespace:  service.NamespaceOrDefault(),
+					partition:  service.PartitionOrDefault(),
+					datacenter: h.stateConfig.source.Datacenter,
+				}
+
+				handler := &handlerUpstreams{handlerState: h.handlerState}
+				if err := handler.watchDiscoveryChain(ctx, snap, watchOpts); err != nil {
+					return fmt.Errorf("failed to watch discovery chain for %s: %w", upstreamID, err)
+				}
+			}
+		}
+
+	case *structs.TLSRouteConfigEntry:
+		snap.APIGateway.TLSRoutes.Set(ref, route)
+
+		for _, service := range route.Services {
+			upstreamID := NewUpstreamIDFromServiceName(service.ServiceName())
+			seenUpstreamIDs.add(upstreamID)
+
+			// For each listener, check if this route should bind and, if so, create an upstream.
+			for _, listener := range snap.APIGateway.Listeners {
+				shouldBind := false
+				for _, parent := range route.Parents {
+					if h.referenceIsForListener(parent, listener, snap) {
+						shouldBind = true
+						break
+					}
+				}
+				if !shouldBind {
+					continue
+				}
+
+				upstream := structs.Upstream{
+					DestinationName:      service.Name,
+					DestinationNamespace: service.NamespaceOrDefault(),
+					DestinationPartition: service.PartitionOrDefault(),
+					LocalBindPort:        listener.Port,
+					// TLS routes pass the encrypted stream through to the upstream
+					// untouched, so it is proxied as plain TCP.
+					Config: map[string]interface{}{
+						"protocol": "tcp",
+					},
+				}
+
+				listenerKey := APIGatewayListenerKeyFromListener(listener)
+				upstreams[listenerKey] = append(upstreams[listenerKey], upstream)
+			}
+
+			watchOpts := discoveryChainWatchOpts{
+				id:         upstreamID,
+				name:       service.Name,
+				namespace:  service.NamespaceOrDefault(),
+				partition:  service.PartitionOrDefault(),
+				datacenter: h.stateConfig.source.Datacenter,
+			}
+
 			handler := &handlerUpstreams{handlerState: h.handlerState}
 			if err := handler.watchDiscoveryChain(ctx, snap, watchOpts); err != nil {
 				return fmt.Errorf("failed to watch discovery chain for %s: %w", upstreamID, err)
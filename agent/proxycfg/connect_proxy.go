@@ -106,6 +106,19 @@ func (s *handlerConnectProxy) initialize(ctx context.Context) (ConfigSnapshot, e
 		return snap, err
 	}
 
+	// Watch for the trust bundles of federated SPIFFE trust domains. Like
+	// JWT providers, only the bundles referenced by the intentions of the
+	// proxy are added to the validation context of the public listener.
+	err = s.dataSources.ConfigEntryList.Notify(ctx, &structs.ConfigEntryQuery{
+		Kind:           structs.TrustBundle,
+		Datacenter:     s.source.Datacenter,
+		QueryOptions:   structs.QueryOptions{Token: s.token},
+		EnterpriseMeta: *structs.DefaultEnterpriseMetaInPartition(s.proxyID.PartitionOrDefault()),
+	}, trustBundlesID, s.ch)
+	if err != nil {
+		return snap, err
+	}
+
 	// Get information about the entire service mesh.
 	err = s.dataSources.ConfigEntry.Notify(ctx, &structs.ConfigEntryQuery{
 		Kind:           structs.MeshConfig,
@@ -234,15 +247,17 @@ func (s *handlerConnectProxy) initialize(ctx context.Context) (ConfigSnapshot, e
 			}
 
 			err := s.dataSources.CompiledDiscoveryChain.Notify(ctx, &structs.DiscoveryChainRequest{
-				Datacenter:             s.source.Datacenter,
-				QueryOptions:           structs.QueryOptions{Token: s.token},
-				Name:                   u.DestinationName,
-				EvaluateInDatacenter:   dc,
-				EvaluateInNamespace:    ns,
-				EvaluateInPartition:    partition,
-				OverrideMeshGateway:    u.MeshGateway,
-				OverrideProtocol:       cfg.Protocol,
-				OverrideConnectTimeout: cfg.ConnectTimeout(),
+				Datacenter:                 s.source.Datacenter,
+				QueryOptions:               structs.QueryOptions{Token: s.token},
+				Name:                       u.DestinationName,
+				EvaluateInDatacenter:       dc,
+				EvaluateInNamespace:        ns,
+				EvaluateInPartition:        partition,
+				OverrideMeshGateway:        u.MeshGateway,
+				OverrideProtocol:           cfg.Protocol,
+				OverrideConnectTimeout:     cfg.ConnectTimeout(),
+				OverrideLimits:             cfg.Limits,
+				OverridePassiveHealthCheck: cfg.PassiveHealthCheck,
 			}, "discovery-chain:"+uid.String(), s.ch)
 			if err != nil {
 				return snap, fmt.Errorf("failed to watch discovery chain for %s: %v", uid.String(), err)
@@ -355,6 +370,26 @@ func (s *handlerConnectProxy) handleUpdate(ctx context.Context, u UpdateEvent, s
 		}
 
 		snap.JWTProviders = providers
+	case u.CorrelationID == trustBundlesID:
+		resp, ok := u.Result.(*structs.IndexedConfigEntries)
+		if !ok {
+			return fmt.Errorf("invalid type for response: %T", u.Result)
+		}
+
+		bundles := make([]*structs.TrustBundleConfigEntry, 0, len(resp.Entries))
+		for _, entry := range resp.Entries {
+			bundle, ok := entry.(*structs.TrustBundleConfigEntry)
+			if !ok {
+				return fmt.Errorf("invalid type for response: %T", entry)
+			}
+			// Bundles that have not been fetched yet cannot authenticate
+			// anyone.
+			if len(bundle.RootPEMs) == 0 {
+				continue
+			}
+			bundles = append(bundles, bundle)
+		}
+		snap.ConnectProxy.FederatedTrustBundles = bundles
 	case u.CorrelationID == peeredUpstreamsID:
 		resp, ok := u.Result.(*structs.IndexedPeeredServiceList)
 		if !ok {
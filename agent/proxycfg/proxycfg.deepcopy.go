@@ -421,8 +421,11 @@ func (o *configSnapshotAPIGateway) DeepCopy() *configSnapshotAPIGateway {
 	}
 	cp.HTTPRoutes = o.HTTPRoutes.DeepCopy()
 	cp.TCPRoutes = o.TCPRoutes.DeepCopy()
+	cp.GRPCRoutes = o.GRPCRoutes.DeepCopy()
+	cp.TLSRoutes = o.TLSRoutes.DeepCopy()
 	cp.InlineCertificates = o.InlineCertificates.DeepCopy()
 	cp.FileSystemCertificates = o.FileSystemCertificates.DeepCopy()
+	cp.ACMECertificates = o.ACMECertificates.DeepCopy()
 	if o.Listeners != nil {
 		cp.Listeners = make(map[string]structs.APIGatewayListener, len(o.Listeners))
 		for k2, v2 := range o.Listeners {
@@ -464,6 +467,15 @@ func (o *configSnapshotConnectProxy) DeepCopy() *configSnapshotConnectProxy {
 			}
 		}
 	}
+	if o.FederatedTrustBundles != nil {
+		cp.FederatedTrustBundles = make([]*structs.TrustBundleConfigEntry, len(o.FederatedTrustBundles))
+		copy(cp.FederatedTrustBundles, o.FederatedTrustBundles)
+		for i2 := range o.FederatedTrustBundles {
+			if o.FederatedTrustBundles[i2] != nil {
+				cp.FederatedTrustBundles[i2] = o.FederatedTrustBundles[i2].DeepCopy()
+			}
+		}
+	}
 	if o.WatchedServiceChecks != nil {
 		cp.WatchedServiceChecks = make(map[structs.ServiceID][]structs.CheckType, len(o.WatchedServiceChecks))
 		for k2, v2 := range o.WatchedServiceChecks {
@@ -743,6 +755,18 @@ func (o *configSnapshotMeshGateway) DeepCopy() *configSnapshotMeshGateway {
 			cp.Limits.MaxConcurrentRequests = new(int)
 			*cp.Limits.MaxConcurrentRequests = *o.Limits.MaxConcurrentRequests
 		}
+		if o.Limits.RetryBudget != nil {
+			cp.Limits.RetryBudget = new(structs.RetryBudget)
+			*cp.Limits.RetryBudget = *o.Limits.RetryBudget
+			if o.Limits.RetryBudget.BudgetPercent != nil {
+				cp.Limits.RetryBudget.BudgetPercent = new(float64)
+				*cp.Limits.RetryBudget.BudgetPercent = *o.Limits.RetryBudget.BudgetPercent
+			}
+			if o.Limits.RetryBudget.MinRetryConcurrency != nil {
+				cp.Limits.RetryBudget.MinRetryConcurrency = new(int)
+				*cp.Limits.RetryBudget.MinRetryConcurrency = *o.Limits.RetryBudget.MinRetryConcurrency
+			}
+		}
 	}
 	return &cp
 }
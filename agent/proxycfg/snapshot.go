@@ -150,6 +150,12 @@ type configSnapshotConnectProxy struct {
 	InboundPeerTrustBundlesSet bool
 	InboundPeerTrustBundles    []*pbpeering.PeeringTrustBundle
 
+	// FederatedTrustBundles are the bundles of foreign SPIFFE trust domains
+	// imported with trust-bundle config entries. The public listener only
+	// trusts the bundles of the trust domains that are allowed to connect by
+	// the intentions of the proxy.
+	FederatedTrustBundles []*structs.TrustBundleConfigEntry
+
 	WatchedServiceChecks   map[structs.ServiceID][]structs.CheckType // TODO: missing garbage collection
 	PreparedQueryEndpoints map[UpstreamID]structs.CheckServiceNodes  // DEPRECATED:see:WatchedUpstreamEndpoints
 
@@ -741,10 +747,16 @@ type configSnapshotAPIGateway struct {
 
 	HTTPRoutes watch.Map[structs.ResourceReference, *structs.HTTPRouteConfigEntry]
 	TCPRoutes  watch.Map[structs.ResourceReference, *structs.TCPRouteConfigEntry]
+	GRPCRoutes watch.Map[structs.ResourceReference, *structs.GRPCRouteConfigEntry]
+	TLSRoutes  watch.Map[structs.ResourceReference, *structs.TLSRouteConfigEntry]
 
 	InlineCertificates     watch.Map[structs.ResourceReference, *structs.InlineCertificateConfigEntry]
 	FileSystemCertificates watch.Map[structs.ResourceReference, *structs.FileSystemCertificateConfigEntry]
 
+	// ACMECertificates are the acme-certificates referenced by any listener
+	// of the gateway, whether the listener is bound to them or not.
+	ACMECertificates watch.Map[structs.ResourceReference, *structs.ACMECertificateConfigEntry]
+
 	// LeafCertWatchCancel is a CancelFunc to use when refreshing this gateway's
 	// leaf cert watch with different parameters.
 	LeafCertWatchCancel context.CancelFunc
@@ -809,6 +821,38 @@ DOMAIN_LOOP:
 					chains = append(chains, chain)
 				}
 			}
+		case structs.GRPCRoute:
+			route, ok := c.GRPCRoutes.Get(routeRef)
+			if !ok || listener.Protocol != structs.ListenerProtocolHTTP {
+				continue
+			}
+			synthesizer.AddGRPCRoute(*route)
+			for _, service := range route.GetServices() {
+				id := NewUpstreamIDFromServiceName(structs.NewServiceName(service.Name, &service.EnterpriseMeta))
+				if chain := c.DiscoveryChain[id]; chain != nil {
+					chains = append(chains, chain)
+				}
+			}
+		case structs.TLSRoute:
+			route, ok := c.TLSRoutes.Get(routeRef)
+			if !ok || listener.Protocol != structs.ListenerProtocolTLS {
+				continue
+			}
+			// TLS routes are passed through without synthesizing a new chain,
+			// so only add the route once the chain for its service is known in
+			// order to keep the services and chains aligned.
+			var routeChains []*structs.CompiledDiscoveryChain
+			for _, service := range route.GetServices() {
+				id := NewUpstreamIDFromServiceName(structs.NewServiceName(service.Name, &service.EnterpriseMeta))
+				if chain := c.DiscoveryChain[id]; chain != nil {
+					routeChains = append(routeChains, chain)
+				}
+			}
+			if len(routeChains) != len(route.Services) {
+				continue
+			}
+			synthesizer.AddTLSRoute(*route)
+			chains = append(chains, routeChains...)
 		default:
 			return nil, nil, nil, fmt.Errorf("unknown route kind %q", routeRef.Kind)
 		}
@@ -824,6 +868,12 @@ DOMAIN_LOOP:
 	}
 
 	// reconstruct the upstreams
+	// TLS listeners pass the raw TCP stream through to the upstream
+	protocol := string(listener.Protocol)
+	if listener.Protocol == structs.ListenerProtocolTLS {
+		protocol = string(structs.ListenerProtocolTCP)
+	}
+
 	upstreams := make([]structs.Upstream, 0, len(services))
 	for _, service := range services {
 		upstreams = append(upstreams, structs.Upstream{
@@ -833,7 +883,7 @@ DOMAIN_LOOP:
 			IngressHosts:         service.Hosts,
 			LocalBindPort:        listener.Port,
 			Config: map[string]interface{}{
-				"protocol": string(listener.Protocol),
+				"protocol": protocol,
 			},
 		})
 	}
@@ -1110,6 +1160,19 @@ func (s *ConfigSnapshot) RootPEMs() string {
 	return rootPEMs
 }
 
+// RevocationListPEMs returns the concatenated revocation lists signed by the
+// Connect CA, or an empty string when no certificates are revoked.
+func (s *ConfigSnapshot) RevocationListPEMs() string {
+	if s.Roots == nil {
+		return ""
+	}
+	var crlPEMs string
+	for _, crl := range s.Roots.RevocationLists {
+		crlPEMs += lib.EnsureTrailingNewline(crl)
+	}
+	return crlPEMs
+}
+
 func (s *ConfigSnapshot) MeshConfig() *structs.MeshConfigEntry {
 	switch s.Kind {
 	case structs.ServiceKindConnectProxy:
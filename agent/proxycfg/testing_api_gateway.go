@@ -73,12 +73,19 @@ func TestConfigSnapshotAPIGateway(
 		baseEvents = append(baseEvents, watch)
 
 		// Add the watch event for the discovery chain.
+		protocol := string(route.GetProtocol())
+		switch route.(type) {
+		case *structs.GRPCRouteConfigEntry:
+			protocol = "grpc"
+		case *structs.TLSRouteConfigEntry:
+			protocol = "tcp"
+		}
 		entries := []structs.ConfigEntry{
 			&structs.ProxyConfigEntry{
 				Kind: structs.ProxyDefaults,
 				Name: structs.ProxyConfigGlobal,
 				Config: map[string]interface{}{
-					"protocol": route.GetProtocol(),
+					"protocol": protocol,
 				},
 			},
 			&structs.ServiceResolverConfigEntry{
@@ -127,6 +134,17 @@ func TestConfigSnapshotAPIGateway(
 	}, nsFn, nil, testSpliceEvents(baseEvents, extraUpdates))
 }
 
+// TestACMECertificateUpdateEvent returns the update event that is delivered
+// when an acme-certificate config entry referenced by an API gateway changes.
+func TestACMECertificateUpdateEvent(entry *structs.ACMECertificateConfigEntry) UpdateEvent {
+	return UpdateEvent{
+		CorrelationID: acmeCertificateConfigWatchID,
+		Result: &structs.ConfigEntryResponse{
+			Entry: entry,
+		},
+	}
+}
+
 // TestConfigSnapshotAPIGateway_NilConfigEntry is used to test when
 // the update event for the config entry returns nil
 // since this always happens on the first watch if it doesn't exist.
@@ -9,6 +9,7 @@ import (
 
 	"github.com/mitchellh/go-testing-interface"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/agent/connect"
@@ -22,10 +23,6 @@ import (
 func TestConfigSnapshot(t testing.T, nsFn func(ns *structs.NodeService), extraUpdates []UpdateEvent) *ConfigSnapshot {
 	roots, leaf := TestCerts(t)
 
-	// no entries implies we'll get a default chain
-	dbChain := discoverychain.TestCompileConfigEntries(t, "db", "default", "default", "dc1", connect.TestClusterID+".consul", nil, nil)
-	assert.True(t, dbChain.Default)
-
 	var (
 		upstreams   = structs.TestUpstreams(t, false)
 		dbUpstream  = upstreams[0]
@@ -37,6 +34,39 @@ func TestConfigSnapshot(t testing.T, nsFn func(ns *structs.NodeService), extraUp
 		webSN = structs.ServiceIDString("web", nil)
 	)
 
+	ns := &structs.NodeService{
+		Kind:    structs.ServiceKindConnectProxy,
+		Service: "web-sidecar-proxy",
+		Port:    9999,
+		Proxy: structs.ConnectProxyConfig{
+			DestinationServiceID:   "web",
+			DestinationServiceName: "web",
+			LocalServiceAddress:    "127.0.0.1",
+			LocalServicePort:       8080,
+			Config: map[string]interface{}{
+				"foo": "bar",
+			},
+			Upstreams: upstreams,
+		},
+		Meta:            nil,
+		TaggedAddresses: nil,
+	}
+	if nsFn != nil {
+		nsFn(ns)
+	}
+
+	var dbConfig map[string]interface{}
+	for _, u := range ns.Proxy.Upstreams {
+		if NewUpstreamID(&u) == dbUID {
+			dbConfig = u.Config
+		}
+	}
+
+	// no entries implies we'll get a default chain
+	dbChain := discoverychain.TestCompileConfigEntries(t, "db", "default", "default", "dc1", connect.TestClusterID+".consul",
+		testUpstreamCompileOverrides(t, dbConfig), nil)
+	assert.True(t, dbChain.Default)
+
 	baseEvents := []UpdateEvent{
 		{
 			CorrelationID: rootsWatchID,
@@ -74,23 +104,19 @@ func TestConfigSnapshot(t testing.T, nsFn func(ns *structs.NodeService), extraUp
 		},
 	}
 
-	return testConfigSnapshotFixture(t, &structs.NodeService{
-		Kind:    structs.ServiceKindConnectProxy,
-		Service: "web-sidecar-proxy",
-		Port:    9999,
-		Proxy: structs.ConnectProxyConfig{
-			DestinationServiceID:   "web",
-			DestinationServiceName: "web",
-			LocalServiceAddress:    "127.0.0.1",
-			LocalServicePort:       8080,
-			Config: map[string]interface{}{
-				"foo": "bar",
-			},
-			Upstreams: upstreams,
-		},
-		Meta:            nil,
-		TaggedAddresses: nil,
-	}, nsFn, nil, testSpliceEvents(baseEvents, extraUpdates))
+	return testConfigSnapshotFixture(t, ns, nil, nil, testSpliceEvents(baseEvents, extraUpdates))
+}
+
+// testUpstreamCompileOverrides returns the compile overrides that the proxy
+// state derives from the config of an upstream when watching its chain.
+func testUpstreamCompileOverrides(t testing.T, m map[string]interface{}) func(req *discoverychain.CompileRequest) {
+	cfg, err := parseReducedUpstreamConfig(m)
+	require.NoError(t, err)
+
+	return func(req *discoverychain.CompileRequest) {
+		req.OverrideLimits = cfg.Limits
+		req.OverridePassiveHealthCheck = cfg.PassiveHealthCheck
+	}
 }
 
 // TestConfigSnapshotDiscoveryChain returns a fully populated snapshot using a discovery chain
@@ -37,6 +37,21 @@ func setupTestVariationConfigEntriesAndSnapshot(
 			node.Service.Kind = structs.ServiceKindTerminatingGateway
 		}
 	}
+	if variation == "resolver-with-prioritize-by-locality" {
+		nodes[0].Node.Locality = &structs.Locality{Region: "us-east-1", Zone: "us-east-1a"}
+		nodes[1].Node.Locality = &structs.Locality{Region: "us-east-1", Zone: "us-east-1b"}
+		nodes = append(nodes, structs.CheckServiceNode{
+			Node: &structs.Node{
+				ID:         "test3",
+				Node:       "test3",
+				Address:    "10.10.1.3",
+				Datacenter: "dc1",
+				Partition:  structs.NodeEnterpriseMetaInDefaultPartition().PartitionOrEmpty(),
+				Locality:   &structs.Locality{Region: "us-west-2", Zone: "us-west-2a"},
+			},
+			Service: structs.TestNodeServiceWithName("db"),
+		})
+	}
 	events := []UpdateEvent{
 		{
 			CorrelationID: "discovery-chain:" + dbUID.String(),
@@ -258,7 +273,15 @@ func setupTestVariationConfigEntriesAndSnapshot(
 	case "register-to-terminating-gateway":
 	case "redirect-to-lb-node":
 	case "resolver-with-lb":
+	case "resolver-with-prioritize-by-locality":
+	case "resolver-with-limits":
+	case "resolver-with-limits-overridden-by-upstream":
 	case "splitter-overweight":
+	case "chain-and-router-with-mirror":
+	case "chain-and-router-with-fault":
+	case "chain-and-router-with-redirect":
+	case "chain-and-router-with-hedging":
+	case "chain-and-router-with-session-affinity":
 	default:
 		extraEvents := extraUpdateEvents(t, variation, dbUID)
 		events = append(events, extraEvents...)
@@ -1097,6 +1120,342 @@ func setupTestVariationDiscoveryChain(
 				},
 			},
 		)
+	case "resolver-with-limits-overridden-by-upstream":
+		compileSetup = func(req *discoverychain.CompileRequest) {
+			req.OverrideLimits = &structs.UpstreamLimits{
+				MaxConnections: pointerTo(10),
+			}
+		}
+		fallthrough
+	case "resolver-with-limits":
+		entries = append(entries,
+			&structs.ServiceResolverConfigEntry{
+				Kind:           structs.ServiceResolver,
+				Name:           "db",
+				EnterpriseMeta: entMeta,
+				Limits: &structs.UpstreamLimits{
+					MaxConnections:        pointerTo(100),
+					MaxConcurrentRequests: pointerTo(200),
+					RetryBudget: &structs.RetryBudget{
+						BudgetPercent:       pointerTo(25.0),
+						MinRetryConcurrency: pointerTo(5),
+					},
+				},
+				PassiveHealthCheck: &structs.PassiveHealthCheck{
+					Interval:    10 * time.Second,
+					MaxFailures: 5,
+				},
+			},
+		)
+	case "resolver-with-prioritize-by-locality":
+		entries = append(entries,
+			&structs.ServiceResolverConfigEntry{
+				Kind:           structs.ServiceResolver,
+				Name:           "db",
+				EnterpriseMeta: entMeta,
+				PrioritizeByLocality: &structs.ServiceResolverPrioritizeByLocality{
+					Mode: "failover",
+				},
+			},
+		)
+	case "chain-and-router-with-mirror":
+		entries = append(entries,
+			&structs.ProxyConfigEntry{
+				Kind:           structs.ProxyDefaults,
+				Name:           structs.ProxyConfigGlobal,
+				EnterpriseMeta: entMeta,
+				Protocol:       "http",
+				Config: map[string]interface{}{
+					"protocol": "http",
+				},
+			},
+			&structs.ServiceResolverConfigEntry{
+				Kind:           structs.ServiceResolver,
+				Name:           "db",
+				EnterpriseMeta: entMeta,
+				Subsets: map[string]structs.ServiceResolverSubset{
+					"v2": {Filter: "Service.Meta.version == v2"},
+				},
+			},
+			&structs.ServiceRouterConfigEntry{
+				Kind:           structs.ServiceRouter,
+				Name:           "db",
+				EnterpriseMeta: entMeta,
+				Routes: []structs.ServiceRoute{
+					{
+						Match: httpMatch(&structs.ServiceRouteHTTPMatch{
+							PathPrefix: "/all",
+						}),
+						Destination: &structs.ServiceRouteDestination{
+							Mirror: &structs.ServiceRouteMirror{
+								ServiceSubset: "v2",
+							},
+						},
+					},
+					{
+						Match: httpMatch(&structs.ServiceRouteHTTPMatch{
+							PathPrefix: "/some",
+						}),
+						Destination: &structs.ServiceRouteDestination{
+							Service: "prefix",
+							Mirror: &structs.ServiceRouteMirror{
+								Service: "shadow",
+								Percent: pointerTo[float32](12.5),
+							},
+						},
+					},
+					{
+						Match: httpMatch(&structs.ServiceRouteHTTPMatch{
+							PathPrefix: "/none",
+						}),
+						Destination: &structs.ServiceRouteDestination{
+							Service: "prefix",
+							Mirror: &structs.ServiceRouteMirror{
+								Service: "shadow",
+								Percent: pointerTo[float32](0),
+							},
+						},
+					},
+				},
+			},
+		)
+	case "chain-and-router-with-fault":
+		entries = append(entries,
+			&structs.ProxyConfigEntry{
+				Kind:           structs.ProxyDefaults,
+				Name:           structs.ProxyConfigGlobal,
+				EnterpriseMeta: entMeta,
+				Protocol:       "http",
+				Config: map[string]interface{}{
+					"protocol": "http",
+				},
+			},
+			&structs.ServiceRouterConfigEntry{
+				Kind:           structs.ServiceRouter,
+				Name:           "db",
+				EnterpriseMeta: entMeta,
+				Routes: []structs.ServiceRoute{
+					{
+						Match: httpMatch(&structs.ServiceRouteHTTPMatch{
+							PathPrefix: "/delay",
+						}),
+						Fault: &structs.ServiceRouteFault{
+							Delay: &structs.ServiceRouteFaultDelay{
+								FixedDelay: 3 * time.Second,
+								Percent:    25,
+							},
+						},
+					},
+					{
+						Match: httpMatch(&structs.ServiceRouteHTTPMatch{
+							PathPrefix: "/abort",
+						}),
+						Fault: &structs.ServiceRouteFault{
+							Abort: &structs.ServiceRouteFaultAbort{
+								HTTPStatus: 503,
+								Percent:    0.5,
+							},
+							Header: []structs.ServiceRouteHTTPMatchHeader{
+								{Name: "x-chaos", Exact: "enabled"},
+							},
+						},
+					},
+					{
+						Match: httpMatch(&structs.ServiceRouteHTTPMatch{
+							PathPrefix: "/grpc",
+						}),
+						Fault: &structs.ServiceRouteFault{
+							Delay: &structs.ServiceRouteFaultDelay{
+								FixedDelay: 100 * time.Millisecond,
+							},
+							Abort: &structs.ServiceRouteFaultAbort{
+								GRPCStatus: 14,
+								Percent:    10,
+							},
+						},
+					},
+				},
+			},
+		)
+	case "chain-and-router-with-redirect":
+		entries = append(entries,
+			&structs.ProxyConfigEntry{
+				Kind:           structs.ProxyDefaults,
+				Name:           structs.ProxyConfigGlobal,
+				EnterpriseMeta: entMeta,
+				Protocol:       "http",
+				Config: map[string]interface{}{
+					"protocol": "http",
+				},
+			},
+			&structs.ServiceRouterConfigEntry{
+				Kind:           structs.ServiceRouter,
+				Name:           "db",
+				EnterpriseMeta: entMeta,
+				Routes: []structs.ServiceRoute{
+					{
+						Match: httpMatch(&structs.ServiceRouteHTTPMatch{
+							PathPrefix: "/insecure",
+						}),
+						Redirect: &structs.ServiceRouteRedirect{
+							Scheme: "https",
+							Port:   8443,
+						},
+					},
+					{
+						Match: httpMatch(&structs.ServiceRouteHTTPMatch{
+							PathPrefix: "/old/",
+						}),
+						Redirect: &structs.ServiceRouteRedirect{
+							Host:          "new.example.com",
+							PrefixRewrite: "/new/",
+							StripQuery:    true,
+							StatusCode:    307,
+						},
+					},
+					{
+						Match: httpMatch(&structs.ServiceRouteHTTPMatch{
+							PathExact: "/moved",
+						}),
+						Redirect: &structs.ServiceRouteRedirect{
+							Path:       "/elsewhere",
+							StatusCode: 302,
+						},
+					},
+					{
+						Match: httpMatch(&structs.ServiceRouteHTTPMatch{
+							PathPrefix: "/maintenance",
+						}),
+						DirectResponse: &structs.ServiceRouteDirectResponse{
+							Status: 503,
+							Body:   "down for maintenance",
+						},
+						Fault: &structs.ServiceRouteFault{
+							Delay: &structs.ServiceRouteFaultDelay{
+								FixedDelay: time.Second,
+							},
+						},
+					},
+					{
+						Match: httpMatch(&structs.ServiceRouteHTTPMatch{
+							PathExact: "/healthz",
+						}),
+						DirectResponse: &structs.ServiceRouteDirectResponse{
+							Status: 200,
+						},
+					},
+				},
+			},
+		)
+	case "chain-and-router-with-hedging":
+		entries = append(entries,
+			&structs.ProxyConfigEntry{
+				Kind:           structs.ProxyDefaults,
+				Name:           structs.ProxyConfigGlobal,
+				EnterpriseMeta: entMeta,
+				Protocol:       "http",
+				Config: map[string]interface{}{
+					"protocol": "http",
+				},
+			},
+			&structs.ServiceRouterConfigEntry{
+				Kind:           structs.ServiceRouter,
+				Name:           "db",
+				EnterpriseMeta: entMeta,
+				Routes: []structs.ServiceRoute{
+					{
+						Match: httpMatch(&structs.ServiceRouteHTTPMatch{
+							PathPrefix: "/hedged",
+						}),
+						Destination: &structs.ServiceRouteDestination{
+							Service:       "prefix",
+							NumRetries:    2,
+							PerTryTimeout: 250 * time.Millisecond,
+							HedgePolicy: &structs.HedgePolicy{
+								InitialRequests:      2,
+								HedgeOnPerTryTimeout: true,
+							},
+							RetryBudget: &structs.RetryBudget{
+								BudgetPercent:       pointerTo(25.0),
+								MinRetryConcurrency: pointerTo(5),
+							},
+						},
+					},
+					{
+						Match: httpMatch(&structs.ServiceRouteHTTPMatch{
+							PathPrefix: "/budgeted",
+						}),
+						Destination: &structs.ServiceRouteDestination{
+							NumRetries: 3,
+							RetryBudget: &structs.RetryBudget{
+								BudgetPercent: pointerTo(20.0),
+							},
+						},
+					},
+					{
+						Match: httpMatch(&structs.ServiceRouteHTTPMatch{
+							PathPrefix: "/strict",
+						}),
+						Destination: &structs.ServiceRouteDestination{
+							NumRetries: 1,
+							RetryBudget: &structs.RetryBudget{
+								BudgetPercent:       pointerTo(10.0),
+								MinRetryConcurrency: pointerTo(2),
+							},
+						},
+					},
+				},
+			},
+		)
+	case "chain-and-router-with-session-affinity":
+		entries = append(entries,
+			&structs.ProxyConfigEntry{
+				Kind:           structs.ProxyDefaults,
+				Name:           structs.ProxyConfigGlobal,
+				EnterpriseMeta: entMeta,
+				Protocol:       "http",
+				Config: map[string]interface{}{
+					"protocol": "http",
+				},
+			},
+			&structs.ServiceResolverConfigEntry{
+				Kind:           structs.ServiceResolver,
+				Name:           "db",
+				EnterpriseMeta: entMeta,
+				ConnectTimeout: 33 * time.Second,
+				LoadBalancer: &structs.LoadBalancer{
+					Policy: structs.LBPolicyLeastRequest,
+					SessionAffinity: &structs.SessionAffinity{
+						Type: structs.SessionAffinityCookie,
+						Name: "session",
+						CookieConfig: &structs.SessionAffinityCookieConfig{
+							TTL:  10 * time.Minute,
+							Path: "/",
+						},
+					},
+				},
+			},
+			&structs.ServiceRouterConfigEntry{
+				Kind:           structs.ServiceRouter,
+				Name:           "db",
+				EnterpriseMeta: entMeta,
+				Routes: []structs.ServiceRoute{
+					{
+						Match: httpMatch(&structs.ServiceRouteHTTPMatch{
+							PathPrefix: "/pinned",
+						}),
+						Destination: &structs.ServiceRouteDestination{
+							Service: "prefix",
+							SessionAffinity: &structs.SessionAffinity{
+								Type:   structs.SessionAffinityHeader,
+								Name:   "x-session-id",
+								Strict: true,
+							},
+						},
+					},
+				},
+			},
+		)
 	default:
 		e, p := extraDiscoChainConfig(t, variation, entMeta)
 
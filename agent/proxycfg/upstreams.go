@@ -559,15 +559,17 @@ func (s *handlerUpstreams) watchDiscoveryChain(ctx context.Context, snap *Config
 
 	ctx, cancel := context.WithCancel(ctx)
 	err := s.dataSources.CompiledDiscoveryChain.Notify(ctx, &structs.DiscoveryChainRequest{
-		Datacenter:             s.source.Datacenter,
-		QueryOptions:           structs.QueryOptions{Token: s.token},
-		Name:                   opts.name,
-		EvaluateInDatacenter:   opts.datacenter,
-		EvaluateInNamespace:    opts.namespace,
-		EvaluateInPartition:    opts.partition,
-		OverrideProtocol:       opts.cfg.Protocol,
-		OverrideConnectTimeout: opts.cfg.ConnectTimeout(),
-		OverrideMeshGateway:    opts.meshGateway,
+		Datacenter:                 s.source.Datacenter,
+		QueryOptions:               structs.QueryOptions{Token: s.token},
+		Name:                       opts.name,
+		EvaluateInDatacenter:       opts.datacenter,
+		EvaluateInNamespace:        opts.namespace,
+		EvaluateInPartition:        opts.partition,
+		OverrideProtocol:           opts.cfg.Protocol,
+		OverrideConnectTimeout:     opts.cfg.ConnectTimeout(),
+		OverrideLimits:             opts.cfg.Limits,
+		OverridePassiveHealthCheck: opts.cfg.PassiveHealthCheck,
+		OverrideMeshGateway:        opts.meshGateway,
 	}, "discovery-chain:"+opts.id.String(), s.ch)
 	if err != nil {
 		cancel()
@@ -586,6 +588,11 @@ func (s *handlerUpstreams) watchDiscoveryChain(ctx context.Context, snap *Config
 type reducedUpstreamConfig struct {
 	Protocol         string `mapstructure:"protocol"`
 	ConnectTimeoutMs int    `mapstructure:"connect_timeout_ms"`
+
+	// Limits and PassiveHealthCheck override the defaults from the resolver of
+	// the upstream service.
+	Limits             *structs.UpstreamLimits     `mapstructure:"-"`
+	PassiveHealthCheck *structs.PassiveHealthCheck `mapstructure:"-"`
 }
 
 func (c *reducedUpstreamConfig) ConnectTimeout() time.Duration {
@@ -594,7 +601,13 @@ func (c *reducedUpstreamConfig) ConnectTimeout() time.Duration {
 
 func parseReducedUpstreamConfig(m map[string]interface{}) (reducedUpstreamConfig, error) {
 	var cfg reducedUpstreamConfig
-	err := mapstructure.WeakDecode(m, &cfg)
+	if err := mapstructure.WeakDecode(m, &cfg); err != nil {
+		return cfg, err
+	}
+
+	full, err := structs.ParseUpstreamConfigNoDefaults(m)
+	cfg.Limits = full.Limits
+	cfg.PassiveHealthCheck = full.PassiveHealthCheck
 	return cfg, err
 }
 
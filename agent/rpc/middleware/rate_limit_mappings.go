@@ -69,6 +69,7 @@ var rpcRateLimitSpecs = map[string]rate.OperationSpec{
 	"ConnectCA.Roots":            {Type: rate.OperationTypeRead, Category: rate.OperationCategoryConnectCA},
 	"ConnectCA.Sign":             {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryConnectCA},
 	"ConnectCA.SignIntermediate": {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryConnectCA},
+	"ConnectCA.SignJWTSVID":      {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryConnectCA},
 
 	"Coordinate.ListDatacenters": {Type: rate.OperationTypeRead, Category: rate.OperationCategoryCoordinate},
 	"Coordinate.ListNodes":       {Type: rate.OperationTypeRead, Category: rate.OperationCategoryCoordinate},
@@ -141,6 +142,7 @@ var rpcRateLimitSpecs = map[string]rate.OperationSpec{
 	"Status.Peers":     {Type: rate.OperationTypeExempt, Category: rate.OperationCategoryStatus},
 	"Status.Ping":      {Type: rate.OperationTypeExempt, Category: rate.OperationCategoryStatus},
 	"Status.RaftStats": {Type: rate.OperationTypeExempt, Category: rate.OperationCategoryStatus},
+	"Status.ReadIndex": {Type: rate.OperationTypeExempt, Category: rate.OperationCategoryStatus},
 
 	"Txn.Apply": {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryTxn},
 	"Txn.Read":  {Type: rate.OperationTypeRead, Category: rate.OperationCategoryTxn},
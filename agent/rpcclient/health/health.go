@@ -102,6 +102,9 @@ func (c *Client) Notify(
 func (c *Client) useStreaming(req structs.ServiceSpecificRequest) bool {
 	return c.UseStreamingBackend &&
 		!req.Ingress &&
+		// The materialized view is not caught up with the leader for each
+		// request, so follower-consistent reads must use the RPC backend.
+		!req.FollowerConsistent &&
 		// Streaming is incompatible with NearestN queries (due to lack of ordering),
 		// so we can only use it if the NearestN would never work (Node == "")
 		// or if we explicitly say to ignore the Node field for queries (agentless xDS).
@@ -98,6 +98,15 @@ func TestClient_ServiceNodes_BackendRouting(t *testing.T) {
 			},
 			expected: useRPC,
 		},
+		{
+			name: "rpc if follower-consistent",
+			req: structs.ServiceSpecificRequest{
+				Datacenter:   "dc1",
+				ServiceName:  "web1",
+				QueryOptions: structs.QueryOptions{MinQueryIndex: 22, FollowerConsistent: true},
+			},
+			expected: useRPC,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+// aclClientCertTokenPrefix is the prefix of the tokens that agents use for
+// requests authenticated by a client certificate binding.
+const aclClientCertTokenPrefix = "client-cert."
+
+// ACLClientCertIdentity is the identity of a request that an agent
+// authenticated with a client certificate binding. Agents send it to the
+// servers in a token instead of creating an ACL token for the binding, and the
+// servers synthesize an identity with the roles and policies of the binding
+// when they resolve the token. Nothing is stored in the state store.
+//
+// The token is signed with the secret of the agent token, which the servers
+// look up by its accessor ID, so only the agent can create it.
+type ACLClientCertIdentity struct {
+	// AgentTokenAccessorID is the accessor ID of the agent token.
+	AgentTokenAccessorID string
+
+	// Node and Binding name the agent and the binding that authenticated the
+	// request.
+	Node    string
+	Binding string
+
+	// Roles and Policies are the names of the roles and policies of the
+	// binding. The agent token must be linked to them, or be allowed to
+	// write ACLs.
+	Roles    []string `json:",omitempty"`
+	Policies []string `json:",omitempty"`
+}
+
+// Token returns the token for the identity, signed with the secret of the
+// agent token.
+func (i *ACLClientCertIdentity) Token(agentTokenSecret string) (string, error) {
+	payload, err := json.Marshal(i)
+	if err != nil {
+		return "", err
+	}
+	mac := hmac.New(sha256.New, []byte(agentTokenSecret))
+	mac.Write(payload)
+
+	return aclClientCertTokenPrefix +
+		base64.RawURLEncoding.EncodeToString(payload) + "." +
+		base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// ParseACLClientCertToken returns the identity of a client certificate token
+// without verifying its signature. The second return value is false if the
+// token is not a client certificate token.
+func ParseACLClientCertToken(token string) (*ACLClientCertIdentity, bool) {
+	rest, ok := strings.CutPrefix(token, aclClientCertTokenPrefix)
+	if !ok {
+		return nil, false
+	}
+	encoded, _, ok := strings.Cut(rest, ".")
+	if !ok {
+		return nil, false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, false
+	}
+	var identity ACLClientCertIdentity
+	if err := json.Unmarshal(payload, &identity); err != nil {
+		return nil, false
+	}
+	return &identity, true
+}
+
+// VerifyACLClientCertToken reports whether the token was signed with the
+// secret of the agent token.
+func VerifyACLClientCertToken(token, agentTokenSecret string) bool {
+	rest, ok := strings.CutPrefix(token, aclClientCertTokenPrefix)
+	if !ok {
+		return false
+	}
+	encoded, sig, ok := strings.Cut(rest, ".")
+	if !ok {
+		return false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return false
+	}
+	got, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(agentTokenSecret))
+	mac.Write(payload)
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+// ACLClientCertAccessorID returns the accessor ID of the identity that is
+// synthesized for a client certificate token. It is derived from the token so
+// that agents can log it and the servers report the same ID.
+func ACLClientCertAccessorID(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	id, _ := uuid.FormatUUID(sum[:16])
+	return id
+}
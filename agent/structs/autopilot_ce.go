@@ -5,6 +5,18 @@
 
 package structs
 
+// AutopilotConfigExt holds the redundancy zone and upgrade migration settings
+// that are passed to the autopilot promoter.
+type AutopilotConfigExt struct {
+	RedundancyZoneTag       string
+	DisableUpgradeMigration bool
+	UpgradeVersionTag       string
+}
+
 func (c *AutopilotConfig) autopilotConfigExt() interface{} {
-	return nil
+	return &AutopilotConfigExt{
+		RedundancyZoneTag:       c.RedundancyZoneTag,
+		DisableUpgradeMigration: c.DisableUpgradeMigration,
+		UpgradeVersionTag:       c.UpgradeVersionTag,
+	}
 }
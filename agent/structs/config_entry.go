@@ -42,8 +42,13 @@ const (
 	BoundAPIGateway       string = "bound-api-gateway"
 	FileSystemCertificate string = "file-system-certificate"
 	InlineCertificate     string = "inline-certificate"
+	ACMECertificate       string = "acme-certificate"
 	HTTPRoute             string = "http-route"
 	TCPRoute              string = "tcp-route"
+	GRPCRoute             string = "grpc-route"
+	TLSRoute              string = "tls-route"
+	CanaryRollout         string = "canary-rollout"
+	TrustBundle           string = "trust-bundle"
 	// TODO: decide if we want to highlight 'ip' keyword in the name of RateLimitIPConfig
 	RateLimitIPConfig string = "control-plane-request-limit"
 	JWTProvider       string = "jwt-provider"
@@ -72,8 +77,13 @@ var AllConfigEntryKinds = []string{
 	BoundAPIGateway,
 	HTTPRoute,
 	TCPRoute,
+	GRPCRoute,
+	TLSRoute,
+	CanaryRollout,
+	TrustBundle,
 	FileSystemCertificate,
 	InlineCertificate,
+	ACMECertificate,
 	RateLimitIPConfig,
 	JWTProvider,
 }
@@ -420,7 +430,6 @@ func IsIP(address string) bool {
 
 // RateLimits is rate limiting configuration that is applied to
 // inbound traffic for a service.
-// Rate limiting is a Consul enterprise feature.
 type RateLimits struct {
 	InstanceLevel InstanceLevelRateLimits `alias:"instance_level"`
 }
@@ -838,10 +847,20 @@ func MakeConfigEntry(kind, name string) (ConfigEntry, error) {
 		return &FileSystemCertificateConfigEntry{Name: name}, nil
 	case InlineCertificate:
 		return &InlineCertificateConfigEntry{Name: name}, nil
+	case ACMECertificate:
+		return &ACMECertificateConfigEntry{Name: name}, nil
 	case HTTPRoute:
 		return &HTTPRouteConfigEntry{Name: name}, nil
 	case TCPRoute:
 		return &TCPRouteConfigEntry{Name: name}, nil
+	case GRPCRoute:
+		return &GRPCRouteConfigEntry{Name: name}, nil
+	case TLSRoute:
+		return &TLSRouteConfigEntry{Name: name}, nil
+	case CanaryRollout:
+		return &CanaryRolloutConfigEntry{Name: name}, nil
+	case TrustBundle:
+		return &TrustBundleConfigEntry{Name: name}, nil
 	case JWTProvider:
 		return &JWTProviderConfigEntry{Name: name}, nil
 	default:
@@ -1256,6 +1275,10 @@ type UpstreamLimits struct {
 	// to the upstream cluster at a point in time. This is mostly applicable to HTTP/2
 	// clusters since all HTTP/1.1 requests are limited by MaxConnections.
 	MaxConcurrentRequests *int `json:",omitempty" alias:"max_concurrent_requests"`
+
+	// RetryBudget limits the number of concurrent retries to the upstream
+	// cluster relative to the number of active requests.
+	RetryBudget *RetryBudget `json:",omitempty" alias:"retry_budget"`
 }
 
 func (ul *UpstreamLimits) Clone() *UpstreamLimits {
@@ -1266,6 +1289,7 @@ func (ul *UpstreamLimits) Clone() *UpstreamLimits {
 		MaxConnections:        intPointerCopy(ul.MaxConnections),
 		MaxPendingRequests:    intPointerCopy(ul.MaxPendingRequests),
 		MaxConcurrentRequests: intPointerCopy(ul.MaxConcurrentRequests),
+		RetryBudget:           ul.RetryBudget.Clone(),
 	}
 }
 
@@ -1292,6 +1316,49 @@ func (ul UpstreamLimits) Validate() error {
 	if ul.MaxConcurrentRequests != nil && *ul.MaxConcurrentRequests < 0 {
 		return fmt.Errorf("max concurrent requests cannot be negative")
 	}
+	if ul.RetryBudget != nil {
+		if err := ul.RetryBudget.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RetryBudget bounds the number of concurrent retries to an upstream cluster
+// as a share of its active requests, so that retries cannot multiply the
+// load on an already degraded service.
+type RetryBudget struct {
+	// BudgetPercent is the percentage of active requests that may be retries
+	// at any point in time. Defaults to 20.
+	BudgetPercent *float64 `json:",omitempty" alias:"budget_percent"`
+
+	// MinRetryConcurrency is the number of concurrent retries that are
+	// always allowed regardless of the number of active requests. Defaults
+	// to 3.
+	MinRetryConcurrency *int `json:",omitempty" alias:"min_retry_concurrency"`
+}
+
+func (rb *RetryBudget) Clone() *RetryBudget {
+	if rb == nil {
+		return nil
+	}
+	rb2 := RetryBudget{
+		MinRetryConcurrency: intPointerCopy(rb.MinRetryConcurrency),
+	}
+	if rb.BudgetPercent != nil {
+		budget := *rb.BudgetPercent
+		rb2.BudgetPercent = &budget
+	}
+	return &rb2
+}
+
+func (rb RetryBudget) Validate() error {
+	if rb.BudgetPercent != nil && (*rb.BudgetPercent < 0 || *rb.BudgetPercent > 100) {
+		return fmt.Errorf("retry budget percent must be a percentage between 0 and 100")
+	}
+	if rb.MinRetryConcurrency != nil && *rb.MinRetryConcurrency < 0 {
+		return fmt.Errorf("retry budget min retry concurrency cannot be negative")
+	}
 	return nil
 }
 
@@ -0,0 +1,377 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/lib"
+)
+
+const (
+	// ACMEChallengeHTTP01 proves control of a domain by serving a token
+	// over plain HTTP on port 80 of the domain. The token is served by the
+	// API gateways that reference the certificate.
+	ACMEChallengeHTTP01 = "http-01"
+
+	// ACMEChallengeDNS01 proves control of a domain with a TXT record. The
+	// record is managed by the hook configured with connect.acme_dns_hook
+	// on the servers.
+	ACMEChallengeDNS01 = "dns-01"
+
+	// DefaultACMERenewBefore is how long before its expiry a certificate is
+	// renewed if the config entry doesn't specify it.
+	DefaultACMERenewBefore = 30 * 24 * time.Hour
+)
+
+// ACMECertificateConfigEntry manages a certificate that is obtained and
+// renewed from an ACME directory by the leader of the primary datacenter.
+// Once issued, the certificate is used by API gateways like an inline
+// certificate.
+type ACMECertificateConfigEntry struct {
+	// Kind of config entry. This will be set to structs.ACMECertificate.
+	Kind string
+
+	// Name is used to match the config entry with its associated certificate.
+	Name string
+
+	// Directory is the URL of the directory of the ACME server, such as
+	// "https://acme-v02.api.letsencrypt.org/directory".
+	Directory string
+
+	// DirectoryCACert is a PEM encoded set of CA certificates used to
+	// authenticate the ACME server instead of the system roots.
+	DirectoryCACert string `json:",omitempty" alias:"directory_ca_cert"`
+
+	// Email is the contact address of the ACME account.
+	Email string `json:",omitempty"`
+
+	// Domains are the DNS names the certificate is issued for.
+	Domains []string
+
+	// Challenge is the type of challenge used to prove control of the
+	// domains, either "http-01" or "dns-01". Defaults to "http-01".
+	Challenge string `json:",omitempty"`
+
+	// RenewBefore is how long before its expiry the certificate is renewed.
+	// Defaults to 30 days, or a third of the lifetime of the certificate if
+	// that is shorter.
+	RenewBefore time.Duration `json:",omitempty" alias:"renew_before"`
+
+	// Certificate is the PEM encoded certificate chain that was last issued.
+	// It is managed by Consul.
+	Certificate string `json:",omitempty"`
+
+	// PrivateKey is the PEM encoded private key of the certificate. It is
+	// managed by Consul.
+	PrivateKey string `json:",omitempty" alias:"private_key"`
+
+	// AccountKey is the PEM encoded private key of the ACME account that
+	// orders the certificate. It is managed by Consul.
+	AccountKey string `json:",omitempty" alias:"account_key"`
+
+	// Challenges are the pending HTTP-01 challenges that the API gateways
+	// referencing the certificate answer. They are managed by Consul.
+	Challenges []ACMEChallenge `json:",omitempty"`
+
+	Meta map[string]string `json:",omitempty"`
+	// Status is the result of the last order of the certificate.
+	Status             Status
+	Hash               uint64 `json:",omitempty" hash:"ignore"`
+	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
+	RaftIndex          `hash:"ignore"`
+}
+
+// ACMEChallenge is an HTTP-01 challenge for one of the domains of an
+// acme-certificate config entry.
+type ACMEChallenge struct {
+	// Domain is the domain being validated.
+	Domain string
+
+	// Token is served at "/.well-known/acme-challenge/<Token>".
+	Token string
+
+	// KeyAuthorization is the response to serve for the token.
+	KeyAuthorization string `alias:"key_authorization"`
+}
+
+// ACMEChallengePath returns the path that the response to an HTTP-01
+// challenge with the given token is served at.
+func ACMEChallengePath(token string) string {
+	return "/.well-known/acme-challenge/" + token
+}
+
+func (e *ACMECertificateConfigEntry) MarshalJSON() ([]byte, error) {
+	type Alias ACMECertificateConfigEntry
+	exported := &struct {
+		RenewBefore string `json:",omitempty"`
+		*Alias
+	}{
+		RenewBefore: e.RenewBefore.String(),
+		Alias:       (*Alias)(e),
+	}
+	if e.RenewBefore == 0 {
+		exported.RenewBefore = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (e *ACMECertificateConfigEntry) UnmarshalJSON(data []byte) error {
+	type Alias ACMECertificateConfigEntry
+	aux := &struct {
+		RenewBefore string
+		*Alias
+	}{
+		Alias: (*Alias)(e),
+	}
+	if err := lib.UnmarshalJSON(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if aux.RenewBefore != "" {
+		if e.RenewBefore, err = time.ParseDuration(aux.RenewBefore); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IssuedCertificate returns the leaf of the certificate chain that was last
+// issued, or nil if no certificate was issued yet.
+func (e *ACMECertificateConfigEntry) IssuedCertificate() (*x509.Certificate, error) {
+	if e.Certificate == "" {
+		return nil, nil
+	}
+	block, _ := pem.Decode([]byte(e.Certificate))
+	if block == nil {
+		return nil, errors.New("failed to parse certificate PEM")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// RenewAt returns when the given certificate of the entry should be renewed.
+func (e *ACMECertificateConfigEntry) RenewAt(cert *x509.Certificate) time.Time {
+	renewBefore := e.RenewBefore
+	if renewBefore == 0 {
+		renewBefore = DefaultACMERenewBefore
+	}
+	if third := cert.NotAfter.Sub(cert.NotBefore) / 3; third < renewBefore {
+		renewBefore = third
+	}
+	return cert.NotAfter.Add(-renewBefore)
+}
+
+// CoversDomains returns true if the given certificate was issued for exactly
+// the domains of the entry.
+func (e *ACMECertificateConfigEntry) CoversDomains(cert *x509.Certificate) bool {
+	return sameDomains(e.Domains, cert.DNSNames)
+}
+
+// InlineCertificate returns the issued certificate as an inline certificate
+// so that it can be served like one, or nil if no certificate was issued yet.
+func (e *ACMECertificateConfigEntry) InlineCertificate() *InlineCertificateConfigEntry {
+	if e.Certificate == "" || e.PrivateKey == "" {
+		return nil
+	}
+	return &InlineCertificateConfigEntry{
+		Kind:           InlineCertificate,
+		Name:           e.Name,
+		Certificate:    e.Certificate,
+		PrivateKey:     e.PrivateKey,
+		EnterpriseMeta: e.EnterpriseMeta,
+		RaftIndex:      e.RaftIndex,
+	}
+}
+
+func (e *ACMECertificateConfigEntry) SetHash(h uint64) {
+	e.Hash = h
+}
+
+func (e *ACMECertificateConfigEntry) GetHash() uint64 {
+	return e.Hash
+}
+
+func (e *ACMECertificateConfigEntry) GetKind() string            { return ACMECertificate }
+func (e *ACMECertificateConfigEntry) GetName() string            { return e.Name }
+func (e *ACMECertificateConfigEntry) GetMeta() map[string]string { return e.Meta }
+func (e *ACMECertificateConfigEntry) GetRaftIndex() *RaftIndex   { return &e.RaftIndex }
+func (e *ACMECertificateConfigEntry) GetEnterpriseMeta() *acl.EnterpriseMeta {
+	return &e.EnterpriseMeta
+}
+
+var _ ControlledConfigEntry = (*ACMECertificateConfigEntry)(nil)
+
+func (e *ACMECertificateConfigEntry) GetStatus() Status       { return e.Status }
+func (e *ACMECertificateConfigEntry) SetStatus(status Status) { e.Status = status }
+func (e *ACMECertificateConfigEntry) DefaultStatus() Status   { return Status{} }
+
+var _ UpdatableConfigEntry = (*ACMECertificateConfigEntry)(nil)
+
+// UpdateOver keeps the issued certificate and the ACME account when the
+// entry is rewritten without them, so that editing an entry does not take
+// the certificate away from the gateways until it is issued again.
+func (e *ACMECertificateConfigEntry) UpdateOver(rawPrev ConfigEntry) error {
+	prev, ok := rawPrev.(*ACMECertificateConfigEntry)
+	if !ok {
+		return fmt.Errorf("previous config entry is not of type %T: %T", e, rawPrev)
+	}
+
+	if e.Directory != prev.Directory {
+		return nil
+	}
+	if e.AccountKey == "" {
+		e.AccountKey = prev.AccountKey
+	}
+	if e.Certificate == "" && e.PrivateKey == "" && sameDomains(e.Domains, prev.Domains) {
+		e.Certificate = prev.Certificate
+		e.PrivateKey = prev.PrivateKey
+	}
+
+	h, err := HashConfigEntry(e)
+	if err != nil {
+		return err
+	}
+	e.Hash = h
+	return nil
+}
+
+func (e *ACMECertificateConfigEntry) Normalize() error {
+	if e == nil {
+		return fmt.Errorf("config entry is nil")
+	}
+
+	e.Kind = ACMECertificate
+	e.EnterpriseMeta.Normalize()
+
+	if e.Challenge == "" {
+		e.Challenge = ACMEChallengeHTTP01
+	}
+	for i, domain := range e.Domains {
+		e.Domains[i] = strings.ToLower(domain)
+	}
+
+	h, err := HashConfigEntry(e)
+	if err != nil {
+		return err
+	}
+	e.Hash = h
+	return nil
+}
+
+func (e *ACMECertificateConfigEntry) Validate() error {
+	if e.Name == "" {
+		return fmt.Errorf("Name is required")
+	}
+
+	u, err := url.Parse(e.Directory)
+	if err != nil {
+		return fmt.Errorf("Directory is invalid: %w", err)
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("Directory must be an https URL")
+	}
+	if e.DirectoryCACert != "" {
+		if err := validatePEMCertificates(e.DirectoryCACert); err != nil {
+			return fmt.Errorf("DirectoryCACert is invalid: %w", err)
+		}
+	}
+
+	switch e.Challenge {
+	case "", ACMEChallengeHTTP01, ACMEChallengeDNS01:
+	default:
+		return fmt.Errorf("Challenge must be one of %q or %q", ACMEChallengeHTTP01, ACMEChallengeDNS01)
+	}
+
+	if len(e.Domains) == 0 {
+		return fmt.Errorf("at least one domain is required")
+	}
+	seen := make(map[string]struct{}, len(e.Domains))
+	for _, domain := range e.Domains {
+		if _, ok := seen[domain]; ok {
+			return fmt.Errorf("domain %q is specified more than once", domain)
+		}
+		seen[domain] = struct{}{}
+
+		name := domain
+		if strings.HasPrefix(domain, wildcardPrefix) {
+			if e.Challenge != ACMEChallengeDNS01 {
+				return fmt.Errorf("wildcard domain %q requires the %q challenge", domain, ACMEChallengeDNS01)
+			}
+			name = strings.TrimPrefix(domain, wildcardPrefix)
+		}
+		if _, ok := dns.IsDomainName(name); !ok || strings.Contains(name, "*") {
+			return fmt.Errorf("domain %q must be a valid DNS hostname", domain)
+		}
+	}
+
+	if e.RenewBefore < 0 {
+		return fmt.Errorf("RenewBefore cannot be negative")
+	}
+
+	if e.Certificate != "" || e.PrivateKey != "" {
+		if _, err := tls.X509KeyPair([]byte(e.Certificate), []byte(e.PrivateKey)); err != nil {
+			return fmt.Errorf("Certificate and PrivateKey are invalid: %w", err)
+		}
+	}
+	if e.AccountKey != "" {
+		if block, _ := pem.Decode([]byte(e.AccountKey)); block == nil {
+			return errors.New("failed to parse account key PEM")
+		}
+	}
+
+	return validateConfigEntryMeta(e.Meta)
+}
+
+// Hosts returns the domains of the entry.
+func (e *ACMECertificateConfigEntry) Hosts() ([]string, error) {
+	return e.Domains, nil
+}
+
+func (e *ACMECertificateConfigEntry) CanRead(authz acl.Authorizer) error {
+	var authzContext acl.AuthorizerContext
+	e.FillAuthzContext(&authzContext)
+	return authz.ToAllowAuthorizer().MeshReadAllowed(&authzContext)
+}
+
+func (e *ACMECertificateConfigEntry) CanWrite(authz acl.Authorizer) error {
+	var authzContext acl.AuthorizerContext
+	e.FillAuthzContext(&authzContext)
+	return authz.ToAllowAuthorizer().MeshWriteAllowed(&authzContext)
+}
+
+// sameDomains returns true if both lists contain the same domains,
+// regardless of their order and case.
+func sameDomains(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	normalize := func(domains []string) []string {
+		out := make([]string, len(domains))
+		for i, domain := range domains {
+			out[i] = strings.ToLower(domain)
+		}
+		sort.Strings(out)
+		return out
+	}
+	na, nb := normalize(a), normalize(b)
+	for i := range na {
+		if na[i] != nb[i] {
+			return false
+		}
+	}
+	return true
+}
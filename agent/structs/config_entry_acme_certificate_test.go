@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/acl"
+)
+
+func TestACMECertificateConfigEntry(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]configEntryTestcase{
+		"valid http-01": {
+			entry: &ACMECertificateConfigEntry{
+				Name:      "example",
+				Directory: "https://acme.example.com/directory",
+				Domains:   []string{"Example.com", "www.example.com"},
+			},
+			expected: &ACMECertificateConfigEntry{
+				Kind:           ACMECertificate,
+				Name:           "example",
+				Directory:      "https://acme.example.com/directory",
+				Domains:        []string{"example.com", "www.example.com"},
+				Challenge:      ACMEChallengeHTTP01,
+				EnterpriseMeta: *acl.DefaultEnterpriseMeta(),
+			},
+		},
+		"valid dns-01 wildcard": {
+			entry: &ACMECertificateConfigEntry{
+				Kind:            ACMECertificate,
+				Name:            "example",
+				Directory:       "https://acme.example.com/directory",
+				DirectoryCACert: validCertificate,
+				Email:           "admin@example.com",
+				Domains:         []string{"*.example.com", "example.com"},
+				Challenge:       ACMEChallengeDNS01,
+				RenewBefore:     7 * 24 * time.Hour,
+			},
+			expectUnchanged: true,
+		},
+		"missing name": {
+			entry: &ACMECertificateConfigEntry{
+				Directory: "https://acme.example.com/directory",
+				Domains:   []string{"example.com"},
+			},
+			validateErr: "Name is required",
+		},
+		"http directory": {
+			entry: &ACMECertificateConfigEntry{
+				Name:      "example",
+				Directory: "http://acme.example.com/directory",
+				Domains:   []string{"example.com"},
+			},
+			validateErr: "Directory must be an https URL",
+		},
+		"invalid directory CA cert": {
+			entry: &ACMECertificateConfigEntry{
+				Name:            "example",
+				Directory:       "https://acme.example.com/directory",
+				DirectoryCACert: "not a certificate",
+				Domains:         []string{"example.com"},
+			},
+			validateErr: "DirectoryCACert is invalid",
+		},
+		"unknown challenge": {
+			entry: &ACMECertificateConfigEntry{
+				Name:      "example",
+				Directory: "https://acme.example.com/directory",
+				Domains:   []string{"example.com"},
+				Challenge: "tls-alpn-01",
+			},
+			validateErr: "Challenge must be one of",
+		},
+		"no domains": {
+			entry: &ACMECertificateConfigEntry{
+				Name:      "example",
+				Directory: "https://acme.example.com/directory",
+			},
+			validateErr: "at least one domain is required",
+		},
+		"duplicate domain": {
+			entry: &ACMECertificateConfigEntry{
+				Name:      "example",
+				Directory: "https://acme.example.com/directory",
+				Domains:   []string{"example.com", "EXAMPLE.com"},
+			},
+			validateErr: "domain \"example.com\" is specified more than once",
+		},
+		"wildcard with http-01": {
+			entry: &ACMECertificateConfigEntry{
+				Name:      "example",
+				Directory: "https://acme.example.com/directory",
+				Domains:   []string{"*.example.com"},
+			},
+			validateErr: "wildcard domain \"*.example.com\" requires the \"dns-01\" challenge",
+		},
+		"invalid domain": {
+			entry: &ACMECertificateConfigEntry{
+				Name:      "example",
+				Directory: "https://acme.example.com/directory",
+				Domains:   []string{"www.*.example.com"},
+				Challenge: ACMEChallengeDNS01,
+			},
+			validateErr: "must be a valid DNS hostname",
+		},
+		"negative renew before": {
+			entry: &ACMECertificateConfigEntry{
+				Name:        "example",
+				Directory:   "https://acme.example.com/directory",
+				Domains:     []string{"example.com"},
+				RenewBefore: -time.Hour,
+			},
+			validateErr: "RenewBefore cannot be negative",
+		},
+		"invalid certificate": {
+			entry: &ACMECertificateConfigEntry{
+				Name:        "example",
+				Directory:   "https://acme.example.com/directory",
+				Domains:     []string{"example.com"},
+				Certificate: "not a certificate",
+			},
+			validateErr: "Certificate and PrivateKey are invalid",
+		},
+		"invalid account key": {
+			entry: &ACMECertificateConfigEntry{
+				Name:       "example",
+				Directory:  "https://acme.example.com/directory",
+				Domains:    []string{"example.com"},
+				AccountKey: "not a key",
+			},
+			validateErr: "failed to parse account key PEM",
+		},
+	}
+	testConfigEntryNormalizeAndValidate(t, cases)
+}
+
+func TestACMECertificateConfigEntry_UpdateOver(t *testing.T) {
+	prev := &ACMECertificateConfigEntry{
+		Kind:        ACMECertificate,
+		Name:        "example",
+		Directory:   "https://acme.example.com/directory",
+		Domains:     []string{"example.com", "www.example.com"},
+		Certificate: validCertificate,
+		PrivateKey:  validPrivateKey,
+		AccountKey:  "account key",
+	}
+
+	t.Run("keeps issued certificate", func(t *testing.T) {
+		entry := &ACMECertificateConfigEntry{
+			Name:        "example",
+			Directory:   "https://acme.example.com/directory",
+			Domains:     []string{"www.example.com", "example.com"},
+			RenewBefore: time.Hour,
+		}
+		require.NoError(t, entry.Normalize())
+		hash := entry.Hash
+
+		require.NoError(t, entry.UpdateOver(prev))
+		require.Equal(t, validCertificate, entry.Certificate)
+		require.Equal(t, validPrivateKey, entry.PrivateKey)
+		require.Equal(t, "account key", entry.AccountKey)
+		require.NotEqual(t, hash, entry.Hash)
+	})
+
+	t.Run("domains changed", func(t *testing.T) {
+		entry := &ACMECertificateConfigEntry{
+			Name:      "example",
+			Directory: "https://acme.example.com/directory",
+			Domains:   []string{"example.com"},
+		}
+		require.NoError(t, entry.UpdateOver(prev))
+		require.Empty(t, entry.Certificate)
+		require.Empty(t, entry.PrivateKey)
+		require.Equal(t, "account key", entry.AccountKey)
+	})
+
+	t.Run("directory changed", func(t *testing.T) {
+		entry := &ACMECertificateConfigEntry{
+			Name:      "example",
+			Directory: "https://other.example.com/directory",
+			Domains:   []string{"example.com", "www.example.com"},
+		}
+		require.NoError(t, entry.UpdateOver(prev))
+		require.Empty(t, entry.Certificate)
+		require.Empty(t, entry.AccountKey)
+	})
+}
+
+func TestACMECertificateConfigEntry_RenewAt(t *testing.T) {
+	notBefore := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	cert := &x509.Certificate{
+		NotBefore: notBefore,
+		NotAfter:  notBefore.Add(90 * 24 * time.Hour),
+		DNSNames:  []string{"example.com", "www.example.com"},
+	}
+
+	entry := &ACMECertificateConfigEntry{Domains: []string{"www.example.com", "example.com"}}
+	require.Equal(t, cert.NotAfter.Add(-DefaultACMERenewBefore), entry.RenewAt(cert))
+	require.True(t, entry.CoversDomains(cert))
+
+	entry.RenewBefore = 24 * time.Hour
+	require.Equal(t, cert.NotAfter.Add(-24*time.Hour), entry.RenewAt(cert))
+
+	// The certificate is renewed a third of its lifetime before it expires
+	// at the latest.
+	entry.RenewBefore = 60 * 24 * time.Hour
+	require.Equal(t, cert.NotAfter.Add(-30*24*time.Hour), entry.RenewAt(cert))
+
+	// A certificate is ordered again when domains are added or removed.
+	entry.Domains = append(entry.Domains, "api.example.com")
+	require.False(t, entry.CoversDomains(cert))
+	entry.Domains = []string{"example.com"}
+	require.False(t, entry.CoversDomains(cert))
+}
+
+func TestACMECertificateConfigEntry_JSON(t *testing.T) {
+	entry := &ACMECertificateConfigEntry{
+		Kind:        ACMECertificate,
+		Name:        "example",
+		Directory:   "https://acme.example.com/directory",
+		Domains:     []string{"example.com"},
+		RenewBefore: 36 * time.Hour,
+	}
+
+	encoded, err := json.Marshal(entry)
+	require.NoError(t, err)
+	require.Contains(t, string(encoded), `"RenewBefore":"36h0m0s"`)
+
+	var decoded ACMECertificateConfigEntry
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	require.Equal(t, entry.RenewBefore, decoded.RenewBefore)
+	require.Equal(t, entry.Domains, decoded.Domains)
+}
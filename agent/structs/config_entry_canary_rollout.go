@@ -0,0 +1,283 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/lib"
+)
+
+// CanaryRolloutConfigEntry describes a progressive shift of traffic for a
+// service from a stable subset to a canary subset. The rollout is driven by a
+// controller on the leader, which owns the service-splitter for the service
+// while the rollout is in progress: it writes the weights of each step in
+// turn, advances once a step's pause has elapsed and the canary subset passes
+// the health gate, and returns all traffic to the stable subset if the gate
+// keeps failing.
+//
+// Both subsets must be defined by the service's service-resolver, and the
+// service must use an http-based protocol so that a splitter can be written.
+type CanaryRolloutConfigEntry struct {
+	Kind string
+
+	// Name is the name of the service being rolled out. The controller
+	// manages the service-splitter with the same name.
+	Name string
+
+	// StableSubset is the resolver subset that receives the traffic which is
+	// not sent to the canary. If empty, the resolver's default subset is used.
+	StableSubset string `json:",omitempty" alias:"stable_subset"`
+
+	// CanarySubset is the resolver subset that traffic is shifted to.
+	CanarySubset string `json:",omitempty" alias:"canary_subset"`
+
+	// Steps is the schedule of canary weights. Weights must be strictly
+	// increasing. The rollout completes once the pause of the last step has
+	// elapsed.
+	Steps []CanaryRolloutStep
+
+	// HealthGate determines when the canary subset is healthy enough for the
+	// rollout to continue.
+	HealthGate CanaryRolloutHealthGate `alias:"health_gate"`
+
+	Meta map[string]string `json:",omitempty"`
+	// Status is the asynchronous reconciliation status of the rollout, which
+	// includes the current step.
+	Status             Status
+	Hash               uint64 `json:",omitempty" hash:"ignore"`
+	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
+	RaftIndex          `hash:"ignore"`
+}
+
+// CanaryRolloutStep is a single step in a canary rollout.
+type CanaryRolloutStep struct {
+	// Weight is the percentage of traffic, between 0 and 100, sent to the
+	// canary subset during this step.
+	Weight float32
+
+	// Pause is how long the step is held before the rollout advances to the
+	// next one.
+	Pause time.Duration `json:",omitempty"`
+}
+
+func (s *CanaryRolloutStep) MarshalJSON() ([]byte, error) {
+	type Alias CanaryRolloutStep
+	exported := &struct {
+		Pause string `json:",omitempty"`
+		*Alias
+	}{
+		Pause: s.Pause.String(),
+		Alias: (*Alias)(s),
+	}
+	if s.Pause == 0 {
+		exported.Pause = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (s *CanaryRolloutStep) UnmarshalJSON(data []byte) error {
+	type Alias CanaryRolloutStep
+	aux := &struct {
+		Pause string
+		*Alias
+	}{
+		Alias: (*Alias)(s),
+	}
+	if err := lib.UnmarshalJSON(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if aux.Pause != "" {
+		if s.Pause, err = time.ParseDuration(aux.Pause); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CanaryRolloutHealthGate is evaluated against the catalog health of the
+// instances in the canary subset and, optionally, the error ratio of the
+// requests sent to them.
+type CanaryRolloutHealthGate struct {
+	// MinPassingRatio is the fraction of canary instances, between 0 and 1,
+	// that must be passing their health checks. If zero then every instance
+	// must be passing.
+	MinPassingRatio float32 `json:",omitempty" alias:"min_passing_ratio"`
+
+	// MinPassingInstances is the minimum number of passing canary instances
+	// required before traffic is shifted. Defaults to 1.
+	MinPassingInstances int `json:",omitempty" alias:"min_passing_instances"`
+
+	// GracePeriod is how long the health gate may fail before the rollout is
+	// rolled back.
+	GracePeriod time.Duration `json:",omitempty" alias:"grace_period"`
+
+	// MaxErrorRatio is the highest fraction of requests to the canary subset,
+	// between 0 and 1, that may fail with a 5xx response as observed by the
+	// calling proxies. If zero then the error ratio is not evaluated.
+	MaxErrorRatio float32 `json:",omitempty" alias:"max_error_ratio"`
+}
+
+func (g *CanaryRolloutHealthGate) MarshalJSON() ([]byte, error) {
+	type Alias CanaryRolloutHealthGate
+	exported := &struct {
+		GracePeriod string `json:",omitempty"`
+		*Alias
+	}{
+		GracePeriod: g.GracePeriod.String(),
+		Alias:       (*Alias)(g),
+	}
+	if g.GracePeriod == 0 {
+		exported.GracePeriod = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (g *CanaryRolloutHealthGate) UnmarshalJSON(data []byte) error {
+	type Alias CanaryRolloutHealthGate
+	aux := &struct {
+		GracePeriod string
+		*Alias
+	}{
+		Alias: (*Alias)(g),
+	}
+	if err := lib.UnmarshalJSON(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if aux.GracePeriod != "" {
+		if g.GracePeriod, err = time.ParseDuration(aux.GracePeriod); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RequiredPassingRatio returns the fraction of canary instances that must be
+// passing, applying the default of all instances.
+func (g CanaryRolloutHealthGate) RequiredPassingRatio() float32 {
+	if g.MinPassingRatio == 0 {
+		return 1
+	}
+	return g.MinPassingRatio
+}
+
+// RequiredPassingInstances returns the number of canary instances that must
+// be passing, applying the default of one instance.
+func (g CanaryRolloutHealthGate) RequiredPassingInstances() int {
+	if g.MinPassingInstances == 0 {
+		return 1
+	}
+	return g.MinPassingInstances
+}
+
+func (e *CanaryRolloutConfigEntry) SetHash(h uint64) {
+	e.Hash = h
+}
+
+func (e *CanaryRolloutConfigEntry) GetHash() uint64 {
+	return e.Hash
+}
+
+func (e *CanaryRolloutConfigEntry) GetKind() string                        { return CanaryRollout }
+func (e *CanaryRolloutConfigEntry) GetName() string                        { return e.Name }
+func (e *CanaryRolloutConfigEntry) GetMeta() map[string]string             { return e.Meta }
+func (e *CanaryRolloutConfigEntry) GetRaftIndex() *RaftIndex               { return &e.RaftIndex }
+func (e *CanaryRolloutConfigEntry) GetEnterpriseMeta() *acl.EnterpriseMeta { return &e.EnterpriseMeta }
+
+var _ ControlledConfigEntry = (*CanaryRolloutConfigEntry)(nil)
+
+func (e *CanaryRolloutConfigEntry) GetStatus() Status       { return e.Status }
+func (e *CanaryRolloutConfigEntry) SetStatus(status Status) { e.Status = status }
+func (e *CanaryRolloutConfigEntry) DefaultStatus() Status   { return Status{} }
+
+// ListRelatedServices returns nil as a rollout only shifts traffic between
+// subsets of its own service.
+func (e *CanaryRolloutConfigEntry) ListRelatedServices() []ServiceID {
+	return nil
+}
+
+func (e *CanaryRolloutConfigEntry) Normalize() error {
+	if e == nil {
+		return fmt.Errorf("config entry is nil")
+	}
+
+	e.Kind = CanaryRollout
+	e.EnterpriseMeta.Normalize()
+
+	for i, step := range e.Steps {
+		e.Steps[i].Weight = NormalizeServiceSplitWeight(step.Weight)
+	}
+
+	h, err := HashConfigEntry(e)
+	if err != nil {
+		return err
+	}
+	e.Hash = h
+	return nil
+}
+
+func (e *CanaryRolloutConfigEntry) Validate() error {
+	if e.Name == "" {
+		return fmt.Errorf("Name is required")
+	}
+
+	if e.CanarySubset == "" {
+		return fmt.Errorf("CanarySubset is required")
+	}
+
+	if e.CanarySubset == e.StableSubset {
+		return fmt.Errorf("CanarySubset and StableSubset must be different")
+	}
+
+	if len(e.Steps) == 0 {
+		return fmt.Errorf("no steps configured")
+	}
+
+	prev := 0
+	for i, step := range e.Steps {
+		weight := scaleWeight(step.Weight)
+		if weight <= 0 || weight > 100*100 {
+			return fmt.Errorf("Steps[%d].Weight must be greater than 0 and at most 100", i)
+		}
+		if weight <= prev {
+			return fmt.Errorf("Steps[%d].Weight must be greater than the weight of the previous step", i)
+		}
+		prev = weight
+
+		if step.Pause < 0 {
+			return fmt.Errorf("Steps[%d].Pause cannot be negative", i)
+		}
+	}
+
+	gate := e.HealthGate
+	if gate.MinPassingRatio < 0 || gate.MinPassingRatio > 1 {
+		return fmt.Errorf("HealthGate.MinPassingRatio must be between 0 and 1")
+	}
+	if gate.MinPassingInstances < 0 {
+		return fmt.Errorf("HealthGate.MinPassingInstances cannot be negative")
+	}
+	if gate.GracePeriod < 0 {
+		return fmt.Errorf("HealthGate.GracePeriod cannot be negative")
+	}
+	if gate.MaxErrorRatio < 0 || gate.MaxErrorRatio > 1 {
+		return fmt.Errorf("HealthGate.MaxErrorRatio must be between 0 and 1")
+	}
+
+	return validateConfigEntryMeta(e.Meta)
+}
+
+func (e *CanaryRolloutConfigEntry) CanRead(authz acl.Authorizer) error {
+	return canReadDiscoveryChain(e, authz)
+}
+
+func (e *CanaryRolloutConfigEntry) CanWrite(authz acl.Authorizer) error {
+	return canWriteDiscoveryChain(e, authz)
+}
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/acl"
+)
+
+func TestCanaryRolloutConfigEntry(t *testing.T) {
+	t.Parallel()
+
+	steps := []CanaryRolloutStep{
+		{Weight: 10, Pause: time.Minute},
+		{Weight: 50, Pause: time.Minute},
+		{Weight: 100},
+	}
+
+	cases := map[string]configEntryTestcase{
+		"valid": {
+			entry: &CanaryRolloutConfigEntry{
+				Name:         "web",
+				StableSubset: "v1",
+				CanarySubset: "v2",
+				Steps:        steps,
+				HealthGate: CanaryRolloutHealthGate{
+					MinPassingRatio:     0.9,
+					MinPassingInstances: 2,
+					GracePeriod:         30 * time.Second,
+				},
+			},
+			expected: &CanaryRolloutConfigEntry{
+				Kind:         CanaryRollout,
+				Name:         "web",
+				StableSubset: "v1",
+				CanarySubset: "v2",
+				Steps:        steps,
+				HealthGate: CanaryRolloutHealthGate{
+					MinPassingRatio:     0.9,
+					MinPassingInstances: 2,
+					GracePeriod:         30 * time.Second,
+				},
+				EnterpriseMeta: *acl.DefaultEnterpriseMeta(),
+			},
+		},
+		"missing name": {
+			entry: &CanaryRolloutConfigEntry{
+				CanarySubset: "v2",
+				Steps:        steps,
+			},
+			validateErr: "Name is required",
+		},
+		"missing canary subset": {
+			entry: &CanaryRolloutConfigEntry{
+				Name:         "web",
+				StableSubset: "v1",
+				Steps:        steps,
+			},
+			validateErr: "CanarySubset is required",
+		},
+		"same subsets": {
+			entry: &CanaryRolloutConfigEntry{
+				Name:         "web",
+				StableSubset: "v1",
+				CanarySubset: "v1",
+				Steps:        steps,
+			},
+			validateErr: "CanarySubset and StableSubset must be different",
+		},
+		"no steps": {
+			entry: &CanaryRolloutConfigEntry{
+				Name:         "web",
+				CanarySubset: "v2",
+			},
+			validateErr: "no steps configured",
+		},
+		"zero weight": {
+			entry: &CanaryRolloutConfigEntry{
+				Name:         "web",
+				CanarySubset: "v2",
+				Steps:        []CanaryRolloutStep{{Weight: 0}},
+			},
+			validateErr: "Steps[0].Weight must be greater than 0 and at most 100",
+		},
+		"weight over 100": {
+			entry: &CanaryRolloutConfigEntry{
+				Name:         "web",
+				CanarySubset: "v2",
+				Steps:        []CanaryRolloutStep{{Weight: 101}},
+			},
+			validateErr: "Steps[0].Weight must be greater than 0 and at most 100",
+		},
+		"decreasing weights": {
+			entry: &CanaryRolloutConfigEntry{
+				Name:         "web",
+				CanarySubset: "v2",
+				Steps:        []CanaryRolloutStep{{Weight: 50}, {Weight: 50}},
+			},
+			validateErr: "Steps[1].Weight must be greater than the weight of the previous step",
+		},
+		"negative pause": {
+			entry: &CanaryRolloutConfigEntry{
+				Name:         "web",
+				CanarySubset: "v2",
+				Steps:        []CanaryRolloutStep{{Weight: 50, Pause: -time.Second}},
+			},
+			validateErr: "Steps[0].Pause cannot be negative",
+		},
+		"invalid passing ratio": {
+			entry: &CanaryRolloutConfigEntry{
+				Name:         "web",
+				CanarySubset: "v2",
+				Steps:        steps,
+				HealthGate:   CanaryRolloutHealthGate{MinPassingRatio: 1.5},
+			},
+			validateErr: "HealthGate.MinPassingRatio must be between 0 and 1",
+		},
+		"negative grace period": {
+			entry: &CanaryRolloutConfigEntry{
+				Name:         "web",
+				CanarySubset: "v2",
+				Steps:        steps,
+				HealthGate:   CanaryRolloutHealthGate{GracePeriod: -time.Second},
+			},
+			validateErr: "HealthGate.GracePeriod cannot be negative",
+		},
+		"invalid error ratio": {
+			entry: &CanaryRolloutConfigEntry{
+				Name:         "web",
+				CanarySubset: "v2",
+				Steps:        steps,
+				HealthGate:   CanaryRolloutHealthGate{MaxErrorRatio: -0.1},
+			},
+			validateErr: "HealthGate.MaxErrorRatio must be between 0 and 1",
+		},
+	}
+	testConfigEntryNormalizeAndValidate(t, cases)
+}
+
+func TestCanaryRolloutConfigEntry_JSON(t *testing.T) {
+	entry := &CanaryRolloutConfigEntry{
+		Kind:         CanaryRollout,
+		Name:         "web",
+		CanarySubset: "v2",
+		Steps:        []CanaryRolloutStep{{Weight: 10, Pause: 90 * time.Second}, {Weight: 100}},
+		HealthGate:   CanaryRolloutHealthGate{GracePeriod: 30 * time.Second},
+	}
+
+	encoded, err := json.Marshal(entry)
+	require.NoError(t, err)
+	require.Contains(t, string(encoded), `"Pause":"1m30s"`)
+	require.Contains(t, string(encoded), `"GracePeriod":"30s"`)
+
+	var decoded CanaryRolloutConfigEntry
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	require.Equal(t, entry.Steps, decoded.Steps)
+	require.Equal(t, entry.HealthGate, decoded.HealthGate)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-multierror"
 
 	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/api"
 )
 
 func (e *ProxyConfigEntry) validateEnterpriseMeta() error {
@@ -54,13 +55,89 @@ func makeEnterpriseConfigEntry(kind, name string) ConfigEntry {
 }
 
 func validateRatelimit(rl *RateLimits) error {
-	if rl != nil {
-		return fmt.Errorf("invalid rate_limits config. Rate limiting is a consul enterprise feature")
+	if rl == nil {
+		return nil
+	}
+
+	il := rl.InstanceLevel
+	if err := validateInstanceLevelRatelimit(il.RequestsPerSecond, il.RequestsMaxBurst); err != nil {
+		return fmt.Errorf("invalid rate_limits config: %w", err)
+	}
+
+	for i, route := range il.Routes {
+		paths := 0
+		if route.PathExact != "" {
+			paths++
+			if !strings.HasPrefix(route.PathExact, "/") {
+				return fmt.Errorf("invalid rate_limits config: Routes[%d] PathExact doesn't start with '/': %q", i, route.PathExact)
+			}
+		}
+		if route.PathPrefix != "" {
+			paths++
+			if !strings.HasPrefix(route.PathPrefix, "/") {
+				return fmt.Errorf("invalid rate_limits config: Routes[%d] PathPrefix doesn't start with '/': %q", i, route.PathPrefix)
+			}
+		}
+		if route.PathRegex != "" {
+			paths++
+		}
+		if paths != 1 {
+			return fmt.Errorf("invalid rate_limits config: Routes[%d] must contain exactly one of PathExact, PathPrefix, or PathRegex", i)
+		}
+		if route.RequestsPerSecond <= 0 {
+			return fmt.Errorf("invalid rate_limits config: Routes[%d] RequestsPerSecond is required", i)
+		}
+		if err := validateInstanceLevelRatelimit(route.RequestsPerSecond, route.RequestsMaxBurst); err != nil {
+			return fmt.Errorf("invalid rate_limits config: Routes[%d] %w", i, err)
+		}
 	}
 	return nil
 }
 
-func (rl RateLimits) ToEnvoyExtension() *EnvoyExtension { return nil }
+func validateInstanceLevelRatelimit(rps, burst int) error {
+	switch {
+	case rps < 0:
+		return fmt.Errorf("RequestsPerSecond must be greater than or equal to zero")
+	case burst < 0:
+		return fmt.Errorf("RequestsMaxBurst must be greater than or equal to zero")
+	case burst > 0 && rps == 0:
+		return fmt.Errorf("RequestsPerSecond is required when RequestsMaxBurst is set")
+	case burst > 0 && burst < rps:
+		return fmt.Errorf("RequestsMaxBurst must be greater than or equal to RequestsPerSecond")
+	}
+	return nil
+}
+
+// ToEnvoyExtension returns the builtin local rate limit extension enforcing
+// the instance level rate limits on the public listener of the service's
+// sidecar proxies, or nil if no limit is configured.
+func (rl RateLimits) ToEnvoyExtension() *EnvoyExtension {
+	il := rl.InstanceLevel
+	if il.RequestsPerSecond == 0 && len(il.Routes) == 0 {
+		return nil
+	}
+
+	routes := make([]interface{}, 0, len(il.Routes))
+	for _, route := range il.Routes {
+		routes = append(routes, map[string]interface{}{
+			"PathExact":         route.PathExact,
+			"PathPrefix":        route.PathPrefix,
+			"PathRegex":         route.PathRegex,
+			"RequestsPerSecond": route.RequestsPerSecond,
+			"RequestsMaxBurst":  route.RequestsMaxBurst,
+		})
+	}
+
+	return &EnvoyExtension{
+		Name: api.BuiltinLocalRatelimitExtension,
+		Arguments: map[string]interface{}{
+			"ProxyType":         string(api.ServiceKindConnectProxy),
+			"RequestsPerSecond": il.RequestsPerSecond,
+			"RequestsMaxBurst":  il.RequestsMaxBurst,
+			"Routes":            routes,
+		},
+	}
+}
 
 // GetLocalUpstreamIDs returns the list of non-peer service ids for upstreams defined on this request.
 // This is often used for fetching service-defaults config entries.
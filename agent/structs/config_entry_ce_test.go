@@ -10,6 +10,8 @@ import (
 
 	"github.com/hashicorp/hcl"
 	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/api"
 )
 
 func TestDecodeConfigEntry_CE(t *testing.T) {
@@ -158,3 +160,109 @@ func Test_GetLocalUpstreamIDs(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceConfigEntry_RateLimits_CE(t *testing.T) {
+	makeEntry := func(il InstanceLevelRateLimits) *ServiceConfigEntry {
+		return &ServiceConfigEntry{
+			Kind:       ServiceDefaults,
+			Name:       "web",
+			Protocol:   "http",
+			RateLimits: &RateLimits{InstanceLevel: il},
+		}
+	}
+
+	cases := map[string]configEntryTestcase{
+		"instance level limits": {
+			entry: makeEntry(InstanceLevelRateLimits{
+				RequestsPerSecond: 100,
+				RequestsMaxBurst:  200,
+				Routes: []InstanceLevelRouteRateLimits{
+					{PathExact: "/admin", RequestsPerSecond: 1},
+					{PathPrefix: "/api/", RequestsPerSecond: 10, RequestsMaxBurst: 20},
+					{PathRegex: "/v[0-9]+/.*", RequestsPerSecond: 5},
+				},
+			}),
+		},
+		"route limits only": {
+			entry: makeEntry(InstanceLevelRateLimits{
+				Routes: []InstanceLevelRouteRateLimits{
+					{PathPrefix: "/", RequestsPerSecond: 1},
+				},
+			}),
+		},
+		"negative requests per second": {
+			entry:       makeEntry(InstanceLevelRateLimits{RequestsPerSecond: -1}),
+			validateErr: "RequestsPerSecond must be greater than or equal to zero",
+		},
+		"burst without requests per second": {
+			entry:       makeEntry(InstanceLevelRateLimits{RequestsMaxBurst: 10}),
+			validateErr: "RequestsPerSecond is required when RequestsMaxBurst is set",
+		},
+		"burst lower than requests per second": {
+			entry:       makeEntry(InstanceLevelRateLimits{RequestsPerSecond: 10, RequestsMaxBurst: 5}),
+			validateErr: "RequestsMaxBurst must be greater than or equal to RequestsPerSecond",
+		},
+		"route without path": {
+			entry: makeEntry(InstanceLevelRateLimits{
+				Routes: []InstanceLevelRouteRateLimits{{RequestsPerSecond: 1}},
+			}),
+			validateErr: "Routes[0] must contain exactly one of PathExact, PathPrefix, or PathRegex",
+		},
+		"route with multiple paths": {
+			entry: makeEntry(InstanceLevelRateLimits{
+				Routes: []InstanceLevelRouteRateLimits{{PathExact: "/a", PathPrefix: "/b", RequestsPerSecond: 1}},
+			}),
+			validateErr: "Routes[0] must contain exactly one of PathExact, PathPrefix, or PathRegex",
+		},
+		"route with relative path": {
+			entry: makeEntry(InstanceLevelRateLimits{
+				Routes: []InstanceLevelRouteRateLimits{{PathPrefix: "api", RequestsPerSecond: 1}},
+			}),
+			validateErr: `Routes[0] PathPrefix doesn't start with '/': "api"`,
+		},
+		"route without requests per second": {
+			entry: makeEntry(InstanceLevelRateLimits{
+				Routes: []InstanceLevelRouteRateLimits{{PathExact: "/admin"}},
+			}),
+			validateErr: "Routes[0] RequestsPerSecond is required",
+		},
+		"route burst lower than requests per second": {
+			entry: makeEntry(InstanceLevelRateLimits{
+				Routes: []InstanceLevelRouteRateLimits{{PathExact: "/admin", RequestsPerSecond: 10, RequestsMaxBurst: 1}},
+			}),
+			validateErr: "Routes[0] RequestsMaxBurst must be greater than or equal to RequestsPerSecond",
+		},
+	}
+
+	testConfigEntryNormalizeAndValidate(t, cases)
+}
+
+func TestRateLimits_ToEnvoyExtension_CE(t *testing.T) {
+	require.Nil(t, RateLimits{}.ToEnvoyExtension())
+
+	rl := RateLimits{
+		InstanceLevel: InstanceLevelRateLimits{
+			RequestsPerSecond: 100,
+			Routes: []InstanceLevelRouteRateLimits{
+				{PathExact: "/admin", RequestsPerSecond: 1, RequestsMaxBurst: 2},
+			},
+		},
+	}
+	require.Equal(t, &EnvoyExtension{
+		Name: api.BuiltinLocalRatelimitExtension,
+		Arguments: map[string]interface{}{
+			"ProxyType":         "connect-proxy",
+			"RequestsPerSecond": 100,
+			"RequestsMaxBurst":  0,
+			"Routes": []interface{}{
+				map[string]interface{}{
+					"PathExact":         "/admin",
+					"PathPrefix":        "",
+					"PathRegex":         "",
+					"RequestsPerSecond": 1,
+					"RequestsMaxBurst":  2,
+				},
+			},
+		},
+	}, rl.ToEnvoyExtension())
+}
@@ -37,6 +37,10 @@ const (
 	HashPolicyCookie     = "cookie"
 	HashPolicyHeader     = "header"
 	HashPolicyQueryParam = "query_parameter"
+
+	// Strategies used to track stateful sessions
+	SessionAffinityCookie = "cookie"
+	SessionAffinityHeader = "header"
 )
 
 var (
@@ -136,6 +140,15 @@ func (e *ServiceRouterConfigEntry) Normalize() error {
 		if route.Destination != nil && route.Destination.Partition == "" {
 			route.Destination.Partition = e.EnterpriseMeta.PartitionOrEmpty()
 		}
+		if route.Destination != nil && route.Destination.Mirror != nil {
+			mirror := route.Destination.Mirror
+			if mirror.Namespace == "" {
+				mirror.Namespace = e.EnterpriseMeta.NamespaceOrEmpty()
+			}
+			if mirror.Partition == "" {
+				mirror.Partition = e.EnterpriseMeta.PartitionOrEmpty()
+			}
+		}
 	}
 
 	h, err := HashConfigEntry(e)
@@ -243,6 +256,33 @@ func (e *ServiceRouterConfigEntry) Validate() error {
 			}
 		}
 
+		if route.Fault != nil {
+			if err := route.Fault.validate(); err != nil {
+				return fmt.Errorf("Route[%d] Fault %v", i, err)
+			}
+		}
+
+		if route.Redirect != nil {
+			if route.Destination != nil || route.DirectResponse != nil {
+				return fmt.Errorf("Route[%d] should only contain one of Destination, Redirect, or DirectResponse", i)
+			}
+			if route.Redirect.PrefixRewrite != "" && !eligibleForPrefixRewrite {
+				return fmt.Errorf("Route[%d] cannot make use of Redirect PrefixRewrite without configuring either PathExact or PathPrefix", i)
+			}
+			if err := route.Redirect.validate(); err != nil {
+				return fmt.Errorf("Route[%d] Redirect %v", i, err)
+			}
+		}
+
+		if route.DirectResponse != nil {
+			if route.Destination != nil {
+				return fmt.Errorf("Route[%d] should only contain one of Destination, Redirect, or DirectResponse", i)
+			}
+			if err := route.DirectResponse.validate(); err != nil {
+				return fmt.Errorf("Route[%d] DirectResponse %v", i, err)
+			}
+		}
+
 		if route.Destination != nil {
 			if route.Destination.PrefixRewrite != "" && !eligibleForPrefixRewrite {
 				return fmt.Errorf("Route[%d] cannot make use of PrefixRewrite without configuring either PathExact or PathPrefix", i)
@@ -253,6 +293,138 @@ func (e *ServiceRouterConfigEntry) Validate() error {
 					return fmt.Errorf("Route[%d] contains an invalid retry condition: %q", i, r)
 				}
 			}
+
+			if mirror := route.Destination.Mirror; mirror != nil {
+				if mirror.Service == "" && mirror.ServiceSubset == "" {
+					return fmt.Errorf("Route[%d] Mirror must specify at least one of Service or ServiceSubset", i)
+				}
+				if p := mirror.Percent; p != nil && (*p < 0 || *p > 100) {
+					return fmt.Errorf("Route[%d] Mirror Percent must be between 0 and 100, got %v", i, *p)
+				}
+			}
+
+			if route.Destination.PerTryTimeout < 0 {
+				return fmt.Errorf("Route[%d] PerTryTimeout must be >= 0", i)
+			}
+
+			if err := route.Destination.HedgePolicy.validate(route.Destination.PerTryTimeout); err != nil {
+				return fmt.Errorf("Route[%d] HedgePolicy %v", i, err)
+			}
+
+			if route.Destination.RetryBudget != nil {
+				if err := route.Destination.RetryBudget.Validate(); err != nil {
+					return fmt.Errorf("Route[%d] RetryBudget %v", i, err)
+				}
+			}
+
+			if route.Destination.SessionAffinity != nil {
+				if err := route.Destination.SessionAffinity.Validate(); err != nil {
+					return fmt.Errorf("Route[%d] SessionAffinity %v", i, err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// maxDirectResponseBodySize is the largest body Envoy accepts for a direct
+// response with its default configuration.
+const maxDirectResponseBodySize = 4096
+
+func (r *ServiceRouteRedirect) validate() error {
+	if r.Scheme == "" && r.Host == "" && r.Port == 0 && r.Path == "" && r.PrefixRewrite == "" && !r.StripQuery {
+		return fmt.Errorf("must specify at least one of Scheme, Host, Port, Path, PrefixRewrite, or StripQuery")
+	}
+
+	switch r.Scheme {
+	case "", "http", "https":
+	default:
+		return fmt.Errorf("Scheme must be one of http or https, got %q", r.Scheme)
+	}
+
+	if r.Port > 65535 {
+		return fmt.Errorf("Port must not be greater than 65535, got %d", r.Port)
+	}
+
+	if r.Path != "" && r.PrefixRewrite != "" {
+		return fmt.Errorf("should only contain one of Path or PrefixRewrite")
+	}
+	if r.Path != "" && !strings.HasPrefix(r.Path, "/") {
+		return fmt.Errorf("Path doesn't start with '/': %q", r.Path)
+	}
+
+	switch r.StatusCode {
+	case 0, 301, 302, 303, 307, 308:
+	default:
+		return fmt.Errorf("StatusCode must be one of 301, 302, 303, 307, or 308, got %d", r.StatusCode)
+	}
+
+	return nil
+}
+
+func (r *ServiceRouteDirectResponse) validate() error {
+	if r.Status < 200 || r.Status >= 600 {
+		return fmt.Errorf("Status must be in the range [200, 600), got %d", r.Status)
+	}
+	if len(r.Body) > maxDirectResponseBodySize {
+		return fmt.Errorf("Body must not be larger than %d bytes, got %d", maxDirectResponseBodySize, len(r.Body))
+	}
+	return nil
+}
+
+func (f *ServiceRouteFault) validate() error {
+	if f.Delay == nil && f.Abort == nil {
+		return fmt.Errorf("must specify at least one of Delay or Abort")
+	}
+
+	if f.Delay != nil {
+		if f.Delay.FixedDelay <= 0 {
+			return fmt.Errorf("Delay FixedDelay must be greater than zero")
+		}
+		if f.Delay.Percent < 0 || f.Delay.Percent > 100 {
+			return fmt.Errorf("Delay Percent must be between 0 and 100, got %v", f.Delay.Percent)
+		}
+	}
+
+	if f.Abort != nil {
+		switch {
+		case f.Abort.HTTPStatus == 0 && f.Abort.GRPCStatus == 0:
+			return fmt.Errorf("Abort must specify one of HTTPStatus or GRPCStatus")
+		case f.Abort.HTTPStatus != 0 && f.Abort.GRPCStatus != 0:
+			return fmt.Errorf("Abort should only contain one of HTTPStatus or GRPCStatus")
+		case f.Abort.HTTPStatus != 0 && (f.Abort.HTTPStatus < 200 || f.Abort.HTTPStatus >= 600):
+			return fmt.Errorf("Abort HTTPStatus must be in the range [200, 600), got %d", f.Abort.HTTPStatus)
+		case f.Abort.GRPCStatus > 16:
+			return fmt.Errorf("Abort GRPCStatus must be a valid gRPC status code between 1 and 16, got %d", f.Abort.GRPCStatus)
+		}
+		if f.Abort.Percent < 0 || f.Abort.Percent > 100 {
+			return fmt.Errorf("Abort Percent must be between 0 and 100, got %v", f.Abort.Percent)
+		}
+	}
+
+	for j, hdr := range f.Header {
+		if hdr.Name == "" {
+			return fmt.Errorf("Header[%d] missing required Name field", j)
+		}
+		hdrParts := 0
+		if hdr.Present {
+			hdrParts++
+		}
+		if hdr.Exact != "" {
+			hdrParts++
+		}
+		if hdr.Regex != "" {
+			hdrParts++
+		}
+		if hdr.Prefix != "" {
+			hdrParts++
+		}
+		if hdr.Suffix != "" {
+			hdrParts++
+		}
+		if hdrParts != 1 {
+			return fmt.Errorf("Header[%d] should only contain one of Present, Exact, Prefix, Suffix, or Regex", j)
 		}
 	}
 
@@ -325,6 +497,13 @@ func (e *ServiceRouterConfigEntry) ListRelatedServices() []ServiceID {
 			if destID != svcID {
 				found[destID] = struct{}{}
 			}
+
+			if mirror := route.Destination.Mirror; mirror != nil {
+				mirrorID := NewServiceID(defaultIfEmpty(mirror.Service, e.Name), mirror.GetEnterpriseMeta(&e.EnterpriseMeta))
+				if mirrorID != svcID {
+					found[mirrorID] = struct{}{}
+				}
+			}
 		}
 	}
 
@@ -356,6 +535,130 @@ func (e *ServiceRouterConfigEntry) GetEnterpriseMeta() *acl.EnterpriseMeta {
 type ServiceRoute struct {
 	Match       *ServiceRouteMatch       `json:",omitempty"`
 	Destination *ServiceRouteDestination `json:",omitempty"`
+
+	// Fault injects delays and aborts into the requests matching this route
+	// to test the resiliency of the downstream services.
+	Fault *ServiceRouteFault `json:",omitempty"`
+
+	// Redirect answers the requests matching this route with an HTTP redirect
+	// instead of proxying them. It cannot be combined with Destination or
+	// DirectResponse.
+	Redirect *ServiceRouteRedirect `json:",omitempty"`
+
+	// DirectResponse answers the requests matching this route with a fixed
+	// response instead of proxying them. It cannot be combined with
+	// Destination or Redirect.
+	DirectResponse *ServiceRouteDirectResponse `json:",omitempty" alias:"direct_response"`
+}
+
+// ServiceRouteRedirect describes how the URL of the redirect is derived from
+// the URL of the original request. Parts that are not configured are kept
+// from the original request.
+type ServiceRouteRedirect struct {
+	// Scheme replaces the scheme of the request, for example "https".
+	Scheme string `json:",omitempty"`
+
+	// Host replaces the host of the request.
+	Host string `json:",omitempty"`
+
+	// Port replaces the port of the request.
+	Port uint32 `json:",omitempty"`
+
+	// Path replaces the whole path of the request. It cannot be combined with
+	// PrefixRewrite.
+	Path string `json:",omitempty"`
+
+	// PrefixRewrite replaces the matched path prefix of the request. It
+	// requires the route to match on either PathExact or PathPrefix.
+	PrefixRewrite string `json:",omitempty" alias:"prefix_rewrite"`
+
+	// StripQuery removes the query string of the request from the redirect.
+	StripQuery bool `json:",omitempty" alias:"strip_query"`
+
+	// StatusCode is the status of the redirect response, one of 301, 302,
+	// 303, 307 or 308. If zero then 301 is used.
+	StatusCode uint32 `json:",omitempty" alias:"status_code"`
+}
+
+// ServiceRouteDirectResponse describes the fixed response returned for the
+// requests matching a route.
+type ServiceRouteDirectResponse struct {
+	// Status is the status of the response, in the range [200, 600).
+	Status uint32 `json:",omitempty"`
+
+	// Body is returned as the body of the response. It is limited to 4096
+	// bytes.
+	Body string `json:",omitempty"`
+}
+
+// ServiceRouteFault describes the faults injected into the requests matching
+// a route. At least one of Delay or Abort must be configured.
+type ServiceRouteFault struct {
+	Delay *ServiceRouteFaultDelay `json:",omitempty"`
+	Abort *ServiceRouteFaultAbort `json:",omitempty"`
+
+	// Header restricts the fault injection to requests matching all of the
+	// given headers. If empty then all requests matching the route are
+	// eligible.
+	Header []ServiceRouteHTTPMatchHeader `json:",omitempty"`
+}
+
+// ServiceRouteFaultDelay delays requests by a fixed duration before they are
+// proxied to the route destination.
+type ServiceRouteFaultDelay struct {
+	FixedDelay time.Duration `json:",omitempty" alias:"fixed_delay"`
+
+	// Percent is the percentage of eligible requests to delay, between 0 and
+	// 100. If zero then all eligible requests are delayed.
+	Percent float32 `json:",omitempty"`
+}
+
+func (d *ServiceRouteFaultDelay) MarshalJSON() ([]byte, error) {
+	type Alias ServiceRouteFaultDelay
+	exported := &struct {
+		FixedDelay string `json:",omitempty"`
+		*Alias
+	}{
+		FixedDelay: d.FixedDelay.String(),
+		Alias:      (*Alias)(d),
+	}
+	if d.FixedDelay == 0 {
+		exported.FixedDelay = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (d *ServiceRouteFaultDelay) UnmarshalJSON(data []byte) error {
+	type Alias ServiceRouteFaultDelay
+	aux := &struct {
+		FixedDelay string
+		*Alias
+	}{
+		Alias: (*Alias)(d),
+	}
+	if err := lib.UnmarshalJSON(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if aux.FixedDelay != "" {
+		if d.FixedDelay, err = time.ParseDuration(aux.FixedDelay); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ServiceRouteFaultAbort answers requests with an error status instead of
+// proxying them to the route destination. Exactly one of HTTPStatus or
+// GRPCStatus must be set.
+type ServiceRouteFaultAbort struct {
+	HTTPStatus uint32 `json:",omitempty" alias:"http_status"`
+	GRPCStatus uint32 `json:",omitempty" alias:"grpc_status"`
+
+	// Percent is the percentage of eligible requests to abort, between 0 and
+	// 100. If zero then all eligible requests are aborted.
+	Percent float32 `json:",omitempty"`
 }
 
 // ServiceRouteMatch is a set of criteria that can match incoming L7 requests.
@@ -468,9 +771,84 @@ type ServiceRouteDestination struct {
 	// eligible for retry. This again should be feasible in any reasonable proxy.
 	RetryOnStatusCodes []uint32 `json:",omitempty" alias:"retry_on_status_codes"`
 
+	// PerTryTimeout is the amount of time permitted for each attempt of the
+	// request, including the first one. If unset, each attempt may take up to
+	// the RequestTimeout.
+	PerTryTimeout time.Duration `json:",omitempty" alias:"per_try_timeout"`
+
+	// HedgePolicy configures sending additional requests to other instances
+	// of the destination before the first request completes.
+	HedgePolicy *HedgePolicy `json:",omitempty" alias:"hedge_policy"`
+
+	// RetryBudget bounds the number of concurrent retries to the destination
+	// as a share of its active requests. The budget applies to the upstream
+	// cluster of the destination, so it is shared by every route to it.
+	RetryBudget *RetryBudget `json:",omitempty" alias:"retry_budget"`
+
+	// SessionAffinity pins the requests of a client session to the instance
+	// of the destination that served its first request. It takes precedence
+	// over the SessionAffinity of the destination's service-resolver.
+	SessionAffinity *SessionAffinity `json:",omitempty" alias:"session_affinity"`
+
 	// Allow HTTP header manipulation to be configured.
 	RequestHeaders  *HTTPHeaderModifiers `json:",omitempty" alias:"request_headers"`
 	ResponseHeaders *HTTPHeaderModifiers `json:",omitempty" alias:"response_headers"`
+
+	// Mirror configures a service to also receive a copy of the requests
+	// matching this route. Responses from the mirror are discarded.
+	Mirror *ServiceRouteMirror `json:",omitempty"`
+}
+
+// ServiceRouteMirror describes the service that matching requests are
+// shadowed to, in addition to being proxied to the route destination.
+type ServiceRouteMirror struct {
+	// Service is the service to send a copy of the traffic to. If empty then
+	// the default discovery chain service name is used.
+	Service string `json:",omitempty"`
+
+	// ServiceSubset is a named subset of the given service to send a copy of
+	// the traffic to instead of the one defined as that service's
+	// DefaultSubset. If empty the default subset is used.
+	//
+	// Mirrored traffic is never split, so any service-splitter configured for
+	// the mirror service is ignored.
+	ServiceSubset string `json:",omitempty" alias:"service_subset"`
+
+	// Namespace is the namespace to resolve the mirror service from instead
+	// of the current namespace. If empty the current namespace is assumed.
+	Namespace string `json:",omitempty"`
+
+	// Partition is the partition to resolve the mirror service from instead
+	// of the current partition. If empty the current partition is assumed.
+	Partition string `json:",omitempty"`
+
+	// Percent is the percentage of matching requests to mirror, between 0
+	// and 100. If unset then all matching requests are mirrored, and zero
+	// stops mirroring without removing the mirror.
+	Percent *float32 `json:",omitempty"`
+}
+
+// HedgePolicy describes how requests are hedged by sending additional
+// requests to other instances of the destination.
+type HedgePolicy struct {
+	// InitialRequests is the number of requests that are sent at the same
+	// time when a request starts. Defaults to 1.
+	InitialRequests uint32 `json:",omitempty" alias:"initial_requests"`
+
+	// HedgeOnPerTryTimeout sends an additional request to another instance
+	// when an attempt exceeds the PerTryTimeout, without canceling the
+	// attempt. The first response to arrive is used.
+	HedgeOnPerTryTimeout bool `json:",omitempty" alias:"hedge_on_per_try_timeout"`
+}
+
+func (h *HedgePolicy) validate(perTryTimeout time.Duration) error {
+	if h == nil {
+		return nil
+	}
+	if h.HedgeOnPerTryTimeout && perTryTimeout <= 0 {
+		return fmt.Errorf("HedgeOnPerTryTimeout requires a PerTryTimeout")
+	}
+	return nil
 }
 
 func (e *ServiceRouteDestination) MarshalJSON() ([]byte, error) {
@@ -478,10 +856,12 @@ func (e *ServiceRouteDestination) MarshalJSON() ([]byte, error) {
 	exported := &struct {
 		RequestTimeout string `json:",omitempty"`
 		IdleTimeout    string `json:",omitempty"`
+		PerTryTimeout  string `json:",omitempty"`
 		*Alias
 	}{
 		RequestTimeout: e.RequestTimeout.String(),
 		IdleTimeout:    e.IdleTimeout.String(),
+		PerTryTimeout:  e.PerTryTimeout.String(),
 		Alias:          (*Alias)(e),
 	}
 	if e.RequestTimeout == 0 {
@@ -492,6 +872,10 @@ func (e *ServiceRouteDestination) MarshalJSON() ([]byte, error) {
 		exported.IdleTimeout = ""
 	}
 
+	if e.PerTryTimeout == 0 {
+		exported.PerTryTimeout = ""
+	}
+
 	return json.Marshal(exported)
 }
 
@@ -500,6 +884,7 @@ func (e *ServiceRouteDestination) UnmarshalJSON(data []byte) error {
 	aux := &struct {
 		RequestTimeout string
 		IdleTimeout    string
+		PerTryTimeout  string
 		*Alias
 	}{
 		Alias: (*Alias)(e),
@@ -518,11 +903,16 @@ func (e *ServiceRouteDestination) UnmarshalJSON(data []byte) error {
 			return err
 		}
 	}
+	if aux.PerTryTimeout != "" {
+		if e.PerTryTimeout, err = time.ParseDuration(aux.PerTryTimeout); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func (d *ServiceRouteDestination) HasRetryFeatures() bool {
-	return d.NumRetries > 0 || d.RetryOnConnectFailure || len(d.RetryOnStatusCodes) > 0 || len(d.RetryOn) > 0
+	return d.NumRetries > 0 || d.RetryOnConnectFailure || len(d.RetryOnStatusCodes) > 0 || len(d.RetryOn) > 0 || d.PerTryTimeout > 0
 }
 
 // ServiceSplitterConfigEntry defines how incoming requests are split across
@@ -905,6 +1295,17 @@ type ServiceResolverConfigEntry struct {
 	// issuing requests to this upstream service.
 	LoadBalancer *LoadBalancer `json:",omitempty" alias:"load_balancer"`
 
+	// Limits are the default circuit breaking limits applied by callers of
+	// this service. They are only used when the caller does not configure
+	// limits for this upstream in its own proxy or service defaults.
+	Limits *UpstreamLimits `json:",omitempty"`
+
+	// PassiveHealthCheck is the default outlier detection applied by callers
+	// of this service. It is only used when the caller does not configure a
+	// passive health check for this upstream in its own proxy or service
+	// defaults.
+	PassiveHealthCheck *PassiveHealthCheck `json:",omitempty" alias:"passive_health_check"`
+
 	Meta               map[string]string `json:",omitempty"`
 	Hash               uint64            `json:",omitempty" hash:"ignore"`
 	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
@@ -1006,7 +1407,9 @@ func (e *ServiceResolverConfigEntry) IsDefault() bool {
 		e.ConnectTimeout == 0 &&
 		e.RequestTimeout == 0 &&
 		e.LoadBalancer == nil &&
-		e.PrioritizeByLocality == nil
+		e.PrioritizeByLocality == nil &&
+		e.Limits == nil &&
+		e.PassiveHealthCheck == nil
 }
 
 func (e *ServiceResolverConfigEntry) GetKind() string {
@@ -1290,6 +1693,24 @@ func (e *ServiceResolverConfigEntry) Validate() error {
 				}
 			}
 		}
+
+		if lb.SessionAffinity != nil {
+			if err := lb.SessionAffinity.Validate(); err != nil {
+				return fmt.Errorf("Bad LoadBalancer SessionAffinity: %w", err)
+			}
+		}
+	}
+
+	if e.Limits != nil {
+		if err := e.Limits.Validate(); err != nil {
+			return fmt.Errorf("Bad Limits: %w", err)
+		}
+	}
+
+	if e.PassiveHealthCheck != nil {
+		if err := e.PassiveHealthCheck.Validate(); err != nil {
+			return fmt.Errorf("Bad PassiveHealthCheck: %w", err)
+		}
 	}
 
 	return nil
@@ -1539,6 +1960,22 @@ func (fp *ServiceResolverFailoverPolicy) validate() error {
 	return nil
 }
 
+// RegionPriority returns the rank of a failover target in the given region
+// when targets are ordered by locality. A nil region denotes a target in the
+// local cluster, which is always tried first. Targets in the regions listed in
+// Regions follow in that order and targets in all other regions come last.
+func (fp *ServiceResolverFailoverPolicy) RegionPriority(region *string) int {
+	if region == nil {
+		return 0
+	}
+	for i, r := range fp.Regions {
+		if r == *region {
+			return i + 1
+		}
+	}
+	return len(fp.Regions) + 1
+}
+
 type ServiceResolverPrioritizeByLocality struct {
 	// Mode specifies the type of prioritization that will be performed
 	// when selecting nodes in the local partition.
@@ -1546,6 +1983,21 @@ type ServiceResolverPrioritizeByLocality struct {
 	Mode string `json:",omitempty"`
 }
 
+func (pbl *ServiceResolverPrioritizeByLocality) validate() error {
+	if pbl == nil {
+		return nil
+	}
+
+	switch pbl.Mode {
+	case "":
+	case "none":
+	case "failover":
+	default:
+		return fmt.Errorf("Prioritize-by-locality mode must be one of '', 'none', or 'failover'")
+	}
+	return nil
+}
+
 type ServiceResolverFailoverTarget struct {
 	// Service specifies the name of the service to try during failover.
 	Service string `json:",omitempty"`
@@ -1595,6 +2047,11 @@ type LoadBalancer struct {
 	// If no hash policies are present, or none are successfully evaluated,
 	// then a random backend host will be selected.
 	HashPolicies []HashPolicy `json:",omitempty" alias:"hash_policies"`
+
+	// SessionAffinity pins the requests of a client session to the instance
+	// that served its first request. Requests without a session are load
+	// balanced according to the Policy.
+	SessionAffinity *SessionAffinity `json:",omitempty" alias:"session_affinity"`
 }
 
 // RingHashConfig contains configuration for the "ring_hash" policy type
@@ -1650,6 +2107,55 @@ type CookieConfig struct {
 	Path string `json:",omitempty"`
 }
 
+// SessionAffinity configures a stateful session, which stores the address of
+// the instance selected for the first request of a client in a cookie or a
+// header and sends the following requests of the session to that instance.
+type SessionAffinity struct {
+	// Type is the strategy used to track the session.
+	// Must be one of "cookie" or "header".
+	Type string `json:",omitempty"`
+
+	// Name is the name of the cookie or header holding the session.
+	Name string `json:",omitempty"`
+
+	// CookieConfig contains configuration for the "cookie" type.
+	CookieConfig *SessionAffinityCookieConfig `json:",omitempty" alias:"cookie_config"`
+
+	// Strict rejects the requests of a session whose instance is no longer
+	// available instead of load balancing them to another instance.
+	Strict bool `json:",omitempty"`
+}
+
+// SessionAffinityCookieConfig contains configuration for the cookie
+// generated for a "cookie" session.
+type SessionAffinityCookieConfig struct {
+	// TTL for the generated cookie. If unset, a session cookie is generated.
+	TTL time.Duration `json:",omitempty"`
+
+	// The path to set for the cookie
+	Path string `json:",omitempty"`
+}
+
+func (sa *SessionAffinity) Validate() error {
+	switch sa.Type {
+	case SessionAffinityCookie, SessionAffinityHeader:
+	default:
+		return fmt.Errorf("Type %q is not supported, must be one of %q or %q", sa.Type, SessionAffinityCookie, SessionAffinityHeader)
+	}
+	if sa.Name == "" {
+		return fmt.Errorf("Name is required")
+	}
+	if sa.CookieConfig != nil {
+		if sa.Type != SessionAffinityCookie {
+			return fmt.Errorf("CookieConfig provided for %q", sa.Type)
+		}
+		if sa.CookieConfig.TTL < 0 {
+			return fmt.Errorf("CookieConfig TTL must be >= 0")
+		}
+	}
+	return nil
+}
+
 func (lb *LoadBalancer) IsHashBased() bool {
 	if lb == nil {
 		return false
@@ -1729,6 +2235,14 @@ type DiscoveryChainRequest struct {
 	// overridden for any resolver in the compiled chain.
 	OverrideConnectTimeout time.Duration
 
+	// OverrideLimits allows for the circuit breaking limits of any resolver
+	// in the compiled chain to be overridden.
+	OverrideLimits *UpstreamLimits
+
+	// OverridePassiveHealthCheck allows for the outlier detection of any
+	// resolver in the compiled chain to be overridden.
+	OverridePassiveHealthCheck *PassiveHealthCheck
+
 	Datacenter string // where to route the RPC
 	QueryOptions
 }
@@ -1748,23 +2262,27 @@ func (r *DiscoveryChainRequest) CacheInfo() cache.RequestInfo {
 	}
 
 	v, err := hashstructure.Hash(struct {
-		Name                   string
-		EvaluateInDatacenter   string
-		EvaluateInNamespace    string
-		EvaluateInPartition    string
-		OverrideMeshGateway    MeshGatewayConfig
-		OverrideProtocol       string
-		OverrideConnectTimeout time.Duration
-		Filter                 string
+		Name                       string
+		EvaluateInDatacenter       string
+		EvaluateInNamespace        string
+		EvaluateInPartition        string
+		OverrideMeshGateway        MeshGatewayConfig
+		OverrideProtocol           string
+		OverrideConnectTimeout     time.Duration
+		OverrideLimits             *UpstreamLimits
+		OverridePassiveHealthCheck *PassiveHealthCheck
+		Filter                     string
 	}{
-		Name:                   r.Name,
-		EvaluateInDatacenter:   r.EvaluateInDatacenter,
-		EvaluateInNamespace:    r.EvaluateInNamespace,
-		EvaluateInPartition:    r.EvaluateInPartition,
-		OverrideMeshGateway:    r.OverrideMeshGateway,
-		OverrideProtocol:       r.OverrideProtocol,
-		OverrideConnectTimeout: r.OverrideConnectTimeout,
-		Filter:                 r.QueryOptions.Filter,
+		Name:                       r.Name,
+		EvaluateInDatacenter:       r.EvaluateInDatacenter,
+		EvaluateInNamespace:        r.EvaluateInNamespace,
+		EvaluateInPartition:        r.EvaluateInPartition,
+		OverrideMeshGateway:        r.OverrideMeshGateway,
+		OverrideProtocol:           r.OverrideProtocol,
+		OverrideConnectTimeout:     r.OverrideConnectTimeout,
+		OverrideLimits:             r.OverrideLimits,
+		OverridePassiveHealthCheck: r.OverridePassiveHealthCheck,
+		Filter:                     r.QueryOptions.Filter,
 	}, nil)
 	if err == nil {
 		// If there is an error, we don't set the key. A blank key forces
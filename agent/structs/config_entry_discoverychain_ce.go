@@ -17,6 +17,12 @@ func (dest *ServiceRouteDestination) GetEnterpriseMeta(_ *acl.EnterpriseMeta) *a
 	return DefaultEnterpriseMetaInDefaultPartition()
 }
 
+// GetEnterpriseMeta is used to synthesize the EnterpriseMeta struct from
+// fields in the ServiceRouteMirror
+func (mirror *ServiceRouteMirror) GetEnterpriseMeta(_ *acl.EnterpriseMeta) *acl.EnterpriseMeta {
+	return DefaultEnterpriseMetaInDefaultPartition()
+}
+
 // GetEnterpriseMeta is used to synthesize the EnterpriseMeta struct from
 // fields in the ServiceSplit
 func (split *ServiceSplit) GetEnterpriseMeta(_ *acl.EnterpriseMeta) *acl.EnterpriseMeta {
@@ -102,14 +108,6 @@ func (req *DiscoveryChainRequest) WithEnterpriseMeta(_ *acl.EnterpriseMeta) {
 // ValidateEnterprise validates that enterprise fields are only set
 // with enterprise binaries.
 func (f *ServiceResolverFailoverPolicy) ValidateEnterprise() error {
-	if f == nil {
-		return nil
-	}
-
-	if f.Mode != "" {
-		return fmt.Errorf("Setting failover policies requires Consul Enterprise")
-	}
-
 	return nil
 }
 
@@ -117,11 +115,3 @@ func (f *ServiceResolverFailoverPolicy) ValidateEnterprise() error {
 func (e *ServiceResolverConfigEntry) RelatedSamenessGroups() []string {
 	return nil
 }
-
-func (pbl *ServiceResolverPrioritizeByLocality) validate() error {
-	var zero ServiceResolverPrioritizeByLocality
-	if pbl == nil || *pbl == zero {
-		return nil
-	}
-	return fmt.Errorf("Prioritize-by-locality requires Consul Enterprise ")
-}
@@ -88,7 +88,7 @@ func TestServiceResolverConfigEntry_CE(t *testing.T) {
 			validateErr: `Bad Failover["*"]: Setting Namespace requires Consul Enterprise`,
 		},
 		{
-			name: "setting failover Namespace on CE",
+			name: "setting invalid failover policy mode on CE",
 			entry: &ServiceResolverConfigEntry{
 				Kind: ServiceResolver,
 				Name: "test",
@@ -96,7 +96,7 @@ func TestServiceResolverConfigEntry_CE(t *testing.T) {
 					"*": {Service: "s1", Policy: &ServiceResolverFailoverPolicy{Mode: "something"}},
 				},
 			},
-			validateErr: `Bad Failover["*"]: Setting failover policies requires Consul Enterprise`,
+			validateErr: `Bad Failover["*"]: Failover-policy mode must be one of '', 'sequential', or 'order-by-locality'`,
 		},
 		{
 			name: "setting redirect SamenessGroup on CE",
@@ -286,6 +286,38 @@ func TestConfigEntries_ListRelatedServices_AndACLs(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "router: mirror",
+			entry: &ServiceRouterConfigEntry{
+				Kind: ServiceRouter,
+				Name: "test",
+				Routes: []ServiceRoute{
+					{
+						Match: &ServiceRouteMatch{HTTP: &ServiceRouteHTTPMatch{
+							PathPrefix: "/foo",
+						}},
+						Destination: &ServiceRouteDestination{
+							Service: "foo",
+							Mirror: &ServiceRouteMirror{
+								Service: "shadow",
+							},
+						},
+					},
+				},
+			},
+			expectServices: []ServiceID{NewServiceID("foo", nil), NewServiceID("shadow", nil), NewServiceID("test", nil)},
+			expectACLs: []testACL{
+				defaultDenyCase,
+				readTestCase,
+				writeTestCaseDenied,
+				{
+					name:       "can write test (with foo:read and shadow:read)",
+					authorizer: newServiceACL(t, []string{"foo", "shadow"}, []string{"test"}),
+					canRead:    true,
+					canWrite:   true,
+				},
+			},
+		},
 		{
 			name:  "ingress-gateway",
 			entry: &IngressGatewayConfigEntry{Name: "test"},
@@ -1512,6 +1544,72 @@ func TestServiceResolverConfigEntry(t *testing.T) {
 			},
 			validateErr: `Bad Failover["*"]: Targets cannot be set with Datacenters`,
 		},
+		{
+			name: "bad limits",
+			entry: &ServiceResolverConfigEntry{
+				Kind: ServiceResolver,
+				Name: "test",
+				Limits: &UpstreamLimits{
+					MaxConnections: intPointer(-1),
+				},
+			},
+			validateErr: `Bad Limits: max connections cannot be negative`,
+		},
+		{
+			name: "bad retry budget",
+			entry: &ServiceResolverConfigEntry{
+				Kind: ServiceResolver,
+				Name: "test",
+				Limits: &UpstreamLimits{
+					RetryBudget: &RetryBudget{BudgetPercent: float64Pointer(101)},
+				},
+			},
+			validateErr: `Bad Limits: retry budget percent must be a percentage between 0 and 100`,
+		},
+		{
+			name: "bad passive health check",
+			entry: &ServiceResolverConfigEntry{
+				Kind: ServiceResolver,
+				Name: "test",
+				PassiveHealthCheck: &PassiveHealthCheck{
+					Interval: -1 * time.Second,
+				},
+			},
+			validateErr: `Bad PassiveHealthCheck: passive health check interval cannot be negative`,
+		},
+		{
+			name: "prioritize by locality failover",
+			entry: &ServiceResolverConfigEntry{
+				Kind:                 ServiceResolver,
+				Name:                 "test",
+				PrioritizeByLocality: &ServiceResolverPrioritizeByLocality{Mode: "failover"},
+			},
+		},
+		{
+			name: "prioritize by locality invalid mode",
+			entry: &ServiceResolverConfigEntry{
+				Kind:                 ServiceResolver,
+				Name:                 "test",
+				PrioritizeByLocality: &ServiceResolverPrioritizeByLocality{Mode: "nearest"},
+			},
+			validateErr: `Prioritize-by-locality mode must be one of '', 'none', or 'failover'`,
+		},
+		{
+			name: "failover policy order by locality",
+			entry: &ServiceResolverConfigEntry{
+				Kind: ServiceResolver,
+				Name: "test",
+				Failover: map[string]ServiceResolverFailover{
+					"*": {
+						Targets: []ServiceResolverFailoverTarget{{Peer: "cluster-01"}},
+						Policy: &ServiceResolverFailoverPolicy{
+							Mode:    "order-by-locality",
+							Regions: []string{"us-east-1", "us-west-2"},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "failover Targets cannot be set with ServiceSubset",
 			entry: &ServiceResolverConfigEntry{
@@ -1819,6 +1917,80 @@ func TestServiceResolverConfigEntry_LoadBalancer(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "valid cookie session affinity with least request",
+			entry: &ServiceResolverConfigEntry{
+				Kind: ServiceResolver,
+				Name: "test",
+				LoadBalancer: &LoadBalancer{
+					Policy: LBPolicyLeastRequest,
+					SessionAffinity: &SessionAffinity{
+						Type: SessionAffinityCookie,
+						Name: "session",
+						CookieConfig: &SessionAffinityCookieConfig{
+							TTL:  time.Hour,
+							Path: "/",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "valid header session affinity",
+			entry: &ServiceResolverConfigEntry{
+				Kind: ServiceResolver,
+				Name: "test",
+				LoadBalancer: &LoadBalancer{
+					SessionAffinity: &SessionAffinity{
+						Type:   SessionAffinityHeader,
+						Name:   "x-session",
+						Strict: true,
+					},
+				},
+			},
+		},
+		{
+			name: "unsupported session affinity type",
+			entry: &ServiceResolverConfigEntry{
+				Kind: ServiceResolver,
+				Name: "test",
+				LoadBalancer: &LoadBalancer{
+					SessionAffinity: &SessionAffinity{
+						Type: "query_parameter",
+						Name: "session",
+					},
+				},
+			},
+			validateErr: `Bad LoadBalancer SessionAffinity: Type "query_parameter" is not supported`,
+		},
+		{
+			name: "session affinity without name",
+			entry: &ServiceResolverConfigEntry{
+				Kind: ServiceResolver,
+				Name: "test",
+				LoadBalancer: &LoadBalancer{
+					SessionAffinity: &SessionAffinity{
+						Type: SessionAffinityCookie,
+					},
+				},
+			},
+			validateErr: `Bad LoadBalancer SessionAffinity: Name is required`,
+		},
+		{
+			name: "header session affinity with cookie config",
+			entry: &ServiceResolverConfigEntry{
+				Kind: ServiceResolver,
+				Name: "test",
+				LoadBalancer: &LoadBalancer{
+					SessionAffinity: &SessionAffinity{
+						Type:         SessionAffinityHeader,
+						Name:         "x-session",
+						CookieConfig: &SessionAffinityCookieConfig{Path: "/"},
+					},
+				},
+			},
+			validateErr: `Bad LoadBalancer SessionAffinity: CookieConfig provided for "header"`,
+		},
 		{
 			name: "supported match field",
 			entry: &ServiceResolverConfigEntry{
@@ -2743,6 +2915,354 @@ func TestServiceRouterConfigEntry(t *testing.T) {
 			validateErr: "contains an invalid retry condition: \"invalid-retry-condition\"",
 		},
 		////////////////
+		{
+			name: "route with mirror",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					Mirror: &ServiceRouteMirror{
+						Service: "shadow",
+						Percent: ptrTo[float32](12.5),
+					},
+				},
+			}),
+		},
+		{
+			name: "route with mirror to subset of the same service",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Mirror: &ServiceRouteMirror{
+						ServiceSubset: "v2",
+					},
+				},
+			}),
+		},
+		{
+			name: "route with mirror missing service",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					Mirror:  &ServiceRouteMirror{},
+				},
+			}),
+			validateErr: "Mirror must specify at least one of Service or ServiceSubset",
+		},
+		{
+			name: "route with mirror percent out of range",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					Mirror: &ServiceRouteMirror{
+						Service: "shadow",
+						Percent: ptrTo[float32](101),
+					},
+				},
+			}),
+			validateErr: "Mirror Percent must be between 0 and 100",
+		},
+		{
+			name: "route with mirror percent zero",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					Mirror: &ServiceRouteMirror{
+						Service: "shadow",
+						Percent: ptrTo[float32](0),
+					},
+				},
+			}),
+		},
+		{
+			name: "route with hedging and retry budget",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service:       "other",
+					NumRetries:    2,
+					PerTryTimeout: 250 * time.Millisecond,
+					HedgePolicy: &HedgePolicy{
+						InitialRequests:      2,
+						HedgeOnPerTryTimeout: true,
+					},
+					RetryBudget: &RetryBudget{
+						BudgetPercent:       float64Pointer(25),
+						MinRetryConcurrency: intPointer(5),
+					},
+				},
+			}),
+		},
+		{
+			name: "route with negative per try timeout",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service:       "other",
+					PerTryTimeout: -1 * time.Second,
+				},
+			}),
+			validateErr: "PerTryTimeout must be >= 0",
+		},
+		{
+			name: "route hedging on per try timeout without per try timeout",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					HedgePolicy: &HedgePolicy{
+						HedgeOnPerTryTimeout: true,
+					},
+				},
+			}),
+			validateErr: "HedgeOnPerTryTimeout requires a PerTryTimeout",
+		},
+		{
+			name: "route with retry budget percent out of range",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					RetryBudget: &RetryBudget{
+						BudgetPercent: float64Pointer(120),
+					},
+				},
+			}),
+			validateErr: "retry budget percent must be a percentage between 0 and 100",
+		},
+		{
+			name: "route with fault delay and abort",
+			entry: makerouter(ServiceRoute{
+				Match: httpMatch(&ServiceRouteHTTPMatch{PathPrefix: "/chaos"}),
+				Fault: &ServiceRouteFault{
+					Delay: &ServiceRouteFaultDelay{
+						FixedDelay: 2 * time.Second,
+						Percent:    50,
+					},
+					Abort: &ServiceRouteFaultAbort{
+						HTTPStatus: 503,
+					},
+					Header: []ServiceRouteHTTPMatchHeader{
+						{Name: "x-chaos", Present: true},
+					},
+				},
+			}),
+		},
+		{
+			name: "route with fault grpc abort",
+			entry: makerouter(ServiceRoute{
+				Fault: &ServiceRouteFault{
+					Abort: &ServiceRouteFaultAbort{
+						GRPCStatus: 14,
+						Percent:    0.5,
+					},
+				},
+			}),
+		},
+		{
+			name: "route with empty fault",
+			entry: makerouter(ServiceRoute{
+				Fault: &ServiceRouteFault{},
+			}),
+			validateErr: "Fault must specify at least one of Delay or Abort",
+		},
+		{
+			name: "route with fault delay missing duration",
+			entry: makerouter(ServiceRoute{
+				Fault: &ServiceRouteFault{
+					Delay: &ServiceRouteFaultDelay{Percent: 10},
+				},
+			}),
+			validateErr: "Fault Delay FixedDelay must be greater than zero",
+		},
+		{
+			name: "route with fault delay percent out of range",
+			entry: makerouter(ServiceRoute{
+				Fault: &ServiceRouteFault{
+					Delay: &ServiceRouteFaultDelay{FixedDelay: time.Second, Percent: -1},
+				},
+			}),
+			validateErr: "Fault Delay Percent must be between 0 and 100",
+		},
+		{
+			name: "route with fault abort missing status",
+			entry: makerouter(ServiceRoute{
+				Fault: &ServiceRouteFault{
+					Abort: &ServiceRouteFaultAbort{Percent: 10},
+				},
+			}),
+			validateErr: "Fault Abort must specify one of HTTPStatus or GRPCStatus",
+		},
+		{
+			name: "route with fault abort both statuses",
+			entry: makerouter(ServiceRoute{
+				Fault: &ServiceRouteFault{
+					Abort: &ServiceRouteFaultAbort{HTTPStatus: 503, GRPCStatus: 14},
+				},
+			}),
+			validateErr: "Fault Abort should only contain one of HTTPStatus or GRPCStatus",
+		},
+		{
+			name: "route with fault abort invalid http status",
+			entry: makerouter(ServiceRoute{
+				Fault: &ServiceRouteFault{
+					Abort: &ServiceRouteFaultAbort{HTTPStatus: 99},
+				},
+			}),
+			validateErr: "Fault Abort HTTPStatus must be in the range [200, 600)",
+		},
+		{
+			name: "route with fault abort invalid grpc status",
+			entry: makerouter(ServiceRoute{
+				Fault: &ServiceRouteFault{
+					Abort: &ServiceRouteFaultAbort{GRPCStatus: 17},
+				},
+			}),
+			validateErr: "Fault Abort GRPCStatus must be a valid gRPC status code",
+		},
+		{
+			name: "route with fault abort percent out of range",
+			entry: makerouter(ServiceRoute{
+				Fault: &ServiceRouteFault{
+					Abort: &ServiceRouteFaultAbort{HTTPStatus: 500, Percent: 100.5},
+				},
+			}),
+			validateErr: "Fault Abort Percent must be between 0 and 100",
+		},
+		{
+			name: "route with fault header missing name",
+			entry: makerouter(ServiceRoute{
+				Fault: &ServiceRouteFault{
+					Abort:  &ServiceRouteFaultAbort{HTTPStatus: 500},
+					Header: []ServiceRouteHTTPMatchHeader{{Exact: "foo"}},
+				},
+			}),
+			validateErr: "Fault Header[0] missing required Name field",
+		},
+		{
+			name: "route with fault header with multiple matches",
+			entry: makerouter(ServiceRoute{
+				Fault: &ServiceRouteFault{
+					Abort:  &ServiceRouteFaultAbort{HTTPStatus: 500},
+					Header: []ServiceRouteHTTPMatchHeader{{Name: "x-chaos", Exact: "foo", Present: true}},
+				},
+			}),
+			validateErr: "Fault Header[0] should only contain one of Present, Exact, Prefix, Suffix, or Regex",
+		},
+		{
+			name: "route with redirect",
+			entry: makerouter(ServiceRoute{
+				Match: httpMatch(&ServiceRouteHTTPMatch{PathPrefix: "/old/"}),
+				Redirect: &ServiceRouteRedirect{
+					Scheme:        "https",
+					Host:          "example.com",
+					Port:          8443,
+					PrefixRewrite: "/new/",
+					StripQuery:    true,
+					StatusCode:    308,
+				},
+			}),
+		},
+		{
+			name: "route with direct response",
+			entry: makerouter(ServiceRoute{
+				Match: httpMatch(&ServiceRouteHTTPMatch{PathExact: "/healthz"}),
+				DirectResponse: &ServiceRouteDirectResponse{
+					Status: 200,
+					Body:   "ok",
+				},
+			}),
+		},
+		{
+			name: "route with redirect and destination",
+			entry: makerouter(ServiceRoute{
+				Redirect:    &ServiceRouteRedirect{Scheme: "https"},
+				Destination: &ServiceRouteDestination{Service: "other"},
+			}),
+			validateErr: "should only contain one of Destination, Redirect, or DirectResponse",
+		},
+		{
+			name: "route with redirect and direct response",
+			entry: makerouter(ServiceRoute{
+				Redirect:       &ServiceRouteRedirect{Scheme: "https"},
+				DirectResponse: &ServiceRouteDirectResponse{Status: 200},
+			}),
+			validateErr: "should only contain one of Destination, Redirect, or DirectResponse",
+		},
+		{
+			name: "route with direct response and destination",
+			entry: makerouter(ServiceRoute{
+				DirectResponse: &ServiceRouteDirectResponse{Status: 200},
+				Destination:    &ServiceRouteDestination{Service: "other"},
+			}),
+			validateErr: "should only contain one of Destination, Redirect, or DirectResponse",
+		},
+		{
+			name: "route with empty redirect",
+			entry: makerouter(ServiceRoute{
+				Redirect: &ServiceRouteRedirect{StatusCode: 302},
+			}),
+			validateErr: "Redirect must specify at least one of Scheme, Host, Port, Path, PrefixRewrite, or StripQuery",
+		},
+		{
+			name: "route with redirect invalid scheme",
+			entry: makerouter(ServiceRoute{
+				Redirect: &ServiceRouteRedirect{Scheme: "ftp"},
+			}),
+			validateErr: `Redirect Scheme must be one of http or https, got "ftp"`,
+		},
+		{
+			name: "route with redirect invalid port",
+			entry: makerouter(ServiceRoute{
+				Redirect: &ServiceRouteRedirect{Port: 70000},
+			}),
+			validateErr: "Redirect Port must not be greater than 65535",
+		},
+		{
+			name: "route with redirect path and prefix rewrite",
+			entry: makerouter(ServiceRoute{
+				Match: httpMatch(&ServiceRouteHTTPMatch{PathPrefix: "/old/"}),
+				Redirect: &ServiceRouteRedirect{
+					Path:          "/new",
+					PrefixRewrite: "/new/",
+				},
+			}),
+			validateErr: "Redirect should only contain one of Path or PrefixRewrite",
+		},
+		{
+			name: "route with redirect relative path",
+			entry: makerouter(ServiceRoute{
+				Redirect: &ServiceRouteRedirect{Path: "new"},
+			}),
+			validateErr: `Redirect Path doesn't start with '/': "new"`,
+		},
+		{
+			name: "route with redirect prefix rewrite without path match",
+			entry: makerouter(ServiceRoute{
+				Match:    httpMatch(&ServiceRouteHTTPMatch{PathRegex: "/old/.*"}),
+				Redirect: &ServiceRouteRedirect{PrefixRewrite: "/new/"},
+			}),
+			validateErr: "cannot make use of Redirect PrefixRewrite without configuring either PathExact or PathPrefix",
+		},
+		{
+			name: "route with redirect invalid status code",
+			entry: makerouter(ServiceRoute{
+				Redirect: &ServiceRouteRedirect{Scheme: "https", StatusCode: 200},
+			}),
+			validateErr: "Redirect StatusCode must be one of 301, 302, 303, 307, or 308, got 200",
+		},
+		{
+			name: "route with direct response missing status",
+			entry: makerouter(ServiceRoute{
+				DirectResponse: &ServiceRouteDirectResponse{Body: "hello"},
+			}),
+			validateErr: "DirectResponse Status must be in the range [200, 600), got 0",
+		},
+		{
+			name: "route with direct response body too large",
+			entry: makerouter(ServiceRoute{
+				DirectResponse: &ServiceRouteDirectResponse{
+					Status: 200,
+					Body:   strings.Repeat("a", 4097),
+				},
+			}),
+			validateErr: "DirectResponse Body must not be larger than 4096 bytes, got 4097",
+		},
+		////////////////
 		{
 			name: "default route with case insensitive match",
 			entry: makerouter(routeMatch(httpMatch(&ServiceRouteHTTPMatch{
@@ -868,20 +868,27 @@ func (e *APIGatewayConfigEntry) validateListeners() error {
 	validProtocols := map[APIGatewayListenerProtocol]bool{
 		ListenerProtocolHTTP: true,
 		ListenerProtocolTCP:  true,
+		ListenerProtocolTLS:  true,
 	}
 	allowedCertificateKinds := map[string]bool{
 		FileSystemCertificate: true,
 		InlineCertificate:     true,
+		ACMECertificate:       true,
 	}
 
 	for _, listener := range e.Listeners {
 		if !validProtocols[listener.Protocol] {
-			return fmt.Errorf("unsupported listener protocol %q, must be one of 'tcp', or 'http'", listener.Protocol)
+			return fmt.Errorf("unsupported listener protocol %q, must be one of 'tcp', 'tls', or 'http'", listener.Protocol)
 		}
 		if listener.Protocol == ListenerProtocolTCP && listener.Hostname != "" {
 			// TODO: once we have SNI matching we should be able to implement this
 			return fmt.Errorf("hostname specification is not supported when using TCP")
 		}
+		if listener.Protocol == ListenerProtocolTLS && !listener.TLS.IsEmpty() {
+			// TLS listeners pass encrypted traffic through untouched, so
+			// there is nothing for the gateway to terminate.
+			return fmt.Errorf("TLS configuration is not supported when using TLS passthrough")
+		}
 		if listener.Port <= 0 || listener.Port > 65535 {
 			return fmt.Errorf("listener port %d not in the range 1-65535", listener.Port)
 		}
@@ -890,7 +897,7 @@ func (e *APIGatewayConfigEntry) validateListeners() error {
 		}
 		for _, certificate := range listener.TLS.Certificates {
 			if !allowedCertificateKinds[certificate.Kind] {
-				return fmt.Errorf("unsupported certificate kind: %q, must be 'file-system-certificate' or 'inline-certificate'", certificate.Kind)
+				return fmt.Errorf("unsupported certificate kind: %q, must be 'file-system-certificate', 'inline-certificate' or 'acme-certificate'", certificate.Kind)
 			}
 			if certificate.Name == "" {
 				return fmt.Errorf("certificate reference must have a name")
@@ -921,6 +928,7 @@ type APIGatewayListenerProtocol string
 const (
 	ListenerProtocolHTTP APIGatewayListenerProtocol = "http"
 	ListenerProtocolTCP  APIGatewayListenerProtocol = "tcp"
+	ListenerProtocolTLS  APIGatewayListenerProtocol = "tls"
 )
 
 // APIGatewayListener represents an individual listener for an APIGateway
@@ -934,7 +942,7 @@ type APIGatewayListener struct {
 	// Port is the port at which this listener should bind.
 	Port int
 	// Protocol is the protocol that a listener should use. It must
-	// either be http or tcp.
+	// be one of http, tcp, or tls.
 	Protocol APIGatewayListenerProtocol
 	// TLS is the TLS settings for the listener.
 	TLS APIGatewayTLSConfiguration
@@ -1162,11 +1170,15 @@ func (e *BoundAPIGatewayConfigEntry) Normalize() error {
 
 func (e *BoundAPIGatewayConfigEntry) Validate() error {
 	allowedCertificateKinds := map[string]bool{
-		InlineCertificate: true,
+		FileSystemCertificate: true,
+		InlineCertificate:     true,
+		ACMECertificate:       true,
 	}
 	allowedRouteKinds := map[string]bool{
 		HTTPRoute: true,
 		TCPRoute:  true,
+		GRPCRoute: true,
+		TLSRoute:  true,
 	}
 
 	// These should already be validated by upstream validation
@@ -1175,7 +1187,7 @@ func (e *BoundAPIGatewayConfigEntry) Validate() error {
 	for _, listener := range e.Listeners {
 		for _, certificate := range listener.Certificates {
 			if !allowedCertificateKinds[certificate.Kind] {
-				return fmt.Errorf("unsupported certificate kind: %q, must be 'inline-certificate'", certificate.Kind)
+				return fmt.Errorf("unsupported certificate kind: %q, must be 'file-system-certificate', 'inline-certificate' or 'acme-certificate'", certificate.Kind)
 			}
 			if certificate.Name == "" {
 				return fmt.Errorf("certificate reference must have a name")
@@ -1183,7 +1195,7 @@ func (e *BoundAPIGatewayConfigEntry) Validate() error {
 		}
 		for _, route := range listener.Routes {
 			if !allowedRouteKinds[route.Kind] {
-				return fmt.Errorf("unsupported route kind: %q, must be one of 'http-route', or 'tcp-route'", route.Kind)
+				return fmt.Errorf("unsupported route kind: %q, must be one of 'http-route', 'tcp-route', 'grpc-route', or 'tls-route'", route.Kind)
 			}
 			if route.Name == "" {
 				return fmt.Errorf("route reference must have a name")
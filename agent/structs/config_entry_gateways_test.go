@@ -1250,6 +1250,26 @@ func TestAPIGateway_Listeners(t *testing.T) {
 			},
 			validateErr: "hostname specification is not supported",
 		},
+		"tls configuration on tls passthrough listener": {
+			entry: &APIGatewayConfigEntry{
+				Kind: "api-gateway",
+				Name: "api-gw-five",
+				Listeners: []APIGatewayListener{
+					{
+						Name:     "listener",
+						Port:     443,
+						Protocol: ListenerProtocolTLS,
+						TLS: APIGatewayTLSConfiguration{
+							Certificates: []ResourceReference{{
+								Kind: InlineCertificate,
+								Name: "cert",
+							}},
+						},
+					},
+				},
+			},
+			validateErr: "TLS configuration is not supported when using TLS passthrough",
+		},
 		"invalid port": {
 			entry: &APIGatewayConfigEntry{
 				Kind: "api-gateway",
@@ -5,6 +5,7 @@ package structs
 
 import (
 	"fmt"
+	"net/url"
 	"sort"
 	"strings"
 	"time"
@@ -620,6 +621,11 @@ func computeIntentionPrecedence(entry *ServiceIntentionsConfigEntry, src *Source
 	// Given the maximum, the exact value is determined based on the
 	// number of source exact values.
 	countSrc := intentionCountExact(src.Name, &src.EnterpriseMeta)
+	if src.Type == IntentionSourceSPIFFE && strings.HasSuffix(src.Name, "/"+WildcardSpecifier) {
+		// A SPIFFE source matching a whole trust domain is as specific as
+		// a wildcard service name.
+		countSrc = 1
+	}
 	return max - (2 - countSrc)
 }
 
@@ -711,7 +717,11 @@ func (e *ServiceIntentionsConfigEntry) validate(legacyWrite bool) error {
 			return fmt.Errorf("Sources[%d].SamenessGroup: %v ", i, err)
 		}
 
-		if err := validateIntentionWildcards(src.Name, &src.EnterpriseMeta, src.Peer, src.SamenessGroup); err != nil {
+		if src.Type == IntentionSourceSPIFFE {
+			if err := src.validateSPIFFESource(); err != nil {
+				return fmt.Errorf("Sources[%d].%v", i, err)
+			}
+		} else if err := validateIntentionWildcards(src.Name, &src.EnterpriseMeta, src.Peer, src.SamenessGroup); err != nil {
 			return fmt.Errorf("Sources[%d].%v", i, err)
 		}
 
@@ -813,8 +823,12 @@ func (e *ServiceIntentionsConfigEntry) validate(legacyWrite bool) error {
 
 		switch src.Type {
 		case IntentionSourceConsul:
+		case IntentionSourceSPIFFE:
+			if legacyWrite {
+				return fmt.Errorf("Sources[%d].Type cannot be set to 'spiffe' by legacy intentions", i)
+			}
 		default:
-			return fmt.Errorf("Sources[%d].Type must be set to 'consul'", i)
+			return fmt.Errorf("Sources[%d].Type must be set to 'consul' or 'spiffe'", i)
 		}
 
 		for j, perm := range src.Permissions {
@@ -926,6 +940,28 @@ func (e *ServiceIntentionsConfigEntry) validate(legacyWrite bool) error {
 }
 
 // Wildcard usage verification
+// validateSPIFFESource validates a source of type spiffe. Its name is either
+// the SPIFFE ID of a workload or "spiffe://<trust-domain>/*" to match every
+// workload of a trust domain.
+func (s *SourceIntention) validateSPIFFESource() error {
+	if s.Peer != "" {
+		return fmt.Errorf("Peer: cannot be set for sources of type 'spiffe'")
+	}
+	if s.SamenessGroup != "" {
+		return fmt.Errorf("SamenessGroup: cannot be set for sources of type 'spiffe'")
+	}
+
+	id, err := url.Parse(s.Name)
+	if err != nil || id.Scheme != "spiffe" || id.Host == "" || id.RawQuery != "" || id.Fragment != "" ||
+		!strings.HasPrefix(id.Path, "/") || !validTrustDomain.MatchString(id.Host) {
+		return fmt.Errorf("Name: must be a SPIFFE ID for sources of type 'spiffe': %q", s.Name)
+	}
+	if strings.Contains(id.Path, WildcardSpecifier) && id.Path != "/"+WildcardSpecifier {
+		return fmt.Errorf("Name: wildcard character '*' can only be used to match a whole trust domain, as in \"spiffe://%s/*\"", id.Host)
+	}
+	return nil
+}
+
 func validateIntentionWildcards(name string, entMeta *acl.EnterpriseMeta, peerName, samenessGroup string) error {
 	ns := entMeta.NamespaceOrDefault()
 	if ns != WildcardSpecifier {
@@ -1265,6 +1265,86 @@ func TestServiceIntentionsConfigEntry(t *testing.T) {
 			},
 			validateErr: `Sources[1] defines peer("peer1") "` + fooName.String() + `" more than once`,
 		},
+		"spiffe sources": {
+			entry: &ServiceIntentionsConfigEntry{
+				Kind: ServiceIntentions,
+				Name: "test",
+				Sources: []*SourceIntention{
+					{
+						Name:   "spiffe://example.org/*",
+						Type:   IntentionSourceSPIFFE,
+						Action: IntentionActionDeny,
+					},
+					{
+						Name:   "spiffe://example.org/ns/prod/sa/web",
+						Type:   IntentionSourceSPIFFE,
+						Action: IntentionActionAllow,
+					},
+				},
+			},
+			check: func(t *testing.T, entry *ServiceIntentionsConfigEntry) {
+				require.Equal(t, 9, entry.Sources[0].Precedence)
+				require.Equal(t, "spiffe://example.org/ns/prod/sa/web", entry.Sources[0].Name)
+				require.Equal(t, 8, entry.Sources[1].Precedence)
+			},
+		},
+		"spiffe source with invalid SPIFFE ID": {
+			entry: &ServiceIntentionsConfigEntry{
+				Kind: ServiceIntentions,
+				Name: "test",
+				Sources: []*SourceIntention{
+					{
+						Name:   "web",
+						Type:   IntentionSourceSPIFFE,
+						Action: IntentionActionAllow,
+					},
+				},
+			},
+			validateErr: `Sources[0].Name: must be a SPIFFE ID for sources of type 'spiffe': "web"`,
+		},
+		"spiffe source with partial wildcard": {
+			entry: &ServiceIntentionsConfigEntry{
+				Kind: ServiceIntentions,
+				Name: "test",
+				Sources: []*SourceIntention{
+					{
+						Name:   "spiffe://example.org/ns/*",
+						Type:   IntentionSourceSPIFFE,
+						Action: IntentionActionAllow,
+					},
+				},
+			},
+			validateErr: `Sources[0].Name: wildcard character '*' can only be used to match a whole trust domain`,
+		},
+		"spiffe source with peer": {
+			entry: &ServiceIntentionsConfigEntry{
+				Kind: ServiceIntentions,
+				Name: "test",
+				Sources: []*SourceIntention{
+					{
+						Name:   "spiffe://example.org/web",
+						Type:   IntentionSourceSPIFFE,
+						Peer:   "peer1",
+						Action: IntentionActionAllow,
+					},
+				},
+			},
+			validateErr: `Sources[0].Peer: cannot be set for sources of type 'spiffe'`,
+		},
+		"unknown source type": {
+			entry: &ServiceIntentionsConfigEntry{
+				Kind: ServiceIntentions,
+				Name: "test",
+				Sources: []*SourceIntention{
+					{
+						Name:   "foo",
+						Type:   "other",
+						Action: IntentionActionAllow,
+					},
+				},
+			},
+			validateErr: `Sources[0].Type must be set to 'consul' or 'spiffe'`,
+		},
 		"JWT - missing provider name": {
 			entry: &ServiceIntentionsConfigEntry{
 				Kind: ServiceIntentions,
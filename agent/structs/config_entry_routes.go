@@ -144,9 +144,31 @@ func normalizeHTTPMatch(match HTTPMatch) HTTPMatch {
 }
 
 func (e *HTTPRouteConfigEntry) Validate() error {
-	for _, host := range e.Hostnames {
-		// validate that each host referenced in a valid dns name and has
-		// no wildcards in it
+	if err := validateRouteHostnames(e.Hostnames); err != nil {
+		return err
+	}
+
+	if err := validateRouteParents(e.Parents); err != nil {
+		return err
+	}
+
+	if err := validateConfigEntryMeta(e.Meta); err != nil {
+		return err
+	}
+
+	for i, rule := range e.Rules {
+		if err := validateRule(rule); err != nil {
+			return fmt.Errorf("Rule[%d], %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// validateRouteHostnames validates that each host referenced is a valid
+// dns name and has no wildcards in it.
+func validateRouteHostnames(hostnames []string) error {
+	for _, host := range hostnames {
 		if _, ok := dns.IsDomainName(host); !ok {
 			return fmt.Errorf("host %q must be a valid DNS hostname", host)
 		}
@@ -155,14 +177,19 @@ func (e *HTTPRouteConfigEntry) Validate() error {
 			return fmt.Errorf("host %q must not be a wildcard", host)
 		}
 	}
+	return nil
+}
 
+// validateRouteParents validates that each parent of a route is a unique
+// reference to a gateway.
+func validateRouteParents(parents []ResourceReference) error {
 	validParentKinds := map[string]bool{
 		APIGateway: true,
 	}
 
-	uniques := make(map[ResourceReference]struct{}, len(e.Parents))
+	uniques := make(map[ResourceReference]struct{}, len(parents))
 
-	for _, parent := range e.Parents {
+	for _, parent := range parents {
 		if !validParentKinds[parent.Kind] {
 			return fmt.Errorf("unsupported parent kind: %q, must be 'api-gateway'", parent.Kind)
 		}
@@ -172,17 +199,6 @@ func (e *HTTPRouteConfigEntry) Validate() error {
 		}
 		uniques[parent] = struct{}{}
 	}
-
-	if err := validateConfigEntryMeta(e.Meta); err != nil {
-		return err
-	}
-
-	for i, rule := range e.Rules {
-		if err := validateRule(rule); err != nil {
-			return fmt.Errorf("Rule[%d], %w", i, err)
-		}
-	}
-
 	return nil
 }
 
@@ -201,6 +217,28 @@ func validateRule(rule HTTPRouteRule) error {
 		if err := validateHTTPService(service); err != nil {
 			return fmt.Errorf("Service[%d], %w", i, err)
 		}
+		// Traffic split between several services is balanced across them
+		// first, so a session cannot be pinned to a single instance.
+		if service.SessionAffinity != nil && len(rule.Services) > 1 {
+			return fmt.Errorf("Service[%d], SessionAffinity is only supported on rules with a single service", i)
+		}
+	}
+
+	if rule.Filters.RedirectFilter != nil || rule.Filters.DirectResponseFilter != nil {
+		if rule.Filters.RedirectFilter != nil && rule.Filters.DirectResponseFilter != nil {
+			return errors.New("HTTPFilters, should only contain one of RedirectFilter or DirectResponseFilter")
+		}
+		if len(rule.Services) > 0 {
+			return errors.New("HTTPFilters, RedirectFilter and DirectResponseFilter cannot be combined with Services")
+		}
+	}
+
+	if err := validateRedirectFilter(rule.Filters.RedirectFilter, rule.Matches); err != nil {
+		return fmt.Errorf("HTTPFilters, RedirectFilter, %w", err)
+	}
+
+	if err := validateDirectResponseFilter(rule.Filters.DirectResponseFilter); err != nil {
+		return fmt.Errorf("HTTPFilters, DirectResponseFilter, %w", err)
 	}
 
 	return nil
@@ -233,6 +271,14 @@ func validateMatch(match HTTPMatch) error {
 }
 
 func validateHTTPService(service HTTPService) error {
+	if service.Filters.RedirectFilter != nil || service.Filters.DirectResponseFilter != nil {
+		return errors.New("HTTPFilters, RedirectFilter and DirectResponseFilter are only supported on rules")
+	}
+	if service.SessionAffinity != nil {
+		if err := service.SessionAffinity.Validate(); err != nil {
+			return fmt.Errorf("SessionAffinity, %w", err)
+		}
+	}
 	return validateFilters(service.Filters)
 }
 
@@ -247,6 +293,29 @@ func validateFilters(filter HTTPFilters) error {
 		return fmt.Errorf("HTTPFilters, URLRewrite, %w", err)
 	}
 
+	if err := validateRetryFilter(filter.RetryFilter); err != nil {
+		return fmt.Errorf("HTTPFilters, RetryFilter, %w", err)
+	}
+
+	return nil
+}
+
+func validateRetryFilter(filter *RetryFilter) error {
+	if filter == nil {
+		return nil
+	}
+
+	if filter.PerTryTimeout < 0 {
+		return errors.New("PerTryTimeout must be >= 0")
+	}
+	if err := filter.HedgePolicy.validate(filter.PerTryTimeout); err != nil {
+		return fmt.Errorf("HedgePolicy, %w", err)
+	}
+	if filter.RetryBudget != nil {
+		if err := filter.RetryBudget.Validate(); err != nil {
+			return fmt.Errorf("RetryBudget, %w", err)
+		}
+	}
 	return nil
 }
 
@@ -257,6 +326,49 @@ func validateURLRewrite(rewrite *URLRewrite) error {
 	return nil
 }
 
+func validateRedirectFilter(filter *RedirectFilter, matches []HTTPMatch) error {
+	if filter == nil {
+		return nil
+	}
+
+	redirect := ServiceRouteRedirect{
+		Scheme:        filter.Scheme,
+		Host:          filter.Hostname,
+		Port:          filter.Port,
+		Path:          filter.Path,
+		PrefixRewrite: filter.PrefixRewrite,
+		StatusCode:    filter.StatusCode,
+	}
+	if err := redirect.validate(); err != nil {
+		return err
+	}
+
+	if filter.PrefixRewrite != "" {
+		if len(matches) == 0 {
+			return errors.New("PrefixRewrite requires an exact or prefix path match")
+		}
+		for _, match := range matches {
+			if match.Path.Match != HTTPPathMatchExact && match.Path.Match != HTTPPathMatchPrefix {
+				return errors.New("PrefixRewrite requires an exact or prefix path match")
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateDirectResponseFilter(filter *DirectResponseFilter) error {
+	if filter == nil {
+		return nil
+	}
+
+	response := ServiceRouteDirectResponse{
+		Status: filter.Status,
+		Body:   filter.Body,
+	}
+	return response.validate()
+}
+
 func validateHeaderFilter(filter HTTPHeaderFilter) error {
 	// TODO: we don't really have validation of the values
 	// passed as header modifiers in our current discoverychain
@@ -324,7 +436,13 @@ func (e *HTTPRouteConfigEntry) CanWrite(authz acl.Authorizer) error {
 }
 
 func (e *HTTPRouteConfigEntry) FilteredHostnames(listenerHostname string) []string {
-	if len(e.Hostnames) == 0 {
+	return filterRouteHostnames(e.Hostnames, listenerHostname)
+}
+
+// filterRouteHostnames returns the subset of a route's hostnames that match
+// the hostname of the listener it is attached to.
+func filterRouteHostnames(routeHostnames []string, listenerHostname string) []string {
+	if len(routeHostnames) == 0 {
 		// we have no hostnames specified here, so treat it like a wildcard
 		return []string{listenerHostname}
 	}
@@ -333,7 +451,7 @@ func (e *HTTPRouteConfigEntry) FilteredHostnames(listenerHostname string) []stri
 	listenerHostname = strings.TrimPrefix(strings.TrimPrefix(listenerHostname, "*"), ".")
 
 	hostnames := []string{}
-	for _, hostname := range e.Hostnames {
+	for _, hostname := range routeHostnames {
 		if wildcardHostname {
 			if strings.HasSuffix(hostname, listenerHostname) {
 				hostnames = append(hostnames, hostname)
@@ -470,6 +588,12 @@ type HTTPFilters struct {
 	RetryFilter   *RetryFilter
 	TimeoutFilter *TimeoutFilter
 	JWT           *JWTFilter
+
+	// RedirectFilter and DirectResponseFilter answer the request without
+	// forwarding it to a service. They are only valid on a rule, not on the
+	// services of a rule, and cannot be combined.
+	RedirectFilter       *RedirectFilter
+	DirectResponseFilter *DirectResponseFilter
 }
 
 // HTTPResponseFilters specifies a list of filters used to modify the
@@ -494,6 +618,16 @@ type RetryFilter struct {
 	RetryOn               []string
 	RetryOnStatusCodes    []uint32
 	RetryOnConnectFailure bool
+
+	// PerTryTimeout is the amount of time permitted for each attempt of the
+	// request.
+	PerTryTimeout time.Duration `json:",omitempty"`
+	// HedgePolicy configures sending additional requests to other instances
+	// of the service before the first request completes.
+	HedgePolicy *HedgePolicy `json:",omitempty"`
+	// RetryBudget bounds the number of concurrent retries to the service as
+	// a share of its active requests.
+	RetryBudget *RetryBudget `json:",omitempty"`
 }
 
 type TimeoutFilter struct {
@@ -501,6 +635,30 @@ type TimeoutFilter struct {
 	IdleTimeout    time.Duration
 }
 
+// RedirectFilter answers a request with an HTTP redirect. Parts of the
+// redirect URL that are not configured are kept from the original request.
+type RedirectFilter struct {
+	// Scheme replaces the scheme of the request, for example "https".
+	Scheme string
+	// Hostname replaces the host of the request.
+	Hostname string
+	// Port replaces the port of the request.
+	Port uint32
+	// Path replaces the whole path of the request.
+	Path string
+	// PrefixRewrite replaces the matched path prefix of the request. It
+	// requires every match of the rule to be an exact or prefix path match.
+	PrefixRewrite string
+	// StatusCode is one of 301, 302, 303, 307 or 308, defaulting to 301.
+	StatusCode uint32
+}
+
+// DirectResponseFilter answers a request with a fixed response.
+type DirectResponseFilter struct {
+	Status uint32
+	Body   string
+}
+
 // HTTPRouteRule specifies the routing rules used to determine what upstream
 // service an HTTP request is routed to.
 type HTTPRouteRule struct {
@@ -533,6 +691,10 @@ type HTTPService struct {
 	// response returned from the upstream service
 	ResponseFilters HTTPResponseFilters
 
+	// SessionAffinity pins the requests of a client session to the instance
+	// of the service that served its first request.
+	SessionAffinity *SessionAffinity `json:",omitempty"`
+
 	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
 }
 
@@ -625,26 +787,12 @@ func (e *TCPRouteConfigEntry) Normalize() error {
 }
 
 func (e *TCPRouteConfigEntry) Validate() error {
-	validParentKinds := map[string]bool{
-		APIGateway: true,
-	}
-
 	if len(e.Services) > 1 {
 		return fmt.Errorf("tcp-route currently only supports one service")
 	}
 
-	uniques := make(map[ResourceReference]struct{}, len(e.Parents))
-
-	for _, parent := range e.Parents {
-		if !validParentKinds[parent.Kind] {
-			return fmt.Errorf("unsupported parent kind: %q, must be 'api-gateway'", parent.Kind)
-		}
-
-		if _, ok := uniques[parent]; ok {
-			return errors.New("route parents must be unique")
-		}
-		uniques[parent] = struct{}{}
-
+	if err := validateRouteParents(e.Parents); err != nil {
+		return err
 	}
 
 	if err := validateConfigEntryMeta(e.Meta); err != nil {
@@ -676,3 +824,412 @@ type TCPService struct {
 func (s TCPService) ServiceName() ServiceName {
 	return NewServiceName(s.Name, &s.EnterpriseMeta)
 }
+
+// GRPCRouteConfigEntry manages the configuration for a gRPC route
+// with the given name.
+type GRPCRouteConfigEntry struct {
+	// Kind of the config entry. This will be set to structs.GRPCRoute.
+	Kind string
+
+	// Name is used to match the config entry with its associated set
+	// of resources, which may include routers, splitters, filters, etc.
+	Name string
+
+	// Parents is a list of gateways that this route should be bound to
+	Parents []ResourceReference
+	// Rules are a list of gRPC-based routing rules that this route should
+	// use for constructing a routing table.
+	Rules []GRPCRouteRule
+	// Hostnames are the hostnames for which this GRPCRoute should respond to requests.
+	Hostnames []string
+
+	Meta map[string]string `json:",omitempty"`
+	// Status is the asynchronous reconciliation status which a GRPCRoute propagates to the user.
+	Status             Status
+	Hash               uint64 `json:",omitempty" hash:"ignore"`
+	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
+	RaftIndex          `hash:"ignore"`
+}
+
+func (e *GRPCRouteConfigEntry) SetHash(h uint64) {
+	e.Hash = h
+}
+
+func (e *GRPCRouteConfigEntry) GetHash() uint64 {
+	return e.Hash
+}
+
+func (e *GRPCRouteConfigEntry) GetKind() string                        { return GRPCRoute }
+func (e *GRPCRouteConfigEntry) GetName() string                        { return e.Name }
+func (e *GRPCRouteConfigEntry) GetMeta() map[string]string             { return e.Meta }
+func (e *GRPCRouteConfigEntry) GetEnterpriseMeta() *acl.EnterpriseMeta { return &e.EnterpriseMeta }
+func (e *GRPCRouteConfigEntry) GetRaftIndex() *RaftIndex               { return &e.RaftIndex }
+
+var _ ControlledConfigEntry = (*GRPCRouteConfigEntry)(nil)
+
+func (e *GRPCRouteConfigEntry) GetStatus() Status       { return e.Status }
+func (e *GRPCRouteConfigEntry) SetStatus(status Status) { e.Status = status }
+func (e *GRPCRouteConfigEntry) DefaultStatus() Status   { return Status{} }
+
+var _ BoundRoute = (*GRPCRouteConfigEntry)(nil)
+
+func (e *GRPCRouteConfigEntry) GetParents() []ResourceReference { return e.Parents }
+
+// GetProtocol returns the listener protocol that a GRPCRoute binds to. gRPC
+// traffic is served by the same HTTP listeners that serve HTTPRoutes.
+func (e *GRPCRouteConfigEntry) GetProtocol() APIGatewayListenerProtocol { return ListenerProtocolHTTP }
+
+func (e *GRPCRouteConfigEntry) GetServiceNames() []ServiceName {
+	services := []ServiceName{}
+	for _, service := range e.GetServices() {
+		services = append(services, NewServiceName(service.Name, &service.EnterpriseMeta))
+	}
+	return services
+}
+
+func (e *GRPCRouteConfigEntry) GetServices() []GRPCService {
+	targets := []GRPCService{}
+	for _, rule := range e.Rules {
+		targets = append(targets, rule.Services...)
+	}
+	return targets
+}
+
+func (e *GRPCRouteConfigEntry) Normalize() error {
+	for i, parent := range e.Parents {
+		if parent.Kind == "" {
+			parent.Kind = APIGateway
+		}
+		parent.EnterpriseMeta.Merge(e.GetEnterpriseMeta())
+		parent.EnterpriseMeta.Normalize()
+		e.Parents[i] = parent
+	}
+
+	for i, rule := range e.Rules {
+		for j, match := range rule.Matches {
+			if match.Method.Match == "" && (match.Method.Service != "" || match.Method.Method != "") {
+				match.Method.Match = GRPCMethodMatchExact
+			}
+			rule.Matches[j] = match
+		}
+
+		for j, service := range rule.Services {
+			service.EnterpriseMeta.Merge(e.GetEnterpriseMeta())
+			service.EnterpriseMeta.Normalize()
+			if service.Weight <= 0 {
+				service.Weight = 1
+			}
+			rule.Services[j] = service
+		}
+		e.Rules[i] = rule
+	}
+
+	h, err := HashConfigEntry(e)
+	if err != nil {
+		return err
+	}
+	e.Hash = h
+
+	return nil
+}
+
+func (e *GRPCRouteConfigEntry) Validate() error {
+	if err := validateRouteHostnames(e.Hostnames); err != nil {
+		return err
+	}
+
+	if err := validateRouteParents(e.Parents); err != nil {
+		return err
+	}
+
+	if err := validateConfigEntryMeta(e.Meta); err != nil {
+		return err
+	}
+
+	for i, rule := range e.Rules {
+		if err := validateGRPCRule(rule); err != nil {
+			return fmt.Errorf("Rule[%d], %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func validateGRPCRule(rule GRPCRouteRule) error {
+	if err := validateGRPCFilters(rule.Filters); err != nil {
+		return err
+	}
+
+	for i, match := range rule.Matches {
+		if err := validateGRPCMatch(match); err != nil {
+			return fmt.Errorf("Match[%d], %w", i, err)
+		}
+	}
+
+	for i, service := range rule.Services {
+		if err := validateGRPCFilters(service.Filters); err != nil {
+			return fmt.Errorf("Service[%d], %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func validateGRPCMatch(match GRPCMatch) error {
+	if err := validateGRPCMethodMatch(match.Method); err != nil {
+		return fmt.Errorf("Method, %w", err)
+	}
+
+	for i, header := range match.Headers {
+		if err := validateHTTPHeaderMatch(header); err != nil {
+			return fmt.Errorf("Headers[%d], %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func validateGRPCMethodMatch(method GRPCMethodMatch) error {
+	switch method.Match {
+	case "":
+		return nil
+	case GRPCMethodMatchExact:
+		if strings.ContainsRune(method.Service, '/') || strings.ContainsRune(method.Method, '/') {
+			return fmt.Errorf("exact type match must not contain '/'")
+		}
+	case GRPCMethodMatchRegularExpression:
+	default:
+		return fmt.Errorf("match type should be one of exact or regex")
+	}
+
+	if method.Service == "" && method.Method == "" {
+		return fmt.Errorf("must specify at least one of Service or Method")
+	}
+	return nil
+}
+
+func validateGRPCFilters(filter GRPCFilters) error {
+	for i, header := range filter.Headers {
+		if err := validateHeaderFilter(header); err != nil {
+			return fmt.Errorf("GRPCFilters, Headers[%d], %w", i, err)
+		}
+	}
+	if err := validateRetryFilter(filter.RetryFilter); err != nil {
+		return fmt.Errorf("GRPCFilters, RetryFilter, %w", err)
+	}
+	return nil
+}
+
+func (e *GRPCRouteConfigEntry) CanRead(authz acl.Authorizer) error {
+	var authzContext acl.AuthorizerContext
+	e.FillAuthzContext(&authzContext)
+	return authz.ToAllowAuthorizer().MeshReadAllowed(&authzContext)
+}
+
+func (e *GRPCRouteConfigEntry) CanWrite(authz acl.Authorizer) error {
+	var authzContext acl.AuthorizerContext
+	e.FillAuthzContext(&authzContext)
+	return authz.ToAllowAuthorizer().MeshWriteAllowed(&authzContext)
+}
+
+func (e *GRPCRouteConfigEntry) FilteredHostnames(listenerHostname string) []string {
+	return filterRouteHostnames(e.Hostnames, listenerHostname)
+}
+
+// GRPCRouteRule specifies the routing rules used to determine what upstream
+// service a gRPC request is routed to.
+type GRPCRouteRule struct {
+	// Filters is a list of gRPC-based filters used to modify a request prior
+	// to routing it to the upstream service
+	Filters GRPCFilters
+	// ResponseFilters is a list of HTTP-based filters used to modify a response
+	// returned by the upstream service
+	ResponseFilters HTTPResponseFilters
+	// Matches specified the matching criteria used in the routing table. If a
+	// request matches the given GRPCMatch configuration, then traffic is routed
+	// to services specified in the Services field.
+	Matches []GRPCMatch
+	// Services is a list of gRPC-based services to route to if the request matches
+	// the rules specified in the Matches field.
+	Services []GRPCService
+}
+
+// GRPCMatch specifies the criteria that should be
+// used in determining whether or not a request should
+// be routed to a given set of services.
+type GRPCMatch struct {
+	Headers []HTTPHeaderMatch
+	Method  GRPCMethodMatch
+}
+
+// GRPCMethodMatchType specifies how gRPC service and method matching
+// criteria should be applied to a request.
+type GRPCMethodMatchType string
+
+const (
+	GRPCMethodMatchExact             GRPCMethodMatchType = "exact"
+	GRPCMethodMatchRegularExpression GRPCMethodMatchType = "regex"
+)
+
+// GRPCMethodMatch specifies how a match should be done on the
+// fully-qualified gRPC service and method name of a request. If
+// either Service or Method is empty, any value is matched.
+type GRPCMethodMatch struct {
+	Match   GRPCMethodMatchType
+	Service string
+	Method  string
+}
+
+// GRPCFilters specifies a list of filters used to modify a request
+// before it is routed to an upstream.
+type GRPCFilters struct {
+	Headers       []HTTPHeaderFilter
+	RetryFilter   *RetryFilter
+	TimeoutFilter *TimeoutFilter
+}
+
+// GRPCService is a service reference for gRPC-based routing rules
+type GRPCService struct {
+	Name string
+	// Weight is an arbitrary integer used in calculating how much
+	// traffic should be sent to the given service.
+	Weight int
+	// Filters is a list of gRPC-based filters used to modify a request prior
+	// to routing it to the upstream service
+	Filters GRPCFilters
+
+	// ResponseFilters is a list of HTTP-based filters used to modify the
+	// response returned from the upstream service
+	ResponseFilters HTTPResponseFilters
+
+	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
+}
+
+func (s GRPCService) ServiceName() ServiceName {
+	return NewServiceName(s.Name, &s.EnterpriseMeta)
+}
+
+// TLSRouteConfigEntry manages the configuration for a TLS route
+// with the given name. TLS routes pass encrypted traffic through
+// to their upstream based on the SNI value of the connection.
+type TLSRouteConfigEntry struct {
+	// Kind of the config entry. This will be set to structs.TLSRoute.
+	Kind string
+
+	// Name is used to match the config entry with its associated set
+	// of resources.
+	Name string
+
+	// Parents is a list of gateways that this route should be bound to
+	Parents []ResourceReference
+
+	// Hostnames are the SNI hostnames for which this TLSRoute should
+	// route connections.
+	Hostnames []string
+
+	// Services is a list of TCP-based services that this should route to.
+	// Currently, this must specify at maximum one service.
+	Services []TCPService
+
+	Meta map[string]string `json:",omitempty"`
+	// Status is the asynchronous reconciliation status which a TLSRoute propagates to the user.
+	Status             Status
+	Hash               uint64 `json:",omitempty" hash:"ignore"`
+	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
+	RaftIndex          `hash:"ignore"`
+}
+
+func (e *TLSRouteConfigEntry) SetHash(h uint64) {
+	e.Hash = h
+}
+
+func (e *TLSRouteConfigEntry) GetHash() uint64 {
+	return e.Hash
+}
+
+func (e *TLSRouteConfigEntry) GetKind() string                        { return TLSRoute }
+func (e *TLSRouteConfigEntry) GetName() string                        { return e.Name }
+func (e *TLSRouteConfigEntry) GetMeta() map[string]string             { return e.Meta }
+func (e *TLSRouteConfigEntry) GetRaftIndex() *RaftIndex               { return &e.RaftIndex }
+func (e *TLSRouteConfigEntry) GetEnterpriseMeta() *acl.EnterpriseMeta { return &e.EnterpriseMeta }
+
+var _ ControlledConfigEntry = (*TLSRouteConfigEntry)(nil)
+
+func (e *TLSRouteConfigEntry) GetStatus() Status       { return e.Status }
+func (e *TLSRouteConfigEntry) SetStatus(status Status) { e.Status = status }
+func (e *TLSRouteConfigEntry) DefaultStatus() Status   { return Status{} }
+
+var _ BoundRoute = (*TLSRouteConfigEntry)(nil)
+
+func (e *TLSRouteConfigEntry) GetParents() []ResourceReference         { return e.Parents }
+func (e *TLSRouteConfigEntry) GetProtocol() APIGatewayListenerProtocol { return ListenerProtocolTLS }
+
+func (e *TLSRouteConfigEntry) GetServiceNames() []ServiceName {
+	services := []ServiceName{}
+	for _, service := range e.Services {
+		services = append(services, NewServiceName(service.Name, &service.EnterpriseMeta))
+	}
+	return services
+}
+
+func (e *TLSRouteConfigEntry) GetServices() []TCPService { return e.Services }
+
+func (e *TLSRouteConfigEntry) Normalize() error {
+	for i, parent := range e.Parents {
+		if parent.Kind == "" {
+			parent.Kind = APIGateway
+		}
+		parent.EnterpriseMeta.Merge(e.GetEnterpriseMeta())
+		parent.EnterpriseMeta.Normalize()
+		e.Parents[i] = parent
+	}
+
+	for i, service := range e.Services {
+		service.EnterpriseMeta.Merge(e.GetEnterpriseMeta())
+		service.EnterpriseMeta.Normalize()
+		e.Services[i] = service
+	}
+
+	h, err := HashConfigEntry(e)
+	if err != nil {
+		return err
+	}
+	e.Hash = h
+	return nil
+}
+
+func (e *TLSRouteConfigEntry) Validate() error {
+	if len(e.Services) > 1 {
+		return fmt.Errorf("tls-route currently only supports one service")
+	}
+
+	if err := validateRouteHostnames(e.Hostnames); err != nil {
+		return err
+	}
+
+	if err := validateRouteParents(e.Parents); err != nil {
+		return err
+	}
+
+	if err := validateConfigEntryMeta(e.Meta); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (e *TLSRouteConfigEntry) CanRead(authz acl.Authorizer) error {
+	var authzContext acl.AuthorizerContext
+	e.FillAuthzContext(&authzContext)
+	return authz.ToAllowAuthorizer().MeshReadAllowed(&authzContext)
+}
+
+func (e *TLSRouteConfigEntry) CanWrite(authz acl.Authorizer) error {
+	var authzContext acl.AuthorizerContext
+	e.FillAuthzContext(&authzContext)
+	return authz.ToAllowAuthorizer().MeshWriteAllowed(&authzContext)
+}
+
+func (e *TLSRouteConfigEntry) FilteredHostnames(listenerHostname string) []string {
+	return filterRouteHostnames(e.Hostnames, listenerHostname)
+}
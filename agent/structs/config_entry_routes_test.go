@@ -5,6 +5,7 @@ package structs
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -362,6 +363,205 @@ func TestHTTPRoute(t *testing.T) {
 			},
 		},
 
+		"rule with redirect filter": {
+			entry: &HTTPRouteConfigEntry{
+				Kind: HTTPRoute,
+				Name: "route-one",
+				Rules: []HTTPRouteRule{{
+					Filters: HTTPFilters{
+						RedirectFilter: &RedirectFilter{
+							Scheme:        "https",
+							PrefixRewrite: "/v2/",
+							StatusCode:    302,
+						},
+					},
+					Matches: []HTTPMatch{{
+						Path: HTTPPathMatch{
+							Match: HTTPPathMatchPrefix,
+							Value: "/v1/",
+						},
+					}},
+				}},
+			},
+		},
+		"rule with direct response filter": {
+			entry: &HTTPRouteConfigEntry{
+				Kind: HTTPRoute,
+				Name: "route-one",
+				Rules: []HTTPRouteRule{{
+					Filters: HTTPFilters{
+						DirectResponseFilter: &DirectResponseFilter{
+							Status: 503,
+							Body:   "down for maintenance",
+						},
+					},
+				}},
+			},
+		},
+		"rule with redirect and direct response filters": {
+			entry: &HTTPRouteConfigEntry{
+				Kind: HTTPRoute,
+				Name: "route-one",
+				Rules: []HTTPRouteRule{{
+					Filters: HTTPFilters{
+						RedirectFilter:       &RedirectFilter{Scheme: "https"},
+						DirectResponseFilter: &DirectResponseFilter{Status: 200},
+					},
+				}},
+			},
+			validateErr: "Rule[0], HTTPFilters, should only contain one of RedirectFilter or DirectResponseFilter",
+		},
+		"rule with redirect filter and services": {
+			entry: &HTTPRouteConfigEntry{
+				Kind: HTTPRoute,
+				Name: "route-one",
+				Rules: []HTTPRouteRule{{
+					Filters: HTTPFilters{
+						RedirectFilter: &RedirectFilter{Scheme: "https"},
+					},
+					Services: []HTTPService{{Name: "test"}},
+				}},
+			},
+			validateErr: "Rule[0], HTTPFilters, RedirectFilter and DirectResponseFilter cannot be combined with Services",
+		},
+		"rule with invalid redirect filter": {
+			entry: &HTTPRouteConfigEntry{
+				Kind: HTTPRoute,
+				Name: "route-one",
+				Rules: []HTTPRouteRule{{
+					Filters: HTTPFilters{
+						RedirectFilter: &RedirectFilter{Scheme: "https", StatusCode: 404},
+					},
+				}},
+			},
+			validateErr: "Rule[0], HTTPFilters, RedirectFilter, StatusCode must be one of 301, 302, 303, 307, or 308, got 404",
+		},
+		"rule with redirect prefix rewrite and regex path match": {
+			entry: &HTTPRouteConfigEntry{
+				Kind: HTTPRoute,
+				Name: "route-one",
+				Rules: []HTTPRouteRule{{
+					Filters: HTTPFilters{
+						RedirectFilter: &RedirectFilter{PrefixRewrite: "/v2/"},
+					},
+					Matches: []HTTPMatch{{
+						Path: HTTPPathMatch{
+							Match: HTTPPathMatchRegularExpression,
+							Value: "/v1/.*",
+						},
+					}},
+				}},
+			},
+			validateErr: "Rule[0], HTTPFilters, RedirectFilter, PrefixRewrite requires an exact or prefix path match",
+		},
+		"rule with invalid direct response filter": {
+			entry: &HTTPRouteConfigEntry{
+				Kind: HTTPRoute,
+				Name: "route-one",
+				Rules: []HTTPRouteRule{{
+					Filters: HTTPFilters{
+						DirectResponseFilter: &DirectResponseFilter{Status: 99},
+					},
+				}},
+			},
+			validateErr: "Rule[0], HTTPFilters, DirectResponseFilter, Status must be in the range [200, 600), got 99",
+		},
+		"rule with retry filter hedging and retry budget": {
+			entry: &HTTPRouteConfigEntry{
+				Kind: HTTPRoute,
+				Name: "route-one",
+				Rules: []HTTPRouteRule{{
+					Filters: HTTPFilters{
+						RetryFilter: &RetryFilter{
+							NumRetries:    2,
+							PerTryTimeout: time.Second,
+							HedgePolicy:   &HedgePolicy{HedgeOnPerTryTimeout: true},
+							RetryBudget:   &RetryBudget{MinRetryConcurrency: intPointer(2)},
+						},
+					},
+					Services: []HTTPService{{Name: "test"}},
+				}},
+			},
+		},
+		"rule with retry filter hedging without per try timeout": {
+			entry: &HTTPRouteConfigEntry{
+				Kind: HTTPRoute,
+				Name: "route-one",
+				Rules: []HTTPRouteRule{{
+					Filters: HTTPFilters{
+						RetryFilter: &RetryFilter{
+							HedgePolicy: &HedgePolicy{HedgeOnPerTryTimeout: true},
+						},
+					},
+					Services: []HTTPService{{Name: "test"}},
+				}},
+			},
+			validateErr: "Rule[0], HTTPFilters, RetryFilter, HedgePolicy, HedgeOnPerTryTimeout requires a PerTryTimeout",
+		},
+		"service with session affinity": {
+			entry: &HTTPRouteConfigEntry{
+				Kind: HTTPRoute,
+				Name: "route-one",
+				Rules: []HTTPRouteRule{{
+					Services: []HTTPService{{
+						Name: "test",
+						SessionAffinity: &SessionAffinity{
+							Type: SessionAffinityCookie,
+							Name: "session",
+						},
+					}},
+				}},
+			},
+		},
+		"service with invalid session affinity": {
+			entry: &HTTPRouteConfigEntry{
+				Kind: HTTPRoute,
+				Name: "route-one",
+				Rules: []HTTPRouteRule{{
+					Services: []HTTPService{{
+						Name:            "test",
+						SessionAffinity: &SessionAffinity{Type: SessionAffinityHeader},
+					}},
+				}},
+			},
+			validateErr: "Rule[0], Service[0], SessionAffinity, Name is required",
+		},
+		"session affinity on split services": {
+			entry: &HTTPRouteConfigEntry{
+				Kind: HTTPRoute,
+				Name: "route-one",
+				Rules: []HTTPRouteRule{{
+					Services: []HTTPService{
+						{Name: "test", Weight: 50},
+						{
+							Name:   "test-canary",
+							Weight: 50,
+							SessionAffinity: &SessionAffinity{
+								Type: SessionAffinityCookie,
+								Name: "session",
+							},
+						},
+					},
+				}},
+			},
+			validateErr: "Rule[0], Service[1], SessionAffinity is only supported on rules with a single service",
+		},
+		"service with redirect filter": {
+			entry: &HTTPRouteConfigEntry{
+				Kind: HTTPRoute,
+				Name: "route-one",
+				Rules: []HTTPRouteRule{{
+					Services: []HTTPService{{
+						Name: "test",
+						Filters: HTTPFilters{
+							RedirectFilter: &RedirectFilter{Scheme: "https"},
+						},
+					}},
+				}},
+			},
+			validateErr: "Rule[0], Service[0], HTTPFilters, RedirectFilter and DirectResponseFilter are only supported on rules",
+		},
+
 		"duplicate parents with no listener specified": {
 			entry: &HTTPRouteConfigEntry{
 				Kind: HTTPRoute,
@@ -910,3 +1110,210 @@ func TestHTTPMatch_DeepEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestGRPCRoute(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]configEntryTestcase{
+		"normalize parent and match kind": {
+			entry: &GRPCRouteConfigEntry{
+				Kind: GRPCRoute,
+				Name: "route-one",
+				Parents: []ResourceReference{{
+					Name: "gateway",
+				}},
+				Rules: []GRPCRouteRule{{
+					Matches: []GRPCMatch{{
+						Method: GRPCMethodMatch{
+							Service: "foo.Bar",
+						},
+					}},
+					Services: []GRPCService{{
+						Name: "foo",
+					}},
+				}},
+			},
+			normalizeOnly: true,
+			check: func(t *testing.T, entry ConfigEntry) {
+				expectedParent := ResourceReference{
+					Kind:           APIGateway,
+					Name:           "gateway",
+					EnterpriseMeta: *acl.DefaultEnterpriseMeta(),
+				}
+				route := entry.(*GRPCRouteConfigEntry)
+				require.Len(t, route.Parents, 1)
+				require.Equal(t, expectedParent, route.Parents[0])
+				require.Equal(t, GRPCMethodMatchExact, route.Rules[0].Matches[0].Method.Match)
+				require.Equal(t, 1, route.Rules[0].Services[0].Weight)
+			},
+		},
+		"invalid parent kind": {
+			entry: &GRPCRouteConfigEntry{
+				Kind: GRPCRoute,
+				Name: "route-two",
+				Parents: []ResourceReference{{
+					Kind: "route",
+					Name: "gateway",
+				}},
+			},
+			validateErr: "unsupported parent kind",
+		},
+		"wildcard hostnames": {
+			entry: &GRPCRouteConfigEntry{
+				Kind: GRPCRoute,
+				Name: "route-two",
+				Parents: []ResourceReference{{
+					Name: "gateway",
+				}},
+				Hostnames: []string{"*"},
+			},
+			validateErr: "host \"*\" must not be a wildcard",
+		},
+		"exact match with slash": {
+			entry: &GRPCRouteConfigEntry{
+				Kind: GRPCRoute,
+				Name: "route-two",
+				Parents: []ResourceReference{{
+					Name: "gateway",
+				}},
+				Rules: []GRPCRouteRule{{
+					Matches: []GRPCMatch{{
+						Method: GRPCMethodMatch{
+							Match:   GRPCMethodMatchExact,
+							Service: "foo.Bar/Baz",
+						},
+					}},
+				}},
+			},
+			validateErr: "exact type match must not contain '/'",
+		},
+		"invalid match type": {
+			entry: &GRPCRouteConfigEntry{
+				Kind: GRPCRoute,
+				Name: "route-two",
+				Parents: []ResourceReference{{
+					Name: "gateway",
+				}},
+				Rules: []GRPCRouteRule{{
+					Matches: []GRPCMatch{{
+						Method: GRPCMethodMatch{
+							Match:  "prefix",
+							Method: "Get",
+						},
+					}},
+				}},
+			},
+			validateErr: "match type should be one of exact or regex",
+		},
+		"regex match without service or method": {
+			entry: &GRPCRouteConfigEntry{
+				Kind: GRPCRoute,
+				Name: "route-two",
+				Parents: []ResourceReference{{
+					Name: "gateway",
+				}},
+				Rules: []GRPCRouteRule{{
+					Matches: []GRPCMatch{{
+						Method: GRPCMethodMatch{
+							Match: GRPCMethodMatchRegularExpression,
+						},
+					}},
+				}},
+			},
+			validateErr: "must specify at least one of Service or Method",
+		},
+		"valid regex match": {
+			entry: &GRPCRouteConfigEntry{
+				Kind: GRPCRoute,
+				Name: "route-two",
+				Parents: []ResourceReference{{
+					Name: "gateway",
+				}},
+				Rules: []GRPCRouteRule{{
+					Matches: []GRPCMatch{{
+						Method: GRPCMethodMatch{
+							Match:   GRPCMethodMatchRegularExpression,
+							Service: "foo\\..*",
+						},
+					}},
+					Services: []GRPCService{{
+						Name: "foo",
+					}},
+				}},
+			},
+		},
+	}
+	testConfigEntryNormalizeAndValidate(t, cases)
+}
+
+func TestTLSRoute(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]configEntryTestcase{
+		"multiple services": {
+			entry: &TLSRouteConfigEntry{
+				Kind: TLSRoute,
+				Name: "route-one",
+				Services: []TCPService{{
+					Name: "foo",
+				}, {
+					Name: "bar",
+				}},
+			},
+			validateErr: "tls-route currently only supports one service",
+		},
+		"normalize parent kind": {
+			entry: &TLSRouteConfigEntry{
+				Kind: TLSRoute,
+				Name: "route-two",
+				Parents: []ResourceReference{{
+					Name: "gateway",
+				}},
+				Hostnames: []string{"foo.example.com"},
+				Services: []TCPService{{
+					Name: "foo",
+				}},
+			},
+			normalizeOnly: true,
+			check: func(t *testing.T, entry ConfigEntry) {
+				expectedParent := ResourceReference{
+					Kind:           APIGateway,
+					Name:           "gateway",
+					EnterpriseMeta: *acl.DefaultEnterpriseMeta(),
+				}
+				route := entry.(*TLSRouteConfigEntry)
+				require.Len(t, route.Parents, 1)
+				require.Equal(t, expectedParent, route.Parents[0])
+			},
+		},
+		"wildcard hostnames": {
+			entry: &TLSRouteConfigEntry{
+				Kind:      TLSRoute,
+				Name:      "route-two",
+				Hostnames: []string{"*"},
+				Services: []TCPService{{
+					Name: "foo",
+				}},
+			},
+			validateErr: "host \"*\" must not be a wildcard",
+		},
+		"duplicate parents": {
+			entry: &TLSRouteConfigEntry{
+				Kind: TLSRoute,
+				Name: "route-two",
+				Parents: []ResourceReference{
+					{
+						Kind: "api-gateway",
+						Name: "gateway",
+					},
+					{
+						Kind: "api-gateway",
+						Name: "gateway",
+					},
+				},
+			},
+			validateErr: "route parents must be unique",
+		},
+	}
+	testConfigEntryNormalizeAndValidate(t, cases)
+}
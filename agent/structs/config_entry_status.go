@@ -233,6 +233,60 @@ func NewRouteCondition(name api.RouteConditionType, status api.ConditionStatus,
 	}
 }
 
+// NewCanaryRolloutCondition is a helper to build allowable Conditions for a
+// canary-rollout config entry
+func NewCanaryRolloutCondition(name api.CanaryRolloutConditionType, status api.ConditionStatus, reason api.CanaryRolloutConditionReason, message string) Condition {
+	if err := api.ValidateCanaryRolloutConditionReason(name, status, reason); err != nil {
+		// note we panic here because an invalid combination is a programmer error
+		// this  should never actually be hit
+		panic(err)
+	}
+
+	return Condition{
+		Type:               string(name),
+		Status:             string(status),
+		Reason:             string(reason),
+		Message:            message,
+		LastTransitionTime: ptrTo(time.Now().UTC()),
+	}
+}
+
+// NewTrustBundleCondition is a helper to build allowable Conditions for a
+// trust-bundle config entry
+func NewTrustBundleCondition(name api.TrustBundleConditionType, status api.ConditionStatus, reason api.TrustBundleConditionReason, message string) Condition {
+	if err := api.ValidateTrustBundleConditionReason(name, status, reason); err != nil {
+		// note we panic here because an invalid combination is a programmer error
+		// this  should never actually be hit
+		panic(err)
+	}
+
+	return Condition{
+		Type:               string(name),
+		Status:             string(status),
+		Reason:             string(reason),
+		Message:            message,
+		LastTransitionTime: ptrTo(time.Now().UTC()),
+	}
+}
+
+// NewACMECertificateCondition is a helper to build allowable Conditions
+// for an acme-certificate config entry
+func NewACMECertificateCondition(name api.ACMECertificateConditionType, status api.ConditionStatus, reason api.ACMECertificateConditionReason, message string) Condition {
+	if err := api.ValidateACMECertificateConditionReason(name, status, reason); err != nil {
+		// note we panic here because an invalid combination is a programmer error
+		// this  should never actually be hit
+		panic(err)
+	}
+
+	return Condition{
+		Type:               string(name),
+		Status:             string(status),
+		Reason:             string(reason),
+		Message:            message,
+		LastTransitionTime: ptrTo(time.Now().UTC()),
+	}
+}
+
 func ptrTo[T any](val T) *T {
 	return &val
 }
@@ -1193,6 +1193,72 @@ func TestDecodeConfigEntry(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "service-router: hedging and retry budget",
+			snake: `
+				kind = "service-router"
+				name = "main"
+				routes = [
+					{
+						destination {
+							service         = "carrot"
+							num_retries     = 2
+							per_try_timeout = "250ms"
+							hedge_policy {
+								initial_requests         = 2
+								hedge_on_per_try_timeout = true
+							}
+							retry_budget {
+								budget_percent        = 25.5
+								min_retry_concurrency = 4
+							}
+						}
+					},
+				]
+			`,
+			camel: `
+				Kind = "service-router"
+				Name = "main"
+				Routes = [
+					{
+						Destination {
+							Service       = "carrot"
+							NumRetries    = 2
+							PerTryTimeout = "250ms"
+							HedgePolicy {
+								InitialRequests      = 2
+								HedgeOnPerTryTimeout = true
+							}
+							RetryBudget {
+								BudgetPercent       = 25.5
+								MinRetryConcurrency = 4
+							}
+						}
+					},
+				]
+			`,
+			expect: &ServiceRouterConfigEntry{
+				Kind: "service-router",
+				Name: "main",
+				Routes: []ServiceRoute{
+					{
+						Destination: &ServiceRouteDestination{
+							Service:       "carrot",
+							NumRetries:    2,
+							PerTryTimeout: 250 * time.Millisecond,
+							HedgePolicy: &HedgePolicy{
+								InitialRequests:      2,
+								HedgeOnPerTryTimeout: true,
+							},
+							RetryBudget: &RetryBudget{
+								BudgetPercent:       float64Pointer(25.5),
+								MinRetryConcurrency: intPointer(4),
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "service-splitter: kitchen sink",
 			snake: `
@@ -1588,6 +1654,111 @@ func TestDecodeConfigEntry(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "service-resolver: session affinity",
+			snake: `
+				kind = "service-resolver"
+				name = "main"
+				load_balancer = {
+					policy = "least_request"
+					session_affinity = {
+						type = "cookie"
+						name = "session"
+						strict = true
+						cookie_config = {
+							ttl = "1h"
+							path = "/"
+						}
+					}
+				}
+			`,
+			camel: `
+				Kind = "service-resolver"
+				Name = "main"
+				LoadBalancer = {
+					Policy = "least_request"
+					SessionAffinity = {
+						Type = "cookie"
+						Name = "session"
+						Strict = true
+						CookieConfig = {
+							TTL = "1h"
+							Path = "/"
+						}
+					}
+				}
+			`,
+			expect: &ServiceResolverConfigEntry{
+				Kind: "service-resolver",
+				Name: "main",
+				LoadBalancer: &LoadBalancer{
+					Policy: LBPolicyLeastRequest,
+					SessionAffinity: &SessionAffinity{
+						Type:   SessionAffinityCookie,
+						Name:   "session",
+						Strict: true,
+						CookieConfig: &SessionAffinityCookieConfig{
+							TTL:  time.Hour,
+							Path: "/",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "service-resolver: limits and passive health check",
+			snake: `
+				kind = "service-resolver"
+				name = "main"
+				limits {
+					max_connections = 10
+					max_pending_requests = 20
+					retry_budget {
+						budget_percent = 25.5
+						min_retry_concurrency = 5
+					}
+				}
+				passive_health_check {
+					interval = "10s"
+					max_failures = 3
+					base_ejection_time = "5s"
+				}
+			`,
+			camel: `
+				Kind = "service-resolver"
+				Name = "main"
+				Limits {
+					MaxConnections = 10
+					MaxPendingRequests = 20
+					RetryBudget {
+						BudgetPercent = 25.5
+						MinRetryConcurrency = 5
+					}
+				}
+				PassiveHealthCheck {
+					Interval = "10s"
+					MaxFailures = 3
+					BaseEjectionTime = "5s"
+				}
+			`,
+			expect: &ServiceResolverConfigEntry{
+				Kind: "service-resolver",
+				Name: "main",
+				Limits: &UpstreamLimits{
+					MaxConnections:     intPointer(10),
+					MaxPendingRequests: intPointer(20),
+					RetryBudget: &RetryBudget{
+						BudgetPercent:       float64Pointer(25.5),
+						MinRetryConcurrency: intPointer(5),
+					},
+				},
+				PassiveHealthCheck: &PassiveHealthCheck{
+					Interval:         10 * time.Second,
+					MaxFailures:      3,
+					BaseEjectionTime: durationPointer(5 * time.Second),
+				},
+			},
+		},
 		{
 			// TODO(rb): test SDS stuff here in both places (global/service)
 			name: "ingress-gateway: kitchen sink",
@@ -2348,6 +2519,136 @@ func TestDecodeConfigEntry(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "canary-rollout",
+			snake: `
+				kind = "canary-rollout"
+				name = "web"
+				stable_subset = "v1"
+				canary_subset = "v2"
+				steps = [
+					{
+						weight = 10
+						pause = "5m"
+					},
+					{
+						weight = 100
+					}
+				]
+				health_gate {
+					min_passing_ratio = 0.9
+					min_passing_instances = 2
+					grace_period = "30s"
+					max_error_ratio = 0.05
+				}
+			`,
+			camel: `
+				Kind = "canary-rollout"
+				Name = "web"
+				StableSubset = "v1"
+				CanarySubset = "v2"
+				Steps = [
+					{
+						Weight = 10
+						Pause = "5m"
+					},
+					{
+						Weight = 100
+					}
+				]
+				HealthGate {
+					MinPassingRatio = 0.9
+					MinPassingInstances = 2
+					GracePeriod = "30s"
+					MaxErrorRatio = 0.05
+				}
+			`,
+			expect: &CanaryRolloutConfigEntry{
+				Kind:         "canary-rollout",
+				Name:         "web",
+				StableSubset: "v1",
+				CanarySubset: "v2",
+				Steps: []CanaryRolloutStep{
+					{Weight: 10, Pause: 5 * time.Minute},
+					{Weight: 100},
+				},
+				HealthGate: CanaryRolloutHealthGate{
+					MinPassingRatio:     0.9,
+					MinPassingInstances: 2,
+					GracePeriod:         30 * time.Second,
+					MaxErrorRatio:       0.05,
+				},
+			},
+		},
+		{
+			name: "trust-bundle",
+			snake: `
+				kind = "trust-bundle"
+				name = "example.org"
+				bundle_endpoint {
+					url = "https://example.org/bundle"
+					profile = "https_spiffe"
+					spiffe_id = "spiffe://example.org/bundle-server"
+					ca_cert = "cert"
+				}
+				refresh_interval = "10m"
+			`,
+			camel: `
+				Kind = "trust-bundle"
+				Name = "example.org"
+				BundleEndpoint {
+					URL = "https://example.org/bundle"
+					Profile = "https_spiffe"
+					SpiffeID = "spiffe://example.org/bundle-server"
+					CACert = "cert"
+				}
+				RefreshInterval = "10m"
+			`,
+			expect: &TrustBundleConfigEntry{
+				Kind: "trust-bundle",
+				Name: "example.org",
+				BundleEndpoint: TrustBundleEndpoint{
+					URL:      "https://example.org/bundle",
+					Profile:  "https_spiffe",
+					SpiffeID: "spiffe://example.org/bundle-server",
+					CACert:   "cert",
+				},
+				RefreshInterval: 10 * time.Minute,
+			},
+		},
+		{
+			name: "acme-certificate",
+			snake: `
+				kind = "acme-certificate"
+				name = "example"
+				directory = "https://acme.example.com/directory"
+				directory_ca_cert = "cert"
+				email = "admin@example.com"
+				domains = ["*.example.com", "example.com"]
+				challenge = "dns-01"
+				renew_before = "720h"
+			`,
+			camel: `
+				Kind = "acme-certificate"
+				Name = "example"
+				Directory = "https://acme.example.com/directory"
+				DirectoryCACert = "cert"
+				Email = "admin@example.com"
+				Domains = ["*.example.com", "example.com"]
+				Challenge = "dns-01"
+				RenewBefore = "720h"
+			`,
+			expect: &ACMECertificateConfigEntry{
+				Kind:            "acme-certificate",
+				Name:            "example",
+				Directory:       "https://acme.example.com/directory",
+				DirectoryCACert: "cert",
+				Email:           "admin@example.com",
+				Domains:         []string{"*.example.com", "example.com"},
+				Challenge:       "dns-01",
+				RenewBefore:     720 * time.Hour,
+			},
+		},
 		{
 			name: "exported-services",
 			snake: `
@@ -3494,6 +3795,29 @@ func TestParseUpstreamConfig(t *testing.T) {
 				Protocol: "tcp",
 			},
 		},
+		{
+			name: "connect limits map with retry budget",
+			input: map[string]interface{}{
+				"limits": map[string]interface{}{
+					"max_connections": 50,
+					"retry_budget": map[string]interface{}{
+						"budget_percent":        30,
+						"min_retry_concurrency": 2,
+					},
+				},
+			},
+			want: UpstreamConfig{
+				ConnectTimeoutMs: 5000,
+				Limits: &UpstreamLimits{
+					MaxConnections: intPointer(50),
+					RetryBudget: &RetryBudget{
+						BudgetPercent:       float64Pointer(30),
+						MinRetryConcurrency: intPointer(2),
+					},
+				},
+				Protocol: "tcp",
+			},
+		},
 		{
 			name: "connect limits map zero",
 			input: map[string]interface{}{
@@ -3816,6 +4140,10 @@ func durationPointer(d time.Duration) *time.Duration {
 	return &d
 }
 
+func float64Pointer(f float64) *float64 {
+	return &f
+}
+
 func TestValidateOpaqueConfigMap(t *testing.T) {
 	tt := map[string]struct {
 		input     map[string]interface{}
@@ -0,0 +1,315 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/lib"
+)
+
+const (
+	// TrustBundleProfileHTTPSWeb authenticates a SPIFFE bundle endpoint with
+	// a certificate issued by a public or otherwise trusted web PKI.
+	TrustBundleProfileHTTPSWeb = "https_web"
+
+	// TrustBundleProfileHTTPSSPIFFE authenticates a SPIFFE bundle endpoint
+	// with an X.509-SVID of the endpoint's SPIFFE ID.
+	TrustBundleProfileHTTPSSPIFFE = "https_spiffe"
+
+	// DefaultTrustBundleRefreshInterval is how often a trust bundle is
+	// refreshed if neither the config entry nor the bundle itself specify an
+	// interval.
+	DefaultTrustBundleRefreshInterval = 5 * time.Minute
+)
+
+// validTrustDomain matches the characters allowed in a SPIFFE trust domain
+// name.
+var validTrustDomain = regexp.MustCompile(`^[a-z0-9._-]+$`)
+
+// TrustBundleConfigEntry imports the trust bundle of a foreign SPIFFE trust
+// domain so that services can accept connections from workloads of that
+// trust domain. The bundle is either configured statically or fetched from
+// a SPIFFE bundle endpoint, in which case the leader of the primary
+// datacenter refreshes it periodically.
+type TrustBundleConfigEntry struct {
+	Kind string
+
+	// Name is the SPIFFE trust domain of the bundle, such as "example.org".
+	Name string
+
+	// BundleEndpoint is the SPIFFE bundle endpoint that the bundle is fetched
+	// from. If the URL is empty the bundle is static and RootPEMs must be set.
+	BundleEndpoint TrustBundleEndpoint `json:",omitempty" alias:"bundle_endpoint"`
+
+	// RefreshInterval is how often the bundle is fetched from the bundle
+	// endpoint. If zero, the refresh hint of the bundle is used.
+	RefreshInterval time.Duration `json:",omitempty" alias:"refresh_interval"`
+
+	// RootPEMs are the X.509 authorities of the trust domain. When the bundle
+	// is fetched from a bundle endpoint they are managed by Consul.
+	RootPEMs []string `json:",omitempty" alias:"root_pems"`
+
+	// Sequence is the sequence number of the last fetched bundle.
+	Sequence uint64 `json:",omitempty"`
+
+	// LastRefresh is when the bundle was last fetched successfully.
+	LastRefresh *time.Time `json:",omitempty" alias:"last_refresh"`
+
+	Meta map[string]string `json:",omitempty"`
+	// Status is the result of the last refresh of the bundle.
+	Status             Status
+	Hash               uint64 `json:",omitempty" hash:"ignore"`
+	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
+	RaftIndex          `hash:"ignore"`
+}
+
+// TrustBundleEndpoint describes a SPIFFE bundle endpoint and how it is
+// authenticated.
+type TrustBundleEndpoint struct {
+	// URL is the https URL of the bundle endpoint.
+	URL string `json:",omitempty"`
+
+	// Profile is the endpoint profile, either "https_web" or "https_spiffe".
+	// Defaults to "https_web".
+	Profile string `json:",omitempty"`
+
+	// SpiffeID is the SPIFFE ID of the bundle endpoint. It is required by the
+	// https_spiffe profile.
+	SpiffeID string `json:",omitempty" alias:"spiffe_id"`
+
+	// CACert is a PEM encoded set of CA certificates used to authenticate the
+	// endpoint. With the https_web profile it replaces the system roots. With
+	// the https_spiffe profile it bootstraps trust in the endpoint before the
+	// bundle of its trust domain is known.
+	CACert string `json:",omitempty" alias:"ca_cert"`
+}
+
+func (e *TrustBundleConfigEntry) MarshalJSON() ([]byte, error) {
+	type Alias TrustBundleConfigEntry
+	exported := &struct {
+		RefreshInterval string `json:",omitempty"`
+		*Alias
+	}{
+		RefreshInterval: e.RefreshInterval.String(),
+		Alias:           (*Alias)(e),
+	}
+	if e.RefreshInterval == 0 {
+		exported.RefreshInterval = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (e *TrustBundleConfigEntry) UnmarshalJSON(data []byte) error {
+	type Alias TrustBundleConfigEntry
+	aux := &struct {
+		RefreshInterval string
+		*Alias
+	}{
+		Alias: (*Alias)(e),
+	}
+	if err := lib.UnmarshalJSON(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if aux.RefreshInterval != "" {
+		if e.RefreshInterval, err = time.ParseDuration(aux.RefreshInterval); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsFetched returns true if the bundle is fetched from a bundle endpoint
+// rather than configured statically.
+func (e *TrustBundleConfigEntry) IsFetched() bool {
+	return e.BundleEndpoint.URL != ""
+}
+
+// NextRefresh returns when the bundle should next be fetched from its bundle
+// endpoint, given the refresh hint of the last fetched bundle.
+func (e *TrustBundleConfigEntry) NextRefresh(refreshHint time.Duration) time.Time {
+	if e.LastRefresh == nil {
+		return time.Time{}
+	}
+	interval := e.RefreshInterval
+	if interval == 0 {
+		interval = refreshHint
+	}
+	if interval <= 0 {
+		interval = DefaultTrustBundleRefreshInterval
+	}
+	return e.LastRefresh.Add(interval)
+}
+
+func (e *TrustBundleConfigEntry) SetHash(h uint64) {
+	e.Hash = h
+}
+
+func (e *TrustBundleConfigEntry) GetHash() uint64 {
+	return e.Hash
+}
+
+func (e *TrustBundleConfigEntry) GetKind() string                        { return TrustBundle }
+func (e *TrustBundleConfigEntry) GetName() string                        { return e.Name }
+func (e *TrustBundleConfigEntry) GetMeta() map[string]string             { return e.Meta }
+func (e *TrustBundleConfigEntry) GetRaftIndex() *RaftIndex               { return &e.RaftIndex }
+func (e *TrustBundleConfigEntry) GetEnterpriseMeta() *acl.EnterpriseMeta { return &e.EnterpriseMeta }
+
+var _ ControlledConfigEntry = (*TrustBundleConfigEntry)(nil)
+
+func (e *TrustBundleConfigEntry) GetStatus() Status       { return e.Status }
+func (e *TrustBundleConfigEntry) SetStatus(status Status) { e.Status = status }
+func (e *TrustBundleConfigEntry) DefaultStatus() Status   { return Status{} }
+
+var _ UpdatableConfigEntry = (*TrustBundleConfigEntry)(nil)
+
+// UpdateOver keeps the bundle that was last fetched from the bundle endpoint
+// when the entry is rewritten without it, so that editing an entry does not
+// drop trust in the foreign trust domain until the next refresh.
+func (e *TrustBundleConfigEntry) UpdateOver(rawPrev ConfigEntry) error {
+	prev, ok := rawPrev.(*TrustBundleConfigEntry)
+	if !ok {
+		return fmt.Errorf("previous config entry is not of type %T: %T", e, rawPrev)
+	}
+
+	if !e.IsFetched() || len(e.RootPEMs) > 0 || e.BundleEndpoint.URL != prev.BundleEndpoint.URL {
+		return nil
+	}
+
+	e.RootPEMs = prev.RootPEMs
+	e.Sequence = prev.Sequence
+	e.LastRefresh = prev.LastRefresh
+
+	h, err := HashConfigEntry(e)
+	if err != nil {
+		return err
+	}
+	e.Hash = h
+	return nil
+}
+
+func (e *TrustBundleConfigEntry) Normalize() error {
+	if e == nil {
+		return fmt.Errorf("config entry is nil")
+	}
+
+	e.Kind = TrustBundle
+	e.EnterpriseMeta.Normalize()
+
+	if e.IsFetched() && e.BundleEndpoint.Profile == "" {
+		e.BundleEndpoint.Profile = TrustBundleProfileHTTPSWeb
+	}
+
+	h, err := HashConfigEntry(e)
+	if err != nil {
+		return err
+	}
+	e.Hash = h
+	return nil
+}
+
+func (e *TrustBundleConfigEntry) Validate() error {
+	if e.Name == "" {
+		return fmt.Errorf("Name is required")
+	}
+	if !validTrustDomain.MatchString(e.Name) {
+		return fmt.Errorf("Name must be a SPIFFE trust domain and may only contain lowercase letters, digits, '.', '-' and '_'")
+	}
+
+	if err := e.validatePartitionAndNamespace(); err != nil {
+		return err
+	}
+
+	if e.RefreshInterval < 0 {
+		return fmt.Errorf("RefreshInterval cannot be negative")
+	}
+
+	for i, root := range e.RootPEMs {
+		if err := validatePEMCertificates(root); err != nil {
+			return fmt.Errorf("RootPEMs[%d] is invalid: %w", i, err)
+		}
+	}
+
+	if e.IsFetched() {
+		if err := e.BundleEndpoint.validate(); err != nil {
+			return err
+		}
+	} else {
+		if len(e.RootPEMs) == 0 {
+			return fmt.Errorf("one of BundleEndpoint.URL or RootPEMs is required")
+		}
+		if e.RefreshInterval != 0 {
+			return fmt.Errorf("RefreshInterval requires BundleEndpoint.URL to be set")
+		}
+	}
+
+	return validateConfigEntryMeta(e.Meta)
+}
+
+func (ep TrustBundleEndpoint) validate() error {
+	u, err := url.Parse(ep.URL)
+	if err != nil {
+		return fmt.Errorf("BundleEndpoint.URL is invalid: %w", err)
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("BundleEndpoint.URL must be an https URL")
+	}
+
+	if ep.CACert != "" {
+		if err := validatePEMCertificates(ep.CACert); err != nil {
+			return fmt.Errorf("BundleEndpoint.CACert is invalid: %w", err)
+		}
+	}
+
+	switch ep.Profile {
+	case "", TrustBundleProfileHTTPSWeb:
+		if ep.SpiffeID != "" {
+			return fmt.Errorf("BundleEndpoint.SpiffeID is only valid with the %q profile", TrustBundleProfileHTTPSSPIFFE)
+		}
+	case TrustBundleProfileHTTPSSPIFFE:
+		id, err := url.Parse(ep.SpiffeID)
+		if ep.SpiffeID == "" || err != nil || id.Scheme != "spiffe" || id.Host == "" {
+			return fmt.Errorf("BundleEndpoint.SpiffeID must be a SPIFFE ID with the %q profile", TrustBundleProfileHTTPSSPIFFE)
+		}
+	default:
+		return fmt.Errorf("BundleEndpoint.Profile must be one of %q or %q", TrustBundleProfileHTTPSWeb, TrustBundleProfileHTTPSSPIFFE)
+	}
+	return nil
+}
+
+// validatePEMCertificates returns an error unless the given PEM data contains
+// at least one certificate.
+func validatePEMCertificates(data string) error {
+	if !x509.NewCertPool().AppendCertsFromPEM([]byte(data)) {
+		return errors.New("no PEM encoded certificates found")
+	}
+	return nil
+}
+
+// CanRead allows tokens with service:write on any service to read trust
+// bundles, so that sidecar proxies can validate the identities they allow.
+func (e *TrustBundleConfigEntry) CanRead(authz acl.Authorizer) error {
+	var authzContext acl.AuthorizerContext
+	e.FillAuthzContext(&authzContext)
+
+	if err := authz.ToAllowAuthorizer().ServiceWriteAnyAllowed(&authzContext); err == nil {
+		return nil
+	}
+	return authz.ToAllowAuthorizer().MeshReadAllowed(&authzContext)
+}
+
+func (e *TrustBundleConfigEntry) CanWrite(authz acl.Authorizer) error {
+	var authzContext acl.AuthorizerContext
+	e.FillAuthzContext(&authzContext)
+	return authz.ToAllowAuthorizer().MeshWriteAllowed(&authzContext)
+}
@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/acl"
+)
+
+func TestTrustBundleConfigEntry(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]configEntryTestcase{
+		"valid https_web": {
+			entry: &TrustBundleConfigEntry{
+				Name: "example.org",
+				BundleEndpoint: TrustBundleEndpoint{
+					URL: "https://example.org/bundle",
+				},
+				RefreshInterval: time.Minute,
+			},
+			expected: &TrustBundleConfigEntry{
+				Kind: TrustBundle,
+				Name: "example.org",
+				BundleEndpoint: TrustBundleEndpoint{
+					URL:     "https://example.org/bundle",
+					Profile: TrustBundleProfileHTTPSWeb,
+				},
+				RefreshInterval: time.Minute,
+				EnterpriseMeta:  *acl.DefaultEnterpriseMeta(),
+			},
+		},
+		"valid https_spiffe": {
+			entry: &TrustBundleConfigEntry{
+				Kind: TrustBundle,
+				Name: "example.org",
+				BundleEndpoint: TrustBundleEndpoint{
+					URL:      "https://example.org/bundle",
+					Profile:  TrustBundleProfileHTTPSSPIFFE,
+					SpiffeID: "spiffe://example.org/bundle-server",
+					CACert:   validCertificate,
+				},
+			},
+			expectUnchanged: true,
+		},
+		"valid static": {
+			entry: &TrustBundleConfigEntry{
+				Kind:     TrustBundle,
+				Name:     "example.org",
+				RootPEMs: []string{validCertificate},
+			},
+			expectUnchanged: true,
+		},
+		"missing name": {
+			entry: &TrustBundleConfigEntry{
+				RootPEMs: []string{validCertificate},
+			},
+			validateErr: "Name is required",
+		},
+		"invalid trust domain": {
+			entry: &TrustBundleConfigEntry{
+				Name:     "Example.org",
+				RootPEMs: []string{validCertificate},
+			},
+			validateErr: "Name must be a SPIFFE trust domain",
+		},
+		"no bundle": {
+			entry: &TrustBundleConfigEntry{
+				Name: "example.org",
+			},
+			validateErr: "one of BundleEndpoint.URL or RootPEMs is required",
+		},
+		"invalid root": {
+			entry: &TrustBundleConfigEntry{
+				Name:     "example.org",
+				RootPEMs: []string{"not a certificate"},
+			},
+			validateErr: "RootPEMs[0] is invalid",
+		},
+		"refresh interval without endpoint": {
+			entry: &TrustBundleConfigEntry{
+				Name:            "example.org",
+				RootPEMs:        []string{validCertificate},
+				RefreshInterval: time.Minute,
+			},
+			validateErr: "RefreshInterval requires BundleEndpoint.URL to be set",
+		},
+		"http endpoint": {
+			entry: &TrustBundleConfigEntry{
+				Name:           "example.org",
+				BundleEndpoint: TrustBundleEndpoint{URL: "http://example.org/bundle"},
+			},
+			validateErr: "BundleEndpoint.URL must be an https URL",
+		},
+		"unknown profile": {
+			entry: &TrustBundleConfigEntry{
+				Name: "example.org",
+				BundleEndpoint: TrustBundleEndpoint{
+					URL:     "https://example.org/bundle",
+					Profile: "http",
+				},
+			},
+			validateErr: "BundleEndpoint.Profile must be one of",
+		},
+		"https_spiffe without SPIFFE ID": {
+			entry: &TrustBundleConfigEntry{
+				Name: "example.org",
+				BundleEndpoint: TrustBundleEndpoint{
+					URL:     "https://example.org/bundle",
+					Profile: TrustBundleProfileHTTPSSPIFFE,
+				},
+			},
+			validateErr: "BundleEndpoint.SpiffeID must be a SPIFFE ID",
+		},
+		"https_web with SPIFFE ID": {
+			entry: &TrustBundleConfigEntry{
+				Name: "example.org",
+				BundleEndpoint: TrustBundleEndpoint{
+					URL:      "https://example.org/bundle",
+					SpiffeID: "spiffe://example.org/bundle-server",
+				},
+			},
+			validateErr: "BundleEndpoint.SpiffeID is only valid with the \"https_spiffe\" profile",
+		},
+		"invalid CA cert": {
+			entry: &TrustBundleConfigEntry{
+				Name: "example.org",
+				BundleEndpoint: TrustBundleEndpoint{
+					URL:    "https://example.org/bundle",
+					CACert: "not a certificate",
+				},
+			},
+			validateErr: "BundleEndpoint.CACert is invalid",
+		},
+		"negative refresh interval": {
+			entry: &TrustBundleConfigEntry{
+				Name:            "example.org",
+				BundleEndpoint:  TrustBundleEndpoint{URL: "https://example.org/bundle"},
+				RefreshInterval: -time.Second,
+			},
+			validateErr: "RefreshInterval cannot be negative",
+		},
+	}
+	testConfigEntryNormalizeAndValidate(t, cases)
+}
+
+func TestTrustBundleConfigEntry_UpdateOver(t *testing.T) {
+	refreshed := time.Now().UTC()
+	prev := &TrustBundleConfigEntry{
+		Kind:           TrustBundle,
+		Name:           "example.org",
+		BundleEndpoint: TrustBundleEndpoint{URL: "https://example.org/bundle"},
+		RootPEMs:       []string{validCertificate},
+		Sequence:       3,
+		LastRefresh:    &refreshed,
+	}
+
+	t.Run("keeps fetched bundle", func(t *testing.T) {
+		entry := &TrustBundleConfigEntry{
+			Name:            "example.org",
+			BundleEndpoint:  TrustBundleEndpoint{URL: "https://example.org/bundle"},
+			RefreshInterval: time.Minute,
+		}
+		require.NoError(t, entry.Normalize())
+		hash := entry.Hash
+
+		require.NoError(t, entry.UpdateOver(prev))
+		require.Equal(t, prev.RootPEMs, entry.RootPEMs)
+		require.Equal(t, uint64(3), entry.Sequence)
+		require.Equal(t, &refreshed, entry.LastRefresh)
+		require.NotEqual(t, hash, entry.Hash)
+	})
+
+	t.Run("endpoint changed", func(t *testing.T) {
+		entry := &TrustBundleConfigEntry{
+			Name:           "example.org",
+			BundleEndpoint: TrustBundleEndpoint{URL: "https://other.example.org/bundle"},
+		}
+		require.NoError(t, entry.UpdateOver(prev))
+		require.Empty(t, entry.RootPEMs)
+		require.Nil(t, entry.LastRefresh)
+	})
+
+	t.Run("static bundle", func(t *testing.T) {
+		entry := &TrustBundleConfigEntry{
+			Name:     "example.org",
+			RootPEMs: []string{"other"},
+		}
+		require.NoError(t, entry.UpdateOver(prev))
+		require.Equal(t, []string{"other"}, entry.RootPEMs)
+	})
+}
+
+func TestTrustBundleConfigEntry_NextRefresh(t *testing.T) {
+	entry := &TrustBundleConfigEntry{}
+	require.True(t, entry.NextRefresh(time.Minute).IsZero())
+
+	refreshed := time.Now()
+	entry.LastRefresh = &refreshed
+	require.Equal(t, refreshed.Add(time.Minute), entry.NextRefresh(time.Minute))
+	require.Equal(t, refreshed.Add(DefaultTrustBundleRefreshInterval), entry.NextRefresh(0))
+
+	entry.RefreshInterval = time.Hour
+	require.Equal(t, refreshed.Add(time.Hour), entry.NextRefresh(time.Minute))
+}
+
+func TestTrustBundleConfigEntry_JSON(t *testing.T) {
+	entry := &TrustBundleConfigEntry{
+		Kind:            TrustBundle,
+		Name:            "example.org",
+		BundleEndpoint:  TrustBundleEndpoint{URL: "https://example.org/bundle"},
+		RefreshInterval: 90 * time.Second,
+	}
+
+	encoded, err := json.Marshal(entry)
+	require.NoError(t, err)
+	require.Contains(t, string(encoded), `"RefreshInterval":"1m30s"`)
+
+	var decoded TrustBundleConfigEntry
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	require.Equal(t, entry.RefreshInterval, decoded.RefreshInterval)
+	require.Equal(t, entry.BundleEndpoint, decoded.BundleEndpoint)
+}
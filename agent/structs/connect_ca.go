@@ -6,6 +6,7 @@ package structs
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/consul/lib/stringslice"
@@ -56,6 +57,15 @@ type IndexedCARoots struct {
 	// Roots is a list of root CA certs to trust.
 	Roots []*CARoot
 
+	// Revocations are the leaf certificate revocations that are still in
+	// effect. Agents use them to replace revoked leaf certificates.
+	Revocations []*CARevocation `json:",omitempty"`
+
+	// RevocationLists are the PEM encoded certificate revocation lists signed
+	// by the leaf signing CA of each datacenter. Proxies use them to reject
+	// peers that present a revoked leaf certificate.
+	RevocationLists []string `json:",omitempty"`
+
 	// QueryMeta contains the meta sent via a header. We ignore for JSON
 	// so this whole structure can be returned.
 	QueryMeta `json:"-"`
@@ -132,6 +142,17 @@ type CARoot struct {
 	SigningCert string `json:",omitempty"`
 	SigningKey  string `json:",omitempty"`
 
+	// JWTKeyID is the key ID (RFC 7638 thumbprint) of the key used to sign
+	// JWT-SVIDs while this root is active, and JWTPublicKey is its
+	// PEM-encoded public key. They are published in the JWKS for as long as
+	// the root is trusted.
+	JWTKeyID     string `json:",omitempty"`
+	JWTPublicKey string `json:",omitempty"`
+
+	// JWTSigningKey is the PEM-encoded private key used to sign JWT-SVIDs. It
+	// is only stored in the primary datacenter and never returned by the API.
+	JWTSigningKey string `json:",omitempty"`
+
 	// Active is true if this is the current active CA. This must only
 	// be true for exactly one CA. For any method that modifies roots in the
 	// state store, tests should be written to verify that multiple roots
@@ -191,6 +212,12 @@ type CASignRequest struct {
 	// CSR is the PEM-encoded CSR.
 	CSR string
 
+	// ReplacedCert is the PEM-encoded leaf certificate that the new
+	// certificate replaces because its service identity was revoked. The
+	// serial number of the replaced certificate is added to the revocation
+	// lists.
+	ReplacedCert string `json:",omitempty"`
+
 	// WriteRequest is a common struct containing ACL tokens and other
 	// write-related common elements for requests.
 	WriteRequest
@@ -201,6 +228,52 @@ func (q *CASignRequest) RequestDatacenter() string {
 	return q.Datacenter
 }
 
+// JWTSVIDSignRequest is a request to mint a JWT-SVID for a service.
+type JWTSVIDSignRequest struct {
+	// Datacenter is the target for this request.
+	Datacenter string
+
+	// ServiceDatacenter is the datacenter of the service identified by the
+	// JWT-SVID. JWT-SVIDs are signed in the primary datacenter, so this is
+	// set from Datacenter before the request is forwarded there.
+	ServiceDatacenter string `json:",omitempty"`
+
+	// Service is the name of the service to mint the JWT-SVID for.
+	Service string
+	acl.EnterpriseMeta
+
+	// Audience is the list of audiences the JWT-SVID is valid for.
+	Audience []string
+
+	// TTL is the requested lifetime of the JWT-SVID. It defaults to five
+	// minutes and is capped at one hour.
+	TTL time.Duration
+
+	// WriteRequest is a common struct containing ACL tokens and other
+	// write-related common elements for requests.
+	WriteRequest
+}
+
+// RequestDatacenter returns the datacenter for a given request.
+func (q *JWTSVIDSignRequest) RequestDatacenter() string {
+	return q.Datacenter
+}
+
+// IssuedJWTSVID is a JWT-SVID that has been minted by the Connect CA.
+type IssuedJWTSVID struct {
+	// SpiffeID is the SPIFFE ID in the subject of the JWT-SVID.
+	SpiffeID string
+
+	// Token is the JWT-SVID using JWS compact serialization.
+	Token string
+
+	// KeyID is the ID of the key that signed the JWT-SVID.
+	KeyID string
+
+	// ExpiresAt is the time at which the JWT-SVID expires.
+	ExpiresAt time.Time
+}
+
 // IssuedCert is a certificate that has been issued by a Connect CA.
 type IssuedCert struct {
 	// SerialNumber is the unique serial number for this certificate.
@@ -246,6 +319,9 @@ type IssuedCert struct {
 	ValidAfter  time.Time
 	ValidBefore time.Time
 
+	// RootID is the ID of the active CA root when the certificate was signed.
+	RootID string `json:",omitempty"`
+
 	// EnterpriseMeta is the Consul Enterprise specific metadata
 	acl.EnterpriseMeta
 
@@ -307,6 +383,7 @@ const (
 	ConsulCAProvider = "consul"
 	VaultCAProvider  = "vault"
 	AWSCAProvider    = "aws-pca"
+	PluginCAProvider = "plugin"
 )
 
 // CAConfiguration is the configuration for the current CA plugin.
@@ -395,6 +472,57 @@ func (c *CAConfiguration) GetCommonConfig() (*CommonCAProviderConfig, error) {
 	return &config, nil
 }
 
+// RedactedPKCS11PIN replaces the PKCS#11 PIN of the Consul CA provider in
+// configurations returned by the API. An update that contains it keeps the
+// current PIN, so that configurations can be read, modified and written back.
+const RedactedPKCS11PIN = "<hidden>"
+
+// pkcs11PINKey returns the key of the PKCS#11 PIN in the provider config. Keys
+// are matched case-insensitively, like mapstructure does when decoding them.
+func (c *CAConfiguration) pkcs11PINKey() (string, bool) {
+	if c.Provider != ConsulCAProvider {
+		return "", false
+	}
+	for k := range c.Config {
+		if strings.EqualFold(k, "PKCS11PIN") {
+			return k, true
+		}
+	}
+	return "", false
+}
+
+// RedactPKCS11PIN returns a copy of the configuration with the PKCS#11 PIN
+// replaced by RedactedPKCS11PIN.
+func (c *CAConfiguration) RedactPKCS11PIN() *CAConfiguration {
+	key, ok := c.pkcs11PINKey()
+	if !ok {
+		return c
+	}
+	redacted := *c
+	redacted.Config = make(map[string]interface{}, len(c.Config))
+	for k, v := range c.Config {
+		redacted.Config[k] = v
+	}
+	redacted.Config[key] = RedactedPKCS11PIN
+	return &redacted
+}
+
+// RestorePKCS11PIN replaces a redacted PKCS#11 PIN in an updated configuration
+// with the PIN of the current configuration.
+func (c *CAConfiguration) RestorePKCS11PIN(current *CAConfiguration) {
+	key, ok := c.pkcs11PINKey()
+	if !ok || c.Config[key] != RedactedPKCS11PIN {
+		return
+	}
+	delete(c.Config, key)
+	if current == nil {
+		return
+	}
+	if currentKey, ok := current.pkcs11PINKey(); ok {
+		c.Config[key] = current.Config[currentKey]
+	}
+}
+
 type CommonCAProviderConfig struct {
 	LeafCertTTL time.Duration
 	RootCertTTL time.Duration
@@ -519,6 +647,15 @@ type ConsulCAProviderConfig struct {
 	PrivateKey string
 	RootCert   string
 
+	// PKCS11Module is the path to a PKCS#11 module. If set, the private keys of
+	// the CA are generated in and used from a PKCS#11 token, such as a hardware
+	// security module, instead of being kept in the state store. The token is
+	// selected by either PKCS11Slot or PKCS11TokenLabel.
+	PKCS11Module     string
+	PKCS11Slot       *int
+	PKCS11TokenLabel string
+	PKCS11PIN        string
+
 	// DisableCrossSigning is really only useful in test code to use the built in
 	// provider while exercising logic that depends on the CA provider ability to
 	// cross sign. We don't document this config field publicly or make any
@@ -527,6 +664,19 @@ type ConsulCAProviderConfig struct {
 }
 
 func (c *ConsulCAProviderConfig) Validate() error {
+	if c.PKCS11Module == "" {
+		if c.PKCS11Slot != nil || c.PKCS11TokenLabel != "" || c.PKCS11PIN != "" {
+			return fmt.Errorf("PKCS11Module must be set to use a PKCS#11 token")
+		}
+		return nil
+	}
+
+	if (c.PKCS11Slot == nil) == (c.PKCS11TokenLabel == "") {
+		return fmt.Errorf("exactly one of PKCS11Slot or PKCS11TokenLabel must be set to select a PKCS#11 token")
+	}
+	if c.PrivateKey != "" {
+		return fmt.Errorf("cannot provide a private key when the keys are stored in a PKCS#11 token")
+	}
 	return nil
 }
 
@@ -537,6 +687,11 @@ type CAConsulProviderState struct {
 	RootCert         string
 	IntermediateCert string
 
+	// PKCS11KeyID is the hex encoded ID of the private key in the PKCS#11
+	// token when the provider keeps its keys in a token. PrivateKey is empty
+	// in that case.
+	PKCS11KeyID string
+
 	RaftIndex
 }
 
@@ -574,11 +729,42 @@ type AWSCAProviderConfig struct {
 	DeleteOnExit bool
 }
 
+// PluginCAProviderConfig is the configuration of a CA provider that is
+// implemented by an external plugin binary. The whole configuration, including
+// any plugin-specific fields, is passed on to the plugin.
+type PluginCAProviderConfig struct {
+	CommonCAProviderConfig `mapstructure:",squash"`
+
+	// Plugin is the name of the plugin to launch, as set in the
+	// connect.ca_plugins agent configuration of the servers.
+	Plugin string
+}
+
+// CAPlugin is a CA provider plugin binary that servers may launch. Plugins
+// are only set in the agent configuration, so that the CA configuration,
+// which can be changed through the API, selects a plugin by name but cannot
+// launch arbitrary commands.
+type CAPlugin struct {
+	// Name is the name the CA configuration selects the plugin by.
+	Name string
+
+	// Command is the path to the plugin binary.
+	Command string
+
+	// Args are the arguments the plugin binary is launched with.
+	Args []string
+
+	// SHA256 is the hex encoded SHA-256 checksum of the plugin binary. The
+	// binary is only launched if its checksum matches.
+	SHA256 string
+}
+
 // CALeafOp is the operation for a request related to leaf certificates.
 type CALeafOp string
 
 const (
 	CALeafOpIncrementIndex CALeafOp = "increment-index"
+	CALeafOpDeleteCerts    CALeafOp = "delete-certs"
 )
 
 // CALeafRequest is used to modify connect CA leaf data. This is used by the
@@ -591,6 +777,15 @@ type CALeafRequest struct {
 	// Datacenter is the target for this request.
 	Datacenter string
 
+	// IssuedCert is the certificate that was signed, which is recorded along
+	// with the index for CALeafOpIncrementIndex. CertPEM and PrivateKeyPEM
+	// must not be set.
+	IssuedCert *IssuedCert `json:",omitempty"`
+
+	// SerialNumbers are the serial numbers of the issued certificates to
+	// remove for CALeafOpDeleteCerts.
+	SerialNumbers []string `json:",omitempty"`
+
 	// WriteRequest is a common struct containing ACL tokens and other
 	// write-related common elements for requests.
 	WriteRequest
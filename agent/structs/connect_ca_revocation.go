@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/consul/acl"
+)
+
+// CARevocationOp is the operation for a request related to leaf certificate
+// revocations.
+type CARevocationOp string
+
+const (
+	// CARevocationOpRevoke stores new revocations.
+	CARevocationOpRevoke CARevocationOp = "revoke"
+
+	// CARevocationOpDelete removes revocations and revocation lists that are no
+	// longer needed because every certificate they cover has expired.
+	CARevocationOpDelete CARevocationOp = "delete"
+
+	// CARevocationOpSetList upserts the revocation lists signed by the signing
+	// CA of a datacenter.
+	CARevocationOpSetList CARevocationOp = "set-list"
+
+	// CARevocationOpReplace replaces all revocations and revocation lists. It
+	// is used by secondary datacenters to replicate the primary's state.
+	CARevocationOpReplace CARevocationOp = "replace"
+)
+
+// CARevocationRequest is used to modify leaf certificate revocations. Writes
+// are always handled by the primary datacenter and replicated from there.
+type CARevocationRequest struct {
+	// Datacenter is the target for this request.
+	Datacenter string
+
+	// Op is the type of operation being requested.
+	Op CARevocationOp
+
+	// Revocations are the revocations to store or delete. For a delete only
+	// the ID field needs to be set.
+	Revocations []*CARevocation
+
+	// RevocationLists are the revocation lists to store or delete. For a
+	// delete only the AuthorityKeyID field needs to be set.
+	RevocationLists []*CARevocationList
+
+	// WriteRequest is a common struct containing ACL tokens and other
+	// write-related common elements for requests.
+	WriteRequest
+}
+
+// RequestDatacenter returns the datacenter for a given request.
+func (q *CARevocationRequest) RequestDatacenter() string {
+	return q.Datacenter
+}
+
+// CARevocation revokes Connect leaf certificates before they expire. A
+// revocation either targets a single certificate by its serial number or
+// every certificate that was issued to a service identity before RevokedAt.
+type CARevocation struct {
+	// ID is a unique identifier for the revocation.
+	ID string
+
+	// SerialNumber is the serial number of the revoked certificate. This is
+	// encoded in standard hex separated by :, like IssuedCert.SerialNumber.
+	SerialNumber string `json:",omitempty"`
+
+	// AuthorityKeyID is the key ID of the CA that issued the revoked
+	// certificate, encoded like CARoot.SigningKeyID. Serial numbers are only
+	// unique per signing CA, so when this is empty the serial number is
+	// revoked for every signing CA.
+	AuthorityKeyID string `json:",omitempty"`
+
+	// Service revokes every leaf certificate issued to the service identity
+	// before RevokedAt. The leader of each datacenter revokes the serial
+	// numbers of the certificates it issued to the service identity, and
+	// agents replace those certificates and report their serial numbers.
+	Service string `json:",omitempty"`
+
+	// EnterpriseMeta is the Consul Enterprise specific metadata of the
+	// service identity.
+	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
+
+	// Reason is a human readable description of why the certificate was
+	// revoked.
+	Reason string `json:",omitempty"`
+
+	// RevokedAt is the time the revocation was created.
+	RevokedAt time.Time
+
+	// ExpiresAt is the time after which every certificate covered by the
+	// revocation has expired, so the revocation can be removed.
+	ExpiresAt time.Time
+
+	RaftIndex
+}
+
+// Validate checks that the revocation targets either a serial number or a
+// service identity.
+func (r *CARevocation) Validate() error {
+	switch {
+	case r.SerialNumber == "" && r.Service == "":
+		return fmt.Errorf("one of SerialNumber or Service must be set")
+	case r.SerialNumber != "" && r.Service != "":
+		return fmt.Errorf("only one of SerialNumber or Service can be set")
+	case r.Service != "" && r.AuthorityKeyID != "":
+		return fmt.Errorf("AuthorityKeyID can only be set when revoking a serial number")
+	}
+	return nil
+}
+
+// RevokesCert returns true if the revocation applies to a leaf certificate
+// with the given serial number and authority key ID that was issued to
+// the given service at validAfter.
+func (r *CARevocation) RevokesCert(serial, authorityKeyID, service string, entMeta *acl.EnterpriseMeta, validAfter time.Time) bool {
+	if r.SerialNumber != "" {
+		if !strings.EqualFold(r.SerialNumber, serial) {
+			return false
+		}
+		return r.AuthorityKeyID == "" || strings.EqualFold(r.AuthorityKeyID, authorityKeyID)
+	}
+
+	if r.Service == "" || r.Service != service {
+		return false
+	}
+	if !r.EnterpriseMeta.IsSame(entMeta) {
+		return false
+	}
+	return validAfter.Before(r.RevokedAt)
+}
+
+// CARevocations is a list of revocations.
+type CARevocations []*CARevocation
+
+// Sort sorts revocations by the time they were created.
+func (rs CARevocations) Sort() {
+	sort.SliceStable(rs, func(i, j int) bool {
+		return rs[i].RevokedAt.Before(rs[j].RevokedAt)
+	})
+}
+
+// CARevocationList is a certificate revocation list signed by the leaf
+// signing CA of a datacenter. Every datacenter keeps a list signed by its
+// current signing CA, even without revocations, so that proxies can verify
+// the certificates of every signing CA whenever a revocation is added.
+type CARevocationList struct {
+	// AuthorityKeyID is the key ID of the CA that signed the list. Proxies
+	// match it against the authority key ID of the certificates they verify.
+	AuthorityKeyID string
+
+	// Datacenter is the datacenter whose signing CA signed the list.
+	Datacenter string
+
+	// SerialNumbers are the serial numbers included in the list.
+	SerialNumbers []string `json:",omitempty"`
+
+	// PEM is the PEM encoded revocation list.
+	PEM string
+
+	// Unsupported is set instead of PEM when the CA provider of the
+	// datacenter can't sign revocation lists. Proxies can't verify any
+	// certificate issued by that CA once they load a revocation list, so no
+	// list is distributed while such a CA can still have valid certificates.
+	Unsupported bool `json:",omitempty"`
+
+	// ThisUpdate and NextUpdate are the validity period of the list. Proxies
+	// reject certificates issued by the signing CA once the list expired, so
+	// lists are resigned well before NextUpdate.
+	ThisUpdate time.Time
+	NextUpdate time.Time
+
+	RaftIndex
+}
+
+// IndexedCARevocations is the list of revocations and the revocation lists
+// signed for them.
+type IndexedCARevocations struct {
+	Revocations     CARevocations
+	RevocationLists []*CARevocationList
+
+	// Enforced is true when every signing CA that can still have valid
+	// certificates has a current revocation list, so that proxies load the
+	// lists and reject revoked certificates. Otherwise NotEnforcedReason
+	// explains which list is missing.
+	Enforced          bool
+	NotEnforcedReason string `json:",omitempty"`
+
+	QueryMeta
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"time"
+)
+
+// CARotationPlan describes the effect that a CA configuration update would
+// have. It is the result of a dry run of the update, which validates the new
+// configuration without applying it.
+type CARotationPlan struct {
+	// Provider is the CA provider of the new configuration.
+	Provider string
+
+	// ActiveRootID is the ID of the current active root.
+	ActiveRootID string
+
+	// NewRootID is the ID of the active root after the update. It is only set
+	// in the primary datacenter, since secondary datacenters don't have roots
+	// of their own.
+	NewRootID string `json:",omitempty"`
+
+	// ConfigOnly is true if only the new configuration was validated. Providers
+	// other than the Consul provider change external systems when they are
+	// configured, so a dry run doesn't configure them and the root and the
+	// impact of the update are unknown.
+	ConfigOnly bool `json:",omitempty"`
+
+	// RootChanged is true if the update rotates to a new root.
+	RootChanged bool
+
+	// CrossSigned is true if a copy of the current Consul provider
+	// cross-signed the new root during the dry run. Other providers are only
+	// checked for cross-signing support.
+	CrossSigned bool
+
+	// LeafCerts is the number of unexpired leaf certificates that are
+	// reissued because of the update.
+	LeafCerts int
+
+	// Proxies is the number of proxies and gateways that receive a new leaf
+	// certificate because of the update.
+	Proxies int
+
+	// Warnings describe the disruption the update may cause.
+	Warnings []string `json:",omitempty"`
+}
+
+// CARotationStatus reports the progress of the rotation to the active root in
+// a datacenter.
+type CARotationStatus struct {
+	// Datacenter is the datacenter the status is reported for.
+	Datacenter string
+
+	// ActiveRootID is the ID of the active root.
+	ActiveRootID string
+
+	// Roots are the trusted roots along with the number of unexpired leaf
+	// certificates signed while each of them was active.
+	Roots []CARotationRootStatus
+
+	// LeafCerts counts the unexpired leaf certificates by whether they were
+	// signed under the active root.
+	LeafCerts CARotationProgress
+
+	// Proxies counts the proxies and gateways by whether the latest leaf
+	// certificate of their service identity was signed under the active root.
+	Proxies CARotationProgress
+
+	// Peers reports whether each peer has received the active root.
+	Peers []CARotationPeerStatus
+
+	QueryMeta `json:"-"`
+}
+
+// CARotationRootStatus is the rotation status of a single root.
+type CARotationRootStatus struct {
+	ID     string
+	Name   string
+	Active bool
+
+	// RotatedOutAt is the time at which the root stopped being active.
+	RotatedOutAt time.Time `json:",omitempty"`
+
+	// LeafCerts is the number of unexpired leaf certificates signed while the
+	// root was active.
+	LeafCerts int
+}
+
+// CARotationProgress counts the items that picked up the active root.
+type CARotationProgress struct {
+	// Active is the number of items using the active root.
+	Active int
+
+	// Previous is the number of items still using a previous root.
+	Previous int
+
+	// Unknown is the number of items without a known leaf certificate.
+	Unknown int `json:",omitempty"`
+}
+
+// CARotationPeerStatus reports whether a peer has received the active root.
+type CARotationPeerStatus struct {
+	// Peer is the name of the peering.
+	Peer string
+
+	// Partition is the partition of the peering.
+	Partition string `json:",omitempty"`
+
+	// Connected is true if the peering stream is connected.
+	Connected bool
+
+	// TrustBundleUpdated is true if the last trust bundle sent to the peer
+	// contains the active root.
+	TrustBundleUpdated bool
+
+	// TrustBundleAcknowledged is true if the peer acknowledged the last trust
+	// bundle sent to it.
+	TrustBundleAcknowledged bool
+
+	// LastTrustBundleSent is the time the last trust bundle was sent to the
+	// peer.
+	LastTrustBundleSent *time.Time `json:",omitempty"`
+}
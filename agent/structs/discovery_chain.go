@@ -126,6 +126,11 @@ type DiscoveryResolver struct {
 	RequestTimeout time.Duration      `json:",omitempty"`
 	Target         string             `json:",omitempty"`
 	Failover       *DiscoveryFailover `json:",omitempty"`
+
+	// Limits and PassiveHealthCheck are the defaults from the resolver that
+	// apply when the caller does not configure its own for the upstream.
+	Limits             *UpstreamLimits     `json:",omitempty"`
+	PassiveHealthCheck *PassiveHealthCheck `json:",omitempty"`
 }
 
 func (r *DiscoveryResolver) MarshalJSON() ([]byte, error) {
@@ -168,6 +173,10 @@ func (r *DiscoveryResolver) UnmarshalJSON(data []byte) error {
 type DiscoveryRoute struct {
 	Definition *ServiceRoute `json:",omitempty"`
 	NextNode   string        `json:",omitempty"`
+
+	// MirrorNode is the resolver node that receives a copy of the traffic
+	// matching this route, if the route configures a mirror.
+	MirrorNode string `json:",omitempty"`
 }
 
 // compiled form of ServiceSplit
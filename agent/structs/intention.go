@@ -387,6 +387,9 @@ func (x *Intention) UpdatePrecedence() {
 	// Given the maximum, the exact value is determined based on the
 	// number of source exact values.
 	countSrc := x.countExact(x.SourceNS, x.SourceName)
+	if x.SourceType == IntentionSourceSPIFFE && strings.HasSuffix(x.SourceName, "/"+WildcardSpecifier) {
+		countSrc = 1
+	}
 	x.Precedence = max - (2 - countSrc)
 }
 
@@ -522,6 +525,10 @@ type IntentionSourceType string
 const (
 	// IntentionSourceConsul is a service within the Consul catalog.
 	IntentionSourceConsul IntentionSourceType = "consul"
+
+	// IntentionSourceSPIFFE is a workload of a federated SPIFFE trust domain,
+	// identified by its SPIFFE ID.
+	IntentionSourceSPIFFE IntentionSourceType = "spiffe"
 )
 
 type IntentionTargetType string
@@ -5,6 +5,7 @@ package structs
 
 import (
 	"net"
+	"time"
 
 	"github.com/hashicorp/raft"
 )
@@ -70,6 +71,65 @@ func (op *RaftRemovePeerRequest) RequestDatacenter() string {
 	return op.Datacenter
 }
 
+const (
+	// LogStoreMigrationCopying is the status while the log store of a server
+	// is being copied into the staged log store.
+	LogStoreMigrationCopying = "copying"
+
+	// LogStoreMigrationReady is the status once the staged log store has been
+	// copied and verified. The migration completes on the next restart of the
+	// server with the target backend configured.
+	LogStoreMigrationReady = "ready"
+
+	// LogStoreMigrationSwapping is the status while the staged log store
+	// replaces the current one on startup.
+	LogStoreMigrationSwapping = "swapping"
+
+	// LogStoreMigrationComplete is the status once the server uses the
+	// migrated log store.
+	LogStoreMigrationComplete = "complete"
+
+	// LogStoreMigrationFailed is the status when the copy failed or was
+	// cancelled.
+	LogStoreMigrationFailed = "failed"
+)
+
+// LogStoreMigration describes the migration of the Raft log store of a server
+// to a different backend.
+type LogStoreMigration struct {
+	// Backend is the log store backend that is migrated to.
+	Backend string
+
+	// SourceBackend is the log store backend that is migrated from.
+	SourceBackend string
+
+	// Status is the current phase of the migration.
+	Status string
+
+	// FirstIndex and LastIndex are the range of log entries that have been
+	// copied into the staged log store.
+	FirstIndex uint64
+	LastIndex  uint64
+
+	// CopiedEntries and TotalEntries report the progress of the copy.
+	CopiedEntries uint64
+	TotalEntries  uint64
+
+	// VerifiedCheckpoints is the number of log verification checkpoints
+	// whose checksum was verified against the staged log store.
+	VerifiedCheckpoints int
+
+	// BackupPath is where the previous log store was kept once the migration
+	// completed.
+	BackupPath string `json:",omitempty"`
+
+	// Error describes why the migration failed.
+	Error string `json:",omitempty"`
+
+	StartedAt time.Time
+	UpdatedAt time.Time
+}
+
 // AutopilotSetConfigRequest is used by the Operator endpoint to update the
 // current Autopilot configuration of the cluster.
 type AutopilotSetConfigRequest struct {
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"crypto/x509"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// CertInventoryKindCARoot is the kind of the roots of the Connect CA.
+	CertInventoryKindCARoot = "ca-root"
+
+	// CertInventoryKindCAIntermediate is the kind of the intermediates of
+	// the Connect CA.
+	CertInventoryKindCAIntermediate = "ca-intermediate"
+
+	// CertInventoryKindLeaf is the kind of the leaf certificates the Connect
+	// CA signed for services and mesh gateways.
+	CertInventoryKindLeaf = "leaf"
+
+	// CertInventoryKindAgentTLS is the kind of the certificates agents
+	// present for TLS.
+	CertInventoryKindAgentTLS = "agent-tls"
+)
+
+// CertInventory lists the certificates used in a datacenter along with their
+// expiry.
+type CertInventory struct {
+	// Datacenter is the datacenter the inventory is reported for.
+	Datacenter string
+
+	// Certs are sorted by expiry, the certificates that expire first come
+	// first and the certificates with an unknown expiry come last.
+	Certs []*CertInventoryEntry
+
+	QueryMeta `json:"-"`
+}
+
+// CertInventoryEntry describes a single certificate of the inventory.
+type CertInventoryEntry struct {
+	// Kind is the kind of the certificate: ca-root, ca-intermediate, leaf,
+	// agent-tls, inline-certificate or file-system-certificate.
+	Kind string
+
+	// Name identifies the certificate within its kind: the name of the root,
+	// the service of a leaf certificate, the node of an agent or the name of
+	// a config entry.
+	Name string
+
+	Partition string `json:",omitempty"`
+	Namespace string `json:",omitempty"`
+
+	// Source is where an agent TLS certificate was configured.
+	Source string `json:",omitempty"`
+
+	// Path is the path of the certificate of a file-system-certificate
+	// config entry. Its expiry is only known if the file is readable by the
+	// agent that answered the request.
+	Path string `json:",omitempty"`
+
+	SerialNumber string `json:",omitempty"`
+	Subject      string `json:",omitempty"`
+	Issuer       string `json:",omitempty"`
+
+	// NotBefore and NotAfter are zero if the certificate could not be read.
+	NotBefore time.Time `json:",omitempty"`
+	NotAfter  time.Time `json:",omitempty"`
+
+	// Error describes why the certificate could not be read.
+	Error string `json:",omitempty"`
+}
+
+// NewCertInventoryEntry returns an entry describing the given certificate.
+func NewCertInventoryEntry(kind, name string, cert *x509.Certificate) *CertInventoryEntry {
+	entry := &CertInventoryEntry{Kind: kind, Name: name}
+	entry.SetCert(cert)
+	return entry
+}
+
+// SetCert sets the metadata of the entry from the given certificate.
+func (e *CertInventoryEntry) SetCert(cert *x509.Certificate) {
+	// The serial number is encoded like connect.EncodeSerialNumber does.
+	e.SerialNumber = strings.ReplaceAll(fmt.Sprintf("% x", cert.SerialNumber.Bytes()), " ", ":")
+	e.Subject = cert.Subject.String()
+	e.Issuer = cert.Issuer.String()
+	e.NotBefore = cert.NotBefore
+	e.NotAfter = cert.NotAfter
+	e.Error = ""
+}
+
+// ExpiringWithin removes the certificates of the inventory that don't expire
+// within d of now, including the certificates with an unknown expiry.
+func (c *CertInventory) ExpiringWithin(now time.Time, d time.Duration) {
+	deadline := now.Add(d)
+	certs := c.Certs[:0]
+	for _, cert := range c.Certs {
+		if !cert.NotAfter.IsZero() && cert.NotAfter.Before(deadline) {
+			certs = append(certs, cert)
+		}
+	}
+	c.Certs = certs
+}
+
+// Sort sorts the certificates of the inventory by expiry.
+func (c *CertInventory) Sort() {
+	sort.SliceStable(c.Certs, func(i, j int) bool {
+		a, b := c.Certs[i], c.Certs[j]
+		switch {
+		case a.NotAfter.IsZero() || b.NotAfter.IsZero():
+			return !a.NotAfter.IsZero() && b.NotAfter.IsZero()
+		case !a.NotAfter.Equal(b.NotAfter):
+			return a.NotAfter.Before(b.NotAfter)
+		case a.Kind != b.Kind:
+			return a.Kind < b.Kind
+		}
+		return a.Name < b.Name
+	})
+}
@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCertInventory_ExpiringWithinAndSort(t *testing.T) {
+	now := time.Now()
+	soon := &CertInventoryEntry{Kind: CertInventoryKindLeaf, Name: "web", NotAfter: now.Add(time.Hour)}
+	expired := &CertInventoryEntry{Kind: CertInventoryKindAgentTLS, Name: "node1", NotAfter: now.Add(-time.Hour)}
+	later := &CertInventoryEntry{Kind: CertInventoryKindCARoot, Name: "root", NotAfter: now.Add(365 * 24 * time.Hour)}
+	unknown := &CertInventoryEntry{Kind: FileSystemCertificate, Name: "gateway", Path: "/etc/cert.pem"}
+
+	inventory := &CertInventory{Certs: []*CertInventoryEntry{unknown, later, soon, expired}}
+	inventory.Sort()
+	require.Equal(t, []*CertInventoryEntry{expired, soon, later, unknown}, inventory.Certs)
+
+	// Certificates with an unknown expiry are removed by the filter.
+	inventory.ExpiringWithin(now, 24*time.Hour)
+	require.Equal(t, []*CertInventoryEntry{expired, soon}, inventory.Certs)
+}
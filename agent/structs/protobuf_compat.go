@@ -47,6 +47,15 @@ func (m *QueryOptions) GetRequireConsistent() bool {
 	return false
 }
 
+// GetFollowerConsistent reports whether a follower must catch up with the
+// leader before it handles the read.
+func (m *QueryOptions) GetFollowerConsistent() bool {
+	if m != nil {
+		return m.FollowerConsistent
+	}
+	return false
+}
+
 // GetUseCache helps implement the QueryOptionsCompat interface
 func (m *QueryOptions) GetUseCache() bool {
 	if m != nil {
@@ -120,6 +129,12 @@ func (q *QueryOptions) SetRequireConsistent(requireConsistent bool) {
 	q.RequireConsistent = requireConsistent
 }
 
+// SetFollowerConsistent sets the follower-consistent mode parsed from HTTP
+// requests.
+func (q *QueryOptions) SetFollowerConsistent(followerConsistent bool) {
+	q.FollowerConsistent = followerConsistent
+}
+
 // SetUseCache is needed to implement the structs.QueryOptionsCompat interface
 func (q *QueryOptions) SetUseCache(useCache bool) {
 	q.UseCache = useCache
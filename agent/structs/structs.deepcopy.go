@@ -1,4 +1,4 @@
-// generated by deep-copy -pointer-receiver -o ./structs.deepcopy.go -type APIGatewayListener -type BoundAPIGatewayListener -type CARoot -type CheckServiceNode -type CheckType -type CompiledDiscoveryChain -type ConnectProxyConfig -type DiscoveryFailover -type DiscoveryGraphNode -type DiscoveryResolver -type DiscoveryRoute -type DiscoverySplit -type ExposeConfig -type ExportedServicesConfigEntry -type FileSystemCertificateConfigEntry -type GatewayService -type GatewayServiceTLSConfig -type HTTPHeaderModifiers -type HTTPRouteConfigEntry -type HashPolicy -type HealthCheck -type IndexedCARoots -type IngressListener -type InlineCertificateConfigEntry -type Intention -type IntentionPermission -type LoadBalancer -type MeshConfigEntry -type MeshDirectionalTLSConfig -type MeshTLSConfig -type Node -type NodeService -type PeeringServiceMeta -type ServiceConfigEntry -type ServiceConfigResponse -type ServiceConnect -type ServiceDefinition -type ServiceResolverConfigEntry -type ServiceResolverFailover -type ServiceRoute -type ServiceRouteDestination -type ServiceRouteMatch -type TCPRouteConfigEntry -type Upstream -type UpstreamConfiguration -type Status -type BoundAPIGatewayConfigEntry ./; DO NOT EDIT.
+// generated by deep-copy -pointer-receiver -o ./structs.deepcopy.go -type ACMECertificateConfigEntry -type APIGatewayListener -type BoundAPIGatewayListener -type CARoot -type CanaryRolloutConfigEntry -type CheckServiceNode -type CheckType -type CompiledDiscoveryChain -type ConnectProxyConfig -type DiscoveryFailover -type DiscoveryGraphNode -type DiscoveryResolver -type DiscoveryRoute -type DiscoverySplit -type ExposeConfig -type ExportedServicesConfigEntry -type FileSystemCertificateConfigEntry -type GRPCRouteConfigEntry -type GatewayService -type GatewayServiceTLSConfig -type HTTPHeaderModifiers -type HTTPRouteConfigEntry -type HashPolicy -type HealthCheck -type IndexedCARoots -type IngressListener -type InlineCertificateConfigEntry -type Intention -type IntentionPermission -type LoadBalancer -type MeshConfigEntry -type MeshDirectionalTLSConfig -type MeshTLSConfig -type Node -type NodeService -type PeeringServiceMeta -type ServiceConfigEntry -type ServiceConfigResponse -type ServiceConnect -type ServiceDefinition -type ServiceResolverConfigEntry -type ServiceResolverFailover -type ServiceRoute -type ServiceRouteDestination -type ServiceRouteMatch -type TCPRouteConfigEntry -type TLSRouteConfigEntry -type TrustBundleConfigEntry -type Upstream -type UpstreamConfiguration -type Status -type BoundAPIGatewayConfigEntry ./; DO NOT EDIT.
 
 package structs
 
@@ -7,6 +7,30 @@ import (
 	"time"
 )
 
+// DeepCopy generates a deep copy of *ACMECertificateConfigEntry
+func (o *ACMECertificateConfigEntry) DeepCopy() *ACMECertificateConfigEntry {
+	var cp ACMECertificateConfigEntry = *o
+	if o.Domains != nil {
+		cp.Domains = make([]string, len(o.Domains))
+		copy(cp.Domains, o.Domains)
+	}
+	if o.Challenges != nil {
+		cp.Challenges = make([]ACMEChallenge, len(o.Challenges))
+		copy(cp.Challenges, o.Challenges)
+	}
+	if o.Meta != nil {
+		cp.Meta = make(map[string]string, len(o.Meta))
+		for k2, v2 := range o.Meta {
+			cp.Meta[k2] = v2
+		}
+	}
+	{
+		retV := o.Status.DeepCopy()
+		cp.Status = *retV
+	}
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *APIGatewayListener
 func (o *APIGatewayListener) DeepCopy() *APIGatewayListener {
 	var cp APIGatewayListener = *o
@@ -59,6 +83,26 @@ func (o *CARoot) DeepCopy() *CARoot {
 	return &cp
 }
 
+// DeepCopy generates a deep copy of *CanaryRolloutConfigEntry
+func (o *CanaryRolloutConfigEntry) DeepCopy() *CanaryRolloutConfigEntry {
+	var cp CanaryRolloutConfigEntry = *o
+	if o.Steps != nil {
+		cp.Steps = make([]CanaryRolloutStep, len(o.Steps))
+		copy(cp.Steps, o.Steps)
+	}
+	if o.Meta != nil {
+		cp.Meta = make(map[string]string, len(o.Meta))
+		for k2, v2 := range o.Meta {
+			cp.Meta[k2] = v2
+		}
+	}
+	{
+		retV := o.Status.DeepCopy()
+		cp.Status = *retV
+	}
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *CheckServiceNode
 func (o *CheckServiceNode) DeepCopy() *CheckServiceNode {
 	var cp CheckServiceNode = *o
@@ -258,6 +302,50 @@ func (o *DiscoveryResolver) DeepCopy() *DiscoveryResolver {
 	if o.Failover != nil {
 		cp.Failover = o.Failover.DeepCopy()
 	}
+	if o.Limits != nil {
+		cp.Limits = new(UpstreamLimits)
+		*cp.Limits = *o.Limits
+		if o.Limits.MaxConnections != nil {
+			cp.Limits.MaxConnections = new(int)
+			*cp.Limits.MaxConnections = *o.Limits.MaxConnections
+		}
+		if o.Limits.MaxPendingRequests != nil {
+			cp.Limits.MaxPendingRequests = new(int)
+			*cp.Limits.MaxPendingRequests = *o.Limits.MaxPendingRequests
+		}
+		if o.Limits.MaxConcurrentRequests != nil {
+			cp.Limits.MaxConcurrentRequests = new(int)
+			*cp.Limits.MaxConcurrentRequests = *o.Limits.MaxConcurrentRequests
+		}
+		if o.Limits.RetryBudget != nil {
+			cp.Limits.RetryBudget = new(RetryBudget)
+			*cp.Limits.RetryBudget = *o.Limits.RetryBudget
+			if o.Limits.RetryBudget.BudgetPercent != nil {
+				cp.Limits.RetryBudget.BudgetPercent = new(float64)
+				*cp.Limits.RetryBudget.BudgetPercent = *o.Limits.RetryBudget.BudgetPercent
+			}
+			if o.Limits.RetryBudget.MinRetryConcurrency != nil {
+				cp.Limits.RetryBudget.MinRetryConcurrency = new(int)
+				*cp.Limits.RetryBudget.MinRetryConcurrency = *o.Limits.RetryBudget.MinRetryConcurrency
+			}
+		}
+	}
+	if o.PassiveHealthCheck != nil {
+		cp.PassiveHealthCheck = new(PassiveHealthCheck)
+		*cp.PassiveHealthCheck = *o.PassiveHealthCheck
+		if o.PassiveHealthCheck.EnforcingConsecutive5xx != nil {
+			cp.PassiveHealthCheck.EnforcingConsecutive5xx = new(uint32)
+			*cp.PassiveHealthCheck.EnforcingConsecutive5xx = *o.PassiveHealthCheck.EnforcingConsecutive5xx
+		}
+		if o.PassiveHealthCheck.MaxEjectionPercent != nil {
+			cp.PassiveHealthCheck.MaxEjectionPercent = new(uint32)
+			*cp.PassiveHealthCheck.MaxEjectionPercent = *o.PassiveHealthCheck.MaxEjectionPercent
+		}
+		if o.PassiveHealthCheck.BaseEjectionTime != nil {
+			cp.PassiveHealthCheck.BaseEjectionTime = new(time.Duration)
+			*cp.PassiveHealthCheck.BaseEjectionTime = *o.PassiveHealthCheck.BaseEjectionTime
+		}
+	}
 	return &cp
 }
 
@@ -330,6 +418,204 @@ func (o *FileSystemCertificateConfigEntry) DeepCopy() *FileSystemCertificateConf
 	return &cp
 }
 
+// DeepCopy generates a deep copy of *GRPCRouteConfigEntry
+func (o *GRPCRouteConfigEntry) DeepCopy() *GRPCRouteConfigEntry {
+	var cp GRPCRouteConfigEntry = *o
+	if o.Parents != nil {
+		cp.Parents = make([]ResourceReference, len(o.Parents))
+		copy(cp.Parents, o.Parents)
+	}
+	if o.Rules != nil {
+		cp.Rules = make([]GRPCRouteRule, len(o.Rules))
+		copy(cp.Rules, o.Rules)
+		for i2 := range o.Rules {
+			if o.Rules[i2].Filters.Headers != nil {
+				cp.Rules[i2].Filters.Headers = make([]HTTPHeaderFilter, len(o.Rules[i2].Filters.Headers))
+				copy(cp.Rules[i2].Filters.Headers, o.Rules[i2].Filters.Headers)
+				for i5 := range o.Rules[i2].Filters.Headers {
+					if o.Rules[i2].Filters.Headers[i5].Add != nil {
+						cp.Rules[i2].Filters.Headers[i5].Add = make(map[string]string, len(o.Rules[i2].Filters.Headers[i5].Add))
+						for k7, v7 := range o.Rules[i2].Filters.Headers[i5].Add {
+							cp.Rules[i2].Filters.Headers[i5].Add[k7] = v7
+						}
+					}
+					if o.Rules[i2].Filters.Headers[i5].Remove != nil {
+						cp.Rules[i2].Filters.Headers[i5].Remove = make([]string, len(o.Rules[i2].Filters.Headers[i5].Remove))
+						copy(cp.Rules[i2].Filters.Headers[i5].Remove, o.Rules[i2].Filters.Headers[i5].Remove)
+					}
+					if o.Rules[i2].Filters.Headers[i5].Set != nil {
+						cp.Rules[i2].Filters.Headers[i5].Set = make(map[string]string, len(o.Rules[i2].Filters.Headers[i5].Set))
+						for k7, v7 := range o.Rules[i2].Filters.Headers[i5].Set {
+							cp.Rules[i2].Filters.Headers[i5].Set[k7] = v7
+						}
+					}
+				}
+			}
+			if o.Rules[i2].Filters.RetryFilter != nil {
+				cp.Rules[i2].Filters.RetryFilter = new(RetryFilter)
+				*cp.Rules[i2].Filters.RetryFilter = *o.Rules[i2].Filters.RetryFilter
+				if o.Rules[i2].Filters.RetryFilter.RetryOn != nil {
+					cp.Rules[i2].Filters.RetryFilter.RetryOn = make([]string, len(o.Rules[i2].Filters.RetryFilter.RetryOn))
+					copy(cp.Rules[i2].Filters.RetryFilter.RetryOn, o.Rules[i2].Filters.RetryFilter.RetryOn)
+				}
+				if o.Rules[i2].Filters.RetryFilter.RetryOnStatusCodes != nil {
+					cp.Rules[i2].Filters.RetryFilter.RetryOnStatusCodes = make([]uint32, len(o.Rules[i2].Filters.RetryFilter.RetryOnStatusCodes))
+					copy(cp.Rules[i2].Filters.RetryFilter.RetryOnStatusCodes, o.Rules[i2].Filters.RetryFilter.RetryOnStatusCodes)
+				}
+				if o.Rules[i2].Filters.RetryFilter.HedgePolicy != nil {
+					cp.Rules[i2].Filters.RetryFilter.HedgePolicy = new(HedgePolicy)
+					*cp.Rules[i2].Filters.RetryFilter.HedgePolicy = *o.Rules[i2].Filters.RetryFilter.HedgePolicy
+				}
+				if o.Rules[i2].Filters.RetryFilter.RetryBudget != nil {
+					cp.Rules[i2].Filters.RetryFilter.RetryBudget = new(RetryBudget)
+					*cp.Rules[i2].Filters.RetryFilter.RetryBudget = *o.Rules[i2].Filters.RetryFilter.RetryBudget
+					if o.Rules[i2].Filters.RetryFilter.RetryBudget.BudgetPercent != nil {
+						cp.Rules[i2].Filters.RetryFilter.RetryBudget.BudgetPercent = new(float64)
+						*cp.Rules[i2].Filters.RetryFilter.RetryBudget.BudgetPercent = *o.Rules[i2].Filters.RetryFilter.RetryBudget.BudgetPercent
+					}
+					if o.Rules[i2].Filters.RetryFilter.RetryBudget.MinRetryConcurrency != nil {
+						cp.Rules[i2].Filters.RetryFilter.RetryBudget.MinRetryConcurrency = new(int)
+						*cp.Rules[i2].Filters.RetryFilter.RetryBudget.MinRetryConcurrency = *o.Rules[i2].Filters.RetryFilter.RetryBudget.MinRetryConcurrency
+					}
+				}
+			}
+			if o.Rules[i2].Filters.TimeoutFilter != nil {
+				cp.Rules[i2].Filters.TimeoutFilter = new(TimeoutFilter)
+				*cp.Rules[i2].Filters.TimeoutFilter = *o.Rules[i2].Filters.TimeoutFilter
+			}
+			if o.Rules[i2].ResponseFilters.Headers != nil {
+				cp.Rules[i2].ResponseFilters.Headers = make([]HTTPHeaderFilter, len(o.Rules[i2].ResponseFilters.Headers))
+				copy(cp.Rules[i2].ResponseFilters.Headers, o.Rules[i2].ResponseFilters.Headers)
+				for i5 := range o.Rules[i2].ResponseFilters.Headers {
+					if o.Rules[i2].ResponseFilters.Headers[i5].Add != nil {
+						cp.Rules[i2].ResponseFilters.Headers[i5].Add = make(map[string]string, len(o.Rules[i2].ResponseFilters.Headers[i5].Add))
+						for k7, v7 := range o.Rules[i2].ResponseFilters.Headers[i5].Add {
+							cp.Rules[i2].ResponseFilters.Headers[i5].Add[k7] = v7
+						}
+					}
+					if o.Rules[i2].ResponseFilters.Headers[i5].Remove != nil {
+						cp.Rules[i2].ResponseFilters.Headers[i5].Remove = make([]string, len(o.Rules[i2].ResponseFilters.Headers[i5].Remove))
+						copy(cp.Rules[i2].ResponseFilters.Headers[i5].Remove, o.Rules[i2].ResponseFilters.Headers[i5].Remove)
+					}
+					if o.Rules[i2].ResponseFilters.Headers[i5].Set != nil {
+						cp.Rules[i2].ResponseFilters.Headers[i5].Set = make(map[string]string, len(o.Rules[i2].ResponseFilters.Headers[i5].Set))
+						for k7, v7 := range o.Rules[i2].ResponseFilters.Headers[i5].Set {
+							cp.Rules[i2].ResponseFilters.Headers[i5].Set[k7] = v7
+						}
+					}
+				}
+			}
+			if o.Rules[i2].Matches != nil {
+				cp.Rules[i2].Matches = make([]GRPCMatch, len(o.Rules[i2].Matches))
+				copy(cp.Rules[i2].Matches, o.Rules[i2].Matches)
+				for i4 := range o.Rules[i2].Matches {
+					if o.Rules[i2].Matches[i4].Headers != nil {
+						cp.Rules[i2].Matches[i4].Headers = make([]HTTPHeaderMatch, len(o.Rules[i2].Matches[i4].Headers))
+						copy(cp.Rules[i2].Matches[i4].Headers, o.Rules[i2].Matches[i4].Headers)
+					}
+				}
+			}
+			if o.Rules[i2].Services != nil {
+				cp.Rules[i2].Services = make([]GRPCService, len(o.Rules[i2].Services))
+				copy(cp.Rules[i2].Services, o.Rules[i2].Services)
+				for i4 := range o.Rules[i2].Services {
+					if o.Rules[i2].Services[i4].Filters.Headers != nil {
+						cp.Rules[i2].Services[i4].Filters.Headers = make([]HTTPHeaderFilter, len(o.Rules[i2].Services[i4].Filters.Headers))
+						copy(cp.Rules[i2].Services[i4].Filters.Headers, o.Rules[i2].Services[i4].Filters.Headers)
+						for i7 := range o.Rules[i2].Services[i4].Filters.Headers {
+							if o.Rules[i2].Services[i4].Filters.Headers[i7].Add != nil {
+								cp.Rules[i2].Services[i4].Filters.Headers[i7].Add = make(map[string]string, len(o.Rules[i2].Services[i4].Filters.Headers[i7].Add))
+								for k9, v9 := range o.Rules[i2].Services[i4].Filters.Headers[i7].Add {
+									cp.Rules[i2].Services[i4].Filters.Headers[i7].Add[k9] = v9
+								}
+							}
+							if o.Rules[i2].Services[i4].Filters.Headers[i7].Remove != nil {
+								cp.Rules[i2].Services[i4].Filters.Headers[i7].Remove = make([]string, len(o.Rules[i2].Services[i4].Filters.Headers[i7].Remove))
+								copy(cp.Rules[i2].Services[i4].Filters.Headers[i7].Remove, o.Rules[i2].Services[i4].Filters.Headers[i7].Remove)
+							}
+							if o.Rules[i2].Services[i4].Filters.Headers[i7].Set != nil {
+								cp.Rules[i2].Services[i4].Filters.Headers[i7].Set = make(map[string]string, len(o.Rules[i2].Services[i4].Filters.Headers[i7].Set))
+								for k9, v9 := range o.Rules[i2].Services[i4].Filters.Headers[i7].Set {
+									cp.Rules[i2].Services[i4].Filters.Headers[i7].Set[k9] = v9
+								}
+							}
+						}
+					}
+					if o.Rules[i2].Services[i4].Filters.RetryFilter != nil {
+						cp.Rules[i2].Services[i4].Filters.RetryFilter = new(RetryFilter)
+						*cp.Rules[i2].Services[i4].Filters.RetryFilter = *o.Rules[i2].Services[i4].Filters.RetryFilter
+						if o.Rules[i2].Services[i4].Filters.RetryFilter.RetryOn != nil {
+							cp.Rules[i2].Services[i4].Filters.RetryFilter.RetryOn = make([]string, len(o.Rules[i2].Services[i4].Filters.RetryFilter.RetryOn))
+							copy(cp.Rules[i2].Services[i4].Filters.RetryFilter.RetryOn, o.Rules[i2].Services[i4].Filters.RetryFilter.RetryOn)
+						}
+						if o.Rules[i2].Services[i4].Filters.RetryFilter.RetryOnStatusCodes != nil {
+							cp.Rules[i2].Services[i4].Filters.RetryFilter.RetryOnStatusCodes = make([]uint32, len(o.Rules[i2].Services[i4].Filters.RetryFilter.RetryOnStatusCodes))
+							copy(cp.Rules[i2].Services[i4].Filters.RetryFilter.RetryOnStatusCodes, o.Rules[i2].Services[i4].Filters.RetryFilter.RetryOnStatusCodes)
+						}
+						if o.Rules[i2].Services[i4].Filters.RetryFilter.HedgePolicy != nil {
+							cp.Rules[i2].Services[i4].Filters.RetryFilter.HedgePolicy = new(HedgePolicy)
+							*cp.Rules[i2].Services[i4].Filters.RetryFilter.HedgePolicy = *o.Rules[i2].Services[i4].Filters.RetryFilter.HedgePolicy
+						}
+						if o.Rules[i2].Services[i4].Filters.RetryFilter.RetryBudget != nil {
+							cp.Rules[i2].Services[i4].Filters.RetryFilter.RetryBudget = new(RetryBudget)
+							*cp.Rules[i2].Services[i4].Filters.RetryFilter.RetryBudget = *o.Rules[i2].Services[i4].Filters.RetryFilter.RetryBudget
+							if o.Rules[i2].Services[i4].Filters.RetryFilter.RetryBudget.BudgetPercent != nil {
+								cp.Rules[i2].Services[i4].Filters.RetryFilter.RetryBudget.BudgetPercent = new(float64)
+								*cp.Rules[i2].Services[i4].Filters.RetryFilter.RetryBudget.BudgetPercent = *o.Rules[i2].Services[i4].Filters.RetryFilter.RetryBudget.BudgetPercent
+							}
+							if o.Rules[i2].Services[i4].Filters.RetryFilter.RetryBudget.MinRetryConcurrency != nil {
+								cp.Rules[i2].Services[i4].Filters.RetryFilter.RetryBudget.MinRetryConcurrency = new(int)
+								*cp.Rules[i2].Services[i4].Filters.RetryFilter.RetryBudget.MinRetryConcurrency = *o.Rules[i2].Services[i4].Filters.RetryFilter.RetryBudget.MinRetryConcurrency
+							}
+						}
+					}
+					if o.Rules[i2].Services[i4].Filters.TimeoutFilter != nil {
+						cp.Rules[i2].Services[i4].Filters.TimeoutFilter = new(TimeoutFilter)
+						*cp.Rules[i2].Services[i4].Filters.TimeoutFilter = *o.Rules[i2].Services[i4].Filters.TimeoutFilter
+					}
+					if o.Rules[i2].Services[i4].ResponseFilters.Headers != nil {
+						cp.Rules[i2].Services[i4].ResponseFilters.Headers = make([]HTTPHeaderFilter, len(o.Rules[i2].Services[i4].ResponseFilters.Headers))
+						copy(cp.Rules[i2].Services[i4].ResponseFilters.Headers, o.Rules[i2].Services[i4].ResponseFilters.Headers)
+						for i7 := range o.Rules[i2].Services[i4].ResponseFilters.Headers {
+							if o.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Add != nil {
+								cp.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Add = make(map[string]string, len(o.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Add))
+								for k9, v9 := range o.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Add {
+									cp.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Add[k9] = v9
+								}
+							}
+							if o.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Remove != nil {
+								cp.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Remove = make([]string, len(o.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Remove))
+								copy(cp.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Remove, o.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Remove)
+							}
+							if o.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Set != nil {
+								cp.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Set = make(map[string]string, len(o.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Set))
+								for k9, v9 := range o.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Set {
+									cp.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Set[k9] = v9
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	if o.Hostnames != nil {
+		cp.Hostnames = make([]string, len(o.Hostnames))
+		copy(cp.Hostnames, o.Hostnames)
+	}
+	if o.Meta != nil {
+		cp.Meta = make(map[string]string, len(o.Meta))
+		for k2, v2 := range o.Meta {
+			cp.Meta[k2] = v2
+		}
+	}
+	{
+		retV := o.Status.DeepCopy()
+		cp.Status = *retV
+	}
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *GatewayService
 func (o *GatewayService) DeepCopy() *GatewayService {
 	var cp GatewayService = *o
@@ -420,6 +706,22 @@ func (o *HTTPRouteConfigEntry) DeepCopy() *HTTPRouteConfigEntry {
 					cp.Rules[i2].Filters.RetryFilter.RetryOnStatusCodes = make([]uint32, len(o.Rules[i2].Filters.RetryFilter.RetryOnStatusCodes))
 					copy(cp.Rules[i2].Filters.RetryFilter.RetryOnStatusCodes, o.Rules[i2].Filters.RetryFilter.RetryOnStatusCodes)
 				}
+				if o.Rules[i2].Filters.RetryFilter.HedgePolicy != nil {
+					cp.Rules[i2].Filters.RetryFilter.HedgePolicy = new(HedgePolicy)
+					*cp.Rules[i2].Filters.RetryFilter.HedgePolicy = *o.Rules[i2].Filters.RetryFilter.HedgePolicy
+				}
+				if o.Rules[i2].Filters.RetryFilter.RetryBudget != nil {
+					cp.Rules[i2].Filters.RetryFilter.RetryBudget = new(RetryBudget)
+					*cp.Rules[i2].Filters.RetryFilter.RetryBudget = *o.Rules[i2].Filters.RetryFilter.RetryBudget
+					if o.Rules[i2].Filters.RetryFilter.RetryBudget.BudgetPercent != nil {
+						cp.Rules[i2].Filters.RetryFilter.RetryBudget.BudgetPercent = new(float64)
+						*cp.Rules[i2].Filters.RetryFilter.RetryBudget.BudgetPercent = *o.Rules[i2].Filters.RetryFilter.RetryBudget.BudgetPercent
+					}
+					if o.Rules[i2].Filters.RetryFilter.RetryBudget.MinRetryConcurrency != nil {
+						cp.Rules[i2].Filters.RetryFilter.RetryBudget.MinRetryConcurrency = new(int)
+						*cp.Rules[i2].Filters.RetryFilter.RetryBudget.MinRetryConcurrency = *o.Rules[i2].Filters.RetryFilter.RetryBudget.MinRetryConcurrency
+					}
+				}
 			}
 			if o.Rules[i2].Filters.TimeoutFilter != nil {
 				cp.Rules[i2].Filters.TimeoutFilter = new(TimeoutFilter)
@@ -428,6 +730,14 @@ func (o *HTTPRouteConfigEntry) DeepCopy() *HTTPRouteConfigEntry {
 			if o.Rules[i2].Filters.JWT != nil {
 				cp.Rules[i2].Filters.JWT = o.Rules[i2].Filters.JWT.DeepCopy()
 			}
+			if o.Rules[i2].Filters.RedirectFilter != nil {
+				cp.Rules[i2].Filters.RedirectFilter = new(RedirectFilter)
+				*cp.Rules[i2].Filters.RedirectFilter = *o.Rules[i2].Filters.RedirectFilter
+			}
+			if o.Rules[i2].Filters.DirectResponseFilter != nil {
+				cp.Rules[i2].Filters.DirectResponseFilter = new(DirectResponseFilter)
+				*cp.Rules[i2].Filters.DirectResponseFilter = *o.Rules[i2].Filters.DirectResponseFilter
+			}
 			if o.Rules[i2].ResponseFilters.Headers != nil {
 				cp.Rules[i2].ResponseFilters.Headers = make([]HTTPHeaderFilter, len(o.Rules[i2].ResponseFilters.Headers))
 				copy(cp.Rules[i2].ResponseFilters.Headers, o.Rules[i2].ResponseFilters.Headers)
@@ -505,6 +815,22 @@ func (o *HTTPRouteConfigEntry) DeepCopy() *HTTPRouteConfigEntry {
 							cp.Rules[i2].Services[i4].Filters.RetryFilter.RetryOnStatusCodes = make([]uint32, len(o.Rules[i2].Services[i4].Filters.RetryFilter.RetryOnStatusCodes))
 							copy(cp.Rules[i2].Services[i4].Filters.RetryFilter.RetryOnStatusCodes, o.Rules[i2].Services[i4].Filters.RetryFilter.RetryOnStatusCodes)
 						}
+						if o.Rules[i2].Services[i4].Filters.RetryFilter.HedgePolicy != nil {
+							cp.Rules[i2].Services[i4].Filters.RetryFilter.HedgePolicy = new(HedgePolicy)
+							*cp.Rules[i2].Services[i4].Filters.RetryFilter.HedgePolicy = *o.Rules[i2].Services[i4].Filters.RetryFilter.HedgePolicy
+						}
+						if o.Rules[i2].Services[i4].Filters.RetryFilter.RetryBudget != nil {
+							cp.Rules[i2].Services[i4].Filters.RetryFilter.RetryBudget = new(RetryBudget)
+							*cp.Rules[i2].Services[i4].Filters.RetryFilter.RetryBudget = *o.Rules[i2].Services[i4].Filters.RetryFilter.RetryBudget
+							if o.Rules[i2].Services[i4].Filters.RetryFilter.RetryBudget.BudgetPercent != nil {
+								cp.Rules[i2].Services[i4].Filters.RetryFilter.RetryBudget.BudgetPercent = new(float64)
+								*cp.Rules[i2].Services[i4].Filters.RetryFilter.RetryBudget.BudgetPercent = *o.Rules[i2].Services[i4].Filters.RetryFilter.RetryBudget.BudgetPercent
+							}
+							if o.Rules[i2].Services[i4].Filters.RetryFilter.RetryBudget.MinRetryConcurrency != nil {
+								cp.Rules[i2].Services[i4].Filters.RetryFilter.RetryBudget.MinRetryConcurrency = new(int)
+								*cp.Rules[i2].Services[i4].Filters.RetryFilter.RetryBudget.MinRetryConcurrency = *o.Rules[i2].Services[i4].Filters.RetryFilter.RetryBudget.MinRetryConcurrency
+							}
+						}
 					}
 					if o.Rules[i2].Services[i4].Filters.TimeoutFilter != nil {
 						cp.Rules[i2].Services[i4].Filters.TimeoutFilter = new(TimeoutFilter)
@@ -513,6 +839,14 @@ func (o *HTTPRouteConfigEntry) DeepCopy() *HTTPRouteConfigEntry {
 					if o.Rules[i2].Services[i4].Filters.JWT != nil {
 						cp.Rules[i2].Services[i4].Filters.JWT = o.Rules[i2].Services[i4].Filters.JWT.DeepCopy()
 					}
+					if o.Rules[i2].Services[i4].Filters.RedirectFilter != nil {
+						cp.Rules[i2].Services[i4].Filters.RedirectFilter = new(RedirectFilter)
+						*cp.Rules[i2].Services[i4].Filters.RedirectFilter = *o.Rules[i2].Services[i4].Filters.RedirectFilter
+					}
+					if o.Rules[i2].Services[i4].Filters.DirectResponseFilter != nil {
+						cp.Rules[i2].Services[i4].Filters.DirectResponseFilter = new(DirectResponseFilter)
+						*cp.Rules[i2].Services[i4].Filters.DirectResponseFilter = *o.Rules[i2].Services[i4].Filters.DirectResponseFilter
+					}
 					if o.Rules[i2].Services[i4].ResponseFilters.Headers != nil {
 						cp.Rules[i2].Services[i4].ResponseFilters.Headers = make([]HTTPHeaderFilter, len(o.Rules[i2].Services[i4].ResponseFilters.Headers))
 						copy(cp.Rules[i2].Services[i4].ResponseFilters.Headers, o.Rules[i2].Services[i4].ResponseFilters.Headers)
@@ -535,6 +869,14 @@ func (o *HTTPRouteConfigEntry) DeepCopy() *HTTPRouteConfigEntry {
 							}
 						}
 					}
+					if o.Rules[i2].Services[i4].SessionAffinity != nil {
+						cp.Rules[i2].Services[i4].SessionAffinity = new(SessionAffinity)
+						*cp.Rules[i2].Services[i4].SessionAffinity = *o.Rules[i2].Services[i4].SessionAffinity
+						if o.Rules[i2].Services[i4].SessionAffinity.CookieConfig != nil {
+							cp.Rules[i2].Services[i4].SessionAffinity.CookieConfig = new(SessionAffinityCookieConfig)
+							*cp.Rules[i2].Services[i4].SessionAffinity.CookieConfig = *o.Rules[i2].Services[i4].SessionAffinity.CookieConfig
+						}
+					}
 				}
 			}
 		}
@@ -603,6 +945,20 @@ func (o *IndexedCARoots) DeepCopy() *IndexedCARoots {
 			}
 		}
 	}
+	if o.Revocations != nil {
+		cp.Revocations = make([]*CARevocation, len(o.Revocations))
+		copy(cp.Revocations, o.Revocations)
+		for i2 := range o.Revocations {
+			if o.Revocations[i2] != nil {
+				cp.Revocations[i2] = new(CARevocation)
+				*cp.Revocations[i2] = *o.Revocations[i2]
+			}
+		}
+	}
+	if o.RevocationLists != nil {
+		cp.RevocationLists = make([]string, len(o.RevocationLists))
+		copy(cp.RevocationLists, o.RevocationLists)
+	}
 	return &cp
 }
 
@@ -797,6 +1153,14 @@ func (o *LoadBalancer) DeepCopy() *LoadBalancer {
 			}
 		}
 	}
+	if o.SessionAffinity != nil {
+		cp.SessionAffinity = new(SessionAffinity)
+		*cp.SessionAffinity = *o.SessionAffinity
+		if o.SessionAffinity.CookieConfig != nil {
+			cp.SessionAffinity.CookieConfig = new(SessionAffinityCookieConfig)
+			*cp.SessionAffinity.CookieConfig = *o.SessionAffinity.CookieConfig
+		}
+	}
 	return &cp
 }
 
@@ -1112,6 +1476,50 @@ func (o *ServiceResolverConfigEntry) DeepCopy() *ServiceResolverConfigEntry {
 	if o.LoadBalancer != nil {
 		cp.LoadBalancer = o.LoadBalancer.DeepCopy()
 	}
+	if o.Limits != nil {
+		cp.Limits = new(UpstreamLimits)
+		*cp.Limits = *o.Limits
+		if o.Limits.MaxConnections != nil {
+			cp.Limits.MaxConnections = new(int)
+			*cp.Limits.MaxConnections = *o.Limits.MaxConnections
+		}
+		if o.Limits.MaxPendingRequests != nil {
+			cp.Limits.MaxPendingRequests = new(int)
+			*cp.Limits.MaxPendingRequests = *o.Limits.MaxPendingRequests
+		}
+		if o.Limits.MaxConcurrentRequests != nil {
+			cp.Limits.MaxConcurrentRequests = new(int)
+			*cp.Limits.MaxConcurrentRequests = *o.Limits.MaxConcurrentRequests
+		}
+		if o.Limits.RetryBudget != nil {
+			cp.Limits.RetryBudget = new(RetryBudget)
+			*cp.Limits.RetryBudget = *o.Limits.RetryBudget
+			if o.Limits.RetryBudget.BudgetPercent != nil {
+				cp.Limits.RetryBudget.BudgetPercent = new(float64)
+				*cp.Limits.RetryBudget.BudgetPercent = *o.Limits.RetryBudget.BudgetPercent
+			}
+			if o.Limits.RetryBudget.MinRetryConcurrency != nil {
+				cp.Limits.RetryBudget.MinRetryConcurrency = new(int)
+				*cp.Limits.RetryBudget.MinRetryConcurrency = *o.Limits.RetryBudget.MinRetryConcurrency
+			}
+		}
+	}
+	if o.PassiveHealthCheck != nil {
+		cp.PassiveHealthCheck = new(PassiveHealthCheck)
+		*cp.PassiveHealthCheck = *o.PassiveHealthCheck
+		if o.PassiveHealthCheck.EnforcingConsecutive5xx != nil {
+			cp.PassiveHealthCheck.EnforcingConsecutive5xx = new(uint32)
+			*cp.PassiveHealthCheck.EnforcingConsecutive5xx = *o.PassiveHealthCheck.EnforcingConsecutive5xx
+		}
+		if o.PassiveHealthCheck.MaxEjectionPercent != nil {
+			cp.PassiveHealthCheck.MaxEjectionPercent = new(uint32)
+			*cp.PassiveHealthCheck.MaxEjectionPercent = *o.PassiveHealthCheck.MaxEjectionPercent
+		}
+		if o.PassiveHealthCheck.BaseEjectionTime != nil {
+			cp.PassiveHealthCheck.BaseEjectionTime = new(time.Duration)
+			*cp.PassiveHealthCheck.BaseEjectionTime = *o.PassiveHealthCheck.BaseEjectionTime
+		}
+	}
 	if o.Meta != nil {
 		cp.Meta = make(map[string]string, len(o.Meta))
 		for k2, v2 := range o.Meta {
@@ -1152,6 +1560,30 @@ func (o *ServiceRoute) DeepCopy() *ServiceRoute {
 	if o.Destination != nil {
 		cp.Destination = o.Destination.DeepCopy()
 	}
+	if o.Fault != nil {
+		cp.Fault = new(ServiceRouteFault)
+		*cp.Fault = *o.Fault
+		if o.Fault.Delay != nil {
+			cp.Fault.Delay = new(ServiceRouteFaultDelay)
+			*cp.Fault.Delay = *o.Fault.Delay
+		}
+		if o.Fault.Abort != nil {
+			cp.Fault.Abort = new(ServiceRouteFaultAbort)
+			*cp.Fault.Abort = *o.Fault.Abort
+		}
+		if o.Fault.Header != nil {
+			cp.Fault.Header = make([]ServiceRouteHTTPMatchHeader, len(o.Fault.Header))
+			copy(cp.Fault.Header, o.Fault.Header)
+		}
+	}
+	if o.Redirect != nil {
+		cp.Redirect = new(ServiceRouteRedirect)
+		*cp.Redirect = *o.Redirect
+	}
+	if o.DirectResponse != nil {
+		cp.DirectResponse = new(ServiceRouteDirectResponse)
+		*cp.DirectResponse = *o.DirectResponse
+	}
 	return &cp
 }
 
@@ -1166,12 +1598,44 @@ func (o *ServiceRouteDestination) DeepCopy() *ServiceRouteDestination {
 		cp.RetryOnStatusCodes = make([]uint32, len(o.RetryOnStatusCodes))
 		copy(cp.RetryOnStatusCodes, o.RetryOnStatusCodes)
 	}
+	if o.HedgePolicy != nil {
+		cp.HedgePolicy = new(HedgePolicy)
+		*cp.HedgePolicy = *o.HedgePolicy
+	}
+	if o.RetryBudget != nil {
+		cp.RetryBudget = new(RetryBudget)
+		*cp.RetryBudget = *o.RetryBudget
+		if o.RetryBudget.BudgetPercent != nil {
+			cp.RetryBudget.BudgetPercent = new(float64)
+			*cp.RetryBudget.BudgetPercent = *o.RetryBudget.BudgetPercent
+		}
+		if o.RetryBudget.MinRetryConcurrency != nil {
+			cp.RetryBudget.MinRetryConcurrency = new(int)
+			*cp.RetryBudget.MinRetryConcurrency = *o.RetryBudget.MinRetryConcurrency
+		}
+	}
+	if o.SessionAffinity != nil {
+		cp.SessionAffinity = new(SessionAffinity)
+		*cp.SessionAffinity = *o.SessionAffinity
+		if o.SessionAffinity.CookieConfig != nil {
+			cp.SessionAffinity.CookieConfig = new(SessionAffinityCookieConfig)
+			*cp.SessionAffinity.CookieConfig = *o.SessionAffinity.CookieConfig
+		}
+	}
 	if o.RequestHeaders != nil {
 		cp.RequestHeaders = o.RequestHeaders.DeepCopy()
 	}
 	if o.ResponseHeaders != nil {
 		cp.ResponseHeaders = o.ResponseHeaders.DeepCopy()
 	}
+	if o.Mirror != nil {
+		cp.Mirror = new(ServiceRouteMirror)
+		*cp.Mirror = *o.Mirror
+		if o.Mirror.Percent != nil {
+			cp.Mirror.Percent = new(float32)
+			*cp.Mirror.Percent = *o.Mirror.Percent
+		}
+	}
 	return &cp
 }
 
@@ -1221,6 +1685,58 @@ func (o *TCPRouteConfigEntry) DeepCopy() *TCPRouteConfigEntry {
 	return &cp
 }
 
+// DeepCopy generates a deep copy of *TLSRouteConfigEntry
+func (o *TLSRouteConfigEntry) DeepCopy() *TLSRouteConfigEntry {
+	var cp TLSRouteConfigEntry = *o
+	if o.Parents != nil {
+		cp.Parents = make([]ResourceReference, len(o.Parents))
+		copy(cp.Parents, o.Parents)
+	}
+	if o.Hostnames != nil {
+		cp.Hostnames = make([]string, len(o.Hostnames))
+		copy(cp.Hostnames, o.Hostnames)
+	}
+	if o.Services != nil {
+		cp.Services = make([]TCPService, len(o.Services))
+		copy(cp.Services, o.Services)
+	}
+	if o.Meta != nil {
+		cp.Meta = make(map[string]string, len(o.Meta))
+		for k2, v2 := range o.Meta {
+			cp.Meta[k2] = v2
+		}
+	}
+	{
+		retV := o.Status.DeepCopy()
+		cp.Status = *retV
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *TrustBundleConfigEntry
+func (o *TrustBundleConfigEntry) DeepCopy() *TrustBundleConfigEntry {
+	var cp TrustBundleConfigEntry = *o
+	if o.RootPEMs != nil {
+		cp.RootPEMs = make([]string, len(o.RootPEMs))
+		copy(cp.RootPEMs, o.RootPEMs)
+	}
+	if o.LastRefresh != nil {
+		cp.LastRefresh = new(time.Time)
+		*cp.LastRefresh = *o.LastRefresh
+	}
+	if o.Meta != nil {
+		cp.Meta = make(map[string]string, len(o.Meta))
+		for k2, v2 := range o.Meta {
+			cp.Meta[k2] = v2
+		}
+	}
+	{
+		retV := o.Status.DeepCopy()
+		cp.Status = *retV
+	}
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *Upstream
 func (o *Upstream) DeepCopy() *Upstream {
 	var cp Upstream = *o
@@ -1262,6 +1778,18 @@ func (o *UpstreamConfiguration) DeepCopy() *UpstreamConfiguration {
 						cp.Overrides[i2].Limits.MaxConcurrentRequests = new(int)
 						*cp.Overrides[i2].Limits.MaxConcurrentRequests = *o.Overrides[i2].Limits.MaxConcurrentRequests
 					}
+					if o.Overrides[i2].Limits.RetryBudget != nil {
+						cp.Overrides[i2].Limits.RetryBudget = new(RetryBudget)
+						*cp.Overrides[i2].Limits.RetryBudget = *o.Overrides[i2].Limits.RetryBudget
+						if o.Overrides[i2].Limits.RetryBudget.BudgetPercent != nil {
+							cp.Overrides[i2].Limits.RetryBudget.BudgetPercent = new(float64)
+							*cp.Overrides[i2].Limits.RetryBudget.BudgetPercent = *o.Overrides[i2].Limits.RetryBudget.BudgetPercent
+						}
+						if o.Overrides[i2].Limits.RetryBudget.MinRetryConcurrency != nil {
+							cp.Overrides[i2].Limits.RetryBudget.MinRetryConcurrency = new(int)
+							*cp.Overrides[i2].Limits.RetryBudget.MinRetryConcurrency = *o.Overrides[i2].Limits.RetryBudget.MinRetryConcurrency
+						}
+					}
 				}
 				if o.Overrides[i2].PassiveHealthCheck != nil {
 					cp.Overrides[i2].PassiveHealthCheck = new(PassiveHealthCheck)
@@ -1300,6 +1828,18 @@ func (o *UpstreamConfiguration) DeepCopy() *UpstreamConfiguration {
 				cp.Defaults.Limits.MaxConcurrentRequests = new(int)
 				*cp.Defaults.Limits.MaxConcurrentRequests = *o.Defaults.Limits.MaxConcurrentRequests
 			}
+			if o.Defaults.Limits.RetryBudget != nil {
+				cp.Defaults.Limits.RetryBudget = new(RetryBudget)
+				*cp.Defaults.Limits.RetryBudget = *o.Defaults.Limits.RetryBudget
+				if o.Defaults.Limits.RetryBudget.BudgetPercent != nil {
+					cp.Defaults.Limits.RetryBudget.BudgetPercent = new(float64)
+					*cp.Defaults.Limits.RetryBudget.BudgetPercent = *o.Defaults.Limits.RetryBudget.BudgetPercent
+				}
+				if o.Defaults.Limits.RetryBudget.MinRetryConcurrency != nil {
+					cp.Defaults.Limits.RetryBudget.MinRetryConcurrency = new(int)
+					*cp.Defaults.Limits.RetryBudget.MinRetryConcurrency = *o.Defaults.Limits.RetryBudget.MinRetryConcurrency
+				}
+			}
 		}
 		if o.Defaults.PassiveHealthCheck != nil {
 			cp.Defaults.PassiveHealthCheck = new(PassiveHealthCheck)
@@ -90,6 +90,7 @@ const (
 	RaftLogVerifierCheckpoint                   = 41 // Only used for log verifier, no-op on FSM.
 	ResourceOperationType                       = 42
 	UpdateVirtualIPRequestType                  = 43
+	ConnectCARevocationRequestType              = 44
 )
 
 const (
@@ -159,6 +160,7 @@ var requestTypeStrings = map[MessageType]string{
 	RaftLogVerifierCheckpoint:       "RaftLogVerifierCheckpoint",
 	ResourceOperationType:           "Resource",
 	UpdateVirtualIPRequestType:      "UpdateManualVirtualIPRequestType",
+	ConnectCARevocationRequestType:  "ConnectCARevocation",
 }
 
 const (
@@ -197,6 +199,16 @@ const (
 	// MetaExternalSource is the metadata key used when a resource is managed by a source outside Consul like nomad/k8s
 	MetaExternalSource = "external-source"
 
+	// MetaWorkloadUIDKey is the service metadata key holding the UID of the
+	// processes that may fetch the service's SVIDs from the SPIFFE Workload API.
+	MetaWorkloadUIDKey = "spiffe-workload-uid"
+
+	// MetaWorkloadPIDKey is the service metadata key holding the PID of the
+	// process that may fetch the service's SVIDs from the SPIFFE Workload API.
+	// It is only honored together with MetaWorkloadUIDKey because PIDs are
+	// reused.
+	MetaWorkloadPIDKey = "spiffe-workload-pid"
+
 	// TaggedAddressVirtualIP is the key used to store tagged virtual IPs generated by Consul.
 	TaggedAddressVirtualIP = "consul-virtual"
 
@@ -282,6 +294,12 @@ type QueryOptions struct {
 	// servicing the request. Prevents a stale read.
 	RequireConsistent bool `mapstructure:"require-consistent,omitempty"`
 
+	// If set, any follower can service the request once it has applied the
+	// state of the leader at the time of the request, which the leader reads
+	// after verifying leadership. Prevents a stale read without sending the
+	// request to the leader.
+	FollowerConsistent bool `mapstructure:"follower-consistent,omitempty"`
+
 	// If set, the local agent may respond with an arbitrarily stale locally
 	// cached response. The semantics differ from AllowStale since the agent may
 	// be entirely partitioned from the servers and still considered "healthy" by
@@ -338,6 +356,8 @@ func (q QueryOptions) IsRead() bool {
 func (q QueryOptions) ConsistencyLevel() string {
 	if q.RequireConsistent {
 		return "consistent"
+	} else if q.FollowerConsistent {
+		return "follower-consistent"
 	} else if q.AllowStale {
 		return "stale"
 	} else {
@@ -345,8 +365,10 @@ func (q QueryOptions) ConsistencyLevel() string {
 	}
 }
 
+// AllowStaleRead is true when a follower may service the request. Followers
+// service follower-consistent requests after catching up with the leader.
 func (q QueryOptions) AllowStaleRead() bool {
-	return q.AllowStale
+	return q.AllowStale || q.FollowerConsistent
 }
 
 func (q QueryOptions) TokenSecret() string {
@@ -380,6 +402,15 @@ func (q QueryOptions) HasTimedOut(start time.Time, rpcHoldTimeout, maxQueryTime,
 	return time.Since(start) > rpcHoldTimeout+q.BlockingTimeout(maxQueryTime, defaultQueryTime), nil
 }
 
+// ReadIndexResponse is returned by the leader to a follower that services a
+// follower-consistent read.
+type ReadIndexResponse struct {
+	// Index is the latest index of the state store of the leader, read before
+	// it verified its leadership. The follower services the read once its own
+	// state store reaches this index.
+	Index uint64
+}
+
 type WriteRequest struct {
 	// Token is the ACL token ID. If not provided, the 'anonymous'
 	// token is assumed for backwards compatibility.
@@ -2161,6 +2192,40 @@ func (nodes CheckServiceNodes) ShallowClone() CheckServiceNodes {
 	return dup
 }
 
+// GroupByLocality groups the nodes by how close they are to the given
+// locality: nodes in the same zone come first, followed by the nodes in the
+// same region and then all remaining nodes. Empty groups are omitted. If the
+// locality has no region the nodes are returned as a single group.
+func (nodes CheckServiceNodes) GroupByLocality(locality *Locality) []CheckServiceNodes {
+	if locality == nil || locality.Region == "" {
+		return []CheckServiceNodes{nodes}
+	}
+
+	var sameZone, sameRegion, remaining CheckServiceNodes
+	for _, csn := range nodes {
+		nodeLocality := csn.Locality()
+		switch {
+		case nodeLocality.GetRegion() != locality.Region:
+			remaining = append(remaining, csn)
+		case locality.Zone != "" && nodeLocality.Zone == locality.Zone:
+			sameZone = append(sameZone, csn)
+		default:
+			sameRegion = append(sameRegion, csn)
+		}
+	}
+
+	var groups []CheckServiceNodes
+	for _, group := range []CheckServiceNodes{sameZone, sameRegion, remaining} {
+		if len(group) > 0 {
+			groups = append(groups, group)
+		}
+	}
+	if len(groups) == 0 {
+		return []CheckServiceNodes{nodes}
+	}
+	return groups
+}
+
 // Filter removes nodes that are failing health checks (and any non-passing
 // check if that option is selected). Note that this returns the filtered
 // results AND modifies the receiver for performance.
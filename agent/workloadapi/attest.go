@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package workloadapi
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sort"
+	"strconv"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// Caller describes the process on the other end of a Workload API
+// connection, as reported by the kernel.
+type Caller struct {
+	PID int32
+	UID uint32
+	GID uint32
+
+	// StartTime is the start time of the process when it connected, which
+	// tells it apart from a later process that is given the same PID.
+	StartTime uint64
+}
+
+// AuthType implements credentials.AuthInfo.
+func (Caller) AuthType() string {
+	return "peercred"
+}
+
+// attestedService is a local service that a caller has been attested as,
+// together with the token it was registered with.
+type attestedService struct {
+	Service *structs.NodeService
+	Token   string
+}
+
+// attest returns the local services matching the selectors of the given
+// caller, sorted by service ID so that the default SVID is stable.
+//
+// A service matches when it has at least one selector in its meta and all of
+// the selectors it has match the caller. PIDs are reused once a process exits,
+// so the PID selector only narrows down the UID selector and never stands on
+// its own, and it only matches while the process that connected is running.
+func attest(state LocalState, caller Caller) []attestedService {
+	var matches []attestedService
+	for id, svc := range state.AllServices() {
+		if svc.Kind != structs.ServiceKindTypical {
+			continue
+		}
+		if !selectorsMatch(svc.Meta, caller) {
+			continue
+		}
+		matches = append(matches, attestedService{
+			Service: svc,
+			Token:   state.ServiceToken(id),
+		})
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].Service.CompoundServiceID().String() < matches[j].Service.CompoundServiceID().String()
+	})
+	return matches
+}
+
+func selectorsMatch(meta map[string]string, caller Caller) bool {
+	var found bool
+	if v, ok := meta[structs.MetaWorkloadUIDKey]; ok {
+		uid, err := strconv.ParseUint(v, 10, 32)
+		if err != nil || uint32(uid) != caller.UID {
+			return false
+		}
+		found = true
+	}
+	if v, ok := meta[structs.MetaWorkloadPIDKey]; ok {
+		if !found {
+			return false
+		}
+		pid, err := strconv.ParseInt(v, 10, 32)
+		if err != nil || int32(pid) != caller.PID || !caller.running() {
+			return false
+		}
+	}
+	return found
+}
+
+// running reports whether the process that connected is still running, rather
+// than having exited and its PID given to another process.
+func (c Caller) running() bool {
+	startTime, err := processStartTime(c.PID)
+	return err == nil && startTime == c.StartTime
+}
+
+// callerFromContext returns the attested caller of the RPC in the given
+// context.
+func callerFromContext(ctx context.Context) (Caller, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return Caller{}, errors.New("missing peer information")
+	}
+	caller, ok := p.AuthInfo.(Caller)
+	if !ok {
+		return Caller{}, errors.New("missing peer credentials")
+	}
+	return caller, nil
+}
+
+// Credentials returns gRPC transport credentials that record the peer
+// credentials of each unix socket connection so the server can attest
+// callers. They provide no transport security; the socket's file permissions
+// control who can connect.
+func Credentials() credentials.TransportCredentials {
+	return peerCredentialsTransport{}
+}
+
+type peerCredentialsTransport struct{}
+
+func (peerCredentialsTransport) ClientHandshake(_ context.Context, _ string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return nil, nil, errors.New("peer credentials are only supported by the server")
+}
+
+func (peerCredentialsTransport) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	caller, err := peerCredentials(conn)
+	if err != nil {
+		return nil, nil, err
+	}
+	return conn, caller, nil
+}
+
+func (peerCredentialsTransport) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: "peercred"}
+}
+
+func (t peerCredentialsTransport) Clone() credentials.TransportCredentials {
+	return t
+}
+
+func (peerCredentialsTransport) OverrideServerName(string) error {
+	return nil
+}
@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !linux
+
+package workloadapi
+
+import (
+	"errors"
+	"net"
+)
+
+func peerCredentials(net.Conn) (Caller, error) {
+	return Caller{}, errors.New("workload attestation is only supported on linux")
+}
+
+func processStartTime(int32) (uint64, error) {
+	return 0, errors.New("workload attestation is only supported on linux")
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux
+
+package workloadapi
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+// peerCredentials returns the credentials of the process on the other end of
+// the given unix socket connection, as recorded by the kernel when the
+// connection was established.
+func peerCredentials(conn net.Conn) (Caller, error) {
+	uc, ok := conn.(*net.UnixConn)
+	if !ok {
+		return Caller{}, fmt.Errorf("connection is not a unix socket connection: %T", conn)
+	}
+
+	raw, err := uc.SyscallConn()
+	if err != nil {
+		return Caller{}, err
+	}
+
+	var (
+		cred    *unix.Ucred
+		credErr error
+	)
+	err = raw.Control(func(fd uintptr) {
+		cred, credErr = unix.GetsockoptUcred(int(fd), unix.SOL_SOCKET, unix.SO_PEERCRED)
+	})
+	if err != nil {
+		return Caller{}, err
+	}
+	if credErr != nil {
+		return Caller{}, fmt.Errorf("failed to read peer credentials: %w", credErr)
+	}
+
+	startTime, err := processStartTime(cred.Pid)
+	if err != nil {
+		return Caller{}, err
+	}
+
+	return Caller{PID: cred.Pid, UID: cred.Uid, GID: cred.Gid, StartTime: startTime}, nil
+}
+
+// processStartTime returns the time the process with the given PID started,
+// in clock ticks after boot. Together with the PID it identifies a process,
+// because the kernel reuses PIDs but not start times.
+func processStartTime(pid int32) (uint64, error) {
+	stat, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return 0, fmt.Errorf("failed to read the status of process %d: %w", pid, err)
+	}
+
+	// The command name in the second field may contain spaces and
+	// parentheses, so the remaining fields start after the last ")".
+	i := strings.LastIndexByte(string(stat), ')')
+	if i < 0 {
+		return 0, fmt.Errorf("malformed status of process %d", pid)
+	}
+	// The start time is the 22nd field, and the fields after the command
+	// name start with the 3rd.
+	fields := strings.Fields(string(stat[i+1:]))
+	if len(fields) < 20 {
+		return 0, fmt.Errorf("malformed status of process %d", pid)
+	}
+	return strconv.ParseUint(fields[19], 10, 64)
+}
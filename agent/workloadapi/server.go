@@ -0,0 +1,484 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package workloadapi implements the SPIFFE Workload API on the client agent.
+// Local workloads connect over a unix socket, are attested by their peer
+// credentials against the services registered with the agent, and receive
+// X.509-SVIDs, JWT-SVIDs and trust bundles that are rotated automatically.
+package workloadapi
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/agent/leafcert"
+	"github.com/hashicorp/consul/agent/proxycfg"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/proto-public/pbspiffeworkload"
+)
+
+const (
+	// securityHeaderKey is the metadata key SPIFFE clients set on every
+	// request to guard against server-side request forgery.
+	securityHeaderKey = "workload.spiffe.io"
+
+	rootsWatchID      = "roots"
+	leafWatchIDPrefix = "leaf:"
+)
+
+type Config struct {
+	Logger hclog.Logger
+
+	// Datacenter of the agent, used to fetch roots and leaf certificates.
+	Datacenter string
+
+	// LocalState is used to attest callers against the services registered
+	// with the agent.
+	LocalState LocalState
+
+	CARoots         proxycfg.CARoots
+	LeafCertificate proxycfg.LeafCertificate
+
+	// SignJWTSVID mints a JWT-SVID for a local service.
+	SignJWTSVID func(ctx context.Context, req *structs.JWTSVIDSignRequest) (*structs.IssuedJWTSVID, error)
+}
+
+// LocalState is the subset of the agent's local state used by the server.
+type LocalState interface {
+	AllServices() map[structs.ServiceID]*structs.NodeService
+	ServiceToken(id structs.ServiceID) string
+	Notify(ch chan<- struct{})
+	StopNotify(ch chan<- struct{})
+}
+
+type Server struct {
+	Config
+}
+
+func NewServer(cfg Config) *Server {
+	return &Server{cfg}
+}
+
+var _ pbspiffeworkload.SpiffeWorkloadAPIServer = (*Server)(nil)
+
+func (s *Server) Register(registrar grpc.ServiceRegistrar) {
+	pbspiffeworkload.RegisterSpiffeWorkloadAPIServer(registrar, s)
+}
+
+// FetchX509SVID streams the X.509-SVIDs of every local service the caller is
+// attested as. A new response is sent whenever a certificate or the roots
+// change, and the stream is closed when the caller no longer matches any
+// service.
+func (s *Server) FetchX509SVID(_ *pbspiffeworkload.X509SVIDRequest, stream pbspiffeworkload.SpiffeWorkloadAPI_FetchX509SVIDServer) error {
+	return s.watch(stream.Context(), true, func(w *watchState) error {
+		rsp, err := x509SVIDResponse(w)
+		if err != nil || rsp == nil {
+			return err
+		}
+		return stream.Send(rsp)
+	})
+}
+
+// FetchX509Bundles streams the trust bundle of the cluster to the caller.
+func (s *Server) FetchX509Bundles(_ *pbspiffeworkload.X509BundlesRequest, stream pbspiffeworkload.SpiffeWorkloadAPI_FetchX509BundlesServer) error {
+	return s.watch(stream.Context(), false, func(w *watchState) error {
+		if w.roots == nil {
+			return nil
+		}
+		bundle, err := rootsBundle(w.roots)
+		if err != nil {
+			return err
+		}
+		crls, err := revocationLists(w.roots)
+		if err != nil {
+			return err
+		}
+		return stream.Send(&pbspiffeworkload.X509BundlesResponse{
+			Crl:     crls,
+			Bundles: map[string][]byte{trustDomainID(w.roots): bundle},
+		})
+	})
+}
+
+// FetchJWTSVID returns a JWT-SVID with the requested audience for every local
+// service the caller is attested as, or only for the requested SPIFFE ID.
+func (s *Server) FetchJWTSVID(ctx context.Context, req *pbspiffeworkload.JWTSVIDRequest) (*pbspiffeworkload.JWTSVIDResponse, error) {
+	caller, err := authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Audience) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "audience must be specified")
+	}
+
+	matches := attest(s.LocalState, caller)
+	if len(matches) == 0 {
+		return nil, status.Error(codes.PermissionDenied, "no identity issued")
+	}
+
+	rsp := &pbspiffeworkload.JWTSVIDResponse{}
+	for _, m := range matches {
+		jwtSVID, err := s.SignJWTSVID(ctx, &structs.JWTSVIDSignRequest{
+			Datacenter:     s.Datacenter,
+			Service:        m.Service.Service,
+			EnterpriseMeta: m.Service.EnterpriseMeta,
+			Audience:       req.Audience,
+			WriteRequest:   structs.WriteRequest{Token: m.Token},
+		})
+		if err != nil {
+			if acl.IsErrPermissionDenied(err) {
+				return nil, status.Error(codes.PermissionDenied, err.Error())
+			}
+			return nil, status.Errorf(codes.Internal, "failed to sign JWT-SVID: %v", err)
+		}
+		if req.SpiffeId != "" && req.SpiffeId != jwtSVID.SpiffeID {
+			continue
+		}
+		rsp.Svids = append(rsp.Svids, &pbspiffeworkload.JWTSVID{
+			SpiffeId: jwtSVID.SpiffeID,
+			Svid:     jwtSVID.Token,
+			Hint:     m.Service.ID,
+		})
+	}
+	if len(rsp.Svids) == 0 {
+		return nil, status.Error(codes.PermissionDenied, "no identity issued")
+	}
+	return rsp, nil
+}
+
+// FetchJWTBundles streams the JWT bundle of the cluster to the caller. The
+// bundle contains the JWT signing keys of all the CA roots, so it is updated
+// whenever the CA is rotated.
+func (s *Server) FetchJWTBundles(_ *pbspiffeworkload.JWTBundlesRequest, stream pbspiffeworkload.SpiffeWorkloadAPI_FetchJWTBundlesServer) error {
+	return s.watch(stream.Context(), false, func(w *watchState) error {
+		if w.roots == nil {
+			return nil
+		}
+		bundle, err := jwtBundle(w.roots)
+		if err != nil {
+			return err
+		}
+		return stream.Send(&pbspiffeworkload.JWTBundlesResponse{
+			Bundles: map[string][]byte{trustDomainID(w.roots): bundle},
+		})
+	})
+}
+
+// ValidateJWTSVID validates a JWT-SVID against the JWT bundle of the cluster
+// on behalf of the caller.
+func (s *Server) ValidateJWTSVID(ctx context.Context, req *pbspiffeworkload.ValidateJWTSVIDRequest) (*pbspiffeworkload.ValidateJWTSVIDResponse, error) {
+	if _, err := authorize(ctx); err != nil {
+		return nil, err
+	}
+	if req.Audience == "" {
+		return nil, status.Error(codes.InvalidArgument, "audience must be specified")
+	}
+	if req.Svid == "" {
+		return nil, status.Error(codes.InvalidArgument, "svid must be specified")
+	}
+
+	var roots *structs.IndexedCARoots
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	err := s.watch(watchCtx, false, func(w *watchState) error {
+		if w.roots == nil {
+			return nil
+		}
+		roots = w.roots
+		cancel()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if roots == nil {
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+
+	spiffeID, claims, err := connect.ValidateJWTSVID(req.Svid, req.Audience, roots.Roots, time.Now())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	claimsPB, err := structpb.NewStruct(claims)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode claims: %v", err)
+	}
+	return &pbspiffeworkload.ValidateJWTSVIDResponse{
+		SpiffeId: spiffeID,
+		Claims:   claimsPB,
+	}, nil
+}
+
+// authorize checks the SPIFFE security header and returns the caller of the
+// RPC in the given context.
+func authorize(ctx context.Context) (Caller, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if v := md.Get(securityHeaderKey); len(v) != 1 || v[0] != "true" {
+		return Caller{}, status.Error(codes.InvalidArgument, "security header missing from request")
+	}
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return Caller{}, status.Error(codes.PermissionDenied, err.Error())
+	}
+	return caller, nil
+}
+
+// watchState holds the latest data for the services a caller is attested as.
+type watchState struct {
+	services []attestedService
+	roots    *structs.IndexedCARoots
+	leaves   map[string]*structs.IssuedCert
+}
+
+// watch attests the caller of the RPC in ctx and watches the CA roots, and
+// optionally the leaf certificates of the attested services, calling send
+// after every update until the caller disconnects. Callers are re-attested
+// whenever the local state changes.
+func (s *Server) watch(ctx context.Context, withLeaves bool, send func(*watchState) error) error {
+	caller, err := authorize(ctx)
+	if err != nil {
+		return err
+	}
+	logger := s.Logger.With("pid", caller.PID, "uid", caller.UID)
+
+	stateCh := make(chan struct{}, 1)
+	s.LocalState.Notify(stateCh)
+	defer s.LocalState.StopNotify(stateCh)
+
+	var (
+		state         watchState
+		updateCh      chan proxycfg.UpdateEvent
+		cancelWatches = func() {}
+	)
+	defer func() { cancelWatches() }()
+
+	// reattest (re)starts the watches when the set of services the caller is
+	// attested as changes. Each generation of watches gets its own channel
+	// so that updates from cancelled watches are never observed.
+	reattest := func() error {
+		matches := attest(s.LocalState, caller)
+		if len(matches) == 0 {
+			return status.Error(codes.PermissionDenied, "no identity issued")
+		}
+		if updateCh != nil && sameServices(state.services, matches) {
+			return nil
+		}
+		logger.Debug("attested workload", "services", len(matches))
+
+		cancelWatches()
+		watchCtx, cancel := context.WithCancel(ctx)
+		cancelWatches = cancel
+		updateCh = make(chan proxycfg.UpdateEvent, 1)
+		state = watchState{
+			services: matches,
+			leaves:   make(map[string]*structs.IssuedCert),
+		}
+
+		err := s.CARoots.Notify(watchCtx, &structs.DCSpecificRequest{
+			Datacenter:   s.Datacenter,
+			QueryOptions: structs.QueryOptions{Token: matches[0].Token},
+		}, rootsWatchID, updateCh)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to watch CA roots: %v", err)
+		}
+		if !withLeaves {
+			return nil
+		}
+		for _, m := range matches {
+			err := s.LeafCertificate.Notify(watchCtx, &leafcert.ConnectCALeafRequest{
+				Datacenter:     s.Datacenter,
+				Token:          m.Token,
+				Service:        m.Service.Service,
+				EnterpriseMeta: m.Service.EnterpriseMeta,
+			}, leafWatchIDPrefix+m.Service.CompoundServiceID().String(), updateCh)
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to watch leaf certificate: %v", err)
+			}
+		}
+		return nil
+	}
+	if err := reattest(); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-stateCh:
+			if err := reattest(); err != nil {
+				return err
+			}
+		case u := <-updateCh:
+			if u.Err != nil {
+				if proxycfg.IsTerminalError(u.Err) {
+					return status.Error(codes.PermissionDenied, u.Err.Error())
+				}
+				logger.Error("failed to fetch workload data", "correlationID", u.CorrelationID, "error", u.Err)
+				continue
+			}
+			switch {
+			case u.CorrelationID == rootsWatchID:
+				roots, ok := u.Result.(*structs.IndexedCARoots)
+				if !ok {
+					return status.Errorf(codes.Internal, "invalid type for roots response: %T", u.Result)
+				}
+				state.roots = roots
+			case strings.HasPrefix(u.CorrelationID, leafWatchIDPrefix):
+				leaf, ok := u.Result.(*structs.IssuedCert)
+				if !ok {
+					return status.Errorf(codes.Internal, "invalid type for leaf response: %T", u.Result)
+				}
+				state.leaves[strings.TrimPrefix(u.CorrelationID, leafWatchIDPrefix)] = leaf
+			}
+			if err := send(&state); err != nil {
+				if _, ok := status.FromError(err); ok {
+					return err
+				}
+				return status.Error(codes.Internal, err.Error())
+			}
+		}
+	}
+}
+
+func sameServices(a, b []attestedService) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Service.CompoundServiceID() != b[i].Service.CompoundServiceID() ||
+			a[i].Service.Service != b[i].Service.Service ||
+			a[i].Token != b[i].Token {
+			return false
+		}
+	}
+	return true
+}
+
+// x509SVIDResponse builds the response for FetchX509SVID, or returns nil if
+// the roots or any of the leaf certificates have not been fetched yet.
+func x509SVIDResponse(w *watchState) (*pbspiffeworkload.X509SVIDResponse, error) {
+	if w.roots == nil {
+		return nil, nil
+	}
+	bundle, err := rootsBundle(w.roots)
+	if err != nil {
+		return nil, err
+	}
+	crls, err := revocationLists(w.roots)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pbspiffeworkload.X509SVIDResponse{Crl: crls}
+	for _, m := range w.services {
+		leaf, ok := w.leaves[m.Service.CompoundServiceID().String()]
+		if !ok {
+			return nil, nil
+		}
+		chain, err := decodePEM(leaf.CertPEM, "CERTIFICATE")
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode leaf certificate: %w", err)
+		}
+		signer, err := connect.ParseSigner(leaf.PrivateKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse leaf private key: %w", err)
+		}
+		key, err := x509.MarshalPKCS8PrivateKey(signer)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode leaf private key: %w", err)
+		}
+		rsp.Svids = append(rsp.Svids, &pbspiffeworkload.X509SVID{
+			SpiffeId:    leaf.ServiceURI,
+			X509Svid:    concat(chain),
+			X509SvidKey: key,
+			Bundle:      bundle,
+			Hint:        m.Service.ID,
+		})
+	}
+	return rsp, nil
+}
+
+// rootsBundle returns the concatenated DER encoding of all the CA roots, so
+// that certificates issued by a root being rotated out remain trusted.
+func rootsBundle(roots *structs.IndexedCARoots) ([]byte, error) {
+	var certs [][]byte
+	for _, root := range roots.Roots {
+		der, err := decodePEM(root.RootCert, "CERTIFICATE")
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode CA root %q: %w", root.ID, err)
+		}
+		certs = append(certs, der...)
+	}
+	return concat(certs), nil
+}
+
+func revocationLists(roots *structs.IndexedCARoots) ([][]byte, error) {
+	var crls [][]byte
+	for _, list := range roots.RevocationLists {
+		der, err := decodePEM(list, "X509 CRL")
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode revocation list: %w", err)
+		}
+		crls = append(crls, der...)
+	}
+	return crls, nil
+}
+
+// jwtBundle returns the JWKS containing the JWT signing keys of all the CA
+// roots.
+func jwtBundle(roots *structs.IndexedCARoots) ([]byte, error) {
+	jwks, err := connect.JWKSFromRoots(roots.Roots)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(jwks)
+}
+
+func trustDomainID(roots *structs.IndexedCARoots) string {
+	return "spiffe://" + roots.TrustDomain
+}
+
+func decodePEM(v string, blockType string) ([][]byte, error) {
+	var (
+		out  [][]byte
+		rest = []byte(v)
+	)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != blockType {
+			return nil, fmt.Errorf("unexpected PEM block type %q", block.Type)
+		}
+		out = append(out, block.Bytes)
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("no %s PEM blocks found", blockType)
+	}
+	return out, nil
+}
+
+func concat(blocks [][]byte) []byte {
+	var out []byte
+	for _, b := range blocks {
+		out = append(out, b...)
+	}
+	return out
+}
@@ -0,0 +1,382 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux
+
+package workloadapi
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/agent/connect/ca"
+	"github.com/hashicorp/consul/agent/leafcert"
+	"github.com/hashicorp/consul/agent/local"
+	"github.com/hashicorp/consul/agent/proxycfg"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/proto-public/pbspiffeworkload"
+)
+
+func TestServer_FetchX509SVID(t *testing.T) {
+	state := local.TestState(t)
+	sources := proxycfg.NewTestDataSources()
+	client := testClient(t, state, sources)
+
+	roots, leaf := proxycfg.TestCerts(t)
+	require.NoError(t, sources.CARoots.Set(rootsReq("web-token"), roots))
+	require.NoError(t, sources.LeafCertificate.Set(leafReq("web", "web-token"), leaf))
+
+	uid := strconv.Itoa(os.Getuid())
+	require.NoError(t, state.AddServiceWithChecks(&structs.NodeService{
+		ID:      "web-1",
+		Service: "web",
+		Meta:    map[string]string{structs.MetaWorkloadUIDKey: uid},
+	}, nil, "web-token", false))
+	require.NoError(t, state.AddServiceWithChecks(&structs.NodeService{
+		ID:      "db-1",
+		Service: "db",
+		Meta:    map[string]string{structs.MetaWorkloadUIDKey: strconv.Itoa(os.Getuid() + 1)},
+	}, nil, "db-token", false))
+
+	t.Run("missing security header", func(t *testing.T) {
+		stream, err := client.FetchX509SVID(context.Background(), &pbspiffeworkload.X509SVIDRequest{})
+		require.NoError(t, err)
+		_, err = stream.Recv()
+		require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+	})
+
+	ctx, cancel := context.WithCancel(workloadContext())
+	t.Cleanup(cancel)
+
+	stream, err := client.FetchX509SVID(ctx, &pbspiffeworkload.X509SVIDRequest{})
+	require.NoError(t, err)
+
+	rsp := recvX509SVID(t, stream)
+	require.Len(t, rsp.Svids, 1)
+	svid := rsp.Svids[0]
+	require.Equal(t, leaf.ServiceURI, svid.SpiffeId)
+	require.Equal(t, "web-1", svid.Hint)
+
+	certs, err := x509.ParseCertificates(svid.X509Svid)
+	require.NoError(t, err)
+	require.Len(t, certs, 1)
+	require.Equal(t, connect.EncodeSerialNumber(certs[0].SerialNumber), leaf.SerialNumber)
+
+	_, err = x509.ParsePKCS8PrivateKey(svid.X509SvidKey)
+	require.NoError(t, err)
+
+	bundle, err := x509.ParseCertificates(svid.Bundle)
+	require.NoError(t, err)
+	require.Len(t, bundle, 1)
+
+	t.Run("rotates leaf certificates", func(t *testing.T) {
+		rotated := proxycfg.TestLeafForCA(t, roots.Roots[0])
+		require.NoError(t, sources.LeafCertificate.Set(leafReq("web", "web-token"), rotated))
+
+		for {
+			rsp := recvX509SVID(t, stream)
+			certs, err := x509.ParseCertificates(rsp.Svids[0].X509Svid)
+			require.NoError(t, err)
+			if connect.EncodeSerialNumber(certs[0].SerialNumber) == rotated.SerialNumber {
+				break
+			}
+		}
+	})
+
+	t.Run("closes the stream when the service is deregistered", func(t *testing.T) {
+		require.NoError(t, state.RemoveService(structs.NewServiceID("web-1", nil)))
+
+		for {
+			_, err := stream.Recv()
+			if err != nil {
+				require.Equal(t, codes.PermissionDenied.String(), status.Code(err).String())
+				break
+			}
+		}
+	})
+}
+
+func TestServer_FetchX509Bundles(t *testing.T) {
+	state := local.TestState(t)
+	sources := proxycfg.NewTestDataSources()
+	client := testClient(t, state, sources)
+
+	t.Run("unattested caller", func(t *testing.T) {
+		stream, err := client.FetchX509Bundles(workloadContext(), &pbspiffeworkload.X509BundlesRequest{})
+		require.NoError(t, err)
+		_, err = stream.Recv()
+		require.Equal(t, codes.PermissionDenied.String(), status.Code(err).String())
+	})
+
+	roots, _ := proxycfg.TestCerts(t)
+	require.NoError(t, sources.CARoots.Set(rootsReq("web-token"), roots))
+	require.NoError(t, state.AddServiceWithChecks(&structs.NodeService{
+		ID:      "web-1",
+		Service: "web",
+		Meta: map[string]string{
+			structs.MetaWorkloadUIDKey: strconv.Itoa(os.Getuid()),
+			structs.MetaWorkloadPIDKey: strconv.Itoa(os.Getpid()),
+		},
+	}, nil, "web-token", false))
+
+	ctx, cancel := context.WithCancel(workloadContext())
+	t.Cleanup(cancel)
+
+	stream, err := client.FetchX509Bundles(ctx, &pbspiffeworkload.X509BundlesRequest{})
+	require.NoError(t, err)
+
+	rsp, err := stream.Recv()
+	require.NoError(t, err)
+	require.Contains(t, rsp.Bundles, "spiffe://"+roots.TrustDomain)
+
+	certs, err := x509.ParseCertificates(rsp.Bundles["spiffe://"+roots.TrustDomain])
+	require.NoError(t, err)
+	require.Len(t, certs, 1)
+}
+
+func TestServer_JWTSVID(t *testing.T) {
+	state := local.TestState(t)
+	sources := proxycfg.NewTestDataSources()
+
+	roots, _ := proxycfg.TestCerts(t)
+	root := roots.Roots[0]
+	require.NoError(t, ca.GenerateJWTSigningKey(root))
+	require.NoError(t, sources.CARoots.Set(rootsReq("web-token"), roots))
+
+	client := testClient(t, state, sources, func(cfg *Config) {
+		cfg.SignJWTSVID = func(_ context.Context, req *structs.JWTSVIDSignRequest) (*structs.IssuedJWTSVID, error) {
+			if req.Token != "web-token" {
+				return nil, acl.ErrPermissionDenied
+			}
+			spiffeID := connect.SpiffeIDService{
+				Host:       roots.TrustDomain,
+				Partition:  req.PartitionOrDefault(),
+				Namespace:  req.NamespaceOrDefault(),
+				Datacenter: req.Datacenter,
+				Service:    req.Service,
+			}
+			token, expiresAt, err := ca.SignJWTSVID(root, spiffeID.URI().String(), req.Audience, time.Now(), 0)
+			if err != nil {
+				return nil, err
+			}
+			return &structs.IssuedJWTSVID{
+				SpiffeID:  spiffeID.URI().String(),
+				Token:     token,
+				KeyID:     root.JWTKeyID,
+				ExpiresAt: expiresAt,
+			}, nil
+		}
+	})
+
+	t.Run("unattested caller", func(t *testing.T) {
+		_, err := client.FetchJWTSVID(workloadContext(), &pbspiffeworkload.JWTSVIDRequest{Audience: []string{"api"}})
+		require.Equal(t, codes.PermissionDenied.String(), status.Code(err).String())
+	})
+
+	require.NoError(t, state.AddServiceWithChecks(&structs.NodeService{
+		ID:      "web-1",
+		Service: "web",
+		Meta:    map[string]string{structs.MetaWorkloadUIDKey: strconv.Itoa(os.Getuid())},
+	}, nil, "web-token", false))
+
+	t.Run("missing audience", func(t *testing.T) {
+		_, err := client.FetchJWTSVID(workloadContext(), &pbspiffeworkload.JWTSVIDRequest{})
+		require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+	})
+
+	rsp, err := client.FetchJWTSVID(workloadContext(), &pbspiffeworkload.JWTSVIDRequest{Audience: []string{"api"}})
+	require.NoError(t, err)
+	require.Len(t, rsp.Svids, 1)
+	svid := rsp.Svids[0]
+	require.Equal(t, "spiffe://"+roots.TrustDomain+"/ns/default/dc/dc1/svc/web", svid.SpiffeId)
+	require.Equal(t, "web-1", svid.Hint)
+
+	t.Run("unknown SPIFFE ID", func(t *testing.T) {
+		_, err := client.FetchJWTSVID(workloadContext(), &pbspiffeworkload.JWTSVIDRequest{
+			Audience: []string{"api"},
+			SpiffeId: "spiffe://" + roots.TrustDomain + "/ns/default/dc/dc1/svc/db",
+		})
+		require.Equal(t, codes.PermissionDenied.String(), status.Code(err).String())
+	})
+
+	t.Run("bundles", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(workloadContext())
+		t.Cleanup(cancel)
+
+		stream, err := client.FetchJWTBundles(ctx, &pbspiffeworkload.JWTBundlesRequest{})
+		require.NoError(t, err)
+		rsp, err := stream.Recv()
+		require.NoError(t, err)
+
+		var jwks jose.JSONWebKeySet
+		require.NoError(t, json.Unmarshal(rsp.Bundles["spiffe://"+roots.TrustDomain], &jwks))
+		require.Len(t, jwks.Keys, 1)
+		require.Equal(t, root.JWTKeyID, jwks.Keys[0].KeyID)
+	})
+
+	t.Run("validate", func(t *testing.T) {
+		rsp, err := client.ValidateJWTSVID(workloadContext(), &pbspiffeworkload.ValidateJWTSVIDRequest{
+			Audience: "api",
+			Svid:     svid.Svid,
+		})
+		require.NoError(t, err)
+		require.Equal(t, svid.SpiffeId, rsp.SpiffeId)
+		require.Equal(t, svid.SpiffeId, rsp.Claims.Fields["sub"].GetStringValue())
+	})
+
+	t.Run("validate wrong audience", func(t *testing.T) {
+		_, err := client.ValidateJWTSVID(workloadContext(), &pbspiffeworkload.ValidateJWTSVIDRequest{
+			Audience: "other",
+			Svid:     svid.Svid,
+		})
+		require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+	})
+}
+
+func TestSelectorsMatch(t *testing.T) {
+	startTime, err := processStartTime(int32(os.Getpid()))
+	require.NoError(t, err)
+	caller := Caller{PID: int32(os.Getpid()), UID: 1000, StartTime: startTime}
+	pid := strconv.Itoa(os.Getpid())
+
+	cases := map[string]struct {
+		caller Caller
+		meta   map[string]string
+		match  bool
+	}{
+		"no selectors": {
+			meta:  map[string]string{"foo": "bar"},
+			match: false,
+		},
+		"uid": {
+			meta:  map[string]string{structs.MetaWorkloadUIDKey: "1000"},
+			match: true,
+		},
+		"uid mismatch": {
+			meta:  map[string]string{structs.MetaWorkloadUIDKey: "1001"},
+			match: false,
+		},
+		"uid and pid": {
+			meta:  map[string]string{structs.MetaWorkloadUIDKey: "1000", structs.MetaWorkloadPIDKey: pid},
+			match: true,
+		},
+		"uid matches but pid does not": {
+			meta:  map[string]string{structs.MetaWorkloadUIDKey: "1000", structs.MetaWorkloadPIDKey: pid + "0"},
+			match: false,
+		},
+		"pid without uid": {
+			meta:  map[string]string{structs.MetaWorkloadPIDKey: pid},
+			match: false,
+		},
+		"pid reused by another process": {
+			caller: Caller{PID: caller.PID, UID: caller.UID, StartTime: startTime + 1},
+			meta:   map[string]string{structs.MetaWorkloadUIDKey: "1000", structs.MetaWorkloadPIDKey: pid},
+			match:  false,
+		},
+		"invalid uid": {
+			meta:  map[string]string{structs.MetaWorkloadUIDKey: "root"},
+			match: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := caller
+			if tc.caller != (Caller{}) {
+				c = tc.caller
+			}
+			require.Equal(t, tc.match, selectorsMatch(tc.meta, c))
+		})
+	}
+}
+
+func testClient(t *testing.T, state LocalState, sources *proxycfg.TestDataSources, opts ...func(*Config)) pbspiffeworkload.SpiffeWorkloadAPIClient {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "workload.sock")
+	lis, err := net.Listen("unix", path)
+	require.NoError(t, err)
+
+	cfg := Config{
+		Logger:          hclog.NewNullLogger(),
+		Datacenter:      "dc1",
+		LocalState:      state,
+		CARoots:         sources.CARoots,
+		LeafCertificate: sources.LeafCertificate,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	srv := grpc.NewServer(grpc.Creds(Credentials()))
+	NewServer(cfg).Register(srv)
+
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.Dial("unix://"+path, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return pbspiffeworkload.NewSpiffeWorkloadAPIClient(conn)
+}
+
+func workloadContext() context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), securityHeaderKey, "true")
+}
+
+func rootsReq(token string) *structs.DCSpecificRequest {
+	return &structs.DCSpecificRequest{
+		Datacenter:   "dc1",
+		QueryOptions: structs.QueryOptions{Token: token},
+	}
+}
+
+func leafReq(service, token string) *leafcert.ConnectCALeafRequest {
+	return &leafcert.ConnectCALeafRequest{
+		Datacenter:     "dc1",
+		Token:          token,
+		Service:        service,
+		EnterpriseMeta: *acl.DefaultEnterpriseMeta(),
+	}
+}
+
+func recvX509SVID(t *testing.T, stream pbspiffeworkload.SpiffeWorkloadAPI_FetchX509SVIDClient) *pbspiffeworkload.X509SVIDResponse {
+	t.Helper()
+
+	type result struct {
+		rsp *pbspiffeworkload.X509SVIDResponse
+		err error
+	}
+	ch := make(chan result, 1)
+	go func() {
+		rsp, err := stream.Recv()
+		ch <- result{rsp, err}
+	}()
+
+	select {
+	case r := <-ch:
+		require.NoError(t, r.err)
+		return r.rsp
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for X.509-SVID response")
+		return nil
+	}
+}
@@ -1356,7 +1356,7 @@ func (s *ResourceGenerator) makeUpstreamClusterForPreparedQuery(upstream structs
 	return c, nil
 }
 
-func finalizeUpstreamConfig(cfg structs.UpstreamConfig, chain *structs.CompiledDiscoveryChain, connectTimeout time.Duration) structs.UpstreamConfig {
+func finalizeUpstreamConfig(cfg structs.UpstreamConfig, chain *structs.CompiledDiscoveryChain, resolver *structs.DiscoveryResolver) structs.UpstreamConfig {
 	if cfg.Protocol == "" {
 		cfg.Protocol = chain.Protocol
 	}
@@ -1366,11 +1366,72 @@ func finalizeUpstreamConfig(cfg structs.UpstreamConfig, chain *structs.CompiledD
 	}
 
 	if cfg.ConnectTimeoutMs == 0 {
-		cfg.ConnectTimeoutMs = int(connectTimeout / time.Millisecond)
+		cfg.ConnectTimeoutMs = int(resolver.ConnectTimeout / time.Millisecond)
+	}
+
+	// The compiled discovery chain carries the effective circuit breaking and
+	// outlier detection settings for this caller. Chains compiled by older
+	// servers lack them, in which case the upstream config is kept as is.
+	if resolver.Limits != nil {
+		cfg.Limits = resolver.Limits
+	}
+	if resolver.PassiveHealthCheck != nil {
+		cfg.PassiveHealthCheck = resolver.PassiveHealthCheck
 	}
 	return cfg
 }
 
+// routeRetryBudgets returns the retry budgets configured on the routes of
+// the chain keyed by the name of the resolver node that serves them. Envoy
+// only supports retry budgets on clusters, so a budget configured on a route
+// is shared with every other route to the same resolver. When several routes
+// configure a budget for one resolver the most restrictive values win.
+func routeRetryBudgets(chain *structs.CompiledDiscoveryChain) map[string]*structs.RetryBudget {
+	budgets := make(map[string]*structs.RetryBudget)
+
+	add := func(nodeName string, budget *structs.RetryBudget) {
+		existing, ok := budgets[nodeName]
+		if !ok {
+			budgets[nodeName] = budget.Clone()
+			return
+		}
+		if p := budget.BudgetPercent; p != nil && (existing.BudgetPercent == nil || *p < *existing.BudgetPercent) {
+			v := *p
+			existing.BudgetPercent = &v
+		}
+		if c := budget.MinRetryConcurrency; c != nil && (existing.MinRetryConcurrency == nil || *c < *existing.MinRetryConcurrency) {
+			v := *c
+			existing.MinRetryConcurrency = &v
+		}
+	}
+
+	for _, node := range chain.Nodes {
+		if node == nil || !node.IsRouter() {
+			continue
+		}
+		for _, route := range node.Routes {
+			if route.Definition == nil || route.Definition.Destination == nil || route.Definition.Destination.RetryBudget == nil {
+				continue
+			}
+			budget := route.Definition.Destination.RetryBudget
+
+			next := chain.Nodes[route.NextNode]
+			switch {
+			case next == nil:
+			case next.IsResolver():
+				add(next.Name, budget)
+			case next.IsSplitter():
+				for _, split := range next.Splits {
+					if splitNode := chain.Nodes[split.NextNode]; splitNode != nil && splitNode.IsResolver() {
+						add(splitNode.Name, budget)
+					}
+				}
+			}
+		}
+	}
+	return budgets
+}
+
 func (s *ResourceGenerator) makeUpstreamClustersForDiscoveryChain(
 	uid proxycfg.UpstreamID,
 	upstream *structs.Upstream,
@@ -1425,6 +1486,8 @@ func (s *ResourceGenerator) makeUpstreamClustersForDiscoveryChain(
 		}
 	}
 
+	retryBudgets := routeRetryBudgets(chain)
+
 	var out []*envoy_cluster_v3.Cluster
 	for _, node := range chain.Nodes {
 		switch {
@@ -1442,7 +1505,17 @@ func (s *ResourceGenerator) makeUpstreamClustersForDiscoveryChain(
 		if primaryTargetClusterName == "" {
 			continue
 		}
-		upstreamConfig := finalizeUpstreamConfig(rawUpstreamConfig, chain, node.Resolver.ConnectTimeout)
+		upstreamConfig := finalizeUpstreamConfig(rawUpstreamConfig, chain, node.Resolver)
+		if budget, ok := retryBudgets[node.Name]; ok && (upstreamConfig.Limits == nil || upstreamConfig.Limits.RetryBudget == nil) {
+			// A retry budget configured explicitly on the upstream or the
+			// resolver takes precedence over the one from the routes.
+			limits := upstreamConfig.Limits.Clone()
+			if limits == nil {
+				limits = &structs.UpstreamLimits{}
+			}
+			limits.RetryBudget = budget
+			upstreamConfig.Limits = limits
+		}
 
 		if forMeshGateway && !cfgSnap.Locality.Matches(primaryTarget.Datacenter, primaryTarget.Partition) {
 			s.Logger.Warn("ignoring discovery chain target that crosses a datacenter or partition boundary in a mesh gateway",
@@ -1959,6 +2032,15 @@ func makeThresholdsIfNeeded(limits *structs.UpstreamLimits) []*envoy_cluster_v3.
 	if limits.MaxConcurrentRequests != nil {
 		threshold.MaxRequests = response.MakeUint32Value(*limits.MaxConcurrentRequests)
 	}
+	if rb := limits.RetryBudget; rb != nil {
+		threshold.RetryBudget = &envoy_cluster_v3.CircuitBreakers_Thresholds_RetryBudget{}
+		if rb.BudgetPercent != nil {
+			threshold.RetryBudget.BudgetPercent = &envoy_type_v3.Percent{Value: *rb.BudgetPercent}
+		}
+		if rb.MinRetryConcurrency != nil {
+			threshold.RetryBudget.MinRetryConcurrency = response.MakeUint32Value(*rb.MinRetryConcurrency)
+		}
+	}
 
 	return []*envoy_cluster_v3.CircuitBreakers_Thresholds{threshold}
 }
@@ -580,3 +580,43 @@ func TestMakeJWTCertValidationContext(t *testing.T) {
 		})
 	}
 }
+
+func TestFinalizeUpstreamConfig_LimitsAndPassiveHealthCheck(t *testing.T) {
+	upstreamMaxConns, chainMaxConns := 10, 100
+	upstreamLimits := &structs.UpstreamLimits{MaxConnections: &upstreamMaxConns}
+	upstreamCheck := &structs.PassiveHealthCheck{Interval: 5 * time.Second, MaxFailures: 3}
+	chainLimits := &structs.UpstreamLimits{MaxConnections: &chainMaxConns}
+	chainCheck := &structs.PassiveHealthCheck{Interval: 10 * time.Second, MaxFailures: 5}
+
+	tests := map[string]struct {
+		resolver     *structs.DiscoveryResolver
+		expectLimits *structs.UpstreamLimits
+		expectCheck  *structs.PassiveHealthCheck
+	}{
+		"chain carries effective settings": {
+			resolver: &structs.DiscoveryResolver{
+				Limits:             chainLimits,
+				PassiveHealthCheck: chainCheck,
+			},
+			expectLimits: chainLimits,
+			expectCheck:  chainCheck,
+		},
+		"chain from older server lacks settings": {
+			resolver:     &structs.DiscoveryResolver{},
+			expectLimits: upstreamLimits,
+			expectCheck:  upstreamCheck,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg := structs.UpstreamConfig{
+				Limits:             upstreamLimits,
+				PassiveHealthCheck: upstreamCheck,
+			}
+			got := finalizeUpstreamConfig(cfg, &structs.CompiledDiscoveryChain{Protocol: "http"}, tc.resolver)
+			require.Equal(t, tc.expectLimits, got.Limits)
+			require.Equal(t, tc.expectCheck, got.PassiveHealthCheck)
+		})
+	}
+}
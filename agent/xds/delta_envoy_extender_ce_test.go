@@ -96,6 +96,14 @@ end`,
 		}
 	}
 
+	// Apply the instance level rate limits of the local service and ensure http is used so the extension can be applied.
+	makeRateLimitNsFunc := func(protocol string, rl structs.RateLimits) func(ns *structs.NodeService) {
+		return func(ns *structs.NodeService) {
+			ns.Proxy.Config["protocol"] = protocol
+			ns.Proxy.EnvoyExtensions = []structs.EnvoyExtension{*rl.ToEnvoyExtension()}
+		}
+	}
+
 	// Apply Prop Override extension to the local service and ensure http is used so the extension can be applied.
 	makePropOverrideNsFunc := func(args map[string]interface{}) func(ns *structs.NodeService) {
 		return func(ns *structs.NodeService) {
@@ -531,6 +539,45 @@ end`,
 				})
 			},
 		},
+		{
+			name: "local-ratelimit-applies-to-inbound",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "default", false, makeRateLimitNsFunc("http", structs.RateLimits{
+					InstanceLevel: structs.InstanceLevelRateLimits{
+						RequestsPerSecond: 100,
+						RequestsMaxBurst:  200,
+						Routes: []structs.InstanceLevelRouteRateLimits{
+							{PathExact: "/admin", RequestsPerSecond: 1},
+							{PathPrefix: "/api/", RequestsPerSecond: 10, RequestsMaxBurst: 20},
+							{PathRegex: "/v[0-9]+/.*", RequestsPerSecond: 5},
+						},
+					},
+				}), nil)
+			},
+		},
+		{
+			name: "local-ratelimit-routes-only",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "default", false, makeRateLimitNsFunc("http", structs.RateLimits{
+					InstanceLevel: structs.InstanceLevelRateLimits{
+						Routes: []structs.InstanceLevelRouteRateLimits{
+							{PathPrefix: "/expensive", RequestsPerSecond: 2},
+						},
+					},
+				}), nil)
+			},
+		},
+		{
+			// The public listener of a tcp service has no http filters to rate limit.
+			name: "local-ratelimit-doesnt-apply-to-tcp",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "default", false, makeRateLimitNsFunc("tcp", structs.RateLimits{
+					InstanceLevel: structs.InstanceLevelRateLimits{
+						RequestsPerSecond: 100,
+					},
+				}), nil)
+			},
+		},
 		{
 			name: "lua-inbound-applies-to-inbound",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
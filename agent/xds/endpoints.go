@@ -731,7 +731,7 @@ func (s *ResourceGenerator) endpointsFromDiscoveryChain(
 		if err != nil {
 			return nil, err
 		}
-		upstreamConfig := finalizeUpstreamConfig(rawUpstreamConfig, chain, node.Resolver.ConnectTimeout)
+		upstreamConfig := finalizeUpstreamConfig(rawUpstreamConfig, chain, node.Resolver)
 
 		mappedTargets, err := s.mapDiscoChainTargets(cfgSnap, chain, node, upstreamConfig, forMeshGateway)
 		if err != nil {
@@ -880,6 +880,12 @@ func makeLoadAssignment(logger hclog.Logger, cfgSnap *proxycfg.ConfigSnapshot, c
 		Endpoints:   make([]*envoy_endpoint_v3.LocalityLbEndpoints, 0, len(endpointGroups)),
 	}
 
+	// Failover targets only receive traffic once every instance of the
+	// preceding targets is unhealthy. The overprovisioning factor applies to
+	// the whole assignment, so with failover targets the locality priorities
+	// within each target fail over the same way. Only a single target keeps
+	// Envoy's default factor, where traffic overflows to the next locality in
+	// proportion to the share of unhealthy instances in the closer one.
 	setFullFailoverProvisioningFactor := len(endpointGroups) > 1
 
 	var priority uint32
@@ -891,10 +897,6 @@ func makeLoadAssignment(logger hclog.Logger, cfgSnap *proxycfg.ConfigSnapshot, c
 			continue
 		}
 
-		if len(endpointsByLocality) > 1 {
-			setFullFailoverProvisioningFactor = true
-		}
-
 		for _, endpoints := range endpointsByLocality {
 			es := make([]*envoy_endpoint_v3.LbEndpoint, 0, len(endpointGroup.Endpoints))
 
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !consulent
+
+package xds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+func TestDiscoChainTargets_OrderByLocality(t *testing.T) {
+	region := func(r string) *string { return &r }
+
+	ft := discoChainTargets{
+		baseClusterName: "db.default.dc1.internal.trustdomain.consul",
+		failover:        true,
+		failoverPolicy: structs.ServiceResolverFailoverPolicy{
+			Mode:    "order-by-locality",
+			Regions: []string{"us-west-2", "us-east-1"},
+		},
+		targets: []targetInfo{
+			{TargetID: "eu-west-1", Region: region("eu-west-1")},
+			{TargetID: "us-east-1", Region: region("us-east-1")},
+			{TargetID: "local"},
+			{TargetID: "us-west-2", Region: region("us-west-2")},
+			{TargetID: "ap-south-1", Region: region("ap-south-1")},
+		},
+	}
+
+	groups, err := ft.groupedTargets()
+	require.NoError(t, err)
+
+	var (
+		targetIDs    []string
+		clusterNames []string
+	)
+	for _, g := range groups {
+		require.Len(t, g.Targets, 1)
+		targetIDs = append(targetIDs, g.Targets[0].TargetID)
+		clusterNames = append(clusterNames, g.ClusterName)
+	}
+	require.Equal(t, []string{"local", "us-west-2", "us-east-1", "eu-west-1", "ap-south-1"}, targetIDs)
+	require.Equal(t, []string{
+		"failover-target~0~db.default.dc1.internal.trustdomain.consul",
+		"failover-target~1~db.default.dc1.internal.trustdomain.consul",
+		"failover-target~2~db.default.dc1.internal.trustdomain.consul",
+		"failover-target~3~db.default.dc1.internal.trustdomain.consul",
+		"failover-target~4~db.default.dc1.internal.trustdomain.consul",
+	}, clusterNames)
+
+	// The configured order of the targets is left untouched.
+	require.Equal(t, "eu-west-1", ft.targets[0].TargetID)
+}
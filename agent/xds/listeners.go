@@ -17,9 +17,11 @@ import (
 	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_fault_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
 	envoy_grpc_http1_bridge_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_http1_bridge/v3"
 	envoy_grpc_stats_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_stats/v3"
 	envoy_http_router_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/router/v3"
+	envoy_stateful_session_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/stateful_session/v3"
 	envoy_extensions_filters_listener_http_inspector_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/listener/http_inspector/v3"
 	envoy_original_dst_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/listener/original_dst/v3"
 	envoy_tls_inspector_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/listener/tls_inspector/v3"
@@ -184,6 +186,8 @@ func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.
 				useRDS:          useRDS,
 				fetchTimeoutRDS: cfgSnap.GetXDSCommonConfig(s.Logger).GetXDSFetchTimeout(),
 				tracing:         tracing,
+				faultInjection:  useRDS && chainHasFaultInjection(chain),
+				statefulSession: useRDS && chainHasSessionAffinity(chain),
 			})
 			if err != nil {
 				return nil, err
@@ -220,6 +224,8 @@ func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.
 			useRDS:          useRDS,
 			fetchTimeoutRDS: cfgSnap.GetXDSCommonConfig(s.Logger).GetXDSFetchTimeout(),
 			tracing:         tracing,
+			faultInjection:  useRDS && chainHasFaultInjection(chain),
+			statefulSession: useRDS && chainHasSessionAffinity(chain),
 		})
 		if err != nil {
 			return nil, err
@@ -1178,10 +1184,26 @@ func createDownstreamTransportSocketForConnectTLS(cfgSnap *proxycfg.ConfigSnapsh
 		tlsContext.AlpnProtocols = getAlpnProtocols(proxyCfg.Protocol)
 	}
 
-	// Inject peering trust bundles if this service is exported to peered clusters.
-	err := injectSpiffeValidatorConfigForPeers(cfgSnap, tlsContext, peerBundles)
-	if err != nil {
-		return nil, err
+	if len(federatedTrustBundles(cfgSnap)) > 0 {
+		// Trust the bundles of the federated SPIFFE trust domains referenced
+		// by the intentions of the proxy. Like peers they are validated by
+		// the SPIFFE validator, which doesn't support revocation lists, so
+		// they are only trusted while no revocation lists are distributed.
+		injectFederatedTrustBundlesValidationContext(tlsContext)
+	} else {
+		// Inject peering trust bundles if this service is exported to peered clusters.
+		err := injectSpiffeValidatorConfigForPeers(cfgSnap, tlsContext, peerBundles)
+		if err != nil {
+			return nil, err
+		}
+
+		// Reject revoked client certificates. Peers are validated by the SPIFFE
+		// validator, which doesn't support revocation lists, and peer CAs don't
+		// sign lists for our proxies, so revocation lists are only enforced for
+		// proxies that aren't exported to peers.
+		if cfgSnap.Kind == structs.ServiceKindConnectProxy && len(peerBundles) == 0 {
+			injectRevocationListValidationContext(cfgSnap, tlsContext)
+		}
 	}
 
 	return makeDownstreamTLSTransportSocket(&envoy_tls_v3.DownstreamTlsContext{
@@ -1195,7 +1217,7 @@ func injectSpiffeValidatorConfigForPeers(cfgSnap *proxycfg.ConfigSnapshot, tlsCo
 		return nil
 	}
 
-	spiffeConfig, err := makeSpiffeValidatorConfig(cfgSnap.Roots.TrustDomain, cfgSnap.RootPEMs(), peerBundles)
+	spiffeConfig, err := makeSpiffeValidatorConfig(cfgSnap.Roots.TrustDomain, cfgSnap.RootPEMs(), peerBundles, nil)
 	if err != nil {
 		return err
 	}
@@ -1216,14 +1238,85 @@ func injectSpiffeValidatorConfigForPeers(cfgSnap *proxycfg.ConfigSnapshot, tlsCo
 	return nil
 }
 
+// injectRevocationListValidationContext merges the revocation lists returned
+// by makeRevocationListSecrets into the validation context, so that
+// Envoy rejects revoked certificates without a listener update whenever the
+// lists change.
+func injectRevocationListValidationContext(cfgSnap *proxycfg.ConfigSnapshot, tlsContext *envoy_tls_v3.CommonTlsContext) {
+	if tlsContext == nil || cfgSnap.RevocationListPEMs() == "" {
+		return
+	}
+	typ, ok := tlsContext.ValidationContextType.(*envoy_tls_v3.CommonTlsContext_ValidationContext)
+	if !ok {
+		return
+	}
+
+	tlsContext.ValidationContextType = &envoy_tls_v3.CommonTlsContext_CombinedValidationContext{
+		CombinedValidationContext: &envoy_tls_v3.CommonTlsContext_CombinedCertificateValidationContext{
+			DefaultValidationContext:         typ.ValidationContext,
+			ValidationContextSdsSecretConfig: makeADSSecretConfig(connectRevocationListSecretName),
+		},
+	}
+}
+
+// injectFederatedTrustBundlesValidationContext merges the SPIFFE validator
+// returned by secretsFromSnapshotConnectProxy into the validation context, so
+// that refreshed trust bundles of federated trust domains don't require a
+// listener update.
+func injectFederatedTrustBundlesValidationContext(tlsContext *envoy_tls_v3.CommonTlsContext) {
+	if tlsContext == nil {
+		return
+	}
+	typ, ok := tlsContext.ValidationContextType.(*envoy_tls_v3.CommonTlsContext_ValidationContext)
+	if !ok {
+		return
+	}
+
+	// The local roots are included in the SPIFFE validator config, which
+	// can't be combined with trusted CAs.
+	typ.ValidationContext.TrustedCa = nil
+	tlsContext.ValidationContextType = &envoy_tls_v3.CommonTlsContext_CombinedValidationContext{
+		CombinedValidationContext: &envoy_tls_v3.CommonTlsContext_CombinedCertificateValidationContext{
+			DefaultValidationContext:         typ.ValidationContext,
+			ValidationContextSdsSecretConfig: makeADSSecretConfig(connectTrustBundlesSecretName),
+		},
+	}
+}
+
+// makeADSSecretConfig references a secret returned in xds/secrets.go by name.
+func makeADSSecretConfig(name string) *envoy_tls_v3.SdsSecretConfig {
+	return &envoy_tls_v3.SdsSecretConfig{
+		Name: name,
+		SdsConfig: &envoy_core_v3.ConfigSource{
+			ConfigSourceSpecifier: &envoy_core_v3.ConfigSource_Ads{
+				Ads: &envoy_core_v3.AggregatedConfigSource{},
+			},
+			ResourceApiVersion: envoy_core_v3.ApiVersion_V3,
+		},
+	}
+}
+
 // SPIFFECertValidatorConfig is used to validate certificates from trust domains other than our own.
 // With cluster peering we expect peered clusters to have independent certificate authorities.
 // This means that we cannot use a single set of root CA certificates to validate client certificates for mTLS,
 // but rather we need to validate against different roots depending on the trust domain of the certificate presented.
-func makeSpiffeValidatorConfig(trustDomain, roots string, peerBundles []*pbpeering.PeeringTrustBundle) (*anypb.Any, error) {
+func makeSpiffeValidatorConfig(trustDomain, roots string, peerBundles []*pbpeering.PeeringTrustBundle, federatedBundles []*structs.TrustBundleConfigEntry) (*anypb.Any, error) {
 	// Store the trust bundle for the local trust domain.
 	bundles := map[string]string{trustDomain: roots}
 
+	// Store the trust bundle for each federated SPIFFE trust domain. These
+	// never replace the bundle of the local trust domain or of a peer.
+	for _, b := range federatedBundles {
+		if b.Name == trustDomain {
+			continue
+		}
+		var pems string
+		for _, pem := range b.RootPEMs {
+			pems += lib.EnsureTrailingNewline(pem)
+		}
+		bundles[b.Name] = pems
+	}
+
 	// Store the trust bundle for each trust domain of the peers this proxy is exported to.
 	// This allows us to validate traffic from other trust domains.
 	for _, b := range peerBundles {
@@ -1782,6 +1875,12 @@ func (s *ResourceGenerator) makeFilterChainTerminatingGateway(cfgSnap *proxycfg.
 		return nil, err
 	}
 
+	// Reject revoked client certificates, with the same limitation for
+	// services exported to peers as the public listener of connect proxies.
+	if len(tgtwyOpts.peerTrustBundles) == 0 {
+		injectRevocationListValidationContext(cfgSnap, tlsContext.CommonTlsContext)
+	}
+
 	transportSocket, err := makeDownstreamTLSTransportSocket(tlsContext)
 	if err != nil {
 		return nil, err
@@ -2200,6 +2299,8 @@ type filterChainOpts struct {
 	forwardClientDetails bool
 	forwardClientPolicy  envoy_http_v3.HttpConnectionManager_ForwardClientCertDetails
 	tracing              *envoy_http_v3.HttpConnectionManager_Tracing
+	faultInjection       bool
+	statefulSession      bool
 }
 
 func (s *ResourceGenerator) makeUpstreamFilterChain(opts filterChainOpts) (*envoy_listener_v3.FilterChain, error) {
@@ -2219,6 +2320,8 @@ func (s *ResourceGenerator) makeUpstreamFilterChain(opts filterChainOpts) (*envo
 		tracing:              opts.tracing,
 		accessLogs:           opts.accessLogs,
 		logger:               s.Logger,
+		faultInjection:       opts.faultInjection,
+		statefulSession:      opts.statefulSession,
 	})
 	if err != nil {
 		return nil, err
@@ -2361,6 +2464,7 @@ type listenerFilterOpts struct {
 	statPrefix string
 
 	// HTTP listener filter options
+	faultInjection       bool
 	forwardClientDetails bool
 	forwardClientPolicy  envoy_http_v3.HttpConnectionManager_ForwardClientCertDetails
 	httpAuthzFilters     []*envoy_http_v3.HttpFilter
@@ -2368,6 +2472,7 @@ type listenerFilterOpts struct {
 	requestTimeoutMs     *int
 	routeName            string
 	routePath            string
+	statefulSession      bool
 	tracing              *envoy_http_v3.HttpConnectionManager_Tracing
 	useRDS               bool
 	fetchTimeoutRDS      *durationpb.Duration
@@ -2562,6 +2667,26 @@ func makeHTTPFilter(opts listenerFilterOpts) (*envoy_listener_v3.Filter, error)
 		cfg.Http2ProtocolOptions = &envoy_core_v3.Http2ProtocolOptions{}
 	}
 
+	// The fault filter is left without any fault of its own, so that it only
+	// injects the faults configured on individual routes.
+	if opts.faultInjection {
+		fault, err := makeEnvoyHTTPFilter(faultFilterName, &envoy_fault_v3.HTTPFault{})
+		if err != nil {
+			return nil, err
+		}
+		cfg.HttpFilters = append([]*envoy_http_v3.HttpFilter{fault}, cfg.HttpFilters...)
+	}
+
+	// Likewise the stateful session filter is disabled unless a route enables
+	// it, so that sessions are only pinned for the routes that ask for it.
+	if opts.statefulSession {
+		session, err := makeEnvoyHTTPFilter(statefulSessionFilterName, &envoy_stateful_session_v3.StatefulSession{})
+		if err != nil {
+			return nil, err
+		}
+		cfg.HttpFilters = append([]*envoy_http_v3.HttpFilter{session}, cfg.HttpFilters...)
+	}
+
 	// Note the default leads to setting HttpConnectionManager_SANITIZE
 	if opts.forwardClientDetails {
 		cfg.ForwardClientCertDetails = opts.forwardClientPolicy
@@ -5,6 +5,7 @@ package xds
 
 import (
 	"fmt"
+	"sort"
 
 	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
@@ -44,6 +45,12 @@ func (s *ResourceGenerator) makeAPIGatewayListeners(address string, cfgSnap *pro
 				if cert, ok := cfgSnap.APIGateway.FileSystemCertificates.Get(certRef); ok {
 					certs = append(certs, cert)
 				}
+			case structs.ACMECertificate:
+				if cert, ok := cfgSnap.APIGateway.ACMECertificates.Get(certRef); ok {
+					if inline := cert.InlineCertificate(); inline != nil {
+						certs = append(certs, inline)
+					}
+				}
 			}
 		}
 
@@ -143,6 +150,14 @@ func (s *ResourceGenerator) makeAPIGatewayListeners(address string, cfgSnap *pro
 			}
 			resources = append(resources, l)
 
+		} else if listenerCfg.Protocol == structs.ListenerProtocolTLS {
+			l, err := s.makeAPIGatewayTLSPassthroughListener(address, cfgSnap, readyListener)
+			if err != nil {
+				return nil, err
+			}
+			if l != nil {
+				resources = append(resources, l)
+			}
 		} else {
 			// If multiple upstreams share this port, make a special listener for the protocol.
 			listenerOpts := makeListenerOpts{
@@ -155,14 +170,9 @@ func (s *ResourceGenerator) makeAPIGatewayListeners(address string, cfgSnap *pro
 			}
 			listener := makeListener(listenerOpts)
 
-			routes := make([]*structs.HTTPRouteConfigEntry, 0, len(readyListener.routeReferences))
-			for _, routeRef := range maps.Keys(readyListener.routeReferences) {
-				route, ok := cfgSnap.APIGateway.HTTPRoutes.Get(routeRef)
-				if !ok {
-					return nil, fmt.Errorf("missing route for routeRef %s:%s", routeRef.Kind, routeRef.Name)
-				}
-
-				routes = append(routes, route)
+			routes, err := httpRoutesForListener(cfgSnap, readyListener)
+			if err != nil {
+				return nil, err
 			}
 			consolidatedRoutes := discoverychain.ConsolidateHTTPRoutes(cfgSnap.APIGateway.GatewayConfig, &readyListener.listenerCfg, routes...)
 			routesWithJWT := []*structs.HTTPRouteConfigEntry{}
@@ -221,6 +231,7 @@ func (s *ResourceGenerator) makeAPIGatewayListeners(address string, cfgSnap *pro
 				httpAuthzFilters: authFilters,
 				accessLogs:       &cfgSnap.Proxy.AccessLogs,
 				logger:           s.Logger,
+				statefulSession:  anyConsolidatedRouteChain(cfgSnap, listenerCfg, consolidatedRoutes, chainHasSessionAffinity),
 			}
 
 			// Generate any filter chains needed for services with custom TLS certs
@@ -272,6 +283,137 @@ func (s *ResourceGenerator) makeAPIGatewayListeners(address string, cfgSnap *pro
 	return resources, nil
 }
 
+// makeAPIGatewayTLSPassthroughListener builds a listener for a TLS listener on an API
+// gateway. The gateway does not terminate TLS for these listeners; instead each bound
+// TLSRoute gets a filter chain that matches on the SNI of the connection and proxies
+// the raw TCP stream to the route's service.
+func (s *ResourceGenerator) makeAPIGatewayTLSPassthroughListener(address string, cfgSnap *proxycfg.ConfigSnapshot, readyListener readyListener) (*envoy_listener_v3.Listener, error) {
+	listenerKey := readyListener.listenerKey
+
+	routeRefs := make([]structs.ResourceReference, 0, len(readyListener.routeReferences))
+	for routeRef := range readyListener.routeReferences {
+		if routeRef.Kind == structs.TLSRoute {
+			routeRefs = append(routeRefs, routeRef)
+		}
+	}
+	sort.Slice(routeRefs, func(i, j int) bool {
+		return routeRefs[i].String() < routeRefs[j].String()
+	})
+
+	var filterChains []*envoy_listener_v3.FilterChain
+	seenHostnames := make(map[string]struct{})
+	hasCatchAll := false
+	for _, routeRef := range routeRefs {
+		route, ok := cfgSnap.APIGateway.TLSRoutes.Get(routeRef)
+		if !ok {
+			return nil, fmt.Errorf("missing route for routeRef %s:%s", routeRef.Kind, routeRef.Name)
+		}
+		if len(route.Services) == 0 {
+			continue
+		}
+
+		service := route.Services[0]
+		uid := proxycfg.NewUpstreamIDFromServiceName(service.ServiceName())
+		chain := cfgSnap.APIGateway.DiscoveryChain[uid]
+		if chain == nil {
+			// Wait until a chain is present in the snapshot.
+			continue
+		}
+
+		target, err := simpleChainTarget(chain)
+		if err != nil {
+			return nil, err
+		}
+		clusterName := naming.CustomizeClusterName(target.Name, chain)
+
+		// The first route to claim a hostname wins, any later routes with
+		// the same hostname are ignored.
+		var serverNames []string
+		isCatchAll := false
+		for _, hostname := range route.FilteredHostnames(readyListener.listenerCfg.GetHostname()) {
+			if hostname == "*" {
+				isCatchAll = true
+				continue
+			}
+			if _, ok := seenHostnames[hostname]; ok {
+				continue
+			}
+			seenHostnames[hostname] = struct{}{}
+			serverNames = append(serverNames, hostname)
+		}
+
+		if isCatchAll && len(serverNames) == 0 {
+			if hasCatchAll {
+				continue
+			}
+			hasCatchAll = true
+		} else if len(serverNames) == 0 {
+			continue
+		}
+
+		filter, err := makeTCPProxyFilter(listenerFilterOpts{
+			cluster:    clusterName,
+			filterName: fmt.Sprintf("%s.%s.%s.%s", chain.ServiceName, chain.Namespace, chain.Partition, chain.Datacenter),
+			statPrefix: "ingress_upstream_",
+			accessLogs: &cfgSnap.Proxy.AccessLogs,
+			logger:     s.Logger,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		filterChains = append(filterChains, &envoy_listener_v3.FilterChain{
+			FilterChainMatch: makeSNIFilterChainMatch(serverNames...),
+			Filters:          []*envoy_listener_v3.Filter{filter},
+		})
+	}
+
+	if len(filterChains) == 0 {
+		return nil, nil
+	}
+
+	l := makeListener(makeListenerOpts{
+		name:       listenerKey.Protocol,
+		accessLogs: cfgSnap.Proxy.AccessLogs,
+		addr:       address,
+		port:       listenerKey.Port,
+		direction:  envoy_core_v3.TrafficDirection_OUTBOUND,
+		logger:     s.Logger,
+	})
+
+	tlsInspector, err := makeTLSInspectorListenerFilter()
+	if err != nil {
+		return nil, err
+	}
+	l.ListenerFilters = []*envoy_listener_v3.ListenerFilter{tlsInspector}
+	l.FilterChains = filterChains
+
+	return l, nil
+}
+
+// httpRoutesForListener returns the routes bound to an HTTP listener. Any GRPCRoutes are
+// converted to their equivalent HTTPRoute so that they can be consolidated with the rest.
+func httpRoutesForListener(cfgSnap *proxycfg.ConfigSnapshot, readyListener readyListener) ([]*structs.HTTPRouteConfigEntry, error) {
+	routes := make([]*structs.HTTPRouteConfigEntry, 0, len(readyListener.routeReferences))
+	for _, routeRef := range maps.Keys(readyListener.routeReferences) {
+		if routeRef.Kind == structs.GRPCRoute {
+			route, ok := cfgSnap.APIGateway.GRPCRoutes.Get(routeRef)
+			if !ok {
+				return nil, fmt.Errorf("missing route for routeRef %s:%s", routeRef.Kind, routeRef.Name)
+			}
+			routes = append(routes, discoverychain.GRPCRouteToHTTPRoute(route))
+			continue
+		}
+
+		route, ok := cfgSnap.APIGateway.HTTPRoutes.Get(routeRef)
+		if !ok {
+			return nil, fmt.Errorf("missing route for routeRef %s:%s", routeRef.Kind, routeRef.Name)
+		}
+		routes = append(routes, route)
+	}
+	return routes, nil
+}
+
 // helper struct to persist upstream parent information when ready upstream list is built out
 type readyListener struct {
 	listenerKey      proxycfg.APIGatewayListenerKey
@@ -281,6 +423,25 @@ type readyListener struct {
 	upstreams        []structs.Upstream
 }
 
+// anyConsolidatedRouteChain reports whether the synthesized discovery chain of
+// any of the consolidated routes of a listener matches the given predicate.
+// The chains of the routes, not of the services behind them, hold the routes
+// Envoy is configured with.
+func anyConsolidatedRouteChain(
+	cfgSnap *proxycfg.ConfigSnapshot,
+	listenerCfg structs.APIGatewayListener,
+	routes []structs.HTTPRouteConfigEntry,
+	predicate func(*structs.CompiledDiscoveryChain) bool,
+) bool {
+	for _, route := range routes {
+		upstream := buildHTTPRouteUpstream(route, listenerCfg)
+		if chain := cfgSnap.APIGateway.DiscoveryChain[proxycfg.NewUpstreamID(&upstream)]; chain != nil && predicate(chain) {
+			return true
+		}
+	}
+	return false
+}
+
 // getReadyListeners returns a map containing the list of upstreams for each listener that is ready
 func getReadyListeners(cfgSnap *proxycfg.ConfigSnapshot) map[string]readyListener {
 	ready := map[string]readyListener{}
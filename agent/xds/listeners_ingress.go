@@ -120,6 +120,8 @@ func (s *ResourceGenerator) makeIngressGatewayListeners(address string, cfgSnap
 				httpAuthzFilters: nil,
 				accessLogs:       &cfgSnap.Proxy.AccessLogs,
 				logger:           s.Logger,
+				faultInjection:   upstreamsHaveFaultInjection(upstreams, cfgSnap.IngressGateway.DiscoveryChain),
+				statefulSession:  upstreamsHaveSessionAffinity(upstreams, cfgSnap.IngressGateway.DiscoveryChain),
 			}
 
 			// Generate any filter chains needed for services with custom TLS certs
@@ -10,6 +10,11 @@ import (
 	"github.com/hashicorp/go-hclog"
 )
 
-func prioritizeByLocalityFailover(_ hclog.Logger, _ *structs.Locality, _ structs.CheckServiceNodes) []structs.CheckServiceNodes {
-	return nil
+// prioritizeByLocalityFailover groups the endpoints by how close they are to
+// the locality of the proxy. Each group becomes a separate Envoy priority.
+func prioritizeByLocalityFailover(logger hclog.Logger, locality *structs.Locality, csns structs.CheckServiceNodes) []structs.CheckServiceNodes {
+	if locality == nil || locality.Region == "" {
+		logger.Trace("proxy has no locality, endpoints will not be prioritized")
+	}
+	return csns.GroupByLocality(locality)
 }
@@ -703,7 +703,7 @@ func (s *Converter) makeUpstreamClusterForPreparedQuery(upstream structs.Upstrea
 	return &namedCluster{name: sni, cluster: c}, nil
 }
 
-func finalizeUpstreamConfig(cfg structs.UpstreamConfig, chain *structs.CompiledDiscoveryChain, connectTimeout time.Duration) structs.UpstreamConfig {
+func finalizeUpstreamConfig(cfg structs.UpstreamConfig, chain *structs.CompiledDiscoveryChain, resolver *structs.DiscoveryResolver) structs.UpstreamConfig {
 	if cfg.Protocol == "" {
 		cfg.Protocol = chain.Protocol
 	}
@@ -713,7 +713,17 @@ func finalizeUpstreamConfig(cfg structs.UpstreamConfig, chain *structs.CompiledD
 	}
 
 	if cfg.ConnectTimeoutMs == 0 {
-		cfg.ConnectTimeoutMs = int(connectTimeout / time.Millisecond)
+		cfg.ConnectTimeoutMs = int(resolver.ConnectTimeout / time.Millisecond)
+	}
+
+	// The compiled discovery chain carries the effective circuit breaking and
+	// outlier detection settings for this caller. Chains compiled by older
+	// servers lack them, in which case the upstream config is kept as is.
+	if resolver.Limits != nil {
+		cfg.Limits = resolver.Limits
+	}
+	if resolver.PassiveHealthCheck != nil {
+		cfg.PassiveHealthCheck = resolver.PassiveHealthCheck
 	}
 	return cfg
 }
@@ -837,7 +847,7 @@ func (s *Converter) makeUpstreamClustersForDiscoveryChain(
 			continue
 		}
 
-		upstreamConfig := finalizeUpstreamConfig(rawUpstreamConfig, chain, node.Resolver.ConnectTimeout)
+		upstreamConfig := finalizeUpstreamConfig(rawUpstreamConfig, chain, node.Resolver)
 
 		mappedTargets, err := s.mapDiscoChainTargets(cfgSnap, chain, node, upstreamConfig, forMeshGateway)
 		if err != nil {
@@ -451,7 +451,7 @@ func (s *Converter) endpointsFromDiscoveryChain(
 		if err != nil {
 			return nil, err
 		}
-		upstreamConfig := finalizeUpstreamConfig(rawUpstreamConfig, chain, node.Resolver.ConnectTimeout)
+		upstreamConfig := finalizeUpstreamConfig(rawUpstreamConfig, chain, node.Resolver)
 
 		mappedTargets, err := s.mapDiscoChainTargets(cfgSnap, chain, node, upstreamConfig, forMeshGateway)
 		if err != nil {
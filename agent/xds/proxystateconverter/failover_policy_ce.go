@@ -6,9 +6,19 @@
 package proxystateconverter
 
 import (
-	"fmt"
+	"sort"
 )
 
+// orderByLocality tries the failover targets in the local cluster first,
+// followed by the targets in the regions listed in the failover policy and
+// then the targets in all other regions. Targets keep their configured order
+// within the same rank.
 func (ft discoChainTargets) orderByLocality() ([]discoChainTargetGroup, error) {
-	return nil, fmt.Errorf("order-by-locality is a Consul Enterprise feature")
+	targets := make([]targetInfo, len(ft.targets))
+	copy(targets, ft.targets)
+	sort.SliceStable(targets, func(i, j int) bool {
+		return ft.failoverPolicy.RegionPriority(targets[i].Region) < ft.failoverPolicy.RegionPriority(targets[j].Region)
+	})
+	ft.targets = targets
+	return ft.sequential()
 }
@@ -10,5 +10,5 @@ import (
 )
 
 func prioritizeByLocalityFailover(locality *structs.Locality, csns structs.CheckServiceNodes) []structs.CheckServiceNodes {
-	return nil
+	return csns.GroupByLocality(locality)
 }
@@ -5,6 +5,7 @@ package xds
 
 import (
 	"fmt"
+	"regexp"
 	"sort"
 	"strings"
 
@@ -244,6 +245,12 @@ func intentionToIntermediateRBACForm(
 		Precedence: ixn.Precedence,
 	}
 
+	// Sources of federated SPIFFE trust domains are matched on their SPIFFE
+	// ID rather than on a service name.
+	if ixn.SourceType == structs.IntentionSourceSPIFFE {
+		rixn.Source.SpiffeID = ixn.SourceName
+	}
+
 	// imported services will have addition metadata used to override SpiffeID creation
 	if bundle != nil {
 		rixn.Source.ExportedPartition = bundle.ExportedPartition
@@ -348,6 +355,11 @@ type rbacService struct {
 	Peer              string
 	ExportedPartition string
 	TrustDomain       string
+
+	// SpiffeID is only set for sources of federated SPIFFE trust domains. It
+	// is either an exact SPIFFE ID or "spiffe://<trust-domain>/*", and
+	// replaces the SPIFFE ID built from the service name.
+	SpiffeID string
 }
 
 type rbacIntention struct {
@@ -594,7 +606,9 @@ func makeRBACRules(
 	peerTrustBundles []*pbpeering.PeeringTrustBundle,
 	providerMap map[string]*structs.JWTProviderConfigEntry,
 ) (*envoy_rbac_v3.RBAC, error) {
-	// TODO(banks,rb): Implement revocation list checking?
+	// Revoked certificates of the local trust domain are rejected during the
+	// TLS handshake using the revocation lists distributed in xds/secrets.go,
+	// so they never reach RBAC.
 
 	// TODO(peering): mkeeler asked that these maps come from proxycfg instead of
 	// being constructed in xds to save memory allocation and gc pressure. Low priority.
@@ -912,6 +926,13 @@ func ixnSourceMatches(tester, against rbacService) bool {
 		return false
 	}
 
+	// SPIFFE sources never match Consul services, and only the wildcard of a
+	// trust domain matches the SPIFFE IDs of that trust domain.
+	if tester.SpiffeID != "" || against.SpiffeID != "" {
+		return tester.SpiffeID != "" && against.SpiffeID != "" &&
+			strings.HasPrefix(tester.SpiffeID, strings.TrimSuffix(against.SpiffeID, structs.WildcardSpecifier))
+	}
+
 	matchesAP := tester.PartitionOrDefault() == against.PartitionOrDefault()
 	matchesPeer := tester.Peer == against.Peer
 	matchesNS := tester.NamespaceOrDefault() == against.NamespaceOrDefault() || against.NamespaceOrDefault() == structs.WildcardSpecifier
@@ -921,6 +942,13 @@ func ixnSourceMatches(tester, against rbacService) bool {
 
 // countWild counts the number of wildcard values in the given namespace and name.
 func countWild(src rbacService) int {
+	if src.SpiffeID != "" {
+		if strings.HasSuffix(src.SpiffeID, "/"+structs.WildcardSpecifier) {
+			return 1
+		}
+		return 0
+	}
+
 	// If Partition is wildcard, panic because it's not supported
 	if src.PartitionOrDefault() == structs.WildcardSpecifier {
 		panic("invalid state: intention references wildcard partition")
@@ -1120,6 +1148,13 @@ func parseXFCCToDynamicMetaHTTPFilter() (*envoy_http_v3.HttpFilter, error) {
 }
 
 func makeSpiffePattern(src rbacService) string {
+	if src.SpiffeID != "" {
+		if prefix, ok := strings.CutSuffix(src.SpiffeID, "/"+structs.WildcardSpecifier); ok {
+			return fmt.Sprintf(`^%s/.+$`, regexp.QuoteMeta(prefix))
+		}
+		return fmt.Sprintf(`^%s$`, regexp.QuoteMeta(src.SpiffeID))
+	}
+
 	var (
 		host = src.TrustDomain
 		ap   = src.PartitionOrDefault()
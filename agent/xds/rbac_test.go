@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"sort"
+	"strings"
 	"testing"
 
 	envoy_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
@@ -485,6 +486,16 @@ func TestMakeRBACNetworkAndHTTPFilters(t *testing.T) {
 		ixn.SourcePeer = peer
 		return ixn
 	}
+	testIntentionSPIFFE := func(src string, action structs.IntentionAction) *structs.Intention {
+		ixn := structs.TestIntention(t)
+		ixn.SourceName = src
+		ixn.SourceType = structs.IntentionSourceSPIFFE
+		ixn.DestinationName = "api"
+		ixn.Action = action
+		//nolint:staticcheck
+		ixn.UpdatePrecedence()
+		return ixn
+	}
 	testSourcePermIntention := func(src string, perms ...*structs.IntentionPermission) *structs.Intention {
 		ixn := testIntention(t, src, "api", "")
 		ixn.Permissions = perms
@@ -824,6 +835,14 @@ func TestMakeRBACNetworkAndHTTPFilters(t *testing.T) {
 				testIntentionPeered("web", "peer1", structs.IntentionActionDeny),
 			),
 		},
+		"default-deny-spiffe-sources": {
+			intentionDefaultAllow: false,
+			v1Intentions: sorted(
+				testSourceIntention("web", structs.IntentionActionAllow),
+				testIntentionSPIFFE("spiffe://example.org/*", structs.IntentionActionAllow),
+				testIntentionSPIFFE("spiffe://example.org/ns/prod/sa/web", structs.IntentionActionDeny),
+			),
+		},
 		// ========================
 		"default-allow-path-allow": {
 			intentionDefaultAllow: true,
@@ -1545,6 +1564,13 @@ func TestIxnSourceMatches(t *testing.T) {
 		// name wildcards
 		{"web", "", "*", "", true},
 
+		// spiffe cmp spiffe
+		{"spiffe://example.org/web", "", "spiffe://example.org/*", "", true},
+		{"spiffe://example.org/web", "", "spiffe://other.org/*", "", false},
+		{"spiffe://example.org/*", "", "spiffe://example.org/web", "", false},
+		// spiffe never matches services
+		{"spiffe://example.org/web", "", "*", "", false},
+
 		// peered cmp peered
 		{"web", "peer1", "api", "peer1", false},
 		{"*", "peer1", "*", "peer1", false},
@@ -1558,14 +1584,21 @@ func TestIxnSourceMatches(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(fmt.Sprintf("%s%s cmp %s%s", tc.testerPeer, tc.tester, tc.againstPeer, tc.against), func(t *testing.T) {
 			matches := ixnSourceMatches(
-				rbacService{ServiceName: structs.ServiceNameFromString(tc.tester), Peer: tc.testerPeer},
-				rbacService{ServiceName: structs.ServiceNameFromString(tc.against), Peer: tc.againstPeer},
+				testRBACService(tc.tester, tc.testerPeer),
+				testRBACService(tc.against, tc.againstPeer),
 			)
 			assert.Equal(t, tc.matches, matches)
 		})
 	}
 }
 
+func testRBACService(name, peer string) rbacService {
+	if strings.HasPrefix(name, "spiffe://") {
+		return rbacService{ServiceName: structs.NewServiceName(name, nil), SpiffeID: name}
+	}
+	return rbacService{ServiceName: structs.ServiceNameFromString(name), Peer: peer}
+}
+
 func makeServiceNameSlice(slice []string) []rbacService {
 	if len(slice) == 0 {
 		return nil
@@ -483,6 +483,31 @@ func getConnectProxyDiscoChainTests(enterprise bool) []goldenTestCase {
 			},
 			alsoRunTestForV2: true,
 		},
+		{
+			name: "connect-proxy-with-revocation-lists",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				snap := proxycfg.TestConfigSnapshot(t, func(ns *structs.NodeService) {
+					// This test is only concerned about the validation context in the public listener
+					// so we empty out the upstreams to avoid generating unnecessary upstream listeners.
+					ns.Proxy.Upstreams = structs.Upstreams{}
+				}, nil)
+				// Replaced with a real revocation list by testcommon.SetupTLSRootsAndLeaf.
+				snap.Roots.RevocationLists = []string{"revocation-list"}
+				return snap
+			},
+		},
+		{
+			name: "connect-proxy-with-federated-trust-bundles",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return testConfigSnapshotFederatedTrustBundles(t, false)
+			},
+		},
+		{
+			name: "connect-proxy-with-federated-trust-bundles-and-revocation-lists",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return testConfigSnapshotFederatedTrustBundles(t, true)
+			},
+		},
 		{
 			name: "custom-upstream-default-chain",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
@@ -639,6 +664,36 @@ func getConnectProxyDiscoChainTests(enterprise bool) []goldenTestCase {
 			},
 			alsoRunTestForV2: true,
 		},
+		{
+			name: "connect-proxy-with-chain-and-router-mirror",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "chain-and-router-with-mirror", enterprise, nil, nil)
+			},
+		},
+		{
+			name: "connect-proxy-with-chain-and-router-fault",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "chain-and-router-with-fault", enterprise, nil, nil)
+			},
+		},
+		{
+			name: "connect-proxy-with-chain-and-router-redirect",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "chain-and-router-with-redirect", enterprise, nil, nil)
+			},
+		},
+		{
+			name: "connect-proxy-with-chain-and-router-hedging",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "chain-and-router-with-hedging", enterprise, nil, nil)
+			},
+		},
+		{
+			name: "connect-proxy-with-chain-and-router-session-affinity",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "chain-and-router-with-session-affinity", enterprise, nil, nil)
+			},
+		},
 		{
 			name: "connect-proxy-route-to-lb-resolver",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
@@ -653,6 +708,37 @@ func getConnectProxyDiscoChainTests(enterprise bool) []goldenTestCase {
 			},
 			alsoRunTestForV2: true,
 		},
+		{
+			name: "connect-proxy-resolver-with-limits",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "resolver-with-limits", enterprise, nil, nil)
+			},
+		},
+		{
+			name: "connect-proxy-resolver-with-limits-overridden-by-upstream",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "resolver-with-limits-overridden-by-upstream", enterprise, func(ns *structs.NodeService) {
+					for i := range ns.Proxy.Upstreams {
+						if ns.Proxy.Upstreams[i].DestinationName != "db" {
+							continue // only tweak the db upstream
+						}
+						ns.Proxy.Upstreams[i].Config = map[string]interface{}{
+							"limits": map[string]interface{}{
+								"max_connections": 10,
+							},
+						}
+					}
+				}, nil)
+			},
+		},
+		{
+			name: "connect-proxy-resolver-with-prioritize-by-locality",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "resolver-with-prioritize-by-locality", enterprise, func(ns *structs.NodeService) {
+					ns.Locality = &structs.Locality{Region: "us-east-1", Zone: "us-east-1a"}
+				}, nil)
+			},
+		},
 		{
 			name: "custom-upstream-ignored-with-disco-chain",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
@@ -1297,6 +1383,61 @@ func getAPIGatewayGoldenTestCases(t *testing.T) []goldenTestCase {
 			// TODO(proxystate): api gateways will come at a later date.
 			alsoRunTestForV2: false,
 		},
+		{
+			name: "api-gateway-with-http-route-session-affinity",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotAPIGateway(t, "default", nil, func(entry *structs.APIGatewayConfigEntry, bound *structs.BoundAPIGatewayConfigEntry) {
+					entry.Listeners = []structs.APIGatewayListener{
+						{
+							Name:     "listener",
+							Protocol: structs.ListenerProtocolHTTP,
+							Port:     8080,
+						},
+					}
+					bound.Listeners = []structs.BoundAPIGatewayListener{
+						{
+							Name: "listener",
+							Routes: []structs.ResourceReference{{
+								Kind: structs.HTTPRoute,
+								Name: "route",
+							}},
+						},
+					}
+				}, []structs.BoundRoute{
+					&structs.HTTPRouteConfigEntry{
+						Kind: structs.HTTPRoute,
+						Name: "route",
+						Rules: []structs.HTTPRouteRule{{
+							Services: []structs.HTTPService{{
+								Name: "service",
+								SessionAffinity: &structs.SessionAffinity{
+									Type: "header",
+									Name: "x-session",
+								},
+							}},
+						}},
+						Parents: []structs.ResourceReference{
+							{
+								Kind: structs.APIGateway,
+								Name: "api-gateway",
+							},
+						},
+					},
+				}, nil, []proxycfg.UpdateEvent{{
+					CorrelationID: "discovery-chain:" + serviceUID.String(),
+					Result: &structs.DiscoveryChainResponse{
+						Chain: serviceChain,
+					},
+				}, {
+					CorrelationID: "upstream-target:" + serviceChain.ID() + ":" + serviceUID.String(),
+					Result: &structs.IndexedCheckServiceNodes{
+						Nodes: proxycfg.TestUpstreamNodes(t, "service"),
+					},
+				}})
+			},
+			// TODO(proxystate): api gateways will come at a later date.
+			alsoRunTestForV2: false,
+		},
 		{
 			name: "api-gateway-with-http-route-timeoutfilter-one-set",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
@@ -1507,6 +1648,190 @@ func getAPIGatewayGoldenTestCases(t *testing.T) []goldenTestCase {
 				}, nil, nil)
 			},
 		},
+		{
+			name: "api-gateway-http-listener-with-acme-challenges",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotAPIGateway(t, "default", nil, func(entry *structs.APIGatewayConfigEntry, bound *structs.BoundAPIGatewayConfigEntry) {
+					entry.Listeners = []structs.APIGatewayListener{
+						{
+							Name:     "http",
+							Protocol: structs.ListenerProtocolHTTP,
+							Port:     8080,
+						},
+						{
+							Name:     "https",
+							Protocol: structs.ListenerProtocolHTTP,
+							Port:     8443,
+							TLS: structs.APIGatewayTLSConfiguration{
+								Certificates: []structs.ResourceReference{{
+									Kind: structs.ACMECertificate,
+									Name: "certificate",
+								}},
+							},
+						},
+					}
+					// The certificate is bound once it is issued, until then
+					// the plain HTTP listener answers the challenges.
+					bound.Listeners = []structs.BoundAPIGatewayListener{
+						{
+							Name: "http",
+							Routes: []structs.ResourceReference{
+								{
+									Name: "http-route",
+									Kind: structs.HTTPRoute,
+								},
+							},
+						},
+						{
+							Name: "https",
+							Routes: []structs.ResourceReference{
+								{
+									Name: "http-route",
+									Kind: structs.HTTPRoute,
+								},
+							},
+						},
+					}
+				}, []structs.BoundRoute{
+					&structs.HTTPRouteConfigEntry{
+						Name: "http-route",
+						Kind: structs.HTTPRoute,
+						Parents: []structs.ResourceReference{
+							{
+								Kind: structs.APIGateway,
+								Name: "api-gateway",
+							},
+						},
+						Hostnames: []string{"www.example.com"},
+						Rules: []structs.HTTPRouteRule{
+							{
+								Services: []structs.HTTPService{
+									{Name: "http-service"},
+								},
+							},
+						},
+					},
+				}, nil, []proxycfg.UpdateEvent{
+					proxycfg.TestACMECertificateUpdateEvent(&structs.ACMECertificateConfigEntry{
+						Kind:    structs.ACMECertificate,
+						Name:    "certificate",
+						Domains: []string{"example.com", "www.example.com"},
+						Challenges: []structs.ACMEChallenge{
+							{Domain: "example.com", Token: "token-0", KeyAuthorization: "token-0.thumbprint"},
+							{Domain: "www.example.com", Token: "token-1", KeyAuthorization: "token-1.thumbprint"},
+						},
+					}),
+				})
+			},
+		},
+		{
+			name: "api-gateway-http-listener-with-grpc-route",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotAPIGateway(t, "default", nil, func(entry *structs.APIGatewayConfigEntry, bound *structs.BoundAPIGatewayConfigEntry) {
+					entry.Listeners = []structs.APIGatewayListener{
+						{
+							Name:     "listener",
+							Protocol: structs.ListenerProtocolHTTP,
+							Port:     8080,
+						},
+					}
+					bound.Listeners = []structs.BoundAPIGatewayListener{
+						{
+							Name: "listener",
+							Routes: []structs.ResourceReference{
+								{
+									Name: "grpc-route",
+									Kind: structs.GRPCRoute,
+								},
+							},
+						},
+					}
+				}, []structs.BoundRoute{
+					&structs.GRPCRouteConfigEntry{
+						Name: "grpc-route",
+						Kind: structs.GRPCRoute,
+						Parents: []structs.ResourceReference{
+							{
+								Kind: structs.APIGateway,
+								Name: "api-gateway",
+							},
+						},
+						Rules: []structs.GRPCRouteRule{
+							{
+								Matches: []structs.GRPCMatch{
+									{
+										Method: structs.GRPCMethodMatch{
+											Match:   structs.GRPCMethodMatchExact,
+											Service: "helloworld.Greeter",
+										},
+									},
+								},
+								Services: []structs.GRPCService{
+									{Name: "grpc-service"},
+								},
+							},
+						},
+					},
+				}, nil, nil)
+			},
+		},
+		{
+			name: "api-gateway-tls-listener-with-tls-routes",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotAPIGateway(t, "default", nil, func(entry *structs.APIGatewayConfigEntry, bound *structs.BoundAPIGatewayConfigEntry) {
+					entry.Listeners = []structs.APIGatewayListener{
+						{
+							Name:     "listener",
+							Protocol: structs.ListenerProtocolTLS,
+							Port:     8443,
+						},
+					}
+					bound.Listeners = []structs.BoundAPIGatewayListener{
+						{
+							Name: "listener",
+							Routes: []structs.ResourceReference{
+								{
+									Name: "tls-route-1",
+									Kind: structs.TLSRoute,
+								},
+								{
+									Name: "tls-route-2",
+									Kind: structs.TLSRoute,
+								},
+							},
+						},
+					}
+				}, []structs.BoundRoute{
+					&structs.TLSRouteConfigEntry{
+						Name: "tls-route-1",
+						Kind: structs.TLSRoute,
+						Parents: []structs.ResourceReference{
+							{
+								Kind: structs.APIGateway,
+								Name: "api-gateway",
+							},
+						},
+						Hostnames: []string{"foo.example.com"},
+						Services: []structs.TCPService{
+							{Name: "tls-service-1"},
+						},
+					},
+					&structs.TLSRouteConfigEntry{
+						Name: "tls-route-2",
+						Kind: structs.TLSRoute,
+						Parents: []structs.ResourceReference{
+							{
+								Kind: structs.APIGateway,
+								Name: "api-gateway",
+							},
+						},
+						Services: []structs.TCPService{
+							{Name: "tls-service-2"},
+						},
+					},
+				}, nil, nil)
+			},
+		},
 		{
 			name: "api-gateway-tcp-listener-with-tcp-and-http-route",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
@@ -2095,6 +2420,17 @@ func getTerminatingGatewayPeeringGoldenTestCases() []goldenTestCase {
 			// TODO(proxystate): terminating gateway will come at a later time
 			alsoRunTestForV2: false,
 		},
+		{
+			name: "terminating-gateway-with-revocation-lists",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				snap := proxycfg.TestConfigSnapshotTerminatingGateway(t, true, nil, nil)
+				// Replaced with a real revocation list by testcommon.SetupTLSRootsAndLeaf.
+				snap.Roots.RevocationLists = []string{"revocation-list"}
+				return snap
+			},
+			// TODO(proxystate): terminating gateway will come at a later time
+			alsoRunTestForV2: false,
+		},
 		{
 			name: "terminating-gateway-no-services",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
@@ -2712,6 +3048,15 @@ func getIngressGatewayGoldenTestCases() []goldenTestCase {
 			// TODO(proxystate): ingress gateway will come at a later time
 			alsoRunTestForV2: false,
 		},
+		{
+			name: "ingress-with-chain-and-router-fault",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotIngressGateway(t, true, "http",
+					"chain-and-router-with-fault", nil, nil, nil)
+			},
+			// TODO(proxystate): ingress gateway will come at a later time
+			alsoRunTestForV2: false,
+		},
 		{
 			name:   "ingress-http-multiple-services",
 			create: proxycfg.TestConfigSnapshotIngress_HTTPMultipleServices,
@@ -3274,3 +3619,53 @@ func getXDSFetchTimeoutTestCases() []goldenTestCase {
 		},
 	}
 }
+
+// testConfigSnapshotFederatedTrustBundles returns a snapshot of a connect
+// proxy that allows workloads of the federated trust domain example.org to
+// connect. The bundle of example.net is not referenced by any intention.
+// Without revocation lists the proxy is also exported to peers.
+func testConfigSnapshotFederatedTrustBundles(t testinf.T, revocationLists bool) *proxycfg.ConfigSnapshot {
+	peerBundles := proxycfg.TestPeerTrustBundles(t)
+	events := []proxycfg.UpdateEvent{
+		{
+			CorrelationID: "intentions",
+			Result: structs.SimplifiedIntentions{
+				{
+					SourceType:           structs.IntentionSourceSPIFFE,
+					SourceName:           "spiffe://example.org/*",
+					DestinationName:      "web",
+					DestinationPartition: "default",
+					Action:               structs.IntentionActionAllow,
+				},
+			},
+		},
+	}
+	if !revocationLists {
+		events = append(events, proxycfg.UpdateEvent{
+			CorrelationID: "peering-trust-bundles",
+			Result:        peerBundles,
+		})
+	}
+	snap := proxycfg.TestConfigSnapshot(t, func(ns *structs.NodeService) {
+		// This test is only concerned about the SPIFFE cert validator config in the public listener
+		// so we empty out the upstreams to avoid generating unnecessary upstream listeners.
+		ns.Proxy.Upstreams = structs.Upstreams{}
+	}, events)
+	snap.ConnectProxy.FederatedTrustBundles = []*structs.TrustBundleConfigEntry{
+		{
+			Kind:     structs.TrustBundle,
+			Name:     "example.org",
+			RootPEMs: peerBundles.Bundles[0].RootPEMs,
+		},
+		{
+			Kind:     structs.TrustBundle,
+			Name:     "example.net",
+			RootPEMs: peerBundles.Bundles[1].RootPEMs,
+		},
+	}
+	if revocationLists {
+		// Replaced with a real revocation list by testcommon.SetupTLSRootsAndLeaf.
+		snap.Roots.RevocationLists = []string{"revocation-list"}
+	}
+	return snap
+}
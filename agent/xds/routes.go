@@ -13,7 +13,14 @@ import (
 
 	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_common_fault_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/common/fault/v3"
+	envoy_fault_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	envoy_stateful_session_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/stateful_session/v3"
+	envoy_session_cookie_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/stateful_session/cookie/v3"
+	envoy_session_header_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/stateful_session/header/v3"
+	envoy_http_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/http/v3"
 	envoy_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 
@@ -24,6 +31,7 @@ import (
 	"github.com/hashicorp/consul/agent/xds/config"
 	"github.com/hashicorp/consul/agent/xds/response"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -447,6 +455,8 @@ func (s *ResourceGenerator) routesForAPIGateway(cfgSnap *proxycfg.ConfigSnapshot
 	listenerNames := maps.Keys(readyListeners)
 	sort.Strings(listenerNames)
 
+	challengeRoutes, challengeDomains := makeACMEChallengeRoutes(cfgSnap)
+
 	// Iterate over all listeners that are ready and configure their routes.
 	for _, listenerName := range listenerNames {
 		readyListener, ok := readyListeners[listenerName]
@@ -467,13 +477,9 @@ func (s *ResourceGenerator) routesForAPIGateway(cfgSnap *proxycfg.ConfigSnapshot
 		}
 
 		// Consolidate all routes for this listener into the minimum possible set based on hostname matching.
-		allRoutesForListener := []*structs.HTTPRouteConfigEntry{}
-		for _, routeRef := range maps.Keys(readyListener.routeReferences) {
-			route, ok := cfgSnap.APIGateway.HTTPRoutes.Get(routeRef)
-			if !ok {
-				return nil, fmt.Errorf("missing route for route routeRef %s:%s", routeRef.Name, routeRef.Kind)
-			}
-			allRoutesForListener = append(allRoutesForListener, route)
+		allRoutesForListener, err := httpRoutesForListener(cfgSnap, readyListener)
+		if err != nil {
+			return nil, err
 		}
 		consolidatedRoutes := discoverychain.ConsolidateHTTPRoutes(cfgSnap.APIGateway.GatewayConfig, &readyListener.listenerCfg, allRoutesForListener...)
 
@@ -501,6 +507,12 @@ func (s *ResourceGenerator) routesForAPIGateway(cfgSnap *proxycfg.ConfigSnapshot
 			listenerRoute.VirtualHosts = append(listenerRoute.VirtualHosts, virtualHost)
 		}
 
+		// HTTP-01 challenges are answered over plain HTTP, so only listeners
+		// without TLS serve them.
+		if len(challengeRoutes) > 0 && readyListener.listenerCfg.TLS.IsEmpty() {
+			addACMEChallengeRoutes(listenerRoute, challengeRoutes, challengeDomains)
+		}
+
 		if len(listenerRoute.VirtualHosts) > 0 {
 			// Build up the virtual hosts in a deterministic way
 			slices.SortStableFunc(listenerRoute.VirtualHosts, func(a, b *envoy_route_v3.VirtualHost) int {
@@ -516,6 +528,89 @@ func (s *ResourceGenerator) routesForAPIGateway(cfgSnap *proxycfg.ConfigSnapshot
 	return result, nil
 }
 
+// makeACMEChallengeRoutes returns the routes answering the pending HTTP-01
+// challenges of the acme-certificates referenced by the gateway, along with
+// the domains being validated.
+func makeACMEChallengeRoutes(cfgSnap *proxycfg.ConfigSnapshot) ([]*envoy_route_v3.Route, []string) {
+	var refs []structs.ResourceReference
+	cfgSnap.APIGateway.ACMECertificates.ForEachKey(func(ref structs.ResourceReference) bool {
+		refs = append(refs, ref)
+		return true
+	})
+	sort.Slice(refs, func(i, j int) bool {
+		return refs[i].String() < refs[j].String()
+	})
+
+	var (
+		routes  []*envoy_route_v3.Route
+		domains []string
+		seen    = make(map[string]struct{})
+	)
+	for _, ref := range refs {
+		cert, ok := cfgSnap.APIGateway.ACMECertificates.Get(ref)
+		if !ok {
+			continue
+		}
+		for _, challenge := range cert.Challenges {
+			routes = append(routes, &envoy_route_v3.Route{
+				Match: &envoy_route_v3.RouteMatch{
+					PathSpecifier: &envoy_route_v3.RouteMatch_Path{
+						Path: structs.ACMEChallengePath(challenge.Token),
+					},
+				},
+				Action: &envoy_route_v3.Route_DirectResponse{
+					DirectResponse: &envoy_route_v3.DirectResponseAction{
+						Status: 200,
+						Body: &envoy_core_v3.DataSource{
+							Specifier: &envoy_core_v3.DataSource_InlineString{InlineString: challenge.KeyAuthorization},
+						},
+					},
+				},
+			})
+			if _, ok := seen[challenge.Domain]; !ok {
+				seen[challenge.Domain] = struct{}{}
+				domains = append(domains, challenge.Domain)
+			}
+		}
+	}
+	return routes, domains
+}
+
+// addACMEChallengeRoutes adds the given HTTP-01 challenge routes ahead of
+// the routes of every virtual host of the route configuration, and adds a
+// virtual host for the validated domains that no virtual host matches.
+func addACMEChallengeRoutes(config *envoy_route_v3.RouteConfiguration, routes []*envoy_route_v3.Route, domains []string) {
+	var patterns []string
+	for _, virtualHost := range config.VirtualHosts {
+		virtualHost.Routes = append(slices.Clone(routes), virtualHost.Routes...)
+		patterns = append(patterns, virtualHost.Domains...)
+	}
+
+	// A virtual host for a domain that is already matched by a wildcard
+	// would take precedence over it, and break the routes of the domain.
+	var unlisted []string
+	for _, domain := range domains {
+		matched := false
+		for _, pattern := range patterns {
+			if pattern == "*" || pattern == domain ||
+				(strings.HasPrefix(pattern, "*.") && strings.HasSuffix(domain, pattern[1:])) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			unlisted = append(unlisted, domain)
+		}
+	}
+	if len(unlisted) > 0 {
+		config.VirtualHosts = append(config.VirtualHosts, &envoy_route_v3.VirtualHost{
+			Name:    "acme-challenges",
+			Domains: unlisted,
+			Routes:  slices.Clone(routes),
+		})
+	}
+}
+
 func buildHTTPRouteUpstream(route structs.HTTPRouteConfigEntry, listener structs.APIGatewayListener) structs.Upstream {
 	return structs.Upstream{
 		DestinationName:      route.GetName(),
@@ -652,6 +747,16 @@ func (s *ResourceGenerator) makeUpstreamRouteForDiscoveryChain(
 			discoveryRoute := discoveryRoute
 			routeMatch := makeRouteMatchForDiscoveryRoute(discoveryRoute)
 
+			// Redirects and direct responses are answered by Envoy itself, so
+			// there is no upstream to forward the request to.
+			if route := makeNonForwardingRoute(routeMatch, discoveryRoute.Definition); route != nil {
+				if err := injectFaultToRoute(discoveryRoute.Definition.Fault, route); err != nil {
+					return nil, err
+				}
+				routes = append(routes, route)
+				continue
+			}
+
 			var (
 				routeAction *envoy_route_v3.Route_Route
 				err         error
@@ -717,6 +822,16 @@ func (s *ResourceGenerator) makeUpstreamRouteForDiscoveryChain(
 					routeAction.Route.RetryPolicy = getRetryPolicyForDestination(destination)
 				}
 
+				if destination.HedgePolicy != nil {
+					routeAction.Route.HedgePolicy = getHedgePolicyForDestination(destination)
+				}
+
+				if discoveryRoute.MirrorNode != "" {
+					if policy := s.makeRequestMirrorPolicy(upstreamsSnapshot, discoveryRoute, chain, forMeshGateway); policy != nil {
+						routeAction.Route.RequestMirrorPolicies = []*envoy_route_v3.RouteAction_RequestMirrorPolicy{policy}
+					}
+				}
+
 				if err := injectHeaderManipToRoute(destination, route); err != nil {
 					return nil, fmt.Errorf("failed to apply header manipulation configuration to route: %v", err)
 				}
@@ -726,10 +841,19 @@ func (s *ResourceGenerator) makeUpstreamRouteForDiscoveryChain(
 			if err != nil {
 				return nil, err
 			}
+
 			route.Match = routeMatch
 			route.Action = routeAction
 			route.TypedPerFilterConfig = filter
 
+			if err := injectFaultToRoute(discoveryRoute.Definition.Fault, route); err != nil {
+				return nil, err
+			}
+
+			if err := injectSessionAffinityToRoute(sessionAffinityForRoute(discoveryRoute, nextNode), route); err != nil {
+				return nil, err
+			}
+
 			routes = append(routes, route)
 		}
 
@@ -782,6 +906,9 @@ func (s *ResourceGenerator) makeUpstreamRouteForDiscoveryChain(
 			Match:  makeDefaultRouteMatch(),
 			Action: routeAction,
 		}
+		if err := injectSessionAffinityToRoute(sessionAffinityForNode(startNode), defaultRoute); err != nil {
+			return nil, err
+		}
 
 		routes = []*envoy_route_v3.Route{defaultRoute}
 
@@ -832,9 +959,337 @@ func getRetryPolicyForDestination(destination *structs.ServiceRouteDestination)
 
 	retryPolicy.RetryOn = strings.Join(retryStrings, ",")
 
+	if destination.PerTryTimeout > 0 {
+		retryPolicy.PerTryTimeout = durationpb.New(destination.PerTryTimeout)
+	}
+
 	return retryPolicy
 }
 
+func getHedgePolicyForDestination(destination *structs.ServiceRouteDestination) *envoy_route_v3.HedgePolicy {
+	hedgePolicy := &envoy_route_v3.HedgePolicy{
+		HedgeOnPerTryTimeout: destination.HedgePolicy.HedgeOnPerTryTimeout,
+	}
+	if destination.HedgePolicy.InitialRequests > 0 {
+		hedgePolicy.InitialRequests = response.MakeUint32Value(int(destination.HedgePolicy.InitialRequests))
+	}
+	return hedgePolicy
+}
+
+// makeRequestMirrorPolicy returns the policy shadowing the traffic of a
+// route to its mirror cluster, or nil if that cluster is not available yet.
+func (s *ResourceGenerator) makeRequestMirrorPolicy(
+	upstreamsSnapshot *proxycfg.ConfigSnapshotUpstreams,
+	discoveryRoute *structs.DiscoveryRoute,
+	chain *structs.CompiledDiscoveryChain,
+	forMeshGateway bool,
+) *envoy_route_v3.RouteAction_RequestMirrorPolicy {
+	mirrorNode := chain.Nodes[discoveryRoute.MirrorNode]
+	if mirrorNode == nil || mirrorNode.Type != structs.DiscoveryGraphNodeTypeResolver {
+		return nil
+	}
+
+	clusterName := s.getTargetClusterName(upstreamsSnapshot, chain, mirrorNode.Resolver.Target, forMeshGateway)
+	if clusterName == "" {
+		return nil
+	}
+
+	policy := &envoy_route_v3.RouteAction_RequestMirrorPolicy{
+		Cluster: clusterName,
+	}
+
+	// The smallest representable percentage is 1/10000 or .01% but envoy
+	// deals with integers so scale everything up by 100x.
+	mirror := discoveryRoute.Definition.Destination.Mirror
+	if p := mirror.Percent; p != nil && *p < 100 {
+		policy.RuntimeFraction = &envoy_core_v3.RuntimeFractionalPercent{
+			DefaultValue: &envoy_type_v3.FractionalPercent{
+				Numerator:   uint32(*p * 100),
+				Denominator: envoy_type_v3.FractionalPercent_TEN_THOUSAND,
+			},
+		}
+	}
+	return policy
+}
+
+const faultFilterName = "envoy.filters.http.fault"
+
+// injectFaultToRoute enables the fault filter for the route when the service
+// router configures fault injection for it.
+func injectFaultToRoute(fault *structs.ServiceRouteFault, route *envoy_route_v3.Route) error {
+	if fault == nil {
+		return nil
+	}
+	faultCfg, err := makeHTTPFaultPerRouteConfig(fault)
+	if err != nil {
+		return fmt.Errorf("failed to apply fault injection configuration to route: %v", err)
+	}
+	if route.TypedPerFilterConfig == nil {
+		route.TypedPerFilterConfig = make(map[string]*anypb.Any)
+	}
+	route.TypedPerFilterConfig[faultFilterName] = faultCfg
+	return nil
+}
+
+const statefulSessionFilterName = "envoy.filters.http.stateful_session"
+
+// injectSessionAffinityToRoute enables the stateful session filter for the
+// route when its destination configures session affinity.
+func injectSessionAffinityToRoute(affinity *structs.SessionAffinity, route *envoy_route_v3.Route) error {
+	if affinity == nil {
+		return nil
+	}
+	sessionCfg, err := makeStatefulSessionPerRouteConfig(affinity)
+	if err != nil {
+		return fmt.Errorf("failed to apply session affinity configuration to route: %v", err)
+	}
+	if route.TypedPerFilterConfig == nil {
+		route.TypedPerFilterConfig = make(map[string]*anypb.Any)
+	}
+	route.TypedPerFilterConfig[statefulSessionFilterName] = sessionCfg
+	return nil
+}
+
+// makeStatefulSessionPerRouteConfig returns the configuration of the stateful
+// session filter overriding the disabled one installed on the listener for a
+// single route.
+func makeStatefulSessionPerRouteConfig(affinity *structs.SessionAffinity) (*anypb.Any, error) {
+	var (
+		stateName string
+		state     proto.Message
+	)
+	switch affinity.Type {
+	case structs.SessionAffinityCookie:
+		cookie := &envoy_http_type_v3.Cookie{Name: affinity.Name}
+		if cfg := affinity.CookieConfig; cfg != nil {
+			cookie.Path = cfg.Path
+			if cfg.TTL > 0 {
+				cookie.Ttl = durationpb.New(cfg.TTL)
+			}
+		}
+		stateName = "envoy.http.stateful_session.cookie"
+		state = &envoy_session_cookie_v3.CookieBasedSessionState{Cookie: cookie}
+	case structs.SessionAffinityHeader:
+		stateName = "envoy.http.stateful_session.header"
+		state = &envoy_session_header_v3.HeaderBasedSessionState{Name: affinity.Name}
+	default:
+		return nil, fmt.Errorf("unsupported session affinity type %q", affinity.Type)
+	}
+
+	stateCfg, err := anypb.New(state)
+	if err != nil {
+		return nil, err
+	}
+	return anypb.New(&envoy_stateful_session_v3.StatefulSessionPerRoute{
+		Override: &envoy_stateful_session_v3.StatefulSessionPerRoute_StatefulSession{
+			StatefulSession: &envoy_stateful_session_v3.StatefulSession{
+				SessionState: &envoy_core_v3.TypedExtensionConfig{
+					Name:        stateName,
+					TypedConfig: stateCfg,
+				},
+				Strict: affinity.Strict,
+			},
+		},
+	})
+}
+
+// sessionAffinityForRoute returns the session affinity of the requests
+// matching a route. The affinity of the route destination takes precedence
+// over the one of the service-resolver the route leads to.
+func sessionAffinityForRoute(discoveryRoute *structs.DiscoveryRoute, nextNode *structs.DiscoveryGraphNode) *structs.SessionAffinity {
+	if dest := discoveryRoute.Definition.Destination; dest != nil && dest.SessionAffinity != nil {
+		return dest.SessionAffinity
+	}
+	return sessionAffinityForNode(nextNode)
+}
+
+// sessionAffinityForNode returns the session affinity configured on the
+// service-resolver of a node. Splits are balanced across their services
+// before an instance is picked, so they cannot pin a session.
+func sessionAffinityForNode(node *structs.DiscoveryGraphNode) *structs.SessionAffinity {
+	if node == nil || !node.IsResolver() || node.LoadBalancer == nil {
+		return nil
+	}
+	return node.LoadBalancer.SessionAffinity
+}
+
+// chainHasSessionAffinity reports whether any route of the chain pins client
+// sessions, in which case the stateful session filter must be installed on
+// the listener.
+func chainHasSessionAffinity(chain *structs.CompiledDiscoveryChain) bool {
+	startNode := chain.Nodes[chain.StartNode]
+	if startNode == nil {
+		return false
+	}
+	if !startNode.IsRouter() {
+		return sessionAffinityForNode(startNode) != nil
+	}
+	for _, route := range startNode.Routes {
+		if route.Definition == nil {
+			continue
+		}
+		if sessionAffinityForRoute(route, chain.Nodes[route.NextNode]) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// upstreamsHaveSessionAffinity reports whether the discovery chain of any of
+// the upstreams sharing a gateway listener pins client sessions.
+func upstreamsHaveSessionAffinity(upstreams []structs.Upstream, chains map[proxycfg.UpstreamID]*structs.CompiledDiscoveryChain) bool {
+	for _, u := range upstreams {
+		if chain := chains[proxycfg.NewUpstreamID(&u)]; chain != nil && chainHasSessionAffinity(chain) {
+			return true
+		}
+	}
+	return false
+}
+
+// makeNonForwardingRoute returns a route answering the matching requests with
+// a redirect or a direct response, or nil if the requests matching the route
+// are forwarded to an upstream.
+func makeNonForwardingRoute(match *envoy_route_v3.RouteMatch, definition *structs.ServiceRoute) *envoy_route_v3.Route {
+	switch {
+	case definition.Redirect != nil:
+		return &envoy_route_v3.Route{
+			Match:  match,
+			Action: &envoy_route_v3.Route_Redirect{Redirect: makeRedirectAction(definition.Redirect)},
+		}
+	case definition.DirectResponse != nil:
+		action := &envoy_route_v3.DirectResponseAction{
+			Status: definition.DirectResponse.Status,
+		}
+		if definition.DirectResponse.Body != "" {
+			action.Body = &envoy_core_v3.DataSource{
+				Specifier: &envoy_core_v3.DataSource_InlineString{InlineString: definition.DirectResponse.Body},
+			}
+		}
+		return &envoy_route_v3.Route{
+			Match:  match,
+			Action: &envoy_route_v3.Route_DirectResponse{DirectResponse: action},
+		}
+	default:
+		return nil
+	}
+}
+
+func makeRedirectAction(redirect *structs.ServiceRouteRedirect) *envoy_route_v3.RedirectAction {
+	action := &envoy_route_v3.RedirectAction{
+		HostRedirect: redirect.Host,
+		PortRedirect: redirect.Port,
+		StripQuery:   redirect.StripQuery,
+	}
+
+	if redirect.Scheme != "" {
+		action.SchemeRewriteSpecifier = &envoy_route_v3.RedirectAction_SchemeRedirect{SchemeRedirect: redirect.Scheme}
+	}
+
+	switch {
+	case redirect.Path != "":
+		action.PathRewriteSpecifier = &envoy_route_v3.RedirectAction_PathRedirect{PathRedirect: redirect.Path}
+	case redirect.PrefixRewrite != "":
+		action.PathRewriteSpecifier = &envoy_route_v3.RedirectAction_PrefixRewrite{PrefixRewrite: redirect.PrefixRewrite}
+	}
+
+	switch redirect.StatusCode {
+	case 302:
+		action.ResponseCode = envoy_route_v3.RedirectAction_FOUND
+	case 303:
+		action.ResponseCode = envoy_route_v3.RedirectAction_SEE_OTHER
+	case 307:
+		action.ResponseCode = envoy_route_v3.RedirectAction_TEMPORARY_REDIRECT
+	case 308:
+		action.ResponseCode = envoy_route_v3.RedirectAction_PERMANENT_REDIRECT
+	default:
+		action.ResponseCode = envoy_route_v3.RedirectAction_MOVED_PERMANENTLY
+	}
+
+	return action
+}
+
+// makeHTTPFaultPerRouteConfig returns the configuration of the fault filter
+// overriding the inactive one installed on the listener for a single route.
+func makeHTTPFaultPerRouteConfig(fault *structs.ServiceRouteFault) (*anypb.Any, error) {
+	cfg := &envoy_fault_v3.HTTPFault{}
+
+	if fault.Delay != nil {
+		cfg.Delay = &envoy_common_fault_v3.FaultDelay{
+			FaultDelaySecifier: &envoy_common_fault_v3.FaultDelay_FixedDelay{
+				FixedDelay: durationpb.New(fault.Delay.FixedDelay),
+			},
+			Percentage: makeFaultPercentage(fault.Delay.Percent),
+		}
+	}
+
+	if fault.Abort != nil {
+		cfg.Abort = &envoy_fault_v3.FaultAbort{
+			Percentage: makeFaultPercentage(fault.Abort.Percent),
+		}
+		if fault.Abort.GRPCStatus != 0 {
+			cfg.Abort.ErrorType = &envoy_fault_v3.FaultAbort_GrpcStatus{
+				GrpcStatus: fault.Abort.GRPCStatus,
+			}
+		} else {
+			cfg.Abort.ErrorType = &envoy_fault_v3.FaultAbort_HttpStatus{
+				HttpStatus: fault.Abort.HTTPStatus,
+			}
+		}
+	}
+
+	for _, hdr := range fault.Header {
+		if eh := makeHeaderMatcherForRouteMatch(hdr); eh != nil {
+			cfg.Headers = append(cfg.Headers, eh)
+		}
+	}
+
+	return anypb.New(cfg)
+}
+
+// makeFaultPercentage converts the percentage of a fault, where zero means
+// that all requests are affected.
+func makeFaultPercentage(percent float32) *envoy_type_v3.FractionalPercent {
+	if percent <= 0 || percent >= 100 {
+		return &envoy_type_v3.FractionalPercent{
+			Numerator:   100,
+			Denominator: envoy_type_v3.FractionalPercent_HUNDRED,
+		}
+	}
+
+	// The smallest representable percentage is 1/10000 or .01% but envoy
+	// deals with integers so scale everything up by 100x.
+	return &envoy_type_v3.FractionalPercent{
+		Numerator:   uint32(percent * 100),
+		Denominator: envoy_type_v3.FractionalPercent_TEN_THOUSAND,
+	}
+}
+
+// chainHasFaultInjection reports whether any route of the chain injects
+// faults, in which case the fault filter must be installed on the listener.
+func chainHasFaultInjection(chain *structs.CompiledDiscoveryChain) bool {
+	startNode := chain.Nodes[chain.StartNode]
+	if startNode == nil || startNode.Type != structs.DiscoveryGraphNodeTypeRouter {
+		return false
+	}
+	for _, route := range startNode.Routes {
+		if route.Definition != nil && route.Definition.Fault != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// upstreamsHaveFaultInjection reports whether the discovery chain of any of
+// the upstreams sharing a gateway listener injects faults.
+func upstreamsHaveFaultInjection(upstreams []structs.Upstream, chains map[proxycfg.UpstreamID]*structs.CompiledDiscoveryChain) bool {
+	for _, u := range upstreams {
+		if chain := chains[proxycfg.NewUpstreamID(&u)]; chain != nil && chainHasFaultInjection(chain) {
+			return true
+		}
+	}
+	return false
+}
+
 func makeRouteMatchForDiscoveryRoute(discoveryRoute *structs.DiscoveryRoute) *envoy_route_v3.RouteMatch {
 	match := discoveryRoute.Definition.Match
 	if match == nil || match.IsEmpty() {
@@ -869,63 +1324,9 @@ func makeRouteMatchForDiscoveryRoute(discoveryRoute *structs.DiscoveryRoute) *en
 	if len(match.HTTP.Header) > 0 {
 		em.Headers = make([]*envoy_route_v3.HeaderMatcher, 0, len(match.HTTP.Header))
 		for _, hdr := range match.HTTP.Header {
-			eh := &envoy_route_v3.HeaderMatcher{
-				Name: hdr.Name,
-			}
-
-			switch {
-			case hdr.Exact != "":
-				eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_StringMatch{
-					StringMatch: &envoy_matcher_v3.StringMatcher{
-						MatchPattern: &envoy_matcher_v3.StringMatcher_Exact{
-							Exact: hdr.Exact,
-						},
-						IgnoreCase: false,
-					},
-				}
-			case hdr.Regex != "":
-				eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_StringMatch{
-					StringMatch: &envoy_matcher_v3.StringMatcher{
-						MatchPattern: &envoy_matcher_v3.StringMatcher_SafeRegex{
-							SafeRegex: response.MakeEnvoyRegexMatch(hdr.Regex),
-						},
-						IgnoreCase: false,
-					},
-				}
-
-			case hdr.Prefix != "":
-				eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_StringMatch{
-					StringMatch: &envoy_matcher_v3.StringMatcher{
-						MatchPattern: &envoy_matcher_v3.StringMatcher_Prefix{
-							Prefix: hdr.Prefix,
-						},
-						IgnoreCase: false,
-					},
-				}
-
-			case hdr.Suffix != "":
-				eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_StringMatch{
-					StringMatch: &envoy_matcher_v3.StringMatcher{
-						MatchPattern: &envoy_matcher_v3.StringMatcher_Suffix{
-							Suffix: hdr.Suffix,
-						},
-						IgnoreCase: false,
-					},
-				}
-
-			case hdr.Present:
-				eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_PresentMatch{
-					PresentMatch: true,
-				}
-			default:
-				continue // skip this impossible situation
+			if eh := makeHeaderMatcherForRouteMatch(hdr); eh != nil {
+				em.Headers = append(em.Headers, eh)
 			}
-
-			if hdr.Invert {
-				eh.InvertMatch = true
-			}
-
-			em.Headers = append(em.Headers, eh)
 		}
 	}
 
@@ -985,6 +1386,66 @@ func makeRouteMatchForDiscoveryRoute(discoveryRoute *structs.DiscoveryRoute) *en
 	return em
 }
 
+func makeHeaderMatcherForRouteMatch(hdr structs.ServiceRouteHTTPMatchHeader) *envoy_route_v3.HeaderMatcher {
+	eh := &envoy_route_v3.HeaderMatcher{
+		Name: hdr.Name,
+	}
+
+	switch {
+	case hdr.Exact != "":
+		eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_StringMatch{
+			StringMatch: &envoy_matcher_v3.StringMatcher{
+				MatchPattern: &envoy_matcher_v3.StringMatcher_Exact{
+					Exact: hdr.Exact,
+				},
+				IgnoreCase: false,
+			},
+		}
+	case hdr.Regex != "":
+		eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_StringMatch{
+			StringMatch: &envoy_matcher_v3.StringMatcher{
+				MatchPattern: &envoy_matcher_v3.StringMatcher_SafeRegex{
+					SafeRegex: response.MakeEnvoyRegexMatch(hdr.Regex),
+				},
+				IgnoreCase: false,
+			},
+		}
+
+	case hdr.Prefix != "":
+		eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_StringMatch{
+			StringMatch: &envoy_matcher_v3.StringMatcher{
+				MatchPattern: &envoy_matcher_v3.StringMatcher_Prefix{
+					Prefix: hdr.Prefix,
+				},
+				IgnoreCase: false,
+			},
+		}
+
+	case hdr.Suffix != "":
+		eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_StringMatch{
+			StringMatch: &envoy_matcher_v3.StringMatcher{
+				MatchPattern: &envoy_matcher_v3.StringMatcher_Suffix{
+					Suffix: hdr.Suffix,
+				},
+				IgnoreCase: false,
+			},
+		}
+
+	case hdr.Present:
+		eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_PresentMatch{
+			PresentMatch: true,
+		}
+	default:
+		return nil // skip this impossible situation
+	}
+
+	if hdr.Invert {
+		eh.InvertMatch = true
+	}
+
+	return eh
+}
+
 func makeDefaultRouteMatch() *envoy_route_v3.RouteMatch {
 	return &envoy_route_v3.RouteMatch{
 		PathSpecifier: &envoy_route_v3.RouteMatch_Prefix{
@@ -280,3 +280,40 @@ func TestEnvoyLBConfig_InjectToRouteAction(t *testing.T) {
 		})
 	}
 }
+
+func TestAddACMEChallengeRoutes(t *testing.T) {
+	challenge := &envoy_route_v3.Route{Match: &envoy_route_v3.RouteMatch{
+		PathSpecifier: &envoy_route_v3.RouteMatch_Path{Path: structs.ACMEChallengePath("token")},
+	}}
+	routes := []*envoy_route_v3.Route{challenge}
+	domains := []string{"example.com", "www.example.com", "api.example.org"}
+
+	existing := &envoy_route_v3.Route{Match: &envoy_route_v3.RouteMatch{
+		PathSpecifier: &envoy_route_v3.RouteMatch_Prefix{Prefix: "/"},
+	}}
+	config := &envoy_route_v3.RouteConfiguration{
+		VirtualHosts: []*envoy_route_v3.VirtualHost{
+			{Name: "example", Domains: []string{"example.com", "example.com:80"}, Routes: []*envoy_route_v3.Route{existing}},
+			{Name: "wildcard", Domains: []string{"*.example.com"}, Routes: []*envoy_route_v3.Route{existing}},
+		},
+	}
+	addACMEChallengeRoutes(config, routes, domains)
+
+	// The challenges are answered ahead of the routes of every virtual host,
+	// and by a dedicated virtual host for the domains no virtual host matches.
+	require.Len(t, config.VirtualHosts, 3)
+	for _, virtualHost := range config.VirtualHosts[:2] {
+		require.Equal(t, []*envoy_route_v3.Route{challenge, existing}, virtualHost.Routes)
+	}
+	require.Equal(t, "acme-challenges", config.VirtualHosts[2].Name)
+	require.Equal(t, []string{"api.example.org"}, config.VirtualHosts[2].Domains)
+	require.Equal(t, routes, config.VirtualHosts[2].Routes)
+
+	// A catch-all virtual host answers the challenges of all domains.
+	config = &envoy_route_v3.RouteConfiguration{
+		VirtualHosts: []*envoy_route_v3.VirtualHost{{Name: "all", Domains: []string{"*"}}},
+	}
+	addACMEChallengeRoutes(config, routes, domains)
+	require.Len(t, config.VirtualHosts, 1)
+	require.Equal(t, routes, config.VirtualHosts[0].Routes)
+}
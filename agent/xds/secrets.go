@@ -6,6 +6,7 @@ package xds
 import (
 	"errors"
 	"fmt"
+	"net/url"
 
 	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
@@ -15,6 +16,16 @@ import (
 	"github.com/hashicorp/consul/agent/structs"
 )
 
+const (
+	// connectRevocationListSecretName is the name of the secret containing the
+	// revocation lists of the Connect CA.
+	connectRevocationListSecretName = "connect-ca-revocation-lists"
+
+	// connectTrustBundlesSecretName is the name of the secret containing the
+	// SPIFFE validator for the local, peer and federated trust domains.
+	connectTrustBundlesSecretName = "connect-federated-trust-bundles"
+)
+
 // secretsFromSnapshot returns the xDS API representation of the "secrets"
 // in the snapshot
 func (s *ResourceGenerator) secretsFromSnapshot(cfgSnap *proxycfg.ConfigSnapshot) ([]proto.Message, error) {
@@ -25,9 +36,11 @@ func (s *ResourceGenerator) secretsFromSnapshot(cfgSnap *proxycfg.ConfigSnapshot
 	switch cfgSnap.Kind {
 	case structs.ServiceKindAPIGateway:
 		return s.secretsFromSnapshotAPIGateway(cfgSnap), nil // return any attached certs
-	case structs.ServiceKindConnectProxy,
-		structs.ServiceKindTerminatingGateway,
-		structs.ServiceKindMeshGateway,
+	case structs.ServiceKindConnectProxy:
+		return s.secretsFromSnapshotConnectProxy(cfgSnap)
+	case structs.ServiceKindTerminatingGateway:
+		return makeRevocationListSecrets(cfgSnap), nil
+	case structs.ServiceKindMeshGateway,
 		structs.ServiceKindIngressGateway:
 		return nil, nil
 	default:
@@ -35,6 +48,110 @@ func (s *ResourceGenerator) secretsFromSnapshot(cfgSnap *proxycfg.ConfigSnapshot
 	}
 }
 
+// secretsFromSnapshotConnectProxy returns the "secrets" for a connect proxy,
+// which is the validation context referenced by the public listener. If the
+// proxy trusts federated trust domains it holds their SPIFFE validator,
+// otherwise the revocation lists of the Connect CA.
+func (s *ResourceGenerator) secretsFromSnapshotConnectProxy(cfgSnap *proxycfg.ConfigSnapshot) ([]proto.Message, error) {
+	if federated := federatedTrustBundles(cfgSnap); len(federated) > 0 {
+		// Only the peers that the service is exported to are trusted, like
+		// in the public listener without federated trust domains.
+		peerBundles := cfgSnap.ConnectProxy.InboundPeerTrustBundles
+		spiffeConfig, err := makeSpiffeValidatorConfig(cfgSnap.Roots.TrustDomain, cfgSnap.RootPEMs(), peerBundles, federated)
+		if err != nil {
+			return nil, err
+		}
+		return []proto.Message{
+			&envoy_tls_v3.Secret{
+				Name: connectTrustBundlesSecretName,
+				Type: &envoy_tls_v3.Secret_ValidationContext{
+					ValidationContext: &envoy_tls_v3.CertificateValidationContext{
+						CustomValidatorConfig: &envoy_core_v3.TypedExtensionConfig{
+							Name:        "envoy.tls.cert_validator.spiffe",
+							TypedConfig: spiffeConfig,
+						},
+					},
+				},
+			},
+		}, nil
+	}
+
+	return makeRevocationListSecrets(cfgSnap), nil
+}
+
+// makeRevocationListSecrets returns the validation context holding the
+// revocation lists of the Connect CA, which is referenced by every listener
+// that validates the client certificates of the mesh.
+func makeRevocationListSecrets(cfgSnap *proxycfg.ConfigSnapshot) []proto.Message {
+	crls := cfgSnap.RevocationListPEMs()
+	if crls == "" {
+		return nil
+	}
+
+	return []proto.Message{
+		&envoy_tls_v3.Secret{
+			Name: connectRevocationListSecretName,
+			Type: &envoy_tls_v3.Secret_ValidationContext{
+				ValidationContext: &envoy_tls_v3.CertificateValidationContext{
+					Crl: &envoy_core_v3.DataSource{
+						Specifier: &envoy_core_v3.DataSource_InlineString{
+							InlineString: crls,
+						},
+					},
+					// Only the signing CAs of each datacenter sign revocation
+					// lists, so the roots and intermediates are not checked.
+					OnlyVerifyLeafCertCrl: true,
+				},
+			},
+		},
+	}
+}
+
+// federatedTrustBundles returns the trust bundles of the foreign SPIFFE trust
+// domains that the intentions of a connect proxy allow to connect, excluding
+// the local trust domain.
+//
+// The SPIFFE validator doesn't support revocation lists, so while the Connect
+// CA distributes revocation lists no federated trust domain is trusted.
+// Workloads of federated trust domains are rejected until the revocations
+// expire rather than accepting revoked certificates of the local trust domain.
+func federatedTrustBundles(cfgSnap *proxycfg.ConfigSnapshot) []*structs.TrustBundleConfigEntry {
+	if cfgSnap.Kind != structs.ServiceKindConnectProxy || cfgSnap.Roots == nil {
+		return nil
+	}
+	if cfgSnap.RevocationListPEMs() != "" {
+		return nil
+	}
+
+	trustDomains := make(map[string]struct{})
+	for _, ixn := range cfgSnap.ConnectProxy.Intentions {
+		if ixn.SourceType != structs.IntentionSourceSPIFFE {
+			continue
+		}
+		// Deny intentions don't need the bundle, since workloads of an
+		// untrusted trust domain are rejected anyway.
+		if ixn.Action == structs.IntentionActionDeny && len(ixn.Permissions) == 0 {
+			continue
+		}
+		id, err := url.Parse(ixn.SourceName)
+		if err != nil {
+			continue
+		}
+		trustDomains[id.Host] = struct{}{}
+	}
+
+	var bundles []*structs.TrustBundleConfigEntry
+	for _, b := range cfgSnap.ConnectProxy.FederatedTrustBundles {
+		if b.Name == cfgSnap.Roots.TrustDomain {
+			continue
+		}
+		if _, ok := trustDomains[b.Name]; ok {
+			bundles = append(bundles, b)
+		}
+	}
+	return bundles
+}
+
 // secretsFromSnapshotAPIGateway returns the "secrets" for an api-gateway service
 func (s *ResourceGenerator) secretsFromSnapshotAPIGateway(cfgSnap *proxycfg.ConfigSnapshot) []proto.Message {
 	var resources []proto.Message
@@ -32,6 +32,9 @@ func SetupTLSRootsAndLeaf(t *testing.T, snap *proxycfg.ConfigSnapshot) {
 	}
 	if snap.Roots != nil {
 		snap.Roots.Roots[0].RootCert = loadTestResource(t, "test-root-cert")
+		if len(snap.Roots.RevocationLists) > 0 {
+			snap.Roots.RevocationLists = []string{loadTestResource(t, "test-revocation-list")}
+		}
 	}
 }
 func loadTestResource(t *testing.T, name string) string {
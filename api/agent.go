@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 )
 
 // ServiceKind is the kind of service being registered.
@@ -1189,6 +1190,51 @@ func (a *Agent) ConnectCALeaf(serviceID string, q *QueryOptions) (*LeafCert, *Qu
 	return &out, qm, nil
 }
 
+// JWTSVID is a JWT-SVID that has been issued by the Connect CA.
+type JWTSVID struct {
+	// SpiffeID is the SPIFFE ID in the subject of the JWT-SVID.
+	SpiffeID string
+
+	// Token is the JWT-SVID using JWS compact serialization.
+	Token string
+
+	// KeyID is the ID of the key that signed the JWT-SVID.
+	KeyID string
+
+	// ExpiresAt is the time at which the JWT-SVID expires.
+	ExpiresAt time.Time
+}
+
+// ConnectCAJWTSVID gets a JWT-SVID for the given service that is valid for
+// the given audiences. A zero ttl uses the default lifetime.
+func (a *Agent) ConnectCAJWTSVID(service string, audience []string, ttl time.Duration, q *QueryOptions) (*JWTSVID, *QueryMeta, error) {
+	r := a.c.newRequest("GET", "/v1/agent/connect/ca/jwt-svid/"+service)
+	r.setQueryOptions(q)
+	for _, aud := range audience {
+		r.params.Add("audience", aud)
+	}
+	if ttl != 0 {
+		r.params.Set("ttl", ttl.String())
+	}
+	rtt, resp, err := a.c.doRequest(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return nil, nil, err
+	}
+	qm := &QueryMeta{}
+	parseQueryMeta(resp, qm)
+	qm.RequestTime = rtt
+
+	var out JWTSVID
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, nil, err
+	}
+	return &out, qm, nil
+}
+
 // EnableServiceMaintenance toggles service maintenance mode on
 // for the given service ID.
 func (a *Agent) EnableServiceMaintenance(serviceID, reason string) error {
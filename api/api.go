@@ -133,6 +133,12 @@ type QueryOptions struct {
 	// read.
 	RequireConsistent bool
 
+	// FollowerConsistent allows any Consul server to service a read once it
+	// has caught up with the state of the leader at the time of the read.
+	// This is as consistent as RequireConsistent but spreads the reads
+	// across the servers.
+	FollowerConsistent bool
+
 	// UseCache requests that the agent cache results locally. See
 	// https://www.consul.io/api/features/caching.html for more details on the
 	// semantics.
@@ -862,6 +868,9 @@ func (r *request) setQueryOptions(q *QueryOptions) {
 	if q.RequireConsistent {
 		r.params.Set("consistent", "")
 	}
+	if q.FollowerConsistent {
+		r.params.Set("follower-consistent", "")
+	}
 	if q.WaitIndex != 0 {
 		r.params.Set("index", strconv.FormatUint(q.WaitIndex, 10))
 	}
@@ -800,17 +800,18 @@ func TestAPI_SetQueryOptions(t *testing.T) {
 
 	r := c.newRequest("GET", "/v1/kv/foo")
 	q := &QueryOptions{
-		Namespace:         "operator",
-		Partition:         "asdf",
-		Datacenter:        "foo",
-		Peer:              "dc10",
-		AllowStale:        true,
-		RequireConsistent: true,
-		WaitIndex:         1000,
-		WaitTime:          100 * time.Second,
-		Token:             "12345",
-		Near:              "nodex",
-		LocalOnly:         true,
+		Namespace:          "operator",
+		Partition:          "asdf",
+		Datacenter:         "foo",
+		Peer:               "dc10",
+		AllowStale:         true,
+		RequireConsistent:  true,
+		FollowerConsistent: true,
+		WaitIndex:          1000,
+		WaitTime:           100 * time.Second,
+		Token:              "12345",
+		Near:               "nodex",
+		LocalOnly:          true,
 	}
 	r.setQueryOptions(q)
 
@@ -832,6 +833,9 @@ func TestAPI_SetQueryOptions(t *testing.T) {
 	if _, ok := r.params["consistent"]; !ok {
 		t.Fatalf("bad: %v", r.params)
 	}
+	if _, ok := r.params["follower-consistent"]; !ok {
+		t.Fatalf("bad: %v", r.params)
+	}
 	if r.params.Get("index") != "1000" {
 		t.Fatalf("bad: %v", r.params)
 	}
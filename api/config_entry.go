@@ -36,12 +36,18 @@ const (
 	FileSystemCertificate string = "file-system-certificate"
 	InlineCertificate     string = "inline-certificate"
 	HTTPRoute             string = "http-route"
+	GRPCRoute             string = "grpc-route"
+	TLSRoute              string = "tls-route"
+	CanaryRollout         string = "canary-rollout"
+	TrustBundle           string = "trust-bundle"
+	ACMECertificate       string = "acme-certificate"
 	JWTProvider           string = "jwt-provider"
 )
 
 const (
 	BuiltinAWSLambdaExtension         string = "builtin/aws/lambda"
 	BuiltinExtAuthzExtension          string = "builtin/ext-authz"
+	BuiltinLocalRatelimitExtension    string = "builtin/local-ratelimit"
 	BuiltinLuaExtension               string = "builtin/lua"
 	BuiltinOTELAccessLoggingExtension string = "builtin/otel-access-logging"
 	BuiltinPropertyOverrideExtension  string = "builtin/property-override"
@@ -314,6 +320,23 @@ type UpstreamLimits struct {
 	// to the upstream cluster at a point in time. This is mostly applicable to HTTP/2
 	// clusters since all HTTP/1.1 requests are limited by MaxConnections.
 	MaxConcurrentRequests *int `alias:"max_concurrent_requests"`
+
+	// RetryBudget limits the number of concurrent retries to the upstream
+	// cluster relative to the number of active requests.
+	RetryBudget *RetryBudget `json:",omitempty" alias:"retry_budget"`
+}
+
+// RetryBudget bounds the number of concurrent retries to an upstream cluster
+// as a share of its active requests.
+type RetryBudget struct {
+	// BudgetPercent is the percentage of active requests that may be retries
+	// at any point in time. Defaults to 20.
+	BudgetPercent *float64 `json:",omitempty" alias:"budget_percent"`
+
+	// MinRetryConcurrency is the number of concurrent retries that are
+	// always allowed regardless of the number of active requests. Defaults
+	// to 3.
+	MinRetryConcurrency *int `json:",omitempty" alias:"min_retry_concurrency"`
 }
 
 // RateLimits is rate limiting configuration that is applied to
@@ -454,6 +477,16 @@ func makeConfigEntry(kind, name string) (ConfigEntry, error) {
 		return &InlineCertificateConfigEntry{Kind: kind, Name: name}, nil
 	case HTTPRoute:
 		return &HTTPRouteConfigEntry{Kind: kind, Name: name}, nil
+	case GRPCRoute:
+		return &GRPCRouteConfigEntry{Kind: kind, Name: name}, nil
+	case TLSRoute:
+		return &TLSRouteConfigEntry{Kind: kind, Name: name}, nil
+	case CanaryRollout:
+		return &CanaryRolloutConfigEntry{Kind: kind, Name: name}, nil
+	case TrustBundle:
+		return &TrustBundleConfigEntry{Kind: kind, Name: name}, nil
+	case ACMECertificate:
+		return &ACMECertificateConfigEntry{Kind: kind, Name: name}, nil
 	case RateLimitIPConfig:
 		return &RateLimitIPConfigEntry{Kind: kind, Name: name}, nil
 	case JWTProvider:
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	// ACMEChallengeHTTP01 proves control of a domain by serving a token over
+	// plain HTTP from the API gateways that reference the certificate.
+	ACMEChallengeHTTP01 = "http-01"
+
+	// ACMEChallengeDNS01 proves control of a domain with a TXT record
+	// managed by the DNS hook of the servers.
+	ACMEChallengeDNS01 = "dns-01"
+)
+
+// ACMECertificateConfigEntry manages a certificate that Consul obtains and
+// renews from an ACME directory. API gateways use the certificate like an
+// inline certificate once it is issued.
+type ACMECertificateConfigEntry struct {
+	// Kind of the config entry. This should be set to api.ACMECertificate.
+	Kind string
+
+	Name string
+
+	// Directory is the URL of the directory of the ACME server.
+	Directory string
+
+	// DirectoryCACert is a PEM encoded set of CA certificates used to
+	// authenticate the ACME server instead of the system roots.
+	DirectoryCACert string `json:",omitempty" alias:"directory_ca_cert"`
+
+	// Email is the contact address of the ACME account.
+	Email string `json:",omitempty"`
+
+	// Domains are the DNS names the certificate is issued for.
+	Domains []string
+
+	// Challenge is either "http-01" or "dns-01". Defaults to "http-01".
+	Challenge string `json:",omitempty"`
+
+	// RenewBefore is how long before its expiry the certificate is renewed.
+	// Defaults to 30 days.
+	RenewBefore time.Duration `json:",omitempty" alias:"renew_before"`
+
+	// Certificate is the PEM encoded certificate chain that was last issued.
+	// This is a read-only field.
+	Certificate string `json:",omitempty"`
+
+	// PrivateKey is the PEM encoded private key of the certificate. This is a
+	// read-only field.
+	PrivateKey string `json:",omitempty" alias:"private_key"`
+
+	// AccountKey is the PEM encoded private key of the ACME account. This is
+	// a read-only field.
+	AccountKey string `json:",omitempty" alias:"account_key"`
+
+	// Challenges are the pending HTTP-01 challenges. This is a read-only
+	// field.
+	Challenges []ACMEChallenge `json:",omitempty"`
+
+	Meta map[string]string `json:",omitempty"`
+
+	// Status is the result of the last order of the certificate.
+	Status ConfigEntryStatus
+
+	// CreateIndex is the Raft index this entry was created at. This is a
+	// read-only field.
+	CreateIndex uint64
+
+	// ModifyIndex is used for the Check-And-Set operations and can also be fed
+	// back into the WaitIndex of the QueryOptions in order to perform blocking
+	// queries.
+	ModifyIndex uint64
+
+	// Partition is the partition the config entry is associated with.
+	// Partitioning is a Consul Enterprise feature.
+	Partition string `json:",omitempty"`
+
+	// Namespace is the namespace the config entry is associated with.
+	// Namespacing is a Consul Enterprise feature.
+	Namespace string `json:",omitempty"`
+}
+
+// ACMEChallenge is a pending HTTP-01 challenge for one of the domains of an
+// ACME certificate.
+type ACMEChallenge struct {
+	Domain           string
+	Token            string
+	KeyAuthorization string `alias:"key_authorization"`
+}
+
+func (e *ACMECertificateConfigEntry) GetKind() string            { return ACMECertificate }
+func (e *ACMECertificateConfigEntry) GetName() string            { return e.Name }
+func (e *ACMECertificateConfigEntry) GetPartition() string       { return e.Partition }
+func (e *ACMECertificateConfigEntry) GetNamespace() string       { return e.Namespace }
+func (e *ACMECertificateConfigEntry) GetMeta() map[string]string { return e.Meta }
+func (e *ACMECertificateConfigEntry) GetCreateIndex() uint64     { return e.CreateIndex }
+func (e *ACMECertificateConfigEntry) GetModifyIndex() uint64     { return e.ModifyIndex }
+
+func (e *ACMECertificateConfigEntry) MarshalJSON() ([]byte, error) {
+	type Alias ACMECertificateConfigEntry
+	exported := &struct {
+		RenewBefore string `json:",omitempty"`
+		*Alias
+	}{
+		RenewBefore: e.RenewBefore.String(),
+		Alias:       (*Alias)(e),
+	}
+	if e.RenewBefore == 0 {
+		exported.RenewBefore = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (e *ACMECertificateConfigEntry) UnmarshalJSON(data []byte) error {
+	type Alias ACMECertificateConfigEntry
+	aux := &struct {
+		RenewBefore string
+		*Alias
+	}{
+		Alias: (*Alias)(e),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if aux.RenewBefore != "" {
+		if e.RenewBefore, err = time.ParseDuration(aux.RenewBefore); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// CanaryRolloutConfigEntry describes a progressive shift of traffic for a
+// service from a stable subset to a canary subset. The Consul leader manages
+// the service-splitter of the service while the rollout is in progress.
+type CanaryRolloutConfigEntry struct {
+	// Kind of the config entry. This should be set to api.CanaryRollout.
+	Kind string
+
+	// Name is the name of the service being rolled out.
+	Name string
+
+	// StableSubset is the service-resolver subset that receives the traffic
+	// which is not sent to the canary. If empty, the default subset is used.
+	StableSubset string `json:",omitempty" alias:"stable_subset"`
+
+	// CanarySubset is the service-resolver subset that traffic is shifted to.
+	CanarySubset string `json:",omitempty" alias:"canary_subset"`
+
+	// Steps is the schedule of canary weights, which must be strictly
+	// increasing.
+	Steps []CanaryRolloutStep
+
+	// HealthGate determines when the canary subset is healthy enough for the
+	// rollout to continue.
+	HealthGate CanaryRolloutHealthGate `alias:"health_gate"`
+
+	Meta map[string]string `json:",omitempty"`
+
+	// Status is the asynchronous status of the rollout, including the
+	// current step.
+	Status ConfigEntryStatus
+
+	// CreateIndex is the Raft index this entry was created at. This is a
+	// read-only field.
+	CreateIndex uint64
+
+	// ModifyIndex is used for the Check-And-Set operations and can also be fed
+	// back into the WaitIndex of the QueryOptions in order to perform blocking
+	// queries.
+	ModifyIndex uint64
+
+	// Partition is the partition the config entry is associated with.
+	// Partitioning is a Consul Enterprise feature.
+	Partition string `json:",omitempty"`
+
+	// Namespace is the namespace the config entry is associated with.
+	// Namespacing is a Consul Enterprise feature.
+	Namespace string `json:",omitempty"`
+}
+
+func (e *CanaryRolloutConfigEntry) GetKind() string            { return CanaryRollout }
+func (e *CanaryRolloutConfigEntry) GetName() string            { return e.Name }
+func (e *CanaryRolloutConfigEntry) GetPartition() string       { return e.Partition }
+func (e *CanaryRolloutConfigEntry) GetNamespace() string       { return e.Namespace }
+func (e *CanaryRolloutConfigEntry) GetMeta() map[string]string { return e.Meta }
+func (e *CanaryRolloutConfigEntry) GetCreateIndex() uint64     { return e.CreateIndex }
+func (e *CanaryRolloutConfigEntry) GetModifyIndex() uint64     { return e.ModifyIndex }
+
+// CanaryRolloutStep is a single step in a canary rollout.
+type CanaryRolloutStep struct {
+	// Weight is the percentage of traffic, between 0 and 100, sent to the
+	// canary subset during this step.
+	Weight float32
+
+	// Pause is how long the step is held before the rollout advances.
+	Pause time.Duration `json:",omitempty"`
+}
+
+func (s *CanaryRolloutStep) MarshalJSON() ([]byte, error) {
+	type Alias CanaryRolloutStep
+	exported := &struct {
+		Pause string `json:",omitempty"`
+		*Alias
+	}{
+		Pause: s.Pause.String(),
+		Alias: (*Alias)(s),
+	}
+	if s.Pause == 0 {
+		exported.Pause = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (s *CanaryRolloutStep) UnmarshalJSON(data []byte) error {
+	type Alias CanaryRolloutStep
+	aux := &struct {
+		Pause string
+		*Alias
+	}{
+		Alias: (*Alias)(s),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if aux.Pause != "" {
+		if s.Pause, err = time.ParseDuration(aux.Pause); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CanaryRolloutHealthGate is evaluated against the catalog health of the
+// instances in the canary subset and, optionally, the error ratio of the
+// requests sent to them.
+type CanaryRolloutHealthGate struct {
+	// MinPassingRatio is the fraction of canary instances, between 0 and 1,
+	// that must be passing. If zero then every instance must be passing.
+	MinPassingRatio float32 `json:",omitempty" alias:"min_passing_ratio"`
+
+	// MinPassingInstances is the minimum number of passing canary instances
+	// required before traffic is shifted. Defaults to 1.
+	MinPassingInstances int `json:",omitempty" alias:"min_passing_instances"`
+
+	// GracePeriod is how long the health gate may fail before the rollout is
+	// rolled back.
+	GracePeriod time.Duration `json:",omitempty" alias:"grace_period"`
+
+	// MaxErrorRatio is the highest fraction of requests to the canary subset,
+	// between 0 and 1, that may fail with a 5xx response. If zero then the
+	// error ratio is not evaluated.
+	MaxErrorRatio float32 `json:",omitempty" alias:"max_error_ratio"`
+}
+
+func (g *CanaryRolloutHealthGate) MarshalJSON() ([]byte, error) {
+	type Alias CanaryRolloutHealthGate
+	exported := &struct {
+		GracePeriod string `json:",omitempty"`
+		*Alias
+	}{
+		GracePeriod: g.GracePeriod.String(),
+		Alias:       (*Alias)(g),
+	}
+	if g.GracePeriod == 0 {
+		exported.GracePeriod = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (g *CanaryRolloutHealthGate) UnmarshalJSON(data []byte) error {
+	type Alias CanaryRolloutHealthGate
+	aux := &struct {
+		GracePeriod string
+		*Alias
+	}{
+		Alias: (*Alias)(g),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if aux.GracePeriod != "" {
+		if g.GracePeriod, err = time.ParseDuration(aux.GracePeriod); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -32,8 +32,85 @@ func (e *ServiceRouterConfigEntry) GetCreateIndex() uint64     { return e.Create
 func (e *ServiceRouterConfigEntry) GetModifyIndex() uint64     { return e.ModifyIndex }
 
 type ServiceRoute struct {
-	Match       *ServiceRouteMatch       `json:",omitempty"`
-	Destination *ServiceRouteDestination `json:",omitempty"`
+	Match          *ServiceRouteMatch          `json:",omitempty"`
+	Destination    *ServiceRouteDestination    `json:",omitempty"`
+	Fault          *ServiceRouteFault          `json:",omitempty"`
+	Redirect       *ServiceRouteRedirect       `json:",omitempty"`
+	DirectResponse *ServiceRouteDirectResponse `json:",omitempty" alias:"direct_response"`
+}
+
+// ServiceRouteRedirect describes the HTTP redirect returned for the requests
+// matching a route instead of proxying them.
+type ServiceRouteRedirect struct {
+	Scheme        string `json:",omitempty"`
+	Host          string `json:",omitempty"`
+	Port          uint32 `json:",omitempty"`
+	Path          string `json:",omitempty"`
+	PrefixRewrite string `json:",omitempty" alias:"prefix_rewrite"`
+	StripQuery    bool   `json:",omitempty" alias:"strip_query"`
+	StatusCode    uint32 `json:",omitempty" alias:"status_code"`
+}
+
+// ServiceRouteDirectResponse describes the fixed response returned for the
+// requests matching a route instead of proxying them.
+type ServiceRouteDirectResponse struct {
+	Status uint32 `json:",omitempty"`
+	Body   string `json:",omitempty"`
+}
+
+// ServiceRouteFault describes the delays and aborts injected into the
+// requests matching a route.
+type ServiceRouteFault struct {
+	Delay  *ServiceRouteFaultDelay       `json:",omitempty"`
+	Abort  *ServiceRouteFaultAbort       `json:",omitempty"`
+	Header []ServiceRouteHTTPMatchHeader `json:",omitempty"`
+}
+
+type ServiceRouteFaultDelay struct {
+	FixedDelay time.Duration `json:",omitempty" alias:"fixed_delay"`
+	Percent    float32       `json:",omitempty"`
+}
+
+func (d *ServiceRouteFaultDelay) MarshalJSON() ([]byte, error) {
+	type Alias ServiceRouteFaultDelay
+	exported := &struct {
+		FixedDelay string `json:",omitempty"`
+		*Alias
+	}{
+		FixedDelay: d.FixedDelay.String(),
+		Alias:      (*Alias)(d),
+	}
+	if d.FixedDelay == 0 {
+		exported.FixedDelay = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (d *ServiceRouteFaultDelay) UnmarshalJSON(data []byte) error {
+	type Alias ServiceRouteFaultDelay
+	aux := &struct {
+		FixedDelay string
+		*Alias
+	}{
+		Alias: (*Alias)(d),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if aux.FixedDelay != "" {
+		if d.FixedDelay, err = time.ParseDuration(aux.FixedDelay); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type ServiceRouteFaultAbort struct {
+	HTTPStatus uint32  `json:",omitempty" alias:"http_status"`
+	GRPCStatus uint32  `json:",omitempty" alias:"grpc_status"`
+	Percent    float32 `json:",omitempty"`
 }
 
 type ServiceRouteMatch struct {
@@ -80,8 +157,30 @@ type ServiceRouteDestination struct {
 	RetryOnConnectFailure bool                 `json:",omitempty" alias:"retry_on_connect_failure"`
 	RetryOnStatusCodes    []uint32             `json:",omitempty" alias:"retry_on_status_codes"`
 	RetryOn               []string             `json:",omitempty" alias:"retry_on"`
+	PerTryTimeout         time.Duration        `json:",omitempty" alias:"per_try_timeout"`
+	HedgePolicy           *HedgePolicy         `json:",omitempty" alias:"hedge_policy"`
+	RetryBudget           *RetryBudget         `json:",omitempty" alias:"retry_budget"`
+	SessionAffinity       *SessionAffinity     `json:",omitempty" alias:"session_affinity"`
 	RequestHeaders        *HTTPHeaderModifiers `json:",omitempty" alias:"request_headers"`
 	ResponseHeaders       *HTTPHeaderModifiers `json:",omitempty" alias:"response_headers"`
+	Mirror                *ServiceRouteMirror  `json:",omitempty"`
+}
+
+// ServiceRouteMirror describes the service that requests matching a route
+// are shadowed to. Responses from the mirror are discarded.
+type ServiceRouteMirror struct {
+	Service       string   `json:",omitempty"`
+	ServiceSubset string   `json:",omitempty" alias:"service_subset"`
+	Namespace     string   `json:",omitempty"`
+	Partition     string   `json:",omitempty"`
+	Percent       *float32 `json:",omitempty"`
+}
+
+// HedgePolicy describes how requests to a route destination are hedged by
+// sending additional requests to other instances of the destination.
+type HedgePolicy struct {
+	InitialRequests      uint32 `json:",omitempty" alias:"initial_requests"`
+	HedgeOnPerTryTimeout bool   `json:",omitempty" alias:"hedge_on_per_try_timeout"`
 }
 
 func (e *ServiceRouteDestination) MarshalJSON() ([]byte, error) {
@@ -89,10 +188,12 @@ func (e *ServiceRouteDestination) MarshalJSON() ([]byte, error) {
 	exported := &struct {
 		RequestTimeout string `json:",omitempty"`
 		IdleTimeout    string `json:",omitempty"`
+		PerTryTimeout  string `json:",omitempty"`
 		*Alias
 	}{
 		RequestTimeout: e.RequestTimeout.String(),
 		IdleTimeout:    e.IdleTimeout.String(),
+		PerTryTimeout:  e.PerTryTimeout.String(),
 		Alias:          (*Alias)(e),
 	}
 	if e.RequestTimeout == 0 {
@@ -101,6 +202,9 @@ func (e *ServiceRouteDestination) MarshalJSON() ([]byte, error) {
 	if e.IdleTimeout == 0 {
 		exported.IdleTimeout = ""
 	}
+	if e.PerTryTimeout == 0 {
+		exported.PerTryTimeout = ""
+	}
 
 	return json.Marshal(exported)
 }
@@ -110,6 +214,7 @@ func (e *ServiceRouteDestination) UnmarshalJSON(data []byte) error {
 	aux := &struct {
 		RequestTimeout string
 		IdleTimeout    string
+		PerTryTimeout  string
 		*Alias
 	}{
 		Alias: (*Alias)(e),
@@ -128,6 +233,11 @@ func (e *ServiceRouteDestination) UnmarshalJSON(data []byte) error {
 			return err
 		}
 	}
+	if aux.PerTryTimeout != "" {
+		if e.PerTryTimeout, err = time.ParseDuration(aux.PerTryTimeout); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -183,6 +293,14 @@ type ServiceResolverConfigEntry struct {
 	// issuing requests to this upstream service.
 	LoadBalancer *LoadBalancer `json:",omitempty" alias:"load_balancer"`
 
+	// Limits are the default circuit breaking limits applied by callers of
+	// this service when they do not configure their own.
+	Limits *UpstreamLimits `json:",omitempty"`
+
+	// PassiveHealthCheck is the default outlier detection applied by callers
+	// of this service when they do not configure their own.
+	PassiveHealthCheck *PassiveHealthCheck `json:",omitempty" alias:"passive_health_check"`
+
 	Meta        map[string]string `json:",omitempty"`
 	CreateIndex uint64
 	ModifyIndex uint64
@@ -311,6 +429,10 @@ type LoadBalancer struct {
 	// If no hash policies are present, or none are successfully evaluated,
 	// then a random backend host will be selected.
 	HashPolicies []HashPolicy `json:",omitempty" alias:"hash_policies"`
+
+	// SessionAffinity pins the requests of a client session to the instance
+	// that served its first request.
+	SessionAffinity *SessionAffinity `json:",omitempty" alias:"session_affinity"`
 }
 
 // RingHashConfig contains configuration for the "ring_hash" policy type
@@ -366,6 +488,35 @@ type CookieConfig struct {
 	Path string `json:",omitempty"`
 }
 
+// SessionAffinity configures a stateful session, which stores the address of
+// the instance selected for the first request of a client in a cookie or a
+// header and sends the following requests of the session to that instance.
+type SessionAffinity struct {
+	// Type is the strategy used to track the session.
+	// Must be one of "cookie" or "header".
+	Type string `json:",omitempty"`
+
+	// Name is the name of the cookie or header holding the session.
+	Name string `json:",omitempty"`
+
+	// CookieConfig contains configuration for the "cookie" type.
+	CookieConfig *SessionAffinityCookieConfig `json:",omitempty" alias:"cookie_config"`
+
+	// Strict rejects the requests of a session whose instance is no longer
+	// available instead of load balancing them to another instance.
+	Strict bool `json:",omitempty"`
+}
+
+// SessionAffinityCookieConfig contains configuration for the cookie
+// generated for a "cookie" session.
+type SessionAffinityCookieConfig struct {
+	// TTL for the generated cookie. If unset, a session cookie is generated.
+	TTL time.Duration `json:",omitempty"`
+
+	// The path to set for the cookie
+	Path string `json:",omitempty"`
+}
+
 // HTTPHeaderModifiers is a set of rules for HTTP header modification that
 // should be performed by proxies as the request passes through them. It can
 // operate on either request or response headers depending on the context in
@@ -283,7 +283,8 @@ type APIGatewayListener struct {
 	// Port is the port at which this listener should bind.
 	Port int
 	// Protocol is the protocol that a listener should use, it must
-	// either be "http" or "tcp"
+	// be one of "http", "tcp", or "tls". A "tls" listener passes TLS
+	// connections through to its upstreams without terminating them.
 	Protocol string
 	// TLS is the TLS settings for the listener.
 	TLS APIGatewayTLSConfiguration
@@ -202,6 +202,11 @@ type HTTPFilters struct {
 	RetryFilter   *RetryFilter
 	TimeoutFilter *TimeoutFilter
 	JWT           *JWTFilter
+
+	// RedirectFilter and DirectResponseFilter answer the request without
+	// forwarding it to a service. They are only valid on a rule.
+	RedirectFilter       *RedirectFilter
+	DirectResponseFilter *DirectResponseFilter
 }
 
 // HTTPResponseFilters specifies a list of filters used to modify a
@@ -226,6 +231,9 @@ type RetryFilter struct {
 	RetryOn               []string
 	RetryOnStatusCodes    []uint32
 	RetryOnConnectFailure bool
+	PerTryTimeout         time.Duration `json:",omitempty"`
+	HedgePolicy           *HedgePolicy  `json:",omitempty"`
+	RetryBudget           *RetryBudget  `json:",omitempty"`
 }
 
 type TimeoutFilter struct {
@@ -233,6 +241,24 @@ type TimeoutFilter struct {
 	IdleTimeout    time.Duration
 }
 
+// RedirectFilter answers a request with an HTTP redirect. Parts of the
+// redirect URL that are not configured are kept from the original request.
+type RedirectFilter struct {
+	Scheme        string
+	Hostname      string
+	Port          uint32
+	Path          string
+	PrefixRewrite string
+	// StatusCode is one of 301, 302, 303, 307 or 308, defaulting to 301.
+	StatusCode uint32
+}
+
+// DirectResponseFilter answers a request with a fixed response.
+type DirectResponseFilter struct {
+	Status uint32
+	Body   string
+}
+
 // JWTFilter specifies the JWT configuration for a route
 type JWTFilter struct {
 	Providers []*APIGatewayJWTProvider `json:",omitempty"`
@@ -271,6 +297,134 @@ type HTTPService struct {
 	// response returned from the upstream service
 	ResponseFilters HTTPResponseFilters
 
+	// SessionAffinity pins the requests of a client session to the instance
+	// of the service that served its first request.
+	SessionAffinity *SessionAffinity `json:",omitempty"`
+
+	// Partition is the partition the config entry is associated with.
+	// Partitioning is a Consul Enterprise feature.
+	Partition string `json:",omitempty"`
+
+	// Namespace is the namespace the config entry is associated with.
+	// Namespacing is a Consul Enterprise feature.
+	Namespace string `json:",omitempty"`
+}
+
+// GRPCRouteConfigEntry manages the configuration for a gRPC route
+// with the given name.
+type GRPCRouteConfigEntry struct {
+	// Kind of the config entry. This should be set to api.GRPCRoute.
+	Kind string
+
+	// Name is used to match the config entry with its associated grpc-route.
+	Name string
+
+	// Parents is a list of gateways that this route should be bound to
+	Parents []ResourceReference
+	// Rules are a list of gRPC-based routing rules that this route should
+	// use for constructing a routing table.
+	Rules []GRPCRouteRule
+	// Hostnames are the hostnames for which this GRPCRoute should respond to requests.
+	Hostnames []string
+
+	Meta map[string]string `json:",omitempty"`
+
+	// CreateIndex is the Raft index this entry was created at. This is a
+	// read-only field.
+	CreateIndex uint64
+
+	// ModifyIndex is used for the Check-And-Set operations and can also be fed
+	// back into the WaitIndex of the QueryOptions in order to perform blocking
+	// queries.
+	ModifyIndex uint64
+
+	// Partition is the partition the config entry is associated with.
+	// Partitioning is a Consul Enterprise feature.
+	Partition string `json:",omitempty"`
+
+	// Namespace is the namespace the config entry is associated with.
+	// Namespacing is a Consul Enterprise feature.
+	Namespace string `json:",omitempty"`
+
+	// Status is the asynchronous status which a GRPCRoute propagates to the user.
+	Status ConfigEntryStatus
+}
+
+func (r *GRPCRouteConfigEntry) GetKind() string            { return GRPCRoute }
+func (r *GRPCRouteConfigEntry) GetName() string            { return r.Name }
+func (r *GRPCRouteConfigEntry) GetPartition() string       { return r.Partition }
+func (r *GRPCRouteConfigEntry) GetNamespace() string       { return r.Namespace }
+func (r *GRPCRouteConfigEntry) GetMeta() map[string]string { return r.Meta }
+func (r *GRPCRouteConfigEntry) GetCreateIndex() uint64     { return r.CreateIndex }
+func (r *GRPCRouteConfigEntry) GetModifyIndex() uint64     { return r.ModifyIndex }
+
+// GRPCRouteRule specifies the routing rules used to determine what upstream
+// service a gRPC request is routed to.
+type GRPCRouteRule struct {
+	// Filters is a list of gRPC-based filters used to modify a request prior
+	// to routing it to the upstream service
+	Filters GRPCFilters
+	// ResponseFilters is a list of HTTP-based filters used to modify a response
+	// returned by the upstream service
+	ResponseFilters HTTPResponseFilters
+	// Matches specified the matching criteria used in the routing table. If a
+	// request matches the given GRPCMatch configuration, then traffic is routed
+	// to services specified in the Services field.
+	Matches []GRPCMatch
+	// Services is a list of gRPC-based services to route to if the request matches
+	// the rules specified in the Matches field.
+	Services []GRPCService
+}
+
+// GRPCMatch specifies the criteria that should be
+// used in determining whether or not a request should
+// be routed to a given set of services.
+type GRPCMatch struct {
+	Headers []HTTPHeaderMatch
+	Method  GRPCMethodMatch
+}
+
+// GRPCMethodMatchType specifies how gRPC service and method matching
+// criteria should be applied to a request.
+type GRPCMethodMatchType string
+
+const (
+	GRPCMethodMatchExact             GRPCMethodMatchType = "exact"
+	GRPCMethodMatchRegularExpression GRPCMethodMatchType = "regex"
+)
+
+// GRPCMethodMatch specifies how a match should be done on the
+// fully-qualified gRPC service and method name of a request. If
+// either Service or Method is empty, any value is matched.
+type GRPCMethodMatch struct {
+	Match   GRPCMethodMatchType
+	Service string
+	Method  string
+}
+
+// GRPCFilters specifies a list of filters used to modify a request
+// before it is routed to an upstream.
+type GRPCFilters struct {
+	Headers       []HTTPHeaderFilter
+	RetryFilter   *RetryFilter
+	TimeoutFilter *TimeoutFilter
+}
+
+// GRPCService is a service reference for gRPC-based routing rules
+type GRPCService struct {
+	Name string
+	// Weight is an arbitrary integer used in calculating how much
+	// traffic should be sent to the given service.
+	Weight int
+
+	// Filters is a list of gRPC-based filters used to modify a request prior
+	// to routing it to the upstream service
+	Filters GRPCFilters
+
+	// ResponseFilters is a list of HTTP-based filters used to modify the
+	// response returned from the upstream service
+	ResponseFilters HTTPResponseFilters
+
 	// Partition is the partition the config entry is associated with.
 	// Partitioning is a Consul Enterprise feature.
 	Partition string `json:",omitempty"`
@@ -279,3 +433,52 @@ type HTTPService struct {
 	// Namespacing is a Consul Enterprise feature.
 	Namespace string `json:",omitempty"`
 }
+
+// TLSRouteConfigEntry manages the configuration for a TLS passthrough
+// route with the given name.
+type TLSRouteConfigEntry struct {
+	// Kind of the config entry. This should be set to api.TLSRoute.
+	Kind string
+
+	// Name is used to match the config entry with its associated tls-route.
+	Name string
+
+	// Parents is a list of gateways that this route should be bound to.
+	Parents []ResourceReference
+	// Hostnames are the SNI hostnames for which this TLSRoute should
+	// accept connections.
+	Hostnames []string
+	// Services is a list of TCP-based services that this should route to.
+	// Currently, this must specify at maximum one service.
+	Services []TCPService
+
+	Meta map[string]string `json:",omitempty"`
+
+	// Status is the asynchronous status which a TLSRoute propagates to the user.
+	Status ConfigEntryStatus
+
+	// CreateIndex is the Raft index this entry was created at. This is a
+	// read-only field.
+	CreateIndex uint64
+
+	// ModifyIndex is used for the Check-And-Set operations and can also be fed
+	// back into the WaitIndex of the QueryOptions in order to perform blocking
+	// queries.
+	ModifyIndex uint64
+
+	// Partition is the partition the config entry is associated with.
+	// Partitioning is a Consul Enterprise feature.
+	Partition string `json:",omitempty"`
+
+	// Namespace is the namespace the config entry is associated with.
+	// Namespacing is a Consul Enterprise feature.
+	Namespace string `json:",omitempty"`
+}
+
+func (a *TLSRouteConfigEntry) GetKind() string            { return TLSRoute }
+func (a *TLSRouteConfigEntry) GetName() string            { return a.Name }
+func (a *TLSRouteConfigEntry) GetPartition() string       { return a.Partition }
+func (a *TLSRouteConfigEntry) GetNamespace() string       { return a.Namespace }
+func (a *TLSRouteConfigEntry) GetMeta() map[string]string { return a.Meta }
+func (a *TLSRouteConfigEntry) GetCreateIndex() uint64     { return a.CreateIndex }
+func (a *TLSRouteConfigEntry) GetModifyIndex() uint64     { return a.ModifyIndex }
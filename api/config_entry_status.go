@@ -348,6 +348,333 @@ func ValidateRouteConditionReason(name RouteConditionType, status ConditionStatu
 	return nil
 }
 
+// CanaryRolloutConditionType is a type of condition for a canary rollout.
+type CanaryRolloutConditionType string
+
+// CanaryRolloutConditionReason is a reason for a canary rollout condition.
+type CanaryRolloutConditionReason string
+
+// the following statuses are custom to Consul
+const (
+	// This condition indicates whether the rollout could be applied to the
+	// service's discovery chain.
+	//
+	// Possible reasons for this condition to be true are:
+	//
+	// * "Accepted"
+	//
+	// Possible reasons for this condition to be false are:
+	//
+	// * "SubsetNotFound"
+	// * "SplitterUpdateFailed"
+	//
+	CanaryRolloutConditionAccepted CanaryRolloutConditionType = "Accepted"
+
+	// This reason is used with the "Accepted" condition when the rollout
+	// references valid subsets and its splitter could be written.
+	CanaryRolloutReasonAccepted CanaryRolloutConditionReason = "Accepted"
+
+	// This reason is used with the "Accepted" condition when the stable or
+	// canary subset is not defined by the service's service-resolver.
+	CanaryRolloutReasonSubsetNotFound CanaryRolloutConditionReason = "SubsetNotFound"
+
+	// This reason is used with the "Accepted" condition when writing the
+	// service-splitter for the current step was rejected.
+	CanaryRolloutReasonSplitterUpdateFailed CanaryRolloutConditionReason = "SplitterUpdateFailed"
+
+	// This condition indicates whether the canary subset currently satisfies
+	// the rollout's health gate.
+	//
+	// Possible reasons for this condition to be true are:
+	//
+	// * "HealthGatePassed"
+	//
+	// Possible reasons for this condition to be false are:
+	//
+	// * "HealthGateFailed"
+	//
+	// Possible reasons for this condition to be unknown are:
+	//
+	// * "WaitingForInstances"
+	// * "ErrorRatioUnavailable"
+	//
+	CanaryRolloutConditionHealthy CanaryRolloutConditionType = "Healthy"
+
+	// This reason is used with the "Healthy" condition when enough canary
+	// instances are passing their health checks and the error ratio of the
+	// canary subset, if gated on, is low enough.
+	CanaryRolloutReasonHealthGatePassed CanaryRolloutConditionReason = "HealthGatePassed"
+
+	// This reason is used with the "Healthy" condition when too few canary
+	// instances are passing their health checks or too many requests to the
+	// canary subset fail.
+	CanaryRolloutReasonHealthGateFailed CanaryRolloutConditionReason = "HealthGateFailed"
+
+	// This reason is used with the "Healthy" condition when the canary subset
+	// does not yet have enough registered instances to evaluate.
+	CanaryRolloutReasonWaitingForInstances CanaryRolloutConditionReason = "WaitingForInstances"
+
+	// This reason is used with the "Healthy" condition when the health gate
+	// limits the error ratio but it could not be queried.
+	CanaryRolloutReasonErrorRatioUnavailable CanaryRolloutConditionReason = "ErrorRatioUnavailable"
+
+	// This condition indicates whether the rollout is still shifting traffic.
+	//
+	// Possible reasons for this condition to be true are:
+	//
+	// * "StepApplied"
+	//
+	// Possible reasons for this condition to be false are:
+	//
+	// * "Completed"
+	// * "RolledBack"
+	//
+	CanaryRolloutConditionProgressing CanaryRolloutConditionType = "Progressing"
+
+	// This reason is used with the "Progressing" condition when the weights of
+	// a step have been written to the service-splitter.
+	CanaryRolloutReasonStepApplied CanaryRolloutConditionReason = "StepApplied"
+
+	// This reason is used with the "Progressing" condition when every step
+	// has been applied and its pause has elapsed.
+	CanaryRolloutReasonCompleted CanaryRolloutConditionReason = "Completed"
+
+	// This reason is used with the "Progressing" condition when the health
+	// gate failed for longer than its grace period and all traffic was
+	// returned to the stable subset.
+	CanaryRolloutReasonRolledBack CanaryRolloutConditionReason = "RolledBack"
+)
+
+var validCanaryRolloutConditionReasonsMapping = map[CanaryRolloutConditionType]map[ConditionStatus][]CanaryRolloutConditionReason{
+	CanaryRolloutConditionAccepted: {
+		ConditionStatusTrue: {
+			CanaryRolloutReasonAccepted,
+		},
+		ConditionStatusFalse: {
+			CanaryRolloutReasonSubsetNotFound,
+			CanaryRolloutReasonSplitterUpdateFailed,
+		},
+		ConditionStatusUnknown: {},
+	},
+	CanaryRolloutConditionHealthy: {
+		ConditionStatusTrue: {
+			CanaryRolloutReasonHealthGatePassed,
+		},
+		ConditionStatusFalse: {
+			CanaryRolloutReasonHealthGateFailed,
+		},
+		ConditionStatusUnknown: {
+			CanaryRolloutReasonWaitingForInstances,
+			CanaryRolloutReasonErrorRatioUnavailable,
+		},
+	},
+	CanaryRolloutConditionProgressing: {
+		ConditionStatusTrue: {
+			CanaryRolloutReasonStepApplied,
+		},
+		ConditionStatusFalse: {
+			CanaryRolloutReasonCompleted,
+			CanaryRolloutReasonRolledBack,
+		},
+		ConditionStatusUnknown: {},
+	},
+}
+
+func ValidateCanaryRolloutConditionReason(name CanaryRolloutConditionType, status ConditionStatus, reason CanaryRolloutConditionReason) error {
+	if err := checkConditionStatus(status); err != nil {
+		return err
+	}
+
+	reasons, ok := validCanaryRolloutConditionReasonsMapping[name]
+	if !ok {
+		return fmt.Errorf("unrecognized CanaryRolloutConditionType %s", name)
+	}
+
+	reasonsForStatus, ok := reasons[status]
+	if !ok {
+		return fmt.Errorf("unrecognized ConditionStatus %s", name)
+	}
+
+	if !slices.Contains(reasonsForStatus, reason) {
+		return fmt.Errorf("canary rollout condition reason %s not allowed for canary rollout condition type %s with status %s", reason, name, status)
+	}
+
+	return nil
+}
+
+// TrustBundleConditionType is a type of condition for a trust bundle.
+type TrustBundleConditionType string
+
+// TrustBundleConditionReason is a reason for a trust bundle condition.
+type TrustBundleConditionReason string
+
+// the following statuses are custom to Consul
+const (
+	// This condition indicates whether the bundle was last fetched
+	// successfully from its bundle endpoint.
+	//
+	// Possible reasons for this condition to be true are:
+	//
+	// * "Refreshed"
+	//
+	// Possible reasons for this condition to be false are:
+	//
+	// * "FetchFailed"
+	// * "InvalidBundle"
+	//
+	TrustBundleConditionRefreshed TrustBundleConditionType = "Refreshed"
+
+	// This reason is used with the "Refreshed" condition when the bundle was
+	// fetched and stored.
+	TrustBundleReasonRefreshed TrustBundleConditionReason = "Refreshed"
+
+	// This reason is used with the "Refreshed" condition when the bundle
+	// endpoint could not be reached or authenticated.
+	TrustBundleReasonFetchFailed TrustBundleConditionReason = "FetchFailed"
+
+	// This reason is used with the "Refreshed" condition when the bundle
+	// endpoint returned a document that is not a valid SPIFFE bundle.
+	TrustBundleReasonInvalidBundle TrustBundleConditionReason = "InvalidBundle"
+
+	// This condition indicates whether connect proxies trust the federated
+	// trust domain.
+	//
+	// Possible reasons for this condition to be true are:
+	//
+	// * "Trusted"
+	//
+	// Possible reasons for this condition to be false are:
+	//
+	// * "RevocationListsDistributed"
+	//
+	TrustBundleConditionTrusted TrustBundleConditionType = "Trusted"
+
+	// This reason is used with the "Trusted" condition when connect proxies
+	// validate certificates of the trust domain against the bundle.
+	TrustBundleReasonTrusted TrustBundleConditionReason = "Trusted"
+
+	// This reason is used with the "Trusted" condition when the Connect CA
+	// distributes revocation lists, which connect proxies can't check
+	// together with federated trust domains.
+	TrustBundleReasonRevocationListsDistributed TrustBundleConditionReason = "RevocationListsDistributed"
+)
+
+var validTrustBundleConditionReasonsMapping = map[TrustBundleConditionType]map[ConditionStatus][]TrustBundleConditionReason{
+	TrustBundleConditionRefreshed: {
+		ConditionStatusTrue: {
+			TrustBundleReasonRefreshed,
+		},
+		ConditionStatusFalse: {
+			TrustBundleReasonFetchFailed,
+			TrustBundleReasonInvalidBundle,
+		},
+		ConditionStatusUnknown: {},
+	},
+	TrustBundleConditionTrusted: {
+		ConditionStatusTrue: {
+			TrustBundleReasonTrusted,
+		},
+		ConditionStatusFalse: {
+			TrustBundleReasonRevocationListsDistributed,
+		},
+		ConditionStatusUnknown: {},
+	},
+}
+
+func ValidateTrustBundleConditionReason(name TrustBundleConditionType, status ConditionStatus, reason TrustBundleConditionReason) error {
+	if err := checkConditionStatus(status); err != nil {
+		return err
+	}
+
+	reasons, ok := validTrustBundleConditionReasonsMapping[name]
+	if !ok {
+		return fmt.Errorf("unrecognized TrustBundleConditionType %s", name)
+	}
+
+	reasonsForStatus, ok := reasons[status]
+	if !ok {
+		return fmt.Errorf("unrecognized ConditionStatus %s", name)
+	}
+
+	if !slices.Contains(reasonsForStatus, reason) {
+		return fmt.Errorf("trust bundle condition reason %s not allowed for trust bundle condition type %s with status %s", reason, name, status)
+	}
+
+	return nil
+}
+
+// ACMECertificateConditionType is a type of condition for an ACME
+// certificate.
+type ACMECertificateConditionType string
+
+// ACMECertificateConditionReason is a reason for an ACME certificate
+// condition.
+type ACMECertificateConditionReason string
+
+// the following statuses are custom to Consul
+const (
+	// This condition indicates whether the certificate was last ordered
+	// successfully from its ACME directory.
+	//
+	// Possible reasons for this condition to be true are:
+	//
+	// * "Issued"
+	//
+	// Possible reasons for this condition to be false are:
+	//
+	// * "OrderFailed"
+	// * "ChallengeFailed"
+	//
+	ACMECertificateConditionIssued ACMECertificateConditionType = "Issued"
+
+	// This reason is used with the "Issued" condition when the certificate
+	// was issued and stored.
+	ACMECertificateReasonIssued ACMECertificateConditionReason = "Issued"
+
+	// This reason is used with the "Issued" condition when the ACME account
+	// could not be registered or the order could not be placed or finalized.
+	ACMECertificateReasonOrderFailed ACMECertificateConditionReason = "OrderFailed"
+
+	// This reason is used with the "Issued" condition when the control of
+	// one of the domains could not be proven.
+	ACMECertificateReasonChallengeFailed ACMECertificateConditionReason = "ChallengeFailed"
+)
+
+var validACMECertificateConditionReasonsMapping = map[ACMECertificateConditionType]map[ConditionStatus][]ACMECertificateConditionReason{
+	ACMECertificateConditionIssued: {
+		ConditionStatusTrue: {
+			ACMECertificateReasonIssued,
+		},
+		ConditionStatusFalse: {
+			ACMECertificateReasonOrderFailed,
+			ACMECertificateReasonChallengeFailed,
+		},
+		ConditionStatusUnknown: {},
+	},
+}
+
+func ValidateACMECertificateConditionReason(name ACMECertificateConditionType, status ConditionStatus, reason ACMECertificateConditionReason) error {
+	if err := checkConditionStatus(status); err != nil {
+		return err
+	}
+
+	reasons, ok := validACMECertificateConditionReasonsMapping[name]
+	if !ok {
+		return fmt.Errorf("unrecognized ACMECertificateConditionType %s", name)
+	}
+
+	reasonsForStatus, ok := reasons[status]
+	if !ok {
+		return fmt.Errorf("unrecognized ConditionStatus %s", name)
+	}
+
+	if !slices.Contains(reasonsForStatus, reason) {
+		return fmt.Errorf("acme certificate condition reason %s not allowed for acme certificate condition type %s with status %s", reason, name, status)
+	}
+
+	return nil
+}
+
 func checkConditionStatus(status ConditionStatus) error {
 	switch status {
 	case ConditionStatusTrue, ConditionStatusFalse, ConditionStatusUnknown:
@@ -774,6 +774,53 @@ func TestDecodeConfigEntry(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "service-router: hedging and retry budget",
+			body: `
+			{
+				"Kind": "service-router",
+				"Name": "main",
+				"Routes": [
+					{
+						"Destination": {
+							"Service": "carrot",
+							"NumRetries": 2,
+							"PerTryTimeout": "250ms",
+							"HedgePolicy": {
+								"InitialRequests": 2,
+								"HedgeOnPerTryTimeout": true
+							},
+							"RetryBudget": {
+								"BudgetPercent": 25.5,
+								"MinRetryConcurrency": 4
+							}
+						}
+					}
+				]
+			}
+			`,
+			expect: &ServiceRouterConfigEntry{
+				Kind: "service-router",
+				Name: "main",
+				Routes: []ServiceRoute{
+					{
+						Destination: &ServiceRouteDestination{
+							Service:       "carrot",
+							NumRetries:    2,
+							PerTryTimeout: 250 * time.Millisecond,
+							HedgePolicy: &HedgePolicy{
+								InitialRequests:      2,
+								HedgeOnPerTryTimeout: true,
+							},
+							RetryBudget: &RetryBudget{
+								BudgetPercent:       float64Pointer(25.5),
+								MinRetryConcurrency: intPointer(4),
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "service-splitter: kitchen sink",
 			body: `
@@ -817,6 +864,108 @@ func TestDecodeConfigEntry(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "canary-rollout",
+			body: `
+			{
+				"Kind": "canary-rollout",
+				"Name": "web",
+				"StableSubset": "v1",
+				"CanarySubset": "v2",
+				"Steps": [
+					{
+						"Weight": 10,
+						"Pause": "5m"
+					},
+					{
+						"Weight": 100
+					}
+				],
+				"HealthGate": {
+					"MinPassingRatio": 0.9,
+					"MinPassingInstances": 2,
+					"GracePeriod": "30s",
+					"MaxErrorRatio": 0.05
+				}
+			}
+			`,
+			expect: &CanaryRolloutConfigEntry{
+				Kind:         CanaryRollout,
+				Name:         "web",
+				StableSubset: "v1",
+				CanarySubset: "v2",
+				Steps: []CanaryRolloutStep{
+					{Weight: 10, Pause: 5 * time.Minute},
+					{Weight: 100},
+				},
+				HealthGate: CanaryRolloutHealthGate{
+					MinPassingRatio:     0.9,
+					MinPassingInstances: 2,
+					GracePeriod:         30 * time.Second,
+					MaxErrorRatio:       0.05,
+				},
+			},
+		},
+		{
+			name: "trust-bundle",
+			body: `
+			{
+				"Kind": "trust-bundle",
+				"Name": "example.org",
+				"BundleEndpoint": {
+					"URL": "https://example.org/bundle",
+					"Profile": "https_spiffe",
+					"SpiffeID": "spiffe://example.org/bundle-server"
+				},
+				"RefreshInterval": "10m",
+				"Sequence": 4
+			}
+			`,
+			expect: &TrustBundleConfigEntry{
+				Kind: TrustBundle,
+				Name: "example.org",
+				BundleEndpoint: TrustBundleEndpoint{
+					URL:      "https://example.org/bundle",
+					Profile:  TrustBundleProfileHTTPSSPIFFE,
+					SpiffeID: "spiffe://example.org/bundle-server",
+				},
+				RefreshInterval: 10 * time.Minute,
+				Sequence:        4,
+			},
+		},
+		{
+			name: "acme-certificate",
+			body: `
+			{
+				"Kind": "acme-certificate",
+				"Name": "example",
+				"Directory": "https://acme.example.com/directory",
+				"Email": "admin@example.com",
+				"Domains": ["example.com", "www.example.com"],
+				"Challenge": "http-01",
+				"RenewBefore": "720h",
+				"Challenges": [
+					{
+						"Domain": "example.com",
+						"Token": "token",
+						"KeyAuthorization": "token.thumbprint"
+					}
+				]
+			}
+			`,
+			expect: &ACMECertificateConfigEntry{
+				Kind:        ACMECertificate,
+				Name:        "example",
+				Directory:   "https://acme.example.com/directory",
+				Email:       "admin@example.com",
+				Domains:     []string{"example.com", "www.example.com"},
+				Challenge:   ACMEChallengeHTTP01,
+				RenewBefore: 720 * time.Hour,
+				Challenges: []ACMEChallenge{
+					{Domain: "example.com", Token: "token", KeyAuthorization: "token.thumbprint"},
+				},
+			},
+		},
 		{
 			name: "service-resolver: subsets with failover",
 			body: `
@@ -1025,6 +1174,78 @@ func TestDecodeConfigEntry(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "service-resolver: session affinity",
+			body: `
+			{
+				"Kind": "service-resolver",
+				"Name": "main",
+				"LoadBalancer": {
+					"Policy": "least_request",
+					"SessionAffinity": {
+						"Type": "cookie",
+						"Name": "session",
+						"CookieConfig": {
+							"TTL": "10m",
+							"Path": "/"
+						},
+						"Strict": true
+					}
+				}
+			}
+			`,
+			expect: &ServiceResolverConfigEntry{
+				Kind: "service-resolver",
+				Name: "main",
+				LoadBalancer: &LoadBalancer{
+					Policy: "least_request",
+					SessionAffinity: &SessionAffinity{
+						Type: "cookie",
+						Name: "session",
+						CookieConfig: &SessionAffinityCookieConfig{
+							TTL:  10 * time.Minute,
+							Path: "/",
+						},
+						Strict: true,
+					},
+				},
+			},
+		},
+		{
+			name: "service-resolver: limits and passive health check",
+			body: `
+			{
+				"Kind": "service-resolver",
+				"Name": "main",
+				"Limits": {
+					"MaxConnections": 10,
+					"RetryBudget": {
+						"BudgetPercent": 25.5,
+						"MinRetryConcurrency": 5
+					}
+				},
+				"PassiveHealthCheck": {
+					"Interval": "10s",
+					"MaxFailures": 3
+				}
+			}
+			`,
+			expect: &ServiceResolverConfigEntry{
+				Kind: "service-resolver",
+				Name: "main",
+				Limits: &UpstreamLimits{
+					MaxConnections: intPointer(10),
+					RetryBudget: &RetryBudget{
+						BudgetPercent:       float64Pointer(25.5),
+						MinRetryConcurrency: intPointer(5),
+					},
+				},
+				PassiveHealthCheck: &PassiveHealthCheck{
+					Interval:    10 * time.Second,
+					MaxFailures: 3,
+				},
+			},
+		},
 		{
 			// TODO(rb): test SDS stuff here in both places (global/service)
 			name: "ingress-gateway",
@@ -1441,6 +1662,10 @@ func uint32Pointer(v uint32) *uint32 {
 	return &v
 }
 
+func float64Pointer(f float64) *float64 {
+	return &f
+}
+
 func durationPointer(d time.Duration) *time.Duration {
 	return &d
 }
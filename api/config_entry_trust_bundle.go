@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	// TrustBundleProfileHTTPSWeb authenticates a SPIFFE bundle endpoint with
+	// a web PKI certificate.
+	TrustBundleProfileHTTPSWeb = "https_web"
+
+	// TrustBundleProfileHTTPSSPIFFE authenticates a SPIFFE bundle endpoint
+	// with an X.509-SVID.
+	TrustBundleProfileHTTPSSPIFFE = "https_spiffe"
+)
+
+// TrustBundleConfigEntry imports the trust bundle of a foreign SPIFFE trust
+// domain. The bundle is either configured statically or fetched and
+// periodically refreshed from a SPIFFE bundle endpoint.
+type TrustBundleConfigEntry struct {
+	// Kind of the config entry. This should be set to api.TrustBundle.
+	Kind string
+
+	// Name is the SPIFFE trust domain of the bundle, such as "example.org".
+	Name string
+
+	// BundleEndpoint is the SPIFFE bundle endpoint the bundle is fetched
+	// from. If the URL is empty RootPEMs must be set.
+	BundleEndpoint TrustBundleEndpoint `json:",omitempty" alias:"bundle_endpoint"`
+
+	// RefreshInterval is how often the bundle is fetched. If zero, the
+	// refresh hint of the bundle is used.
+	RefreshInterval time.Duration `json:",omitempty" alias:"refresh_interval"`
+
+	// RootPEMs are the X.509 authorities of the trust domain. When the bundle
+	// is fetched from a bundle endpoint they are managed by Consul.
+	RootPEMs []string `json:",omitempty" alias:"root_pems"`
+
+	// Sequence is the sequence number of the last fetched bundle. This is a
+	// read-only field.
+	Sequence uint64 `json:",omitempty"`
+
+	// LastRefresh is when the bundle was last fetched successfully. This is a
+	// read-only field.
+	LastRefresh *time.Time `json:",omitempty" alias:"last_refresh"`
+
+	Meta map[string]string `json:",omitempty"`
+
+	// Status is the result of the last refresh of the bundle.
+	Status ConfigEntryStatus
+
+	// CreateIndex is the Raft index this entry was created at. This is a
+	// read-only field.
+	CreateIndex uint64
+
+	// ModifyIndex is used for the Check-And-Set operations and can also be fed
+	// back into the WaitIndex of the QueryOptions in order to perform blocking
+	// queries.
+	ModifyIndex uint64
+
+	// Partition is the partition the config entry is associated with.
+	// Partitioning is a Consul Enterprise feature.
+	Partition string `json:",omitempty"`
+
+	// Namespace is the namespace the config entry is associated with.
+	// Namespacing is a Consul Enterprise feature.
+	Namespace string `json:",omitempty"`
+}
+
+func (e *TrustBundleConfigEntry) GetKind() string            { return TrustBundle }
+func (e *TrustBundleConfigEntry) GetName() string            { return e.Name }
+func (e *TrustBundleConfigEntry) GetPartition() string       { return e.Partition }
+func (e *TrustBundleConfigEntry) GetNamespace() string       { return e.Namespace }
+func (e *TrustBundleConfigEntry) GetMeta() map[string]string { return e.Meta }
+func (e *TrustBundleConfigEntry) GetCreateIndex() uint64     { return e.CreateIndex }
+func (e *TrustBundleConfigEntry) GetModifyIndex() uint64     { return e.ModifyIndex }
+
+// TrustBundleEndpoint describes a SPIFFE bundle endpoint and how it is
+// authenticated.
+type TrustBundleEndpoint struct {
+	// URL is the https URL of the bundle endpoint.
+	URL string `json:",omitempty"`
+
+	// Profile is either "https_web" or "https_spiffe". Defaults to
+	// "https_web".
+	Profile string `json:",omitempty"`
+
+	// SpiffeID is the SPIFFE ID of the bundle endpoint, required by the
+	// https_spiffe profile.
+	SpiffeID string `json:",omitempty" alias:"spiffe_id"`
+
+	// CACert is a PEM encoded set of CA certificates used to authenticate the
+	// endpoint.
+	CACert string `json:",omitempty" alias:"ca_cert"`
+}
+
+func (e *TrustBundleConfigEntry) MarshalJSON() ([]byte, error) {
+	type Alias TrustBundleConfigEntry
+	exported := &struct {
+		RefreshInterval string `json:",omitempty"`
+		*Alias
+	}{
+		RefreshInterval: e.RefreshInterval.String(),
+		Alias:           (*Alias)(e),
+	}
+	if e.RefreshInterval == 0 {
+		exported.RefreshInterval = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (e *TrustBundleConfigEntry) UnmarshalJSON(data []byte) error {
+	type Alias TrustBundleConfigEntry
+	aux := &struct {
+		RefreshInterval string
+		*Alias
+	}{
+		Alias: (*Alias)(e),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if aux.RefreshInterval != "" {
+		if e.RefreshInterval, err = time.ParseDuration(aux.RefreshInterval); err != nil {
+			return err
+		}
+	}
+	return nil
+}
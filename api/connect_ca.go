@@ -103,10 +103,46 @@ type CARoot struct {
 	// cannot be active.
 	Active bool
 
+	// JWTKeyID and JWTPublicKey identify the key that signs JWT-SVIDs while
+	// this root is active.
+	JWTKeyID     string
+	JWTPublicKey string
+
 	CreateIndex uint64
 	ModifyIndex uint64
 }
 
+// CAJWKS is the JSON Web Key Set containing the public keys used to validate
+// JWT-SVIDs issued by the Connect CA.
+type CAJWKS struct {
+	Keys []CAJWK `json:"keys"`
+}
+
+// CAJWK is a public JSON Web Key used to validate JWT-SVIDs, or in a SPIFFE
+// bundle an X.509 authority.
+type CAJWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid,omitempty"`
+	Algorithm string `json:"alg,omitempty"`
+	Use       string `json:"use"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+	Y         string `json:"y,omitempty"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+
+	// X5C is the base64 encoded DER certificate of an X.509 authority.
+	X5C []string `json:"x5c,omitempty"`
+}
+
+// CASPIFFEBundle is the trust bundle of the cluster in the SPIFFE bundle
+// format, as served to federated trust domains.
+type CASPIFFEBundle struct {
+	Keys        []CAJWK `json:"keys"`
+	Sequence    uint64  `json:"spiffe_sequence,omitempty"`
+	RefreshHint int64   `json:"spiffe_refresh_hint,omitempty"`
+}
+
 // LeafCert is a certificate that has been issued by a Connect CA.
 type LeafCert struct {
 	// SerialNumber is the unique serial number for this certificate.
@@ -199,3 +235,291 @@ func (h *Connect) CASetConfig(conf *CAConfig, q *WriteOptions) (*WriteMeta, erro
 	wm.RequestTime = rtt
 	return wm, nil
 }
+
+// CARotationPlan describes the effect a CA configuration update would have.
+// It is returned by a dry run of the update.
+type CARotationPlan struct {
+	// Provider is the CA provider of the new configuration.
+	Provider string
+
+	// ActiveRootID is the ID of the current active root and NewRootID is the
+	// ID of the active root after the update. NewRootID is empty in secondary
+	// datacenters.
+	ActiveRootID string
+	NewRootID    string `json:",omitempty"`
+
+	// ConfigOnly is true if only the new configuration was validated, because
+	// the new provider isn't the Consul provider. The root and the impact of
+	// the update are then unknown.
+	ConfigOnly bool `json:",omitempty"`
+
+	// RootChanged is true if the update rotates to a new root and CrossSigned
+	// is true if a copy of the current Consul provider cross-signed the new
+	// root.
+	RootChanged bool
+	CrossSigned bool
+
+	// LeafCerts and Proxies are the number of unexpired leaf certificates and
+	// the number of proxies and gateways affected by the rotation.
+	LeafCerts int
+	Proxies   int
+
+	// Warnings describe the disruption the update may cause.
+	Warnings []string `json:",omitempty"`
+}
+
+// CARotationStatus reports the progress of the rotation to the active root in
+// a datacenter.
+type CARotationStatus struct {
+	Datacenter   string
+	ActiveRootID string
+	Roots        []CARotationRootStatus
+
+	// LeafCerts counts the unexpired leaf certificates and Proxies counts the
+	// proxies and gateways by whether they use the active root.
+	LeafCerts CARotationProgress
+	Proxies   CARotationProgress
+
+	// Peers reports whether each peer has received the active root.
+	Peers []CARotationPeerStatus
+}
+
+// CARotationRootStatus is the rotation status of a single root.
+type CARotationRootStatus struct {
+	ID           string
+	Name         string
+	Active       bool
+	RotatedOutAt time.Time `json:",omitempty"`
+
+	// LeafCerts is the number of unexpired leaf certificates signed while the
+	// root was active.
+	LeafCerts int
+}
+
+// CARotationProgress counts the items that picked up the active root.
+type CARotationProgress struct {
+	Active   int
+	Previous int
+	Unknown  int `json:",omitempty"`
+}
+
+// CARotationPeerStatus reports whether a peer has received the active root.
+type CARotationPeerStatus struct {
+	Peer                    string
+	Partition               string `json:",omitempty"`
+	Connected               bool
+	TrustBundleUpdated      bool
+	TrustBundleAcknowledged bool
+	LastTrustBundleSent     *time.Time `json:",omitempty"`
+}
+
+// CASetConfigDryRun validates an update of the CA configuration and reports
+// its expected impact without applying it.
+func (h *Connect) CASetConfigDryRun(conf *CAConfig, q *WriteOptions) (*CARotationPlan, *WriteMeta, error) {
+	r := h.c.newRequest("PUT", "/v1/connect/ca/configuration")
+	r.setWriteOptions(q)
+	r.params.Set("dry-run", "")
+	r.obj = conf
+	rtt, resp, err := h.c.doRequest(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return nil, nil, err
+	}
+
+	wm := &WriteMeta{}
+	wm.RequestTime = rtt
+
+	var out CARotationPlan
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, nil, err
+	}
+	return &out, wm, nil
+}
+
+// CARotationStatus reports how many leaf certificates, proxies and peers
+// have picked up the active root.
+func (h *Connect) CARotationStatus(q *QueryOptions) (*CARotationStatus, *QueryMeta, error) {
+	r := h.c.newRequest("GET", "/v1/connect/ca/rotation")
+	r.setQueryOptions(q)
+	rtt, resp, err := h.c.doRequest(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return nil, nil, err
+	}
+
+	qm := &QueryMeta{}
+	parseQueryMeta(resp, qm)
+	qm.RequestTime = rtt
+
+	var out CARotationStatus
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, nil, err
+	}
+	return &out, qm, nil
+}
+
+// CARevocation revokes Connect leaf certificates before they expire.
+type CARevocation struct {
+	// ID is a unique identifier for the revocation.
+	ID string `json:",omitempty"`
+
+	// SerialNumber is the serial number of the revoked certificate. This is
+	// encoded in standard hex separated by :.
+	SerialNumber string `json:",omitempty"`
+
+	// AuthorityKeyID is the key ID of the CA that issued the revoked
+	// certificate. When this is empty the serial number is revoked for every
+	// signing CA.
+	AuthorityKeyID string `json:",omitempty"`
+
+	// Service revokes every leaf certificate issued to the service before
+	// the revocation was created.
+	Service   string `json:",omitempty"`
+	Namespace string `json:",omitempty"`
+	Partition string `json:",omitempty"`
+
+	// Reason is a human readable description of why the certificate was
+	// revoked.
+	Reason string `json:",omitempty"`
+
+	// RevokedAt is the time the revocation was created and ExpiresAt is the
+	// time after which every certificate covered by the revocation has
+	// expired.
+	RevokedAt time.Time
+	ExpiresAt time.Time
+
+	CreateIndex uint64 `json:",omitempty"`
+	ModifyIndex uint64 `json:",omitempty"`
+}
+
+// CARevocationList is a certificate revocation list signed by the leaf
+// signing CA of a datacenter.
+type CARevocationList struct {
+	AuthorityKeyID string
+	Datacenter     string
+	SerialNumbers  []string
+	PEM            string
+	Unsupported    bool
+	ThisUpdate     time.Time
+	NextUpdate     time.Time
+
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+// CARevocations is the structure for the results of listing revocations.
+type CARevocations struct {
+	Revocations     []*CARevocation
+	RevocationLists []*CARevocationList
+
+	// Enforced is true when every signing CA that can still have valid
+	// certificates has a current revocation list, so that proxies load the
+	// lists and reject revoked certificates. Otherwise NotEnforcedReason
+	// explains which list is missing.
+	Enforced          bool
+	NotEnforcedReason string `json:",omitempty"`
+}
+
+// CARevoke revokes leaf certificates by serial number or service identity.
+func (h *Connect) CARevoke(revocation *CARevocation, q *WriteOptions) (*CARevocation, *WriteMeta, error) {
+	r := h.c.newRequest("PUT", "/v1/connect/ca/revoke")
+	r.setWriteOptions(q)
+	r.obj = revocation
+	rtt, resp, err := h.c.doRequest(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return nil, nil, err
+	}
+
+	wm := &WriteMeta{}
+	wm.RequestTime = rtt
+
+	var out CARevocation
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, nil, err
+	}
+	return &out, wm, nil
+}
+
+// CARevocations queries the leaf certificate revocations and the revocation
+// lists signed for them.
+func (h *Connect) CARevocations(q *QueryOptions) (*CARevocations, *QueryMeta, error) {
+	r := h.c.newRequest("GET", "/v1/connect/ca/revocations")
+	r.setQueryOptions(q)
+	rtt, resp, err := h.c.doRequest(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return nil, nil, err
+	}
+
+	qm := &QueryMeta{}
+	parseQueryMeta(resp, qm)
+	qm.RequestTime = rtt
+
+	var out CARevocations
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, nil, err
+	}
+	return &out, qm, nil
+}
+
+// CAJWKS returns the JSON Web Key Set that can be used to validate JWT-SVIDs
+// issued by the Connect CA.
+func (h *Connect) CAJWKS(q *QueryOptions) (*CAJWKS, *QueryMeta, error) {
+	r := h.c.newRequest("GET", "/v1/connect/ca/jwks")
+	r.setQueryOptions(q)
+	rtt, resp, err := h.c.doRequest(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return nil, nil, err
+	}
+
+	qm := &QueryMeta{}
+	parseQueryMeta(resp, qm)
+	qm.RequestTime = rtt
+
+	var out CAJWKS
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, nil, err
+	}
+	return &out, qm, nil
+}
+
+// CASPIFFEBundle returns the CA roots of the cluster as a SPIFFE bundle.
+func (h *Connect) CASPIFFEBundle(q *QueryOptions) (*CASPIFFEBundle, *QueryMeta, error) {
+	r := h.c.newRequest("GET", "/v1/connect/ca/bundle")
+	r.setQueryOptions(q)
+	rtt, resp, err := h.c.doRequest(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return nil, nil, err
+	}
+
+	qm := &QueryMeta{}
+	parseQueryMeta(resp, qm)
+	qm.RequestTime = rtt
+
+	var out CASPIFFEBundle
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, nil, err
+	}
+	return &out, qm, nil
+}
@@ -108,3 +108,136 @@ func TestAPI_ConnectCAConfig_get_set(t *testing.T) {
 		require.Equal(r, "bar", updated.State["foo"])
 	})
 }
+
+func TestAPI_ConnectCASetConfigDryRun(t *testing.T) {
+	t.Parallel()
+
+	c, s := makeClient(t)
+	defer s.Stop()
+
+	s.WaitForSerfCheck(t)
+
+	connect := c.Connect()
+
+	var plan *CARotationPlan
+	retry.Run(t, func(r *retry.R) {
+		var err error
+		plan, _, err = connect.CASetConfigDryRun(&CAConfig{
+			Provider: "consul",
+			Config: map[string]interface{}{
+				"PrivateKeyType": "ec",
+				"PrivateKeyBits": 384,
+			},
+		}, nil)
+		r.Check(err)
+	})
+	require.Equal(t, "consul", plan.Provider)
+	require.True(t, plan.RootChanged)
+	require.True(t, plan.CrossSigned)
+	require.NotEqual(t, plan.ActiveRootID, plan.NewRootID)
+
+	// The root didn't change.
+	status, meta, err := connect.CARotationStatus(nil)
+	require.NoError(t, err)
+	require.NotZero(t, meta.LastIndex)
+	require.Equal(t, plan.ActiveRootID, status.ActiveRootID)
+	require.Len(t, status.Roots, 1)
+}
+
+func TestAPI_ConnectCARevoke(t *testing.T) {
+	t.Parallel()
+
+	c, s := makeClient(t)
+	defer s.Stop()
+
+	s.WaitForSerfCheck(t)
+
+	connect := c.Connect()
+
+	// Revoking requires either a serial number or a service.
+	_, _, err := connect.CARevoke(&CARevocation{}, nil)
+	require.Error(t, err)
+
+	var revocation *CARevocation
+	retry.Run(t, func(r *retry.R) {
+		revocation, _, err = connect.CARevoke(&CARevocation{
+			SerialNumber: "0a",
+			Reason:       "key compromise",
+		}, nil)
+		r.Check(err)
+	})
+	require.NotEmpty(t, revocation.ID)
+	require.Equal(t, "0a", revocation.SerialNumber)
+	require.False(t, revocation.ExpiresAt.IsZero())
+
+	list, meta, err := connect.CARevocations(nil)
+	require.NoError(t, err)
+	require.NotZero(t, meta.LastIndex)
+	require.Len(t, list.Revocations, 1)
+	require.Equal(t, revocation.ID, list.Revocations[0].ID)
+	require.Equal(t, "key compromise", list.Revocations[0].Reason)
+	require.True(t, list.Enforced)
+}
+
+func TestAPI_ConnectCAJWKS(t *testing.T) {
+	t.Parallel()
+
+	c, s := makeClient(t)
+	defer s.Stop()
+
+	s.WaitForSerfCheck(t)
+
+	connect := c.Connect()
+	agent := c.Agent()
+
+	var svid *JWTSVID
+	retry.Run(t, func(r *retry.R) {
+		var err error
+		svid, _, err = agent.ConnectCAJWTSVID("web", []string{"api"}, time.Minute, nil)
+		r.Check(err)
+	})
+	require.Contains(t, svid.SpiffeID, "/svc/web")
+	require.NotEmpty(t, svid.Token)
+
+	jwks, _, err := connect.CAJWKS(nil)
+	require.NoError(t, err)
+	require.Len(t, jwks.Keys, 1)
+	require.Equal(t, svid.KeyID, jwks.Keys[0].KeyID)
+	require.Equal(t, "EC", jwks.Keys[0].KeyType)
+	require.Equal(t, "ES256", jwks.Keys[0].Algorithm)
+
+	roots, _, err := connect.CARoots(nil)
+	require.NoError(t, err)
+	require.Equal(t, svid.KeyID, roots.Roots[0].JWTKeyID)
+}
+
+func TestAPI_ConnectCASPIFFEBundle(t *testing.T) {
+	t.Parallel()
+
+	c, s := makeClient(t)
+	defer s.Stop()
+
+	s.WaitForSerfCheck(t)
+
+	connect := c.Connect()
+
+	var roots *CARootList
+	retry.Run(t, func(r *retry.R) {
+		var err error
+		roots, _, err = connect.CARoots(nil)
+		r.Check(err)
+		if len(roots.Roots) == 0 {
+			r.Fatal("no roots")
+		}
+	})
+
+	bundle, _, err := connect.CASPIFFEBundle(nil)
+	require.NoError(t, err)
+	require.NotZero(t, bundle.Sequence)
+	require.NotZero(t, bundle.RefreshHint)
+	require.Len(t, bundle.Keys, 2)
+	require.Equal(t, "x509-svid", bundle.Keys[0].Use)
+	require.Len(t, bundle.Keys[0].X5C, 1)
+	require.Equal(t, "jwt-svid", bundle.Keys[1].Use)
+	require.Equal(t, roots.Roots[0].JWTKeyID, bundle.Keys[1].KeyID)
+}
@@ -149,6 +149,10 @@ type IntentionSourceType string
 const (
 	// IntentionSourceConsul is a service within the Consul catalog.
 	IntentionSourceConsul IntentionSourceType = "consul"
+
+	// IntentionSourceSPIFFE is a workload of a federated SPIFFE trust domain,
+	// identified by its SPIFFE ID.
+	IntentionSourceSPIFFE IntentionSourceType = "spiffe"
 )
 
 // IntentionMatch are the arguments for the intention match API.
@@ -83,6 +83,14 @@ type DiscoveryChainOptions struct {
 	// OverrideConnectTimeout allows for the ConnectTimeout setting to be
 	// overridden for any resolver in the compiled chain.
 	OverrideConnectTimeout time.Duration `json:",omitempty"`
+
+	// OverrideLimits allows for the circuit breaking limits of any resolver
+	// in the compiled chain to be overridden.
+	OverrideLimits *UpstreamLimits `json:",omitempty"`
+
+	// OverridePassiveHealthCheck allows for the outlier detection of any
+	// resolver in the compiled chain to be overridden.
+	OverridePassiveHealthCheck *PassiveHealthCheck `json:",omitempty"`
 }
 
 func (o *DiscoveryChainOptions) requiresPOST() bool {
@@ -91,7 +99,9 @@ func (o *DiscoveryChainOptions) requiresPOST() bool {
 	}
 	return o.OverrideMeshGateway.Mode != "" ||
 		o.OverrideProtocol != "" ||
-		o.OverrideConnectTimeout != 0
+		o.OverrideConnectTimeout != 0 ||
+		o.OverrideLimits != nil ||
+		o.OverridePassiveHealthCheck != nil
 }
 
 type DiscoveryChainResponse struct {
@@ -183,6 +193,11 @@ type DiscoveryResolver struct {
 	ConnectTimeout time.Duration
 	Target         string
 	Failover       *DiscoveryFailover
+
+	// Limits and PassiveHealthCheck are the effective circuit breaking and
+	// outlier detection settings for callers of this resolver.
+	Limits             *UpstreamLimits     `json:",omitempty"`
+	PassiveHealthCheck *PassiveHealthCheck `json:",omitempty"`
 }
 
 func (r *DiscoveryResolver) MarshalJSON() ([]byte, error) {
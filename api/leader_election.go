@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultLeaderElectionSessionName is the Session Name we assign if none
+	// is provided
+	DefaultLeaderElectionSessionName = "Consul API Leader Election"
+)
+
+var (
+	// ErrNotLeader is returned if we attempt to resign from an election
+	// that we are not leading.
+	ErrNotLeader = fmt.Errorf("Not the election leader")
+)
+
+// LeaderElection is a higher level helper built on top of Lock that is used
+// to campaign for leadership of a key, to resign from it, and to observe who
+// the current leader is. The leader is identified by the session holding the
+// key, the value stored at the key is informational.
+type LeaderElection struct {
+	c    *Client
+	opts *LeaderElectionOptions
+
+	lock *Lock
+}
+
+// LeaderElectionOptions is used to parameterize the LeaderElection behavior.
+type LeaderElectionOptions struct {
+	Key              string        // Must be set and have write permissions
+	Value            []byte        // Optional, value stored at the key when elected
+	Session          string        // Optional, created if not specified
+	SessionName      string        // Optional, defaults to DefaultLeaderElectionSessionName
+	SessionTTL       string        // Optional, defaults to DefaultLockSessionTTL
+	MonitorRetries   int           // Optional, defaults to 0 which means no retries
+	MonitorRetryTime time.Duration // Optional, defaults to DefaultMonitorRetryTime
+	LockWaitTime     time.Duration // Optional, defaults to DefaultLockWaitTime
+	LockDelay        time.Duration // Optional, defaults to 15s
+	Fair             bool          // Optional, defaults to false which means candidates race for leadership
+	Namespace        string        `json:",omitempty"` // Optional, defaults to API client config, namespace of ACL token, or "default" namespace
+
+	// LeaderChanged is optional and is invoked by Observe every time the
+	// leader changes. It is given the new leader, or nil if the election
+	// currently has no leader.
+	LeaderChanged func(leader *ElectionLeader)
+}
+
+// ElectionLeader describes the current leader of an election.
+type ElectionLeader struct {
+	// Session is the ID of the session holding the leadership. Every term
+	// of leadership is held by a different session, even when candidates
+	// use the same value.
+	Session string
+
+	// Value is the value the leader stored at the key when elected.
+	Value []byte
+}
+
+// LeaderElection returns a handle to a leader election which can be used
+// to campaign for, resign from and observe the leadership of the given key.
+func (c *Client) LeaderElection(opts *LeaderElectionOptions) (*LeaderElection, error) {
+	if opts.Key == "" {
+		return nil, fmt.Errorf("missing key")
+	}
+	if opts.SessionName == "" {
+		opts.SessionName = DefaultLeaderElectionSessionName
+	}
+	lock, err := c.LockOpts(&LockOptions{
+		Key:              opts.Key,
+		Value:            opts.Value,
+		Session:          opts.Session,
+		SessionName:      opts.SessionName,
+		SessionTTL:       opts.SessionTTL,
+		MonitorRetries:   opts.MonitorRetries,
+		MonitorRetryTime: opts.MonitorRetryTime,
+		LockWaitTime:     opts.LockWaitTime,
+		LockDelay:        opts.LockDelay,
+		Fair:             opts.Fair,
+		Namespace:        opts.Namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+	e := &LeaderElection{
+		c:    c,
+		opts: opts,
+		lock: lock,
+	}
+	return e, nil
+}
+
+// Campaign blocks until this candidate is elected leader, the stopCh is
+// closed, or an error is encountered. On success a channel is returned that
+// is closed when leadership is lost. A nil channel with a nil error means
+// the campaign was aborted through the stopCh.
+func (e *LeaderElection) Campaign(stopCh <-chan struct{}) (<-chan struct{}, error) {
+	return e.lock.Lock(stopCh)
+}
+
+// Resign gives up leadership. It is an error to call this if this candidate
+// is not currently the leader.
+func (e *LeaderElection) Resign() error {
+	if err := e.lock.Unlock(); err != nil {
+		if err == ErrLockNotHeld {
+			return ErrNotLeader
+		}
+		return err
+	}
+	return nil
+}
+
+// Leader returns the current leader, or nil if the election currently has
+// no leader.
+func (e *LeaderElection) Leader(q *QueryOptions) (*ElectionLeader, *QueryMeta, error) {
+	if q == nil {
+		q = &QueryOptions{}
+	}
+	if q.Namespace == "" {
+		q.Namespace = e.opts.Namespace
+	}
+	pair, meta, err := e.c.KV().Get(e.opts.Key, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	if pair == nil {
+		return nil, meta, nil
+	}
+	if pair.Flags != LockFlagValue {
+		return nil, nil, ErrLockConflict
+	}
+	if pair.Session == "" {
+		return nil, meta, nil
+	}
+	return &ElectionLeader{Session: pair.Session, Value: pair.Value}, meta, nil
+}
+
+// Observe watches the election until the stopCh is closed, invoking the
+// LeaderChanged callback every time the leader changes. The callback is
+// always invoked once with the leader at the time Observe is called.
+func (e *LeaderElection) Observe(stopCh <-chan struct{}) error {
+	opts := &QueryOptions{
+		WaitTime:  e.lock.opts.LockWaitTime,
+		Namespace: e.opts.Namespace,
+	}
+	var last string
+	first := true
+	for {
+		select {
+		case <-stopCh:
+			return nil
+		default:
+		}
+
+		leader, meta, err := e.Leader(opts)
+		if err != nil {
+			if IsRetryableError(err) {
+				select {
+				case <-time.After(e.lock.opts.MonitorRetryTime):
+					opts.WaitIndex = 0
+					continue
+				case <-stopCh:
+					return nil
+				}
+			}
+			return fmt.Errorf("failed to read leader: %v", err)
+		}
+		opts.WaitIndex = meta.LastIndex
+
+		var session string
+		if leader != nil {
+			session = leader.Session
+		}
+		changed := first || session != last
+		first = false
+		last = session
+		if changed && e.opts.LeaderChanged != nil {
+			e.opts.LeaderChanged(leader)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPI_LeaderElection(t *testing.T) {
+	t.Parallel()
+	c, s := makeClientWithoutConnect(t)
+	defer s.Stop()
+	s.WaitForSerfCheck(t)
+
+	var (
+		l       sync.Mutex
+		changes []string
+	)
+	observer, err := c.LeaderElection(&LeaderElectionOptions{
+		Key: "test/leader",
+		LeaderChanged: func(leader *ElectionLeader) {
+			l.Lock()
+			defer l.Unlock()
+			if leader == nil {
+				changes = append(changes, "")
+				return
+			}
+			changes = append(changes, string(leader.Value))
+		},
+	})
+	require.NoError(t, err)
+
+	stopCh := make(chan struct{})
+	observeErr := make(chan error, 1)
+	go func() {
+		observeErr <- observer.Observe(stopCh)
+	}()
+
+	candidate, err := c.LeaderElection(&LeaderElectionOptions{
+		Key:   "test/leader",
+		Value: []byte("candidate-1"),
+	})
+	require.NoError(t, err)
+
+	// Resigning before being elected should fail
+	require.Equal(t, ErrNotLeader, candidate.Resign())
+
+	leaderCh, err := candidate.Campaign(nil)
+	require.NoError(t, err)
+	require.NotNil(t, leaderCh)
+
+	leader, _, err := candidate.Leader(nil)
+	require.NoError(t, err)
+	require.NotNil(t, leader)
+	require.Equal(t, "candidate-1", string(leader.Value))
+	require.NotEmpty(t, leader.Session)
+
+	require.NoError(t, candidate.Resign())
+
+	select {
+	case <-leaderCh:
+	case <-time.After(time.Second):
+		t.Fatalf("leadership should be lost after resigning")
+	}
+
+	leader, _, err = candidate.Leader(nil)
+	require.NoError(t, err)
+	require.Nil(t, leader)
+
+	require.Eventually(t, func() bool {
+		l.Lock()
+		defer l.Unlock()
+		return len(changes) >= 3
+	}, 5*time.Second, 50*time.Millisecond)
+
+	close(stopCh)
+
+	l.Lock()
+	defer l.Unlock()
+	require.Equal(t, []string{"", "candidate-1", ""}, changes[:3])
+}
+
+func TestAPI_LeaderElection_SameValue(t *testing.T) {
+	t.Parallel()
+	c, s := makeClientWithoutConnect(t)
+	defer s.Stop()
+	s.WaitForSerfCheck(t)
+
+	var (
+		l        sync.Mutex
+		sessions []string
+	)
+	observer, err := c.LeaderElection(&LeaderElectionOptions{
+		Key: "test/leader",
+		LeaderChanged: func(leader *ElectionLeader) {
+			l.Lock()
+			defer l.Unlock()
+			if leader != nil {
+				sessions = append(sessions, leader.Session)
+			}
+		},
+	})
+	require.NoError(t, err)
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go observer.Observe(stopCh)
+
+	// Both candidates use the empty default value, the handover between them
+	// must still be reported.
+	for i := 0; i < 2; i++ {
+		candidate, err := c.LeaderElection(&LeaderElectionOptions{Key: "test/leader"})
+		require.NoError(t, err)
+
+		leaderCh, err := candidate.Campaign(nil)
+		require.NoError(t, err)
+		require.NotNil(t, leaderCh)
+
+		leader, _, err := candidate.Leader(nil)
+		require.NoError(t, err)
+		require.NotNil(t, leader)
+		require.Empty(t, leader.Value)
+
+		require.Eventually(t, func() bool {
+			l.Lock()
+			defer l.Unlock()
+			return len(sessions) == i+1 && sessions[i] == leader.Session
+		}, 5*time.Second, 50*time.Millisecond)
+
+		require.NoError(t, candidate.Resign())
+	}
+
+	l.Lock()
+	defer l.Unlock()
+	require.NotEqual(t, sessions[0], sessions[1])
+}
+
+func TestAPI_LeaderElection_MonitorRetryTime(t *testing.T) {
+	t.Parallel()
+	c, err := NewClient(DefaultConfig())
+	require.NoError(t, err)
+
+	e, err := c.LeaderElection(&LeaderElectionOptions{Key: "test/leader"})
+	require.NoError(t, err)
+	require.Equal(t, DefaultMonitorRetryTime, e.lock.opts.MonitorRetryTime)
+
+	e, err = c.LeaderElection(&LeaderElectionOptions{
+		Key:              "test/leader",
+		MonitorRetryTime: time.Second,
+	})
+	require.NoError(t, err)
+	require.Equal(t, time.Second, e.lock.opts.MonitorRetryTime)
+}
@@ -5,6 +5,8 @@ package api
 
 import (
 	"fmt"
+	"path"
+	"sort"
 	"sync"
 	"time"
 )
@@ -35,6 +37,10 @@ const (
 	// affects locks and semaphores.
 	DefaultMonitorRetryTime = 2 * time.Second
 
+	// DefaultLockQueueKey is the key suffix used below the lock key to hold
+	// the contender entries when fair (FIFO) acquisition is enabled.
+	DefaultLockQueueKey = ".queue"
+
 	// LockFlagValue is a magic flag we set to indicate a key
 	// is being used for a lock. It is used to detect a potential
 	// conflict with a semaphore.
@@ -83,6 +89,7 @@ type LockOptions struct {
 	LockWaitTime     time.Duration // Optional, defaults to DefaultLockWaitTime
 	LockTryOnce      bool          // Optional, defaults to false which means try forever
 	LockDelay        time.Duration // Optional, defaults to 15s
+	Fair             bool          // Optional, defaults to false which means contenders race for the lock
 	Namespace        string        `json:",omitempty"` // Optional, defaults to API client config, namespace of ACL token, or "default" namespace
 }
 
@@ -179,6 +186,21 @@ func (l *Lock) Lock(stopCh <-chan struct{}) (<-chan struct{}, error) {
 		Namespace: l.opts.Namespace,
 	}
 
+	// In fair mode, enqueue ourselves so that contenders acquire the lock
+	// in the order they arrived. The queue entry is always removed once we
+	// are done contending, whether or not the lock was acquired.
+	if l.opts.Fair {
+		made, _, err := kv.Acquire(l.queueEntry(l.lockSession), &wOpts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to make queue entry: %v", err)
+		}
+		if !made {
+			return nil, fmt.Errorf("failed to make queue entry: entry is held by another session")
+		}
+		defer kv.Delete(l.queueEntry(l.lockSession).Key, &wOpts)
+	}
+	queueOpts := qOpts
+
 	start := time.Now()
 	attempts := 0
 WAIT:
@@ -201,6 +223,21 @@ WAIT:
 	}
 	attempts++
 
+	// In fair mode, wait until we are at the head of the queue before
+	// looking at the lock itself.
+	if l.opts.Fair {
+		queueOpts.WaitTime = qOpts.WaitTime
+		pairs, meta, err := kv.List(l.queuePrefix(), &queueOpts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read lock queue: %v", err)
+		}
+		if head := queueHead(pairs, 1); len(head) == 0 || head[0] != l.lockSession {
+			queueOpts.WaitIndex = meta.LastIndex
+			goto WAIT
+		}
+		queueOpts.WaitIndex = 0
+	}
+
 	// Look for an existing lock, blocking until not taken
 	pair, meta, err := kv.Get(l.opts.Key, &qOpts)
 	if err != nil {
@@ -378,6 +415,43 @@ func (l *Lock) lockEntry(session string) *KVPair {
 	}
 }
 
+// queuePrefix returns the KV prefix holding the fair queue entries
+func (l *Lock) queuePrefix() string {
+	return path.Join(l.opts.Key, DefaultLockQueueKey) + "/"
+}
+
+// queueEntry returns a formatted KVPair for a fair queue entry
+func (l *Lock) queueEntry(session string) *KVPair {
+	return &KVPair{
+		Key:     l.queuePrefix() + session,
+		Session: session,
+		Flags:   LockFlagValue,
+	}
+}
+
+// queueHead returns the sessions of the first n live entries in a fair
+// queue, ordered by the index at which each entry was created. Entries whose
+// session has been invalidated are skipped.
+func queueHead(pairs KVPairs, n int) []string {
+	live := make(KVPairs, 0, len(pairs))
+	for _, pair := range pairs {
+		if pair.Session != "" {
+			live = append(live, pair)
+		}
+	}
+	sort.SliceStable(live, func(i, j int) bool {
+		return live[i].CreateIndex < live[j].CreateIndex
+	})
+	if n > len(live) {
+		n = len(live)
+	}
+	sessions := make([]string, 0, n)
+	for _, pair := range live[:n] {
+		sessions = append(sessions, pair.Session)
+	}
+	return sessions
+}
+
 // monitorLock is a long running routine to monitor a lock ownership
 // It closes the stopCh if we lose our leadership.
 func (l *Lock) monitorLock(session string, stopCh chan struct{}) {
@@ -229,6 +229,83 @@ func TestAPI_LockContend(t *testing.T) {
 	}
 }
 
+func TestAPI_LockFair(t *testing.T) {
+	t.Parallel()
+	c, s := makeClientWithoutConnect(t)
+	defer s.Stop()
+	s.WaitForSerfCheck(t)
+
+	holder, session := createTestLock(t, c, "test/lock")
+	defer session.Destroy(holder.opts.Session, nil)
+
+	leaderCh, err := holder.Lock(nil)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if leaderCh == nil {
+		t.Fatalf("not leader")
+	}
+
+	// Queue up contenders one at a time so their arrival order is known
+	var (
+		l     sync.Mutex
+		order []int
+		wg    sync.WaitGroup
+	)
+	for idx := 0; idx < 3; idx++ {
+		lock, session := createTestLock(t, c, "test/lock")
+		defer session.Destroy(lock.opts.Session, nil)
+		lock.opts.Fair = true
+
+		wg.Add(1)
+		go func(idx int) {
+			defer wg.Done()
+			leaderCh, err := lock.Lock(nil)
+			if err != nil {
+				t.Errorf("err: %v", err)
+				return
+			}
+			if leaderCh == nil {
+				t.Errorf("not leader")
+				return
+			}
+			l.Lock()
+			order = append(order, idx)
+			l.Unlock()
+			lock.Unlock()
+		}(idx)
+
+		retry.Run(t, func(r *retry.R) {
+			pairs, _, err := c.KV().List(lock.queuePrefix(), nil)
+			if err != nil {
+				r.Fatalf("err: %v", err)
+			}
+			if len(pairs) != idx+1 {
+				r.Fatalf("expected %d queued, got %d", idx+1, len(pairs))
+			}
+		})
+	}
+
+	if err := holder.Unlock(); err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	doneCh := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(doneCh)
+	}()
+	select {
+	case <-doneCh:
+	case <-time.After(3 * DefaultLockRetryTime):
+		t.Fatalf("timeout")
+	}
+
+	if len(order) != 3 || order[0] != 0 || order[1] != 1 || order[2] != 2 {
+		t.Fatalf("acquired out of order: %v", order)
+	}
+}
+
 func TestAPI_LockDestroy(t *testing.T) {
 	t.Parallel()
 	c, s := makeClientWithoutConnect(t)
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"time"
+)
+
+const (
+	// CertInventoryKindCARoot is the kind of the roots of the Connect CA.
+	CertInventoryKindCARoot = "ca-root"
+
+	// CertInventoryKindCAIntermediate is the kind of the intermediates of
+	// the Connect CA.
+	CertInventoryKindCAIntermediate = "ca-intermediate"
+
+	// CertInventoryKindLeaf is the kind of the leaf certificates the Connect
+	// CA signed for services and mesh gateways.
+	CertInventoryKindLeaf = "leaf"
+
+	// CertInventoryKindAgentTLS is the kind of the certificates agents
+	// present for TLS.
+	CertInventoryKindAgentTLS = "agent-tls"
+)
+
+// CertInventory lists the certificates used in a datacenter along with their
+// expiry.
+type CertInventory struct {
+	Datacenter string
+
+	// Certs are sorted by expiry, the certificates with an unknown expiry
+	// come last.
+	Certs []*CertInventoryEntry
+}
+
+// CertInventoryEntry describes a single certificate of the inventory.
+type CertInventoryEntry struct {
+	// Kind is the kind of the certificate: ca-root, ca-intermediate, leaf,
+	// agent-tls, inline-certificate or file-system-certificate.
+	Kind string
+
+	// Name identifies the certificate within its kind: the name of the root,
+	// the service of a leaf certificate, the node of an agent or the name of
+	// a config entry.
+	Name      string
+	Partition string `json:",omitempty"`
+	Namespace string `json:",omitempty"`
+
+	// Source is where an agent TLS certificate was configured.
+	Source string `json:",omitempty"`
+
+	// Path is the path of the certificate of a file-system-certificate
+	// config entry.
+	Path string `json:",omitempty"`
+
+	SerialNumber string `json:",omitempty"`
+	Subject      string `json:",omitempty"`
+	Issuer       string `json:",omitempty"`
+
+	// NotBefore and NotAfter are zero if the certificate could not be read.
+	NotBefore time.Time
+	NotAfter  time.Time
+
+	// Error describes why the certificate could not be read.
+	Error string `json:",omitempty"`
+}
+
+// CertInventoryOptions are the options of a certificate inventory query.
+type CertInventoryOptions struct {
+	// ExpiringWithin limits the inventory to the certificates that expire
+	// within the duration. Zero lists every certificate.
+	ExpiringWithin time.Duration
+}
+
+// CertInventory lists the certificates used in a datacenter along with their
+// expiry.
+func (op *Operator) CertInventory(opts *CertInventoryOptions, q *QueryOptions) (*CertInventory, *QueryMeta, error) {
+	r := op.c.newRequest("GET", "/v1/operator/certs")
+	r.setQueryOptions(q)
+	if opts != nil && opts.ExpiringWithin > 0 {
+		r.params.Set("expiring-within", opts.ExpiringWithin.String())
+	}
+	rtt, resp, err := op.c.doRequest(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return nil, nil, err
+	}
+
+	qm := &QueryMeta{}
+	parseQueryMeta(resp, qm)
+	qm.RequestTime = rtt
+
+	var out CertInventory
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, nil, err
+	}
+	return &out, qm, nil
+}
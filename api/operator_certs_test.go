@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+)
+
+func TestAPI_OperatorCertInventory(t *testing.T) {
+	t.Parallel()
+	c, s := makeClient(t)
+	defer s.Stop()
+	s.WaitForSerfCheck(t)
+
+	operator := c.Operator()
+
+	var inventory *CertInventory
+	retry.Run(t, func(r *retry.R) {
+		var err error
+		inventory, _, err = operator.CertInventory(nil, nil)
+		r.Check(err)
+
+		var roots int
+		for _, cert := range inventory.Certs {
+			if cert.Kind == CertInventoryKindCARoot {
+				roots++
+			}
+		}
+		if roots != 1 {
+			r.Fatalf("expected 1 CA root, got %d", roots)
+		}
+	})
+	require.Equal(t, "dc1", inventory.Datacenter)
+
+	// None of the certificates expire soon.
+	inventory, _, err := operator.CertInventory(&CertInventoryOptions{ExpiringWithin: time.Hour}, nil)
+	require.NoError(t, err)
+	require.Empty(t, inventory.Certs)
+}
@@ -3,6 +3,8 @@
 
 package api
 
+import "time"
+
 // RaftServer has information about a server in the Raft configuration.
 type RaftServer struct {
 	// ID is the unique ID for the server. These are currently the same
@@ -47,6 +49,43 @@ type TransferLeaderResponse struct {
 	Success bool
 }
 
+// RaftLogStoreMigration describes the migration of the Raft log store of a
+// server to a different backend.
+type RaftLogStoreMigration struct {
+	// Backend is the log store backend that is migrated to.
+	Backend string
+
+	// SourceBackend is the log store backend that is migrated from.
+	SourceBackend string
+
+	// Status is the current phase of the migration: "copying", "ready",
+	// "swapping", "complete" or "failed".
+	Status string
+
+	// FirstIndex and LastIndex are the range of log entries that have been
+	// copied into the staged log store.
+	FirstIndex uint64
+	LastIndex  uint64
+
+	// CopiedEntries and TotalEntries report the progress of the copy.
+	CopiedEntries uint64
+	TotalEntries  uint64
+
+	// VerifiedCheckpoints is the number of log verification checkpoints
+	// whose checksum was verified against the staged log store.
+	VerifiedCheckpoints int
+
+	// BackupPath is where the previous log store was kept once the migration
+	// completed.
+	BackupPath string `json:",omitempty"`
+
+	// Error describes why the migration failed.
+	Error string `json:",omitempty"`
+
+	StartedAt time.Time
+	UpdatedAt time.Time
+}
+
 // RaftGetConfiguration is used to query the current Raft peer set.
 func (op *Operator) RaftGetConfiguration(q *QueryOptions) (*RaftConfiguration, error) {
 	r := op.c.newRequest("GET", "/v1/operator/raft/configuration")
@@ -130,3 +169,71 @@ func (op *Operator) RaftRemovePeerByID(id string, q *WriteOptions) error {
 	}
 	return nil
 }
+
+// RaftLogStoreMigration returns the log store migration of the server that
+// answers the request, or nil if there is none.
+func (op *Operator) RaftLogStoreMigration(q *QueryOptions) (*RaftLogStoreMigration, error) {
+	r := op.c.newRequest("GET", "/v1/operator/raft/logstore/migration")
+	r.setQueryOptions(q)
+	_, resp, err := op.c.doRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponseBody(resp)
+	found, resp, err := requireNotFoundOrOK(resp)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, nil
+	}
+
+	var out RaftLogStoreMigration
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RaftStartLogStoreMigration starts copying the Raft log store of the server
+// that answers the request to the given backend, "boltdb" or "wal". The
+// migration completes once the server is restarted with that backend
+// configured.
+func (op *Operator) RaftStartLogStoreMigration(backend string, q *WriteOptions) (*RaftLogStoreMigration, error) {
+	r := op.c.newRequest("PUT", "/v1/operator/raft/logstore/migration")
+	r.setWriteOptions(q)
+
+	r.params.Set("backend", backend)
+
+	_, resp, err := op.c.doRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return nil, err
+	}
+
+	var out RaftLogStoreMigration
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RaftCancelLogStoreMigration cancels the log store migration of the server
+// that answers the request and removes the staged log store.
+func (op *Operator) RaftCancelLogStoreMigration(q *WriteOptions) error {
+	r := op.c.newRequest("DELETE", "/v1/operator/raft/logstore/migration")
+	r.setWriteOptions(q)
+
+	_, resp, err := op.c.doRequest(r)
+	if err != nil {
+		return err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return err
+	}
+	return nil
+}
@@ -76,6 +76,7 @@ type SemaphoreOptions struct {
 	MonitorRetryTime  time.Duration // Optional, defaults to DefaultMonitorRetryTime
 	SemaphoreWaitTime time.Duration // Optional, defaults to DefaultSemaphoreWaitTime
 	SemaphoreTryOnce  bool          // Optional, defaults to false which means try forever
+	Fair              bool          // Optional, defaults to false which means contenders race for a slot
 	Namespace         string        `json:",omitempty"` // Optional, defaults to API client config, namespace of ACL token, or "default" namespace
 }
 
@@ -187,6 +188,16 @@ func (s *Semaphore) Acquire(stopCh <-chan struct{}) (<-chan struct{}, error) {
 		return nil, fmt.Errorf("failed to make contender entry: %v", err)
 	}
 
+	// In fair mode, an abandoned contender entry would hold up everyone
+	// queued behind it, so remove it if we give up
+	if s.opts.Fair {
+		defer func() {
+			if !s.isHeld {
+				kv.Delete(s.contenderEntry(s.lockSession).Key, &wOpts)
+			}
+		}()
+	}
+
 	// Setup the query options
 	qOpts := QueryOptions{
 		WaitTime:  s.opts.SemaphoreWaitTime,
@@ -246,6 +257,13 @@ WAIT:
 		goto WAIT
 	}
 
+	// In fair mode, only the oldest waiting contenders may claim the
+	// free slots
+	if s.opts.Fair && !s.isNextInLine(lock, pairs) {
+		qOpts.WaitIndex = meta.LastIndex
+		goto WAIT
+	}
+
 	// Create a new lock with us as a holder
 	lock.Holders[s.lockSession] = true
 	newLock, err := s.encodeLock(lock, lockPair.ModifyIndex)
@@ -494,6 +512,25 @@ func (s *Semaphore) pruneDeadHolders(lock *semaphoreLock, pairs KVPairs) {
 	}
 }
 
+// isNextInLine is used to check if our contender entry is among the oldest
+// waiting contenders that fit in the free slots of the lock
+func (s *Semaphore) isNextInLine(lock *semaphoreLock, pairs KVPairs) bool {
+	lockKey := path.Join(s.opts.Prefix, DefaultSemaphoreKey)
+	waiting := make(KVPairs, 0, len(pairs))
+	for _, pair := range pairs {
+		if pair.Key == lockKey || lock.Holders[pair.Session] {
+			continue
+		}
+		waiting = append(waiting, pair)
+	}
+	for _, session := range queueHead(waiting, lock.Limit-len(lock.Holders)) {
+		if session == s.lockSession {
+			return true
+		}
+	}
+	return false
+}
+
 // monitorLock is a long running routine to monitor a semaphore ownership
 // It closes the stopCh if we lose our slot.
 func (s *Semaphore) monitorLock(session string, stopCh chan struct{}) {
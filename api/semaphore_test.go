@@ -12,6 +12,8 @@ import (
 	"sync"
 	"testing"
 	"time"
+
+	"github.com/hashicorp/consul/sdk/testutil/retry"
 )
 
 func createTestSemaphore(t *testing.T, c *Client, prefix string, limit int) (*Semaphore, *Session) {
@@ -223,6 +225,84 @@ func TestAPI_SemaphoreContend(t *testing.T) {
 	}
 }
 
+func TestAPI_SemaphoreFair(t *testing.T) {
+	t.Parallel()
+	c, s := makeClient(t)
+	defer s.Stop()
+	s.WaitForSerfCheck(t)
+
+	holder, session := createTestSemaphore(t, c, "test/semaphore", 1)
+	defer session.Destroy(holder.opts.Session, nil)
+
+	lockCh, err := holder.Acquire(nil)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if lockCh == nil {
+		t.Fatalf("not hold")
+	}
+
+	// Queue up contenders one at a time so their arrival order is known
+	var (
+		l     sync.Mutex
+		order []int
+		wg    sync.WaitGroup
+	)
+	for idx := 0; idx < 3; idx++ {
+		sema, session := createTestSemaphore(t, c, "test/semaphore", 1)
+		defer session.Destroy(sema.opts.Session, nil)
+		sema.opts.Fair = true
+
+		wg.Add(1)
+		go func(idx int) {
+			defer wg.Done()
+			lockCh, err := sema.Acquire(nil)
+			if err != nil {
+				t.Errorf("err: %v", err)
+				return
+			}
+			if lockCh == nil {
+				t.Errorf("not hold")
+				return
+			}
+			l.Lock()
+			order = append(order, idx)
+			l.Unlock()
+			sema.Release()
+		}(idx)
+
+		retry.Run(t, func(r *retry.R) {
+			pairs, _, err := c.KV().Keys("test/semaphore/", "", nil)
+			if err != nil {
+				r.Fatalf("err: %v", err)
+			}
+			// The coordination key, the holder and the queued contenders
+			if len(pairs) != idx+3 {
+				r.Fatalf("expected %d keys, got %d", idx+3, len(pairs))
+			}
+		})
+	}
+
+	if err := holder.Release(); err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	doneCh := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(doneCh)
+	}()
+	select {
+	case <-doneCh:
+	case <-time.After(2 * DefaultSemaphoreWaitTime):
+		t.Fatalf("timeout")
+	}
+
+	if len(order) != 3 || order[0] != 0 || order[1] != 1 || order[2] != 2 {
+		t.Fatalf("acquired out of order: %v", order)
+	}
+}
+
 func TestAPI_SemaphoreBadLimit(t *testing.T) {
 	t.Parallel()
 	c, s := makeClient(t)
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package bench
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+)
+
+// BenchmarkKVGet_Consistency compares the throughput of the read consistency
+// modes against a local three server cluster. Reads are sent to the leader and
+// to a follower, with as many concurrent clients as -cpu allows.
+//
+//	go test ./bench -run xxx -bench KVGet_Consistency -cpu 1,16,64
+func BenchmarkKVGet_Consistency(b *testing.B) {
+	leader, follower := startCluster(b)
+
+	_, err := leader.Client().KV().Put(&api.KVPair{
+		Key:   "bench",
+		Value: []byte("74a31e96-1d0f-4fa7-aa14-7212a326986e"),
+	}, nil)
+	if err != nil {
+		b.Fatalf("err: %v", err)
+	}
+
+	modes := []struct {
+		name string
+		opts api.QueryOptions
+	}{
+		{"default", api.QueryOptions{}},
+		{"stale", api.QueryOptions{AllowStale: true}},
+		{"consistent", api.QueryOptions{RequireConsistent: true}},
+		{"follower-consistent", api.QueryOptions{FollowerConsistent: true}},
+	}
+	servers := []struct {
+		name  string
+		agent *agent.TestAgent
+	}{
+		{"leader", leader},
+		{"follower", follower},
+	}
+
+	for _, server := range servers {
+		kv := server.agent.Client().KV()
+		for _, mode := range modes {
+			opts := mode.opts
+			b.Run(fmt.Sprintf("%s/%s", server.name, mode.name), func(b *testing.B) {
+				b.SetParallelism(4)
+				b.ResetTimer()
+				b.RunParallel(func(pb *testing.PB) {
+					for pb.Next() {
+						pair, _, err := kv.Get("bench", &opts)
+						if err != nil {
+							b.Errorf("err: %v", err)
+							return
+						}
+						if pair == nil {
+							b.Error("missing key")
+							return
+						}
+					}
+				})
+			})
+		}
+	}
+}
+
+// startCluster starts three servers and returns the leader and one of the
+// followers once they all know the leader.
+func startCluster(b *testing.B) (leader, follower *agent.TestAgent) {
+	b.Helper()
+
+	var agents []*agent.TestAgent
+	for i := 0; i < 3; i++ {
+		// The first server bootstraps the cluster, the others join it.
+		var hcl string
+		if i > 0 {
+			hcl = `bootstrap = false`
+		}
+		a := &agent.TestAgent{Name: fmt.Sprintf("bench-server-%d", i), HCL: hcl}
+		if err := a.Start(b); err != nil {
+			b.Fatalf("err: %v", err)
+		}
+		b.Cleanup(func() { a.Shutdown() })
+		agents = append(agents, a)
+	}
+
+	for _, a := range agents[1:] {
+		addr := fmt.Sprintf("127.0.0.1:%d", agents[0].Config.SerfPortLAN)
+		if _, err := a.JoinLAN([]string{addr}, nil); err != nil {
+			b.Fatalf("err: %v", err)
+		}
+	}
+
+	retry.Run(b, func(r *retry.R) {
+		leader, follower = nil, nil
+		for _, a := range agents {
+			addr, err := a.Client().Status().Leader()
+			if err != nil {
+				r.Fatalf("err: %v", err)
+			}
+			if addr == "" {
+				r.Fatalf("%s has no leader", a.Config.NodeName)
+			}
+			if a.Agent.Stats()["consul"]["leader"] == "true" {
+				leader = a
+			} else {
+				follower = a
+			}
+		}
+		if leader == nil || follower == nil {
+			r.Fatalf("no leader elected")
+		}
+	})
+	return leader, follower
+}
@@ -44,5 +44,13 @@ Usage: consul connect ca <subcommand> [options] [args]
 
       $ consul connect ca set-config -config-file ca.json
 
+  Report the progress of a root rotation:
+
+      $ consul connect ca rotation-status
+
+  Revoke a leaf certificate:
+
+      $ consul connect ca revoke -cert leaf.pem
+
   For more examples, ask for subcommand help or view the documentation.
 `
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package revoke
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/mitchellh/cli"
+
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/flags"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+
+	// flags
+	serial         string
+	authorityKeyID string
+	certFile       string
+	service        string
+	reason         string
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.flags.StringVar(&c.serial, "serial", "",
+		"The serial number of the leaf certificate to revoke, encoded in hex "+
+			"optionally separated by colons.")
+	c.flags.StringVar(&c.authorityKeyID, "authority-key-id", "",
+		"The key ID of the CA that issued the certificate to revoke. Serial numbers "+
+			"are only unique per signing CA, so when this is omitted the serial number "+
+			"is revoked for every signing CA.")
+	c.flags.StringVar(&c.certFile, "cert", "",
+		"The path to a PEM encoded leaf certificate to revoke. The serial number and "+
+			"authority key ID are read from the certificate.")
+	c.flags.StringVar(&c.service, "service", "",
+		"The name of a service whose leaf certificates are all revoked. Certificates "+
+			"issued to the service after the revocation stay valid.")
+	c.flags.StringVar(&c.reason, "reason", "",
+		"A human readable description of why the certificates are revoked.")
+
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.ServerFlags())
+	flags.Merge(c.flags, c.http.MultiTenancyFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		c.UI.Error(fmt.Sprintf("Failed to parse args: %v", err))
+		return 1
+	}
+
+	revocation := &api.CARevocation{
+		SerialNumber:   c.serial,
+		AuthorityKeyID: c.authorityKeyID,
+		Service:        c.service,
+		Reason:         c.reason,
+	}
+
+	if c.certFile != "" {
+		if c.serial != "" || c.authorityKeyID != "" {
+			c.UI.Error("The -cert flag cannot be combined with -serial or -authority-key-id")
+			return 1
+		}
+		bytes, err := os.ReadFile(c.certFile)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error reading certificate file: %s", err))
+			return 1
+		}
+		cert, err := connect.ParseCert(string(bytes))
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing certificate: %s", err))
+			return 1
+		}
+		revocation.SerialNumber = connect.EncodeSerialNumber(cert.SerialNumber)
+		revocation.AuthorityKeyID = connect.EncodeSigningKeyID(cert.AuthorityKeyId)
+	}
+
+	switch {
+	case revocation.SerialNumber == "" && revocation.Service == "":
+		c.UI.Error("One of the -serial, -cert or -service flags is required")
+		return 1
+	case revocation.SerialNumber != "" && revocation.Service != "":
+		c.UI.Error("The -service flag cannot be combined with -serial or -cert")
+		return 1
+	}
+
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	revoked, _, err := client.Connect().CARevoke(revocation, nil)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error revoking certificates: %s", err))
+		return 1
+	}
+
+	if revoked.Service != "" {
+		c.UI.Output(fmt.Sprintf("Revoked certificates issued to service %q before %s (ID %s)",
+			revoked.Service, revoked.RevokedAt.Format(time.RFC3339), revoked.ID))
+	} else {
+		c.UI.Output(fmt.Sprintf("Revoked certificate with serial number %s (ID %s)", revoked.SerialNumber, revoked.ID))
+	}
+	return 0
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return c.help
+}
+
+const synopsis = "Revoke Connect leaf certificates"
+const help = `
+Usage: consul connect ca revoke [options]
+
+  Revokes Connect leaf certificates before they expire. Proxies reject
+  revoked certificates once the datacenter's signing CA has signed an updated
+  revocation list.
+
+  Revoke a single certificate:
+
+      $ consul connect ca revoke -cert leaf.pem -reason "key compromise"
+
+  Revoke a certificate by serial number:
+
+      $ consul connect ca revoke -serial 0a:1b:2c
+
+  Revoke every certificate issued to a service so far:
+
+      $ consul connect ca revoke -service web
+`
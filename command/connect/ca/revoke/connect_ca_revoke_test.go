@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package revoke
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestConnectCARevokeCommand_noTabs(t *testing.T) {
+	t.Parallel()
+	if strings.ContainsRune(New(cli.NewMockUi()).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestConnectCARevokeCommand_validation(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		args []string
+		err  string
+	}{
+		"no target": {
+			args: nil,
+			err:  "One of the -serial, -cert or -service flags is required",
+		},
+		"serial and service": {
+			args: []string{"-serial=0a", "-service=web"},
+			err:  "The -service flag cannot be combined with -serial or -cert",
+		},
+		"cert and serial": {
+			args: []string{"-cert=leaf.pem", "-serial=0a"},
+			err:  "The -cert flag cannot be combined with -serial or -authority-key-id",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ui := cli.NewMockUi()
+			c := New(ui)
+			require.Equal(t, 1, c.Run(tc.args))
+			require.Contains(t, ui.ErrorWriter.String(), tc.err)
+		})
+	}
+}
+
+func TestConnectCARevokeCommand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, ``)
+	defer a.Shutdown()
+
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	ui := cli.NewMockUi()
+	c := New(ui)
+	args := []string{
+		"-http-addr=" + a.HTTPAddr(),
+		"-serial=0A:1B",
+		"-reason=key compromise",
+	}
+
+	code := c.Run(args)
+	require.Equal(t, 0, code, ui.ErrorWriter.String())
+	require.Contains(t, ui.OutputWriter.String(), "Revoked certificate with serial number 0a:1b")
+
+	req := structs.DCSpecificRequest{
+		Datacenter: "dc1",
+	}
+	var reply structs.IndexedCARevocations
+	require.NoError(t, a.RPC(context.Background(), "ConnectCA.Revocations", &req, &reply))
+	require.Len(t, reply.Revocations, 1)
+	require.Equal(t, "0a:1b", reply.Revocations[0].SerialNumber)
+	require.Equal(t, "key compromise", reply.Revocations[0].Reason)
+}
@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package rotationstatus
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/cli"
+	"github.com/ryanuber/columnize"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/flags"
+)
+
+const (
+	formatPretty = "pretty"
+	formatJSON   = "json"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+
+	// flags
+	format string
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.flags.StringVar(&c.format, "format", formatPretty,
+		fmt.Sprintf("Output format {%s|%s}", formatPretty, formatJSON))
+
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.ServerFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		c.UI.Error(fmt.Sprintf("Failed to parse args: %v", err))
+		return 1
+	}
+
+	if c.format != formatPretty && c.format != formatJSON {
+		c.UI.Error(fmt.Sprintf("Invalid format, valid formats are {%s|%s}", formatPretty, formatJSON))
+		return 1
+	}
+
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	// Report every datacenter unless a single one was requested.
+	var datacenters []string
+	if dc := c.http.Datacenter(); dc != "" {
+		datacenters = []string{dc}
+	} else {
+		datacenters, err = client.Catalog().Datacenters()
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error listing datacenters: %s", err))
+			return 1
+		}
+	}
+
+	var statuses []*api.CARotationStatus
+	failed := false
+	for _, dc := range datacenters {
+		status, _, err := client.Connect().CARotationStatus(&api.QueryOptions{Datacenter: dc})
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error reading CA rotation status of datacenter %q: %s", dc, err))
+			failed = true
+			continue
+		}
+		statuses = append(statuses, status)
+	}
+
+	if c.format == formatJSON {
+		output, err := json.MarshalIndent(statuses, "", "    ")
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error marshalling JSON: %s", err))
+			return 1
+		}
+		c.UI.Output(string(output))
+	} else {
+		for i, status := range statuses {
+			if i > 0 {
+				c.UI.Output("")
+			}
+			c.UI.Output(formatStatus(status))
+		}
+	}
+
+	if failed {
+		return 1
+	}
+	return 0
+}
+
+func formatStatus(status *api.CARotationStatus) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Datacenter:  %s\n", status.Datacenter)
+	fmt.Fprintf(&b, "Active root: %s\n", status.ActiveRootID)
+	fmt.Fprintf(&b, "Leaf certs:  %s\n", formatProgress(status.LeafCerts))
+	fmt.Fprintf(&b, "Proxies:     %s\n", formatProgress(status.Proxies))
+
+	if len(status.Roots) > 0 {
+		rows := []string{"Root ID\x1fName\x1fActive\x1fRotated Out\x1fLeaf Certs"}
+		for _, root := range status.Roots {
+			rotatedOut := "-"
+			if !root.RotatedOutAt.IsZero() {
+				rotatedOut = root.RotatedOutAt.Format(time.RFC3339)
+			}
+			rows = append(rows, fmt.Sprintf("%s\x1f%s\x1f%t\x1f%s\x1f%d",
+				root.ID, root.Name, root.Active, rotatedOut, root.LeafCerts))
+		}
+		b.WriteString("\n")
+		b.WriteString(columnize.Format(rows, &columnize.Config{Delim: string([]byte{0x1f})}))
+		b.WriteString("\n")
+	}
+
+	if len(status.Peers) > 0 {
+		rows := []string{"Peer\x1fPartition\x1fConnected\x1fTrust Bundle Updated\x1fAcknowledged"}
+		for _, peer := range status.Peers {
+			rows = append(rows, fmt.Sprintf("%s\x1f%s\x1f%t\x1f%t\x1f%t",
+				peer.Peer, peer.Partition, peer.Connected, peer.TrustBundleUpdated, peer.TrustBundleAcknowledged))
+		}
+		b.WriteString("\n")
+		b.WriteString(columnize.Format(rows, &columnize.Config{Delim: string([]byte{0x1f})}))
+		b.WriteString("\n")
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func formatProgress(p api.CARotationProgress) string {
+	out := fmt.Sprintf("%d active, %d previous", p.Active, p.Previous)
+	if p.Unknown > 0 {
+		out += fmt.Sprintf(", %d unknown", p.Unknown)
+	}
+	return out
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return c.help
+}
+
+const synopsis = "Report the progress of a CA root rotation"
+const help = `
+Usage: consul connect ca rotation-status [options]
+
+  Reports, per datacenter, how many leaf certificates and proxies use the
+  active root and how many still use a previous root, and whether each peer
+  has received a trust bundle that contains the active root.
+
+  Every datacenter is reported unless -datacenter is set.
+
+      $ consul connect ca rotation-status
+
+      $ consul connect ca rotation-status -datacenter dc2 -format json
+`
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package rotationstatus
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestConnectCARotationStatusCommand_noTabs(t *testing.T) {
+	t.Parallel()
+	if strings.ContainsRune(New(cli.NewMockUi()).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestConnectCARotationStatusCommand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, ``)
+	defer a.Shutdown()
+
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	t.Run("pretty", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		c := New(ui)
+		code := c.Run([]string{"-http-addr=" + a.HTTPAddr()})
+		require.Equal(t, 0, code, ui.ErrorWriter.String())
+
+		output := ui.OutputWriter.String()
+		require.Contains(t, output, "Datacenter:  dc1")
+		require.Contains(t, output, "Leaf certs:  ")
+		require.Contains(t, output, "0 previous")
+		require.Contains(t, output, "Root ID")
+	})
+
+	t.Run("json", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		c := New(ui)
+		code := c.Run([]string{"-http-addr=" + a.HTTPAddr(), "-datacenter=dc1", "-format=json"})
+		require.Equal(t, 0, code, ui.ErrorWriter.String())
+
+		var statuses []*api.CARotationStatus
+		require.NoError(t, json.Unmarshal([]byte(ui.OutputWriter.String()), &statuses))
+		require.Len(t, statuses, 1)
+		require.Equal(t, "dc1", statuses[0].Datacenter)
+		require.NotEmpty(t, statuses[0].ActiveRootID)
+		require.Len(t, statuses[0].Roots, 1)
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		c := New(ui)
+		code := c.Run([]string{"-http-addr=" + a.HTTPAddr(), "-format=yaml"})
+		require.Equal(t, 1, code)
+		require.Contains(t, ui.ErrorWriter.String(), "Invalid format")
+	})
+}
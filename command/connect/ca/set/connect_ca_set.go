@@ -8,6 +8,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/consul/command/flags"
@@ -29,6 +30,7 @@ type cmd struct {
 	// flags
 	configFile               flags.StringValue
 	forceWithoutCrossSigning bool
+	dryRun                   bool
 }
 
 func (c *cmd) init() {
@@ -41,6 +43,11 @@ func (c *cmd) init() {
 			"failures during the rollout as new leafs will be rejected by proxies that "+
 			"have not yet observed the new root cert but is the only option if a CA that "+
 			"doesn't support cross signing needs to be reconfigured or mirated away from.")
+	c.flags.BoolVar(&c.dryRun, "dry-run", false,
+		"Validate the new configuration and report the expected impact of the update "+
+			"without applying it. A new Consul provider is configured and the current "+
+			"provider cross-signs the new root if the root changes. The configuration "+
+			"of other providers is only validated.")
 
 	c.http = &flags.HTTPFlags{}
 	flags.Merge(c.flags, c.http.ClientFlags())
@@ -82,6 +89,16 @@ func (c *cmd) Run(args []string) int {
 	}
 	config.ForceWithoutCrossSigning = c.forceWithoutCrossSigning
 
+	if c.dryRun {
+		plan, _, err := client.Connect().CASetConfigDryRun(&config, nil)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error validating CA configuration: %s", err))
+			return 1
+		}
+		c.UI.Output(formatPlan(plan))
+		return 0
+	}
+
 	// Set the new configuration.
 	if _, err := client.Connect().CASetConfig(&config, nil); err != nil {
 		c.UI.Error(fmt.Sprintf("Error setting CA configuration: %s", err))
@@ -91,6 +108,33 @@ func (c *cmd) Run(args []string) int {
 	return 0
 }
 
+func formatPlan(plan *api.CARotationPlan) string {
+	var b strings.Builder
+	b.WriteString("Configuration is valid, no changes were applied.\n\n")
+	fmt.Fprintf(&b, "Provider:       %s\n", plan.Provider)
+	fmt.Fprintf(&b, "Active root:    %s\n", plan.ActiveRootID)
+	if plan.NewRootID != "" {
+		fmt.Fprintf(&b, "New root:       %s\n", plan.NewRootID)
+	}
+	if plan.ConfigOnly {
+		b.WriteString("Root changes:   unknown, only the configuration was validated\n")
+	} else {
+		fmt.Fprintf(&b, "Root changes:   %t\n", plan.RootChanged)
+	}
+	if plan.RootChanged {
+		fmt.Fprintf(&b, "Cross-signed:   %t\n", plan.CrossSigned)
+		fmt.Fprintf(&b, "Leaf certs:     %d\n", plan.LeafCerts)
+		fmt.Fprintf(&b, "Proxies:        %d\n", plan.Proxies)
+	}
+	if len(plan.Warnings) > 0 {
+		b.WriteString("\nWarnings:\n")
+		for _, w := range plan.Warnings {
+			fmt.Fprintf(&b, "  - %s\n", w)
+		}
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
 func (c *cmd) Synopsis() string {
 	return synopsis
 }
@@ -104,4 +148,9 @@ const help = `
 Usage: consul connect ca set-config [options]
 
   Modifies the current Connect Certificate Authority (CA) configuration.
+
+  Validate a new configuration and report the expected impact of the update
+  without applying it:
+
+      $ consul connect ca set-config -config-file ca.json -dry-run
 `
@@ -59,3 +59,39 @@ func TestConnectCASetConfigCommand(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 288*time.Hour, parsed.IntermediateCertTTL)
 }
+
+func TestConnectCASetConfigCommand_DryRun(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, ``)
+	defer a.Shutdown()
+
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+	ui := cli.NewMockUi()
+	c := New(ui)
+	args := []string{
+		"-http-addr=" + a.HTTPAddr(),
+		"-config-file=test-fixtures/ca_config.json",
+		"-dry-run",
+	}
+
+	code := c.Run(args)
+	require.Equal(t, 0, code, ui.ErrorWriter.String())
+	output := ui.OutputWriter.String()
+	require.Contains(t, output, "no changes were applied")
+	// Only the intermediate TTL changes, the root stays the same.
+	require.Contains(t, output, "Root changes:   false")
+
+	// The configuration was not updated.
+	req := structs.DCSpecificRequest{
+		Datacenter: "dc1",
+	}
+	var reply structs.CAConfiguration
+	require.NoError(t, a.RPC(context.Background(), "ConnectCA.ConfigurationGet", &req, &reply))
+	parsed, err := ca.ParseConsulCAConfig(reply.Config)
+	require.NoError(t, err)
+	require.NotEqual(t, 288*time.Hour, parsed.IntermediateCertTTL)
+}
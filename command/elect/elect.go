@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package elect
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/flags"
+	"github.com/mitchellh/cli"
+)
+
+const (
+	// defaultMonitorRetry is the number of 500 errors we will tolerate
+	// before declaring the leadership lost.
+	defaultMonitorRetry = 3
+
+	// defaultMonitorRetryTime is the amount of time to wait between
+	// retries.
+	defaultMonitorRetryTime = 1 * time.Second
+)
+
+func New(ui cli.Ui, shutdownCh <-chan struct{}) *cmd {
+	c := &cmd{UI: ui, ShutdownCh: shutdownCh}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+
+	ShutdownCh <-chan struct{}
+
+	// flags
+	fair         bool
+	monitorRetry int
+	name         string
+	observe      bool
+	value        string
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.flags.BoolVar(&c.fair, "fair", false,
+		"Grant leadership to candidates in the order they started campaigning "+
+			"instead of letting them race for it. All candidates for the key "+
+			"should agree on this value. The default value is false.")
+	c.flags.IntVar(&c.monitorRetry, "monitor-retry", defaultMonitorRetry,
+		"Number of times to retry if Consul returns a 500 error while monitoring "+
+			"the leadership. The default value is 3, with a 1s wait between "+
+			"retries. Set this value to 0 to disable retries.")
+	c.flags.StringVar(&c.name, "name", "",
+		"Optional name to associate with the election session. If not provided, "+
+			"one is generated based on the key.")
+	c.flags.BoolVar(&c.observe, "observe", false,
+		"Do not campaign, instead print the value of the leader every time it "+
+			"changes until interrupted. The default value is false.")
+	c.flags.StringVar(&c.value, "value", "",
+		"Value identifying this candidate, stored at the key while it is the "+
+			"leader. Defaults to the name of the local agent's node.")
+
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.ServerFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		return 1
+	}
+
+	extra := c.flags.Args()
+	switch len(extra) {
+	case 0:
+		c.UI.Error("Key must be specified")
+		return 1
+	case 1:
+	default:
+		c.UI.Error(fmt.Sprintf("Too many arguments (expected 1, got %d)", len(extra)))
+		return 1
+	}
+	key := strings.TrimPrefix(extra[0], "/")
+
+	if c.monitorRetry < 0 {
+		c.UI.Error("Number for 'monitor-retry' must be >= 0")
+		return 1
+	}
+	if c.name == "" {
+		c.name = fmt.Sprintf("Consul election at '%s'", key)
+	}
+
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error connecting to Consul agent: %s", err))
+		return 1
+	}
+	nodeName, err := client.Agent().NodeName()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error querying Consul agent: %s", err))
+		return 1
+	}
+	if c.value == "" {
+		c.value = nodeName
+	}
+
+	opts := &api.LeaderElectionOptions{
+		Key:              key,
+		Value:            []byte(c.value),
+		SessionName:      c.name,
+		MonitorRetries:   c.monitorRetry,
+		MonitorRetryTime: defaultMonitorRetryTime,
+		Fair:             c.fair,
+		LeaderChanged:    c.printLeader,
+	}
+	e, err := client.LeaderElection(opts)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Election setup failed: %s", err))
+		return 1
+	}
+
+	if c.observe {
+		if err := e.Observe(c.ShutdownCh); err != nil {
+			c.UI.Error(fmt.Sprintf("Error observing election: %s", err))
+			return 1
+		}
+		return 0
+	}
+
+	leaderCh, err := e.Campaign(c.ShutdownCh)
+	if leaderCh == nil {
+		if err == nil {
+			c.UI.Error("Shutdown triggered during campaign")
+		} else {
+			c.UI.Error(fmt.Sprintf("Campaign failed: %s", err))
+		}
+		return 1
+	}
+	c.UI.Output(fmt.Sprintf("Elected leader of %q as %q", key, c.value))
+
+	select {
+	case <-c.ShutdownCh:
+	case <-leaderCh:
+		c.UI.Error("Leadership lost")
+		return 1
+	}
+
+	if err := e.Resign(); err != nil {
+		c.UI.Error(fmt.Sprintf("Resign failed: %s", err))
+		return 1
+	}
+	c.UI.Output("Resigned leadership")
+	return 0
+}
+
+// printLeader is used as the LeaderChanged callback when observing.
+func (c *cmd) printLeader(leader *api.ElectionLeader) {
+	if leader == nil {
+		c.UI.Output("(no leader)")
+		return
+	}
+	c.UI.Output(string(leader.Value))
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return c.help
+}
+
+const synopsis = "Campaign for or observe leadership of a key"
+const help = `
+Usage: consul elect [options] key
+
+  Campaigns for leadership of the given key, blocking until elected. Once
+  elected, leadership is held until the command is interrupted, at which
+  point it is resigned. The command exits with an error if leadership is
+  lost while held.
+
+  Campaign for leadership using the local node name as the leader value:
+
+      $ consul elect service/web/leader
+
+  Print the current leader and every subsequent change:
+
+      $ consul elect -observe service/web/leader
+
+  The key provided must have write privileges to campaign.
+`
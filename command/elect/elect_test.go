@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package elect
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestElectCommand_noTabs(t *testing.T) {
+	t.Parallel()
+	if strings.ContainsRune(New(cli.NewMockUi(), nil).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestElectCommand_BadArgs(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		args   []string
+		output string
+	}{
+		"no key":        {args: []string{}, output: "Key must be specified"},
+		"too many args": {args: []string{"a", "b"}, output: "Too many arguments"},
+		"bad retry":     {args: []string{"-monitor-retry=-5", "a"}, output: "must be >= 0"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ui := cli.NewMockUi()
+			c := New(ui, nil)
+			c.flags.SetOutput(ui.ErrorWriter)
+			require.Equal(t, 1, c.Run(tc.args))
+			require.Contains(t, ui.ErrorWriter.String(), tc.output)
+		})
+	}
+}
+
+func TestElectCommand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, ``)
+	defer a.Shutdown()
+
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	ui := cli.NewMockUi()
+	shutdownCh := make(chan struct{})
+	c := New(ui, shutdownCh)
+
+	args := []string{"-http-addr=" + a.HTTPAddr(), "-value=candidate-1", "test/leader"}
+
+	codeCh := make(chan int, 1)
+	go func() {
+		codeCh <- c.Run(args)
+	}()
+
+	client := a.Client()
+	retry.Run(t, func(r *retry.R) {
+		pair, _, err := client.KV().Get("test/leader", nil)
+		require.NoError(r, err)
+		require.NotNil(r, pair)
+		require.NotEmpty(r, pair.Session)
+		require.Equal(r, "candidate-1", string(pair.Value))
+	})
+
+	close(shutdownCh)
+	require.Equal(t, 0, <-codeCh, ui.ErrorWriter.String())
+	require.Contains(t, ui.OutputWriter.String(), "Elected leader")
+
+	// Leadership should have been resigned
+	pair, _, err := client.KV().Get("test/leader", nil)
+	require.NoError(t, err)
+	require.NotNil(t, pair)
+	require.Empty(t, pair.Session)
+}
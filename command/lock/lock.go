@@ -55,6 +55,7 @@ type cmd struct {
 	verbose   bool
 
 	// flags
+	fair               bool
 	limit              int
 	monitorRetry       int
 	name               string
@@ -76,6 +77,10 @@ func (c *cmd) init() {
 		"Exit 2 if the child process exited with an error if this is true, "+
 			"otherwise this doesn't propagate an error from the child. The "+
 			"default value is false.")
+	c.flags.BoolVar(&c.fair, "fair", false,
+		"Acquire the lock or semaphore in the order contenders arrived instead "+
+			"of racing for it, so that no contender is starved. All contenders "+
+			"at the prefix should agree on this value. The default value is false.")
 	c.flags.IntVar(&c.limit, "n", 1,
 		"Optional limit on the number of concurrent lock holders. The underlying "+
 			"implementation switches from a lock to a semaphore when the value is "+
@@ -283,6 +288,7 @@ func (c *cmd) setupLock(client *api.Client, prefix, name string,
 		SessionName:      name,
 		MonitorRetries:   retry,
 		MonitorRetryTime: defaultMonitorRetryTime,
+		Fair:             c.fair,
 	}
 	if oneshot {
 		opts.LockTryOnce = true
@@ -318,6 +324,7 @@ func (c *cmd) setupSemaphore(client *api.Client, limit int, prefix, name string,
 		SessionName:      name,
 		MonitorRetries:   retry,
 		MonitorRetryTime: defaultMonitorRetryTime,
+		Fair:             c.fair,
 	}
 	if oneshot {
 		opts.SemaphoreTryOnce = true
@@ -484,5 +491,8 @@ Usage: consul lock [options] prefix child...
   exclusion. Setting a higher value switches to a semaphore allowing multiple
   holders to coordinate.
 
+  When -fair is set, contenders are granted the lock or a semaphore slot in
+  the order they started waiting for it.
+
   The prefix provided must have write privileges.
 `
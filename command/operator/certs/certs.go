@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package certs
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/cli"
+	"github.com/ryanuber/columnize"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/flags"
+)
+
+const (
+	formatPretty = "pretty"
+	formatJSON   = "json"
+)
+
+// The statuses of a certificate relative to the expiry thresholds.
+const (
+	statusOK       = "ok"
+	statusWarning  = "warning"
+	statusCritical = "critical"
+	statusExpired  = "expired"
+	statusUnknown  = "unknown"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+
+	// flags
+	expiringWithin time.Duration
+	warning        time.Duration
+	critical       time.Duration
+	format         string
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.flags.DurationVar(&c.expiringWithin, "expiring-within", 0,
+		"Only list the certificates that expire within this duration, for example 720h. "+
+			"By default every certificate is listed.")
+	c.flags.DurationVar(&c.warning, "warning", 30*24*time.Hour,
+		"Certificates that expire within this duration are reported with the warning status.")
+	c.flags.DurationVar(&c.critical, "critical", 7*24*time.Hour,
+		"Certificates that expire within this duration are reported with the critical status.")
+	c.flags.StringVar(&c.format, "format", formatPretty,
+		fmt.Sprintf("Output format {%s|%s}", formatPretty, formatJSON))
+
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.ServerFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		c.UI.Error(fmt.Sprintf("Failed to parse args: %v", err))
+		return 1
+	}
+
+	if c.format != formatPretty && c.format != formatJSON {
+		c.UI.Error(fmt.Sprintf("Invalid format, valid formats are {%s|%s}", formatPretty, formatJSON))
+		return 1
+	}
+	if c.critical > c.warning {
+		c.UI.Error("The -critical threshold must not be greater than the -warning threshold")
+		return 1
+	}
+
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+
+	opts := &api.CertInventoryOptions{ExpiringWithin: c.expiringWithin}
+	q := &api.QueryOptions{AllowStale: c.http.Stale()}
+	inventory, _, err := client.Operator().CertInventory(opts, q)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error listing certificates: %s", err))
+		return 1
+	}
+
+	now := time.Now()
+	if c.format == formatJSON {
+		out := struct {
+			Datacenter string
+			Certs      []certStatus
+		}{
+			Datacenter: inventory.Datacenter,
+			Certs:      make([]certStatus, 0, len(inventory.Certs)),
+		}
+		for _, cert := range inventory.Certs {
+			out.Certs = append(out.Certs, certStatus{cert, c.status(cert, now)})
+		}
+		output, err := json.MarshalIndent(out, "", "    ")
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error marshalling JSON: %s", err))
+			return 1
+		}
+		c.UI.Output(string(output))
+		return 0
+	}
+
+	if len(inventory.Certs) == 0 {
+		c.UI.Info("No certificates found.")
+		return 0
+	}
+
+	rows := []string{"Kind\x1fName\x1fDetail\x1fSerial Number\x1fExpires\x1fRemaining\x1fStatus"}
+	for _, cert := range inventory.Certs {
+		name := cert.Name
+		if cert.Namespace != "" {
+			name = cert.Namespace + "/" + name
+		}
+		if cert.Partition != "" {
+			name = cert.Partition + "/" + name
+		}
+		detail := cert.Source
+		if cert.Path != "" {
+			detail = cert.Path
+		}
+		expires, remaining := "-", "-"
+		if !cert.NotAfter.IsZero() {
+			expires = cert.NotAfter.Format(time.RFC3339)
+			remaining = formatRemaining(cert.NotAfter.Sub(now))
+		}
+		rows = append(rows, fmt.Sprintf("%s\x1f%s\x1f%s\x1f%s\x1f%s\x1f%s\x1f%s",
+			cert.Kind, name, detail, cert.SerialNumber, expires, remaining, c.status(cert, now)))
+	}
+	c.UI.Output(columnize.Format(rows, &columnize.Config{Delim: string([]byte{0x1f})}))
+	return 0
+}
+
+// certStatus is a certificate along with its status in the JSON output.
+type certStatus struct {
+	*api.CertInventoryEntry
+	Status string
+}
+
+func (c *cmd) status(cert *api.CertInventoryEntry, now time.Time) string {
+	if cert.NotAfter.IsZero() {
+		return statusUnknown
+	}
+	remaining := cert.NotAfter.Sub(now)
+	switch {
+	case remaining <= 0:
+		return statusExpired
+	case remaining <= c.critical:
+		return statusCritical
+	case remaining <= c.warning:
+		return statusWarning
+	}
+	return statusOK
+}
+
+func formatRemaining(d time.Duration) string {
+	switch {
+	case d <= 0:
+		return "-"
+	case d >= 48*time.Hour:
+		return fmt.Sprintf("%dd", d/(24*time.Hour))
+	}
+	return strings.TrimSuffix(d.Round(time.Minute).String(), "0s")
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return c.help
+}
+
+const synopsis = "List the certificates of the cluster and their expiry"
+const help = `
+Usage: consul operator certs [options]
+
+  Lists the certificates used in a datacenter along with their expiry: the
+  roots and intermediates of the Connect CA, the latest leaf certificate of
+  each service, the inline-certificate, acme-certificate and
+  file-system-certificate config entries, and the TLS certificates of the
+  agent answering the request and of the server handling it. The certificates that expire first are listed first.
+
+  The files of file-system-certificate config entries are only read if they
+  are available to the agent, their expiry is unknown otherwise.
+
+  List the certificates that expire within 30 days:
+
+      $ consul operator certs -expiring-within 720h
+
+  Report the certificates as JSON:
+
+      $ consul operator certs -format json
+`
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package certs
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestOperatorCertsCommand_noTabs(t *testing.T) {
+	t.Parallel()
+	if strings.ContainsRune(New(cli.NewMockUi()).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestOperatorCertsCommand_status(t *testing.T) {
+	t.Parallel()
+	c := New(cli.NewMockUi())
+	c.warning = 30 * 24 * time.Hour
+	c.critical = 7 * 24 * time.Hour
+
+	now := time.Now()
+	cases := map[string]struct {
+		notAfter time.Time
+		status   string
+	}{
+		"unknown":  {time.Time{}, statusUnknown},
+		"expired":  {now.Add(-time.Minute), statusExpired},
+		"critical": {now.Add(24 * time.Hour), statusCritical},
+		"warning":  {now.Add(10 * 24 * time.Hour), statusWarning},
+		"ok":       {now.Add(90 * 24 * time.Hour), statusOK},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.status, c.status(&api.CertInventoryEntry{NotAfter: tc.notAfter}, now))
+		})
+	}
+}
+
+func TestOperatorCertsCommand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, ``)
+	defer a.Shutdown()
+
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	t.Run("pretty", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		c := New(ui)
+		code := c.Run([]string{"-http-addr=" + a.HTTPAddr()})
+		require.Equal(t, 0, code, ui.ErrorWriter.String())
+
+		output := ui.OutputWriter.String()
+		require.Contains(t, output, "Kind")
+		require.Contains(t, output, api.CertInventoryKindCARoot)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		c := New(ui)
+		code := c.Run([]string{"-http-addr=" + a.HTTPAddr(), "-format=json"})
+		require.Equal(t, 0, code, ui.ErrorWriter.String())
+
+		var out struct {
+			Datacenter string
+			Certs      []struct {
+				Kind   string
+				Status string
+			}
+		}
+		require.NoError(t, json.Unmarshal([]byte(ui.OutputWriter.String()), &out))
+		require.Equal(t, "dc1", out.Datacenter)
+		require.NotEmpty(t, out.Certs)
+		for _, cert := range out.Certs {
+			if cert.Kind == api.CertInventoryKindCARoot {
+				require.Equal(t, statusOK, cert.Status)
+			}
+		}
+	})
+
+	t.Run("expiring within", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		c := New(ui)
+		code := c.Run([]string{"-http-addr=" + a.HTTPAddr(), "-expiring-within=1m"})
+		require.Equal(t, 0, code, ui.ErrorWriter.String())
+		require.Contains(t, ui.OutputWriter.String(), "No certificates found.")
+	})
+
+	t.Run("invalid thresholds", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		c := New(ui)
+		code := c.Run([]string{"-http-addr=" + a.HTTPAddr(), "-warning=24h", "-critical=48h"})
+		require.Equal(t, 1, code)
+		require.Contains(t, ui.ErrorWriter.String(), "-critical threshold")
+	})
+}
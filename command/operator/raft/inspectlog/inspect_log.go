@@ -0,0 +1,381 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package inspectlog
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/hashicorp/raft"
+	"github.com/mitchellh/cli"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/command/flags"
+)
+
+const (
+	PrettyFormat string = "pretty"
+	JSONFormat   string = "json"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	help  string
+
+	// flags
+	format   string
+	entries  bool
+	minIndex uint64
+	maxIndex uint64
+	types    string
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.flags.StringVar(&c.format, "format", PrettyFormat,
+		fmt.Sprintf("Output format of the summary {%s}. Entries are always printed as JSON.",
+			strings.Join([]string{PrettyFormat, JSONFormat}, "|")))
+	c.flags.BoolVar(&c.entries, "entries", false,
+		"Print every matching log entry as a JSON object per line instead of the summary.")
+	c.flags.Uint64Var(&c.minIndex, "min-index", 0,
+		"Only include log entries with an index greater than or equal to this value.")
+	c.flags.Uint64Var(&c.maxIndex, "max-index", 0,
+		"Only include log entries with an index less than or equal to this value.")
+	c.flags.StringVar(&c.types, "type", "",
+		"Comma separated list of entry types to include. Types are message types such as "+
+			"\"Register\" or \"KVS\", or Raft log types such as \"LogConfiguration\".")
+
+	c.help = flags.Usage(help, c.flags)
+}
+
+// Summary describes the contents of a log store.
+type Summary struct {
+	Store      string
+	Path       string
+	FirstIndex uint64
+	LastIndex  uint64
+	Segments   []segmentInfo  `json:",omitempty"`
+	Snapshots  []snapshotInfo `json:",omitempty"`
+	Types      []TypeStats
+	Matched    int
+}
+
+// TypeStats describes the log entries of one type.
+type TypeStats struct {
+	Name       string
+	Count      int
+	Size       int
+	FirstIndex uint64
+	LastIndex  uint64
+}
+
+// Entry is a decoded log entry.
+type Entry struct {
+	Index       uint64
+	Term        uint64
+	Type        string
+	MessageType string     `json:",omitempty"`
+	AppendedAt  *time.Time `json:",omitempty"`
+	Size        int
+	Chunked     bool        `json:",omitempty"`
+	Body        interface{} `json:",omitempty"`
+	Error       string      `json:",omitempty"`
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	var path string
+	args = c.flags.Args()
+	switch len(args) {
+	case 0:
+		c.UI.Error("Missing PATH argument")
+		return 1
+	case 1:
+		path = args[0]
+	default:
+		c.UI.Error(fmt.Sprintf("Too many arguments (expected 1, got %d)", len(args)))
+		return 1
+	}
+
+	if c.format != PrettyFormat && c.format != JSONFormat {
+		c.UI.Error(fmt.Sprintf("Unknown format: %s", c.format))
+		return 1
+	}
+	if c.maxIndex > 0 && c.minIndex > c.maxIndex {
+		c.UI.Error("-min-index must not be greater than -max-index")
+		return 1
+	}
+
+	kind, storePath, snapshotPath, err := locateStore(path)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error locating Raft log store: %s", err))
+		return 1
+	}
+
+	store, err := openLogStore(kind, storePath)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error opening Raft log store: %s", err))
+		return 1
+	}
+	defer store.Close()
+
+	summary := &Summary{
+		Store:    store.Kind(),
+		Path:     storePath,
+		Segments: store.Segments(),
+	}
+	stats := make(map[string]*TypeStats)
+	types := c.typeFilter()
+
+	err = store.ForEach(func(log *raft.Log) error {
+		if summary.FirstIndex == 0 {
+			summary.FirstIndex = log.Index
+		}
+		summary.LastIndex = log.Index
+
+		if log.Index < c.minIndex || (c.maxIndex > 0 && log.Index > c.maxIndex) {
+			return nil
+		}
+		entry := decodeEntry(log, c.entries)
+		name := entry.typeName()
+		if len(types) > 0 && !types[strings.ToLower(name)] {
+			return nil
+		}
+		summary.Matched++
+
+		if c.entries {
+			out, err := json.Marshal(entry)
+			if err != nil {
+				return fmt.Errorf("failed to encode log at index %d: %w", log.Index, err)
+			}
+			c.UI.Output(string(out))
+			return nil
+		}
+
+		s, ok := stats[name]
+		if !ok {
+			s = &TypeStats{Name: name, FirstIndex: log.Index}
+			stats[name] = s
+		}
+		s.Count++
+		s.Size += len(log.Data)
+		s.LastIndex = log.Index
+		return nil
+	})
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error reading Raft log store: %s", err))
+		return 1
+	}
+	if c.entries {
+		return 0
+	}
+
+	summary.Snapshots, err = listSnapshots(snapshotPath)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error reading Raft snapshots: %s", err))
+		return 1
+	}
+
+	summary.Types = make([]TypeStats, 0, len(stats))
+	for _, s := range stats {
+		summary.Types = append(summary.Types, *s)
+	}
+	sort.Slice(summary.Types, func(i, j int) bool {
+		if summary.Types[i].Count != summary.Types[j].Count {
+			return summary.Types[i].Count > summary.Types[j].Count
+		}
+		return summary.Types[i].Name < summary.Types[j].Name
+	})
+
+	out, err := formatSummary(c.format, summary)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error outputting summary: %s", err))
+		return 1
+	}
+	c.UI.Output(out)
+	return 0
+}
+
+// typeFilter returns the lower-cased set of types given with -type.
+func (c *cmd) typeFilter() map[string]bool {
+	types := make(map[string]bool)
+	for _, t := range strings.Split(c.types, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			types[strings.ToLower(t)] = true
+		}
+	}
+	return types
+}
+
+// decodeEntry decodes a log entry. The body of commands is only decoded when
+// it is going to be printed.
+func decodeEntry(log *raft.Log, withBody bool) *Entry {
+	entry := &Entry{
+		Index: log.Index,
+		Term:  log.Term,
+		Type:  log.Type.String(),
+		Size:  len(log.Data),
+	}
+	if !log.AppendedAt.IsZero() {
+		entry.AppendedAt = &log.AppendedAt
+	}
+
+	switch log.Type {
+	case raft.LogCommand:
+		if len(log.Data) == 0 {
+			return entry
+		}
+		// Large commands are split across several entries, whose data can only
+		// be decoded once they are put back together.
+		if len(log.Extensions) > 0 {
+			entry.Chunked = true
+			return entry
+		}
+		msgType := structs.MessageType(log.Data[0]) &^ structs.IgnoreUnknownTypeFlag
+		entry.MessageType = msgType.String()
+		if !withBody {
+			return entry
+		}
+
+		var body interface{}
+		if err := structs.Decode(log.Data[1:], &body); err != nil {
+			entry.Error = fmt.Sprintf("failed to decode %s command: %s", entry.MessageType, err)
+			return entry
+		}
+		entry.Body = body
+
+	case raft.LogConfiguration:
+		if !withBody {
+			return entry
+		}
+		configuration, err := decodeConfiguration(log.Data)
+		if err != nil {
+			entry.Error = err.Error()
+			return entry
+		}
+		entry.Body = configuration
+	}
+	return entry
+}
+
+// decodeConfiguration wraps raft.DecodeConfiguration, which panics on invalid
+// data.
+func decodeConfiguration(data []byte) (configuration raft.Configuration, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to decode configuration: %v", r)
+		}
+	}()
+	return raft.DecodeConfiguration(data), nil
+}
+
+// typeName is the name used to filter and group the entry: the message type
+// of commands and the Raft log type of everything else.
+func (e *Entry) typeName() string {
+	if e.MessageType != "" {
+		return e.MessageType
+	}
+	return e.Type
+}
+
+func formatSummary(format string, summary *Summary) (string, error) {
+	if format == JSONFormat {
+		out, err := json.MarshalIndent(summary, "", "   ")
+		if err != nil {
+			return "", err
+		}
+		return string(out), nil
+	}
+
+	var b bytes.Buffer
+	tw := tabwriter.NewWriter(&b, 0, 2, 6, ' ', 0)
+
+	fmt.Fprintf(tw, " Store\t%s\n", summary.Store)
+	fmt.Fprintf(tw, " Path\t%s\n", summary.Path)
+	fmt.Fprintf(tw, " First Index\t%d\n", summary.FirstIndex)
+	fmt.Fprintf(tw, " Last Index\t%d\n", summary.LastIndex)
+	fmt.Fprintf(tw, " Matched Entries\t%d\n", summary.Matched)
+
+	if len(summary.Segments) > 0 {
+		fmt.Fprintln(tw, "\n Segment\tBase Index\tMin Index\tMax Index\tSealed")
+		for _, s := range summary.Segments {
+			maxIndex := "-"
+			if s.MaxIndex > 0 {
+				maxIndex = fmt.Sprintf("%d", s.MaxIndex)
+			}
+			fmt.Fprintf(tw, " %d\t%d\t%d\t%s\t%t\n", s.ID, s.BaseIndex, s.MinIndex, maxIndex, s.Sealed)
+		}
+	}
+
+	fmt.Fprintln(tw, "\n Type\tCount\tSize\tFirst Index\tLast Index")
+	for _, s := range summary.Types {
+		fmt.Fprintf(tw, " %s\t%d\t%d\t%d\t%d\n", s.Name, s.Count, s.Size, s.FirstIndex, s.LastIndex)
+	}
+
+	if len(summary.Snapshots) > 0 {
+		fmt.Fprintln(tw, "\n Snapshot\tIndex\tTerm\tSize")
+		for _, s := range summary.Snapshots {
+			fmt.Fprintf(tw, " %s\t%d\t%d\t%d\n", s.ID, s.Index, s.Term, s.Size)
+		}
+	}
+
+	if err := tw.Flush(); err != nil {
+		return "", err
+	}
+	return strings.TrimRight(b.String(), "\n"), nil
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return c.help
+}
+
+const synopsis = "Inspects the Raft log store of a stopped server"
+const help = `
+Usage: consul operator raft inspect-log [options] PATH
+
+  Opens the Raft log store of a server read-only and prints the range of
+  indexes it holds, the number of entries of each type and the snapshots
+  stored next to it. PATH can be the data directory of the server, its raft
+  directory, a raft.db file or a wal directory. Both the BoltDB and the WAL
+  log store are supported.
+
+  The server must be stopped, or PATH must point at a copy of its data
+  directory. The log store is never modified.
+
+  To summarize the log of a stopped server:
+
+    $ consul operator raft inspect-log /opt/consul/data
+
+  To print the KV and transaction entries of an index range as JSON:
+
+    $ consul operator raft inspect-log -entries -type KVS,Txn \
+        -min-index 1000 -max-index 2000 /opt/consul/data
+
+  Log entries can contain secrets such as ACL tokens. Use
+  "consul snapshot inspect" on the state.bin file of a snapshot to inspect its
+  contents.
+
+  For a full list of options and examples, please see the Consul documentation.
+`
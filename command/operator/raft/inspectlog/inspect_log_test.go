@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package inspectlog
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/raft"
+	raftboltdb "github.com/hashicorp/raft-boltdb/v2"
+	raftwal "github.com/hashicorp/raft-wal"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+func TestOperatorRaftInspectLogCommand_noTabs(t *testing.T) {
+	t.Parallel()
+	if strings.ContainsRune(New(cli.NewMockUi()).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func testLogs(t *testing.T) []*raft.Log {
+	t.Helper()
+
+	register, err := structs.Encode(structs.RegisterRequestType, &structs.RegisterRequest{
+		Datacenter: "dc1",
+		Node:       "node1",
+		Address:    "127.0.0.1",
+	})
+	require.NoError(t, err)
+
+	kvs, err := structs.Encode(structs.KVSRequestType, &structs.KVSRequest{
+		Datacenter: "dc1",
+		Op:         "set",
+		DirEnt:     structs.DirEntry{Key: "foo"},
+	})
+	require.NoError(t, err)
+
+	return []*raft.Log{
+		{
+			Index: 1,
+			Term:  1,
+			Type:  raft.LogConfiguration,
+			Data: raft.EncodeConfiguration(raft.Configuration{Servers: []raft.Server{
+				{Suffrage: raft.Voter, ID: "server1", Address: "127.0.0.1:8300"},
+			}}),
+		},
+		{Index: 2, Term: 1, Type: raft.LogCommand, Data: register},
+		{Index: 3, Term: 1, Type: raft.LogCommand, Data: kvs},
+		{Index: 4, Term: 2, Type: raft.LogCommand, Data: kvs},
+	}
+}
+
+func writeBoltStore(t *testing.T, dataDir string) {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dataDir, "raft"), 0755))
+	store, err := raftboltdb.NewBoltStore(filepath.Join(dataDir, "raft", "raft.db"))
+	require.NoError(t, err)
+	require.NoError(t, store.StoreLogs(testLogs(t)))
+	require.NoError(t, store.Close())
+}
+
+func writeWALStore(t *testing.T, dataDir string) {
+	t.Helper()
+
+	dir := filepath.Join(dataDir, "raft", "wal")
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	wal, err := raftwal.Open(dir)
+	require.NoError(t, err)
+	require.NoError(t, wal.StoreLogs(testLogs(t)))
+	require.NoError(t, wal.Close())
+}
+
+func TestOperatorRaftInspectLogCommand(t *testing.T) {
+	t.Parallel()
+
+	stores := map[string]func(t *testing.T, dataDir string){
+		storeBoltDB: writeBoltStore,
+		storeWAL:    writeWALStore,
+	}
+
+	for kind, write := range stores {
+		kind, write := kind, write
+		t.Run(kind, func(t *testing.T) {
+			dataDir := t.TempDir()
+			write(t, dataDir)
+
+			t.Run("summary", func(t *testing.T) {
+				ui := cli.NewMockUi()
+				code := New(ui).Run([]string{"-format", JSONFormat, dataDir})
+				require.Equal(t, 0, code, ui.ErrorWriter.String())
+
+				var summary Summary
+				require.NoError(t, json.Unmarshal(ui.OutputWriter.Bytes(), &summary))
+				require.Equal(t, kind, summary.Store)
+				require.Equal(t, uint64(1), summary.FirstIndex)
+				require.Equal(t, uint64(4), summary.LastIndex)
+				require.Equal(t, 4, summary.Matched)
+
+				require.Len(t, summary.Types, 3)
+				require.Equal(t, "KVS", summary.Types[0].Name)
+				require.Equal(t, 2, summary.Types[0].Count)
+				require.Equal(t, uint64(3), summary.Types[0].FirstIndex)
+				require.Equal(t, uint64(4), summary.Types[0].LastIndex)
+
+				if kind == storeWAL {
+					require.NotEmpty(t, summary.Segments)
+				}
+			})
+
+			t.Run("pretty summary", func(t *testing.T) {
+				ui := cli.NewMockUi()
+				code := New(ui).Run([]string{filepath.Join(dataDir, "raft")})
+				require.Equal(t, 0, code, ui.ErrorWriter.String())
+				require.Contains(t, ui.OutputWriter.String(), "Register")
+				require.Contains(t, ui.OutputWriter.String(), "LogConfiguration")
+			})
+
+			t.Run("entries", func(t *testing.T) {
+				ui := cli.NewMockUi()
+				code := New(ui).Run([]string{"-entries", "-type", "register,logconfiguration", "-max-index", "3", dataDir})
+				require.Equal(t, 0, code, ui.ErrorWriter.String())
+
+				lines := strings.Split(strings.TrimSpace(ui.OutputWriter.String()), "\n")
+				require.Len(t, lines, 2)
+
+				var configuration Entry
+				require.NoError(t, json.Unmarshal([]byte(lines[0]), &configuration))
+				require.Equal(t, uint64(1), configuration.Index)
+				require.Equal(t, "LogConfiguration", configuration.Type)
+				require.Empty(t, configuration.Error)
+
+				var register struct {
+					Entry
+					Body structs.RegisterRequest
+				}
+				require.NoError(t, json.Unmarshal([]byte(lines[1]), &register))
+				require.Equal(t, uint64(2), register.Index)
+				require.Equal(t, "Register", register.MessageType)
+				require.Equal(t, "node1", register.Body.Node)
+				require.Equal(t, "127.0.0.1", register.Body.Address)
+			})
+		})
+	}
+}
+
+func TestOperatorRaftInspectLogCommand_Errors(t *testing.T) {
+	t.Parallel()
+
+	ui := cli.NewMockUi()
+	require.Equal(t, 1, New(ui).Run(nil))
+	require.Contains(t, ui.ErrorWriter.String(), "Missing PATH argument")
+
+	ui = cli.NewMockUi()
+	require.Equal(t, 1, New(ui).Run([]string{t.TempDir()}))
+	require.Contains(t, ui.ErrorWriter.String(), "no raft.db file or wal directory found")
+
+	ui = cli.NewMockUi()
+	require.Equal(t, 1, New(ui).Run([]string{"-min-index", "5", "-max-index", "2", t.TempDir()}))
+	require.Contains(t, ui.ErrorWriter.String(), "-min-index must not be greater than -max-index")
+}
@@ -0,0 +1,301 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package inspectlog
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/raft"
+	raftboltdb "github.com/hashicorp/raft-boltdb/v2"
+	raftwal "github.com/hashicorp/raft-wal"
+	"github.com/hashicorp/raft-wal/fs"
+	"github.com/hashicorp/raft-wal/metadb"
+	"github.com/hashicorp/raft-wal/segment"
+	"github.com/hashicorp/raft-wal/types"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	storeBoltDB = "boltdb"
+	storeWAL    = "wal"
+
+	boltFileName = "raft.db"
+	walDirName   = "wal"
+	snapshotsDir = "snapshots"
+
+	// openTimeout bounds how long we wait for the file lock of a BoltDB file,
+	// which is held exclusively while a server is running.
+	openTimeout = 1 * time.Second
+)
+
+// logStore is a read-only view of a Raft log store on disk.
+type logStore interface {
+	// Kind returns the type of the store, boltdb or wal.
+	Kind() string
+
+	// Segments returns the segments of a WAL store.
+	Segments() []segmentInfo
+
+	// ForEach calls fn for each log entry in the store, in index order.
+	ForEach(fn func(*raft.Log) error) error
+
+	Close() error
+}
+
+// segmentInfo describes a segment file of a WAL store.
+type segmentInfo struct {
+	ID        uint64
+	BaseIndex uint64
+	MinIndex  uint64
+	MaxIndex  uint64 `json:",omitempty"`
+	Sealed    bool
+}
+
+// locateStore resolves the path given on the command line to the log store
+// and the directory holding the Raft snapshots. The path can be the data
+// directory of a server, its raft directory, the raft.db file or the wal
+// directory.
+func locateStore(path string) (kind, storePath, snapshotPath string, err error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", "", "", err
+	}
+	if !info.IsDir() {
+		return storeBoltDB, path, filepath.Join(filepath.Dir(path), snapshotsDir), nil
+	}
+
+	if _, err := os.Stat(filepath.Join(path, "raft")); err == nil {
+		path = filepath.Join(path, "raft")
+	}
+
+	if _, err := os.Stat(filepath.Join(path, boltFileName)); err == nil {
+		return storeBoltDB, filepath.Join(path, boltFileName), filepath.Join(path, snapshotsDir), nil
+	}
+	if info, err := os.Stat(filepath.Join(path, walDirName)); err == nil && info.IsDir() {
+		return storeWAL, filepath.Join(path, walDirName), filepath.Join(path, snapshotsDir), nil
+	}
+	if _, err := os.Stat(filepath.Join(path, metadb.FileName)); err == nil {
+		return storeWAL, path, filepath.Join(filepath.Dir(path), snapshotsDir), nil
+	}
+	return "", "", "", fmt.Errorf("no %s file or %s directory found in %s", boltFileName, walDirName, path)
+}
+
+// openLogStore opens the log store at the given path without modifying it.
+func openLogStore(kind, path string) (logStore, error) {
+	switch kind {
+	case storeBoltDB:
+		return openBoltStore(path)
+	case storeWAL:
+		return openWALStore(path)
+	default:
+		return nil, fmt.Errorf("unknown log store %q", kind)
+	}
+}
+
+type boltStore struct {
+	store *raftboltdb.BoltStore
+}
+
+func openBoltStore(path string) (*boltStore, error) {
+	store, err := raftboltdb.New(raftboltdb.Options{
+		Path: path,
+		BoltOptions: &bbolt.Options{
+			ReadOnly: true,
+			Timeout:  openTimeout,
+		},
+	})
+	if errors.Is(err, bbolt.ErrTimeout) {
+		return nil, fmt.Errorf("timed out waiting for the lock on %s, is the server still running?", path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &boltStore{store: store}, nil
+}
+
+func (s *boltStore) Kind() string { return storeBoltDB }
+
+func (s *boltStore) Segments() []segmentInfo { return nil }
+
+func (s *boltStore) ForEach(fn func(*raft.Log) error) error {
+	first, err := s.store.FirstIndex()
+	if err != nil {
+		return err
+	}
+	last, err := s.store.LastIndex()
+	if err != nil {
+		return err
+	}
+	if first == 0 {
+		return nil
+	}
+
+	for index := first; index <= last; index++ {
+		var log raft.Log
+		if err := s.store.GetLog(index, &log); err != nil {
+			return fmt.Errorf("failed to read log at index %d: %w", index, err)
+		}
+		if err := fn(&log); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *boltStore) Close() error {
+	return s.store.Close()
+}
+
+// walStore reads the segment files of a WAL directly. The metadata DB is
+// opened read-only to find out which part of each segment is still part of
+// the log, since segments may contain entries that were truncated.
+type walStore struct {
+	dir      string
+	filer    *segment.Filer
+	segments []types.SegmentInfo
+}
+
+func openWALStore(dir string) (*walStore, error) {
+	db, err := bbolt.Open(filepath.Join(dir, metadb.FileName), 0644, &bbolt.Options{
+		ReadOnly: true,
+		Timeout:  openTimeout,
+	})
+	if errors.Is(err, bbolt.ErrTimeout) {
+		return nil, fmt.Errorf("timed out waiting for the lock on %s, is the server still running?", dir)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", metadb.FileName, err)
+	}
+	defer db.Close()
+
+	var state types.PersistentState
+	err = db.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(metadb.MetaBucket))
+		if bucket == nil {
+			return nil
+		}
+		raw := bucket.Get([]byte(metadb.MetaKey))
+		if raw == nil {
+			return nil
+		}
+		return json.Unmarshal(raw, &state)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read WAL metadata: %w", err)
+	}
+
+	sort.Slice(state.Segments, func(i, j int) bool {
+		return state.Segments[i].BaseIndex < state.Segments[j].BaseIndex
+	})
+	return &walStore{
+		dir:      dir,
+		filer:    segment.NewFiler(dir, fs.New()),
+		segments: state.Segments,
+	}, nil
+}
+
+func (s *walStore) Kind() string { return storeWAL }
+
+func (s *walStore) Segments() []segmentInfo {
+	out := make([]segmentInfo, 0, len(s.segments))
+	for _, seg := range s.segments {
+		out = append(out, segmentInfo{
+			ID:        seg.ID,
+			BaseIndex: seg.BaseIndex,
+			MinIndex:  seg.MinIndex,
+			MaxIndex:  seg.MaxIndex,
+			Sealed:    !seg.SealTime.IsZero(),
+		})
+	}
+	return out
+}
+
+func (s *walStore) ForEach(fn func(*raft.Log) error) error {
+	codec := &raftwal.BinaryCodec{}
+	for _, seg := range s.segments {
+		if seg.Codec != raftwal.CodecBinaryV1 {
+			return fmt.Errorf("unsupported codec %d in segment %s", seg.Codec, segment.FileName(seg))
+		}
+
+		// The bounds of DumpSegment are exclusive.
+		var after, before uint64
+		if seg.MinIndex > 0 {
+			after = seg.MinIndex - 1
+		}
+		if seg.MaxIndex > 0 {
+			before = seg.MaxIndex + 1
+		}
+
+		err := s.filer.DumpSegment(seg.BaseIndex, seg.ID, after, before, func(_ types.SegmentInfo, e types.LogEntry) (bool, error) {
+			var log raft.Log
+			if err := codec.Decode(e.Data, &log); err != nil {
+				return false, fmt.Errorf("failed to decode log at index %d: %w", e.Index, err)
+			}
+			if err := fn(&log); err != nil {
+				return false, err
+			}
+			return true, nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to read segment %s: %w", segment.FileName(seg), err)
+		}
+	}
+	return nil
+}
+
+func (s *walStore) Close() error {
+	return nil
+}
+
+// snapshotInfo describes a snapshot stored next to the log store.
+type snapshotInfo struct {
+	ID    string
+	Index uint64
+	Term  uint64
+	Size  int64
+}
+
+// listSnapshots reads the metadata of the snapshots in the given directory,
+// newest first. A missing directory is not an error.
+func listSnapshots(dir string) ([]snapshotInfo, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshots []snapshotInfo
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasSuffix(entry.Name(), ".tmp") {
+			continue
+		}
+		raw, err := os.ReadFile(filepath.Join(dir, entry.Name(), "meta.json"))
+		if err != nil {
+			continue
+		}
+		var meta raft.SnapshotMeta
+		if err := json.Unmarshal(raw, &meta); err != nil {
+			return nil, fmt.Errorf("failed to parse snapshot metadata of %s: %w", entry.Name(), err)
+		}
+		snapshots = append(snapshots, snapshotInfo{
+			ID:    meta.ID,
+			Index: meta.Index,
+			Term:  meta.Term,
+			Size:  meta.Size,
+		})
+	}
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].Index > snapshots[j].Index
+	})
+	return snapshots, nil
+}
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package migratelogstore
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/cli"
+	"github.com/ryanuber/columnize"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/flags"
+)
+
+const (
+	statusReady  = "ready"
+	statusFailed = "failed"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui, pollInterval: time.Second}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+
+	// flags
+	backend string
+	cancel  bool
+	detach  bool
+
+	// pollInterval is how often the progress of the migration is reported.
+	pollInterval time.Duration
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.flags.StringVar(&c.backend, "backend", "",
+		"The log store backend to migrate to, \"boltdb\" or \"wal\". Without this "+
+			"flag the status of the current migration is printed.")
+	c.flags.BoolVar(&c.cancel, "cancel", false,
+		"Cancel the current migration and remove the staged log store.")
+	c.flags.BoolVar(&c.detach, "detach", false,
+		"Return once the migration has started instead of waiting for the copy "+
+			"to complete.")
+
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.ServerFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		c.UI.Error(fmt.Sprintf("Failed to parse args: %v", err))
+		return 1
+	}
+	if c.cancel && c.backend != "" {
+		c.UI.Error("Only one of -backend or -cancel may be given")
+		return 1
+	}
+
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error initializing client: %s", err))
+		return 1
+	}
+	operator := client.Operator()
+
+	if c.cancel {
+		if err := operator.RaftCancelLogStoreMigration(nil); err != nil {
+			c.UI.Error(fmt.Sprintf("Error cancelling log store migration: %s", err))
+			return 1
+		}
+		c.UI.Output("Log store migration cancelled")
+		return 0
+	}
+
+	var migration *api.RaftLogStoreMigration
+	if c.backend != "" {
+		migration, err = operator.RaftStartLogStoreMigration(c.backend, nil)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error starting log store migration: %s", err))
+			return 1
+		}
+		c.UI.Info(fmt.Sprintf("Started migrating the log store from %s to %s", migration.SourceBackend, migration.Backend))
+		for !c.detach && migration.Status != statusReady && migration.Status != statusFailed {
+			time.Sleep(c.pollInterval)
+			migration, err = operator.RaftLogStoreMigration(nil)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error reading log store migration: %s", err))
+				return 1
+			}
+			if migration == nil {
+				c.UI.Error("The log store migration was cancelled")
+				return 1
+			}
+			c.UI.Info(fmt.Sprintf("Copied %d of %d log entries", migration.CopiedEntries, migration.TotalEntries))
+		}
+	} else {
+		migration, err = operator.RaftLogStoreMigration(nil)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error reading log store migration: %s", err))
+			return 1
+		}
+		if migration == nil {
+			c.UI.Error("No log store migration found")
+			return 1
+		}
+	}
+
+	c.UI.Output(formatMigration(migration))
+	switch migration.Status {
+	case statusFailed:
+		return 1
+	case statusReady:
+		c.UI.Info(fmt.Sprintf("\nSet raft_logstore.backend to %q in the configuration of the server and "+
+			"restart it to complete the migration.", migration.Backend))
+	}
+	return 0
+}
+
+func formatMigration(m *api.RaftLogStoreMigration) string {
+	result := []string{
+		fmt.Sprintf("Backend|%s", m.Backend),
+		fmt.Sprintf("Source Backend|%s", m.SourceBackend),
+		fmt.Sprintf("Status|%s", m.Status),
+		fmt.Sprintf("Index Range|%d-%d", m.FirstIndex, m.LastIndex),
+		fmt.Sprintf("Copied Entries|%d/%d", m.CopiedEntries, m.TotalEntries),
+		fmt.Sprintf("Verified Checkpoints|%d", m.VerifiedCheckpoints),
+		fmt.Sprintf("Started|%s", m.StartedAt.Format(time.RFC3339)),
+		fmt.Sprintf("Updated|%s", m.UpdatedAt.Format(time.RFC3339)),
+	}
+	if m.BackupPath != "" {
+		result = append(result, fmt.Sprintf("Backup Path|%s", m.BackupPath))
+	}
+	if m.Error != "" {
+		result = append(result, fmt.Sprintf("Error|%s", strings.ReplaceAll(m.Error, "|", " ")))
+	}
+	return columnize.SimpleFormat(result)
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return c.help
+}
+
+const synopsis = "Migrates the Raft log store of a server to a different backend"
+const help = `
+Usage: consul operator raft migrate-logstore [options]
+
+  Migrates the Raft log store of the server the request is sent to between
+  the BoltDB and the WAL backend without taking it offline.
+
+  The server copies its log store into a staged log store next to it and
+  verifies the copy against the log verification checkpoints and the source
+  log. Once the status is "ready", set raft_logstore.backend to the target
+  backend in the configuration of the server and restart it. On startup the
+  server copies the entries appended since, swaps in the staged log store and
+  keeps the previous one as a backup in the raft/logstore-backup directory.
+
+  Migrate one server at a time and wait for it to rejoin the cluster before
+  migrating the next one. To roll back, migrate the server back to the
+  previous backend.
+
+  To migrate the log store of the local server to WAL:
+
+    $ consul operator raft migrate-logstore -backend wal
+
+  To check on a migration started with -detach:
+
+    $ consul operator raft migrate-logstore
+
+  Interrupting the command does not stop the migration. Use -cancel to stop it
+  and remove the staged log store.
+
+  For a full list of options and examples, please see the Consul documentation.
+`
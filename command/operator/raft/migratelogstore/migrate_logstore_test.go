@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package migratelogstore
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestOperatorRaftMigrateLogStoreCommand_noTabs(t *testing.T) {
+	t.Parallel()
+	if strings.ContainsRune(New(cli.NewMockUi()).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestOperatorRaftMigrateLogStoreCommand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := agent.NewTestAgent(t, ``)
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	run := func(args ...string) (int, *cli.MockUi) {
+		ui := cli.NewMockUi()
+		c := New(ui)
+		c.pollInterval = 10 * time.Millisecond
+		return c.Run(append([]string{"-http-addr=" + a.HTTPAddr()}, args...)), ui
+	}
+
+	code, ui := run()
+	require.Equal(t, 1, code)
+	require.Contains(t, ui.ErrorWriter.String(), "No log store migration found")
+
+	code, ui = run("-backend", "boltdb")
+	require.Equal(t, 1, code)
+	require.Contains(t, ui.ErrorWriter.String(), "already uses the boltdb backend")
+
+	code, ui = run("-backend", "wal")
+	require.Equal(t, 0, code, ui.ErrorWriter.String())
+	require.Contains(t, ui.OutputWriter.String(), "Started migrating the log store from boltdb to wal")
+	require.Regexp(t, `Status\s+ready`, ui.OutputWriter.String())
+	require.Contains(t, ui.OutputWriter.String(), `Set raft_logstore.backend to "wal"`)
+
+	code, ui = run()
+	require.Equal(t, 0, code, ui.ErrorWriter.String())
+	require.Regexp(t, `Backend\s+wal`, ui.OutputWriter.String())
+
+	code, ui = run("-cancel")
+	require.Equal(t, 0, code, ui.ErrorWriter.String())
+	require.Contains(t, ui.OutputWriter.String(), "Log store migration cancelled")
+
+	code, _ = run()
+	require.Equal(t, 1, code)
+}
@@ -60,6 +60,8 @@ import (
 	"github.com/hashicorp/consul/command/connect"
 	"github.com/hashicorp/consul/command/connect/ca"
 	caget "github.com/hashicorp/consul/command/connect/ca/get"
+	carevoke "github.com/hashicorp/consul/command/connect/ca/revoke"
+	carotationstatus "github.com/hashicorp/consul/command/connect/ca/rotationstatus"
 	caset "github.com/hashicorp/consul/command/connect/ca/set"
 	"github.com/hashicorp/consul/command/connect/envoy"
 	pipebootstrap "github.com/hashicorp/consul/command/connect/envoy/pipe-bootstrap"
@@ -67,6 +69,7 @@ import (
 	"github.com/hashicorp/consul/command/connect/proxy"
 	"github.com/hashicorp/consul/command/connect/redirecttraffic"
 	"github.com/hashicorp/consul/command/debug"
+	"github.com/hashicorp/consul/command/elect"
 	"github.com/hashicorp/consul/command/event"
 	"github.com/hashicorp/consul/command/exec"
 	"github.com/hashicorp/consul/command/forceleave"
@@ -99,8 +102,11 @@ import (
 	operautoget "github.com/hashicorp/consul/command/operator/autopilot/get"
 	operautoset "github.com/hashicorp/consul/command/operator/autopilot/set"
 	operautostate "github.com/hashicorp/consul/command/operator/autopilot/state"
+	opercerts "github.com/hashicorp/consul/command/operator/certs"
 	operraft "github.com/hashicorp/consul/command/operator/raft"
+	operraftinspectlog "github.com/hashicorp/consul/command/operator/raft/inspectlog"
 	operraftlist "github.com/hashicorp/consul/command/operator/raft/listpeers"
+	operraftmigratelogstore "github.com/hashicorp/consul/command/operator/raft/migratelogstore"
 	operraftremove "github.com/hashicorp/consul/command/operator/raft/removepeer"
 	"github.com/hashicorp/consul/command/operator/raft/transferleader"
 	"github.com/hashicorp/consul/command/operator/usage"
@@ -207,6 +213,8 @@ func RegisteredCommands(ui cli.Ui) map[string]mcli.CommandFactory {
 		entry{"connect", func(ui cli.Ui) (cli.Command, error) { return connect.New(), nil }},
 		entry{"connect ca", func(ui cli.Ui) (cli.Command, error) { return ca.New(), nil }},
 		entry{"connect ca get-config", func(ui cli.Ui) (cli.Command, error) { return caget.New(ui), nil }},
+		entry{"connect ca revoke", func(ui cli.Ui) (cli.Command, error) { return carevoke.New(ui), nil }},
+		entry{"connect ca rotation-status", func(ui cli.Ui) (cli.Command, error) { return carotationstatus.New(ui), nil }},
 		entry{"connect ca set-config", func(ui cli.Ui) (cli.Command, error) { return caset.New(ui), nil }},
 		entry{"connect proxy", func(ui cli.Ui) (cli.Command, error) { return proxy.New(ui, MakeShutdownCh()), nil }},
 		entry{"connect envoy", func(ui cli.Ui) (cli.Command, error) { return envoy.New(ui), nil }},
@@ -214,6 +222,7 @@ func RegisteredCommands(ui cli.Ui) map[string]mcli.CommandFactory {
 		entry{"connect expose", func(ui cli.Ui) (cli.Command, error) { return expose.New(ui), nil }},
 		entry{"connect redirect-traffic", func(ui cli.Ui) (cli.Command, error) { return redirecttraffic.New(ui), nil }},
 		entry{"debug", func(ui cli.Ui) (cli.Command, error) { return debug.New(ui), nil }},
+		entry{"elect", func(ui cli.Ui) (cli.Command, error) { return elect.New(ui, MakeShutdownCh()), nil }},
 		entry{"event", func(ui cli.Ui) (cli.Command, error) { return event.New(ui), nil }},
 		entry{"exec", func(ui cli.Ui) (cli.Command, error) { return exec.New(ui, MakeShutdownCh()), nil }},
 		entry{"force-leave", func(ui cli.Ui) (cli.Command, error) { return forceleave.New(ui), nil }},
@@ -246,8 +255,11 @@ func RegisteredCommands(ui cli.Ui) map[string]mcli.CommandFactory {
 		entry{"operator autopilot get-config", func(ui cli.Ui) (cli.Command, error) { return operautoget.New(ui), nil }},
 		entry{"operator autopilot set-config", func(ui cli.Ui) (cli.Command, error) { return operautoset.New(ui), nil }},
 		entry{"operator autopilot state", func(ui cli.Ui) (cli.Command, error) { return operautostate.New(ui), nil }},
+		entry{"operator certs", func(ui cli.Ui) (cli.Command, error) { return opercerts.New(ui), nil }},
 		entry{"operator raft", func(cli.Ui) (cli.Command, error) { return operraft.New(), nil }},
+		entry{"operator raft inspect-log", func(ui cli.Ui) (cli.Command, error) { return operraftinspectlog.New(ui), nil }},
 		entry{"operator raft list-peers", func(ui cli.Ui) (cli.Command, error) { return operraftlist.New(ui), nil }},
+		entry{"operator raft migrate-logstore", func(ui cli.Ui) (cli.Command, error) { return operraftmigratelogstore.New(ui), nil }},
 		entry{"operator raft remove-peer", func(ui cli.Ui) (cli.Command, error) { return operraftremove.New(ui), nil }},
 		entry{"operator raft transfer-leader", func(ui cli.Ui) (cli.Command, error) { return transferleader.New(ui), nil }},
 		entry{"operator usage", func(ui cli.Ui) (cli.Command, error) { return usage.New(), nil }},
@@ -5,6 +5,7 @@ package logging
 
 const (
 	ACL                   string = "acl"
+	ACMECertificate       string = "acme_certificate"
 	Agent                 string = "agent"
 	AntiEntropy           string = "anti_entropy"
 	AutoEncrypt           string = "auto_encrypt"
@@ -28,6 +29,8 @@ const (
 	FederationState       string = "federation_state"
 	FSM                   string = "fsm"
 	APIGatewayController  string = "api_gateway_controller"
+	CanaryRollout         string = "canary_rollout"
+	TrustBundle           string = "trust_bundle"
 	GatewayLocator        string = "gateway_locator"
 	HTTP                  string = "http"
 	HTTPRouteController   string = "http_route_controller"
@@ -69,6 +72,7 @@ const (
 	UIMetricsProxy        string = "ui_metrics_proxy"
 	WAN                   string = "wan"
 	Watch                 string = "watch"
+	WorkloadAPI           string = "workload_api"
 	XDS                   string = "xds"
 	XDSCapacityController string = "xds_capacity_controller"
 	Vault                 string = "vault"
@@ -0,0 +1,102 @@
+// Code generated by protoc-gen-grpc-inmem. DO NOT EDIT.
+
+package pbspiffeworkload
+
+import (
+	"context"
+
+	grpc "google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// compile-time check to ensure that the generator is implementing all
+// of the grpc client interfaces methods.
+var _ SpiffeWorkloadAPIClient = CloningSpiffeWorkloadAPIClient{}
+
+// IsCloningSpiffeWorkloadAPIClient is an interface that can be used to detect
+// that a SpiffeWorkloadAPIClient is using the in-memory transport and has already
+// been wrapped with a with a CloningSpiffeWorkloadAPIClient.
+type IsCloningSpiffeWorkloadAPIClient interface {
+	IsCloningSpiffeWorkloadAPIClient() bool
+}
+
+// CloningSpiffeWorkloadAPIClient implements the SpiffeWorkloadAPIClient interface by wrapping
+// another implementation and copying all protobuf messages that pass through the client.
+// This is mainly useful to wrap the an in-process client to insulate users of that
+// client from having to care about potential immutability of data they receive or having
+// the server implementation mutate their internal memory.
+type CloningSpiffeWorkloadAPIClient struct {
+	SpiffeWorkloadAPIClient
+}
+
+func NewCloningSpiffeWorkloadAPIClient(client SpiffeWorkloadAPIClient) SpiffeWorkloadAPIClient {
+	if cloner, ok := client.(IsCloningSpiffeWorkloadAPIClient); ok && cloner.IsCloningSpiffeWorkloadAPIClient() {
+		// prevent a double clone if the underlying client is already the cloning client.
+		return client
+	}
+
+	return CloningSpiffeWorkloadAPIClient{
+		SpiffeWorkloadAPIClient: client,
+	}
+}
+
+// IsCloningSpiffeWorkloadAPIClient implements the IsCloningSpiffeWorkloadAPIClient interface. This
+// is only used to detect wrapped clients that would be double cloning data and prevent that.
+func (c CloningSpiffeWorkloadAPIClient) IsCloningSpiffeWorkloadAPIClient() bool {
+	return true
+}
+
+func (c CloningSpiffeWorkloadAPIClient) FetchJWTSVID(ctx context.Context, in *JWTSVIDRequest, opts ...grpc.CallOption) (*JWTSVIDResponse, error) {
+	in = proto.Clone(in).(*JWTSVIDRequest)
+
+	out, err := c.SpiffeWorkloadAPIClient.FetchJWTSVID(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Clone(out).(*JWTSVIDResponse), nil
+}
+
+func (c CloningSpiffeWorkloadAPIClient) FetchJWTBundles(ctx context.Context, in *JWTBundlesRequest, opts ...grpc.CallOption) (SpiffeWorkloadAPI_FetchJWTBundlesClient, error) {
+	in = proto.Clone(in).(*JWTBundlesRequest)
+
+	st, err := c.SpiffeWorkloadAPIClient.FetchJWTBundles(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCloningStream[*JWTBundlesResponse](st), nil
+}
+
+func (c CloningSpiffeWorkloadAPIClient) ValidateJWTSVID(ctx context.Context, in *ValidateJWTSVIDRequest, opts ...grpc.CallOption) (*ValidateJWTSVIDResponse, error) {
+	in = proto.Clone(in).(*ValidateJWTSVIDRequest)
+
+	out, err := c.SpiffeWorkloadAPIClient.ValidateJWTSVID(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Clone(out).(*ValidateJWTSVIDResponse), nil
+}
+
+func (c CloningSpiffeWorkloadAPIClient) FetchX509SVID(ctx context.Context, in *X509SVIDRequest, opts ...grpc.CallOption) (SpiffeWorkloadAPI_FetchX509SVIDClient, error) {
+	in = proto.Clone(in).(*X509SVIDRequest)
+
+	st, err := c.SpiffeWorkloadAPIClient.FetchX509SVID(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCloningStream[*X509SVIDResponse](st), nil
+}
+
+func (c CloningSpiffeWorkloadAPIClient) FetchX509Bundles(ctx context.Context, in *X509BundlesRequest, opts ...grpc.CallOption) (SpiffeWorkloadAPI_FetchX509BundlesClient, error) {
+	in = proto.Clone(in).(*X509BundlesRequest)
+
+	st, err := c.SpiffeWorkloadAPIClient.FetchX509Bundles(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCloningStream[*X509BundlesResponse](st), nil
+}
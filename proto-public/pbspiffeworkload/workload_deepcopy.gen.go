@@ -0,0 +1,258 @@
+// Code generated by protoc-gen-deepcopy. DO NOT EDIT.
+package pbspiffeworkload
+
+import (
+	proto "google.golang.org/protobuf/proto"
+)
+
+// DeepCopyInto supports using X509SVIDRequest within kubernetes types, where deepcopy-gen is used.
+func (in *X509SVIDRequest) DeepCopyInto(out *X509SVIDRequest) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new X509SVIDRequest. Required by controller-gen.
+func (in *X509SVIDRequest) DeepCopy() *X509SVIDRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(X509SVIDRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new X509SVIDRequest. Required by controller-gen.
+func (in *X509SVIDRequest) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using X509SVIDResponse within kubernetes types, where deepcopy-gen is used.
+func (in *X509SVIDResponse) DeepCopyInto(out *X509SVIDResponse) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new X509SVIDResponse. Required by controller-gen.
+func (in *X509SVIDResponse) DeepCopy() *X509SVIDResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(X509SVIDResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new X509SVIDResponse. Required by controller-gen.
+func (in *X509SVIDResponse) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using X509SVID within kubernetes types, where deepcopy-gen is used.
+func (in *X509SVID) DeepCopyInto(out *X509SVID) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new X509SVID. Required by controller-gen.
+func (in *X509SVID) DeepCopy() *X509SVID {
+	if in == nil {
+		return nil
+	}
+	out := new(X509SVID)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new X509SVID. Required by controller-gen.
+func (in *X509SVID) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using X509BundlesRequest within kubernetes types, where deepcopy-gen is used.
+func (in *X509BundlesRequest) DeepCopyInto(out *X509BundlesRequest) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new X509BundlesRequest. Required by controller-gen.
+func (in *X509BundlesRequest) DeepCopy() *X509BundlesRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(X509BundlesRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new X509BundlesRequest. Required by controller-gen.
+func (in *X509BundlesRequest) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using X509BundlesResponse within kubernetes types, where deepcopy-gen is used.
+func (in *X509BundlesResponse) DeepCopyInto(out *X509BundlesResponse) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new X509BundlesResponse. Required by controller-gen.
+func (in *X509BundlesResponse) DeepCopy() *X509BundlesResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(X509BundlesResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new X509BundlesResponse. Required by controller-gen.
+func (in *X509BundlesResponse) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using JWTSVIDRequest within kubernetes types, where deepcopy-gen is used.
+func (in *JWTSVIDRequest) DeepCopyInto(out *JWTSVIDRequest) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTSVIDRequest. Required by controller-gen.
+func (in *JWTSVIDRequest) DeepCopy() *JWTSVIDRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTSVIDRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new JWTSVIDRequest. Required by controller-gen.
+func (in *JWTSVIDRequest) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using JWTSVIDResponse within kubernetes types, where deepcopy-gen is used.
+func (in *JWTSVIDResponse) DeepCopyInto(out *JWTSVIDResponse) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTSVIDResponse. Required by controller-gen.
+func (in *JWTSVIDResponse) DeepCopy() *JWTSVIDResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTSVIDResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new JWTSVIDResponse. Required by controller-gen.
+func (in *JWTSVIDResponse) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using JWTSVID within kubernetes types, where deepcopy-gen is used.
+func (in *JWTSVID) DeepCopyInto(out *JWTSVID) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTSVID. Required by controller-gen.
+func (in *JWTSVID) DeepCopy() *JWTSVID {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTSVID)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new JWTSVID. Required by controller-gen.
+func (in *JWTSVID) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using JWTBundlesRequest within kubernetes types, where deepcopy-gen is used.
+func (in *JWTBundlesRequest) DeepCopyInto(out *JWTBundlesRequest) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTBundlesRequest. Required by controller-gen.
+func (in *JWTBundlesRequest) DeepCopy() *JWTBundlesRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTBundlesRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new JWTBundlesRequest. Required by controller-gen.
+func (in *JWTBundlesRequest) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using JWTBundlesResponse within kubernetes types, where deepcopy-gen is used.
+func (in *JWTBundlesResponse) DeepCopyInto(out *JWTBundlesResponse) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTBundlesResponse. Required by controller-gen.
+func (in *JWTBundlesResponse) DeepCopy() *JWTBundlesResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTBundlesResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new JWTBundlesResponse. Required by controller-gen.
+func (in *JWTBundlesResponse) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using ValidateJWTSVIDRequest within kubernetes types, where deepcopy-gen is used.
+func (in *ValidateJWTSVIDRequest) DeepCopyInto(out *ValidateJWTSVIDRequest) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidateJWTSVIDRequest. Required by controller-gen.
+func (in *ValidateJWTSVIDRequest) DeepCopy() *ValidateJWTSVIDRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidateJWTSVIDRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new ValidateJWTSVIDRequest. Required by controller-gen.
+func (in *ValidateJWTSVIDRequest) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
+
+// DeepCopyInto supports using ValidateJWTSVIDResponse within kubernetes types, where deepcopy-gen is used.
+func (in *ValidateJWTSVIDResponse) DeepCopyInto(out *ValidateJWTSVIDResponse) {
+	proto.Reset(out)
+	proto.Merge(out, proto.Clone(in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidateJWTSVIDResponse. Required by controller-gen.
+func (in *ValidateJWTSVIDResponse) DeepCopy() *ValidateJWTSVIDResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidateJWTSVIDResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInterface is an autogenerated deepcopy function, copying the receiver, creating a new ValidateJWTSVIDResponse. Required by controller-gen.
+func (in *ValidateJWTSVIDResponse) DeepCopyInterface() interface{} {
+	return in.DeepCopy()
+}
@@ -0,0 +1,143 @@
+// Code generated by protoc-json-shim. DO NOT EDIT.
+package pbspiffeworkload
+
+import (
+	protojson "google.golang.org/protobuf/encoding/protojson"
+)
+
+// MarshalJSON is a custom marshaler for X509SVIDRequest
+func (this *X509SVIDRequest) MarshalJSON() ([]byte, error) {
+	str, err := WorkloadMarshaler.Marshal(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for X509SVIDRequest
+func (this *X509SVIDRequest) UnmarshalJSON(b []byte) error {
+	return WorkloadUnmarshaler.Unmarshal(b, this)
+}
+
+// MarshalJSON is a custom marshaler for X509SVIDResponse
+func (this *X509SVIDResponse) MarshalJSON() ([]byte, error) {
+	str, err := WorkloadMarshaler.Marshal(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for X509SVIDResponse
+func (this *X509SVIDResponse) UnmarshalJSON(b []byte) error {
+	return WorkloadUnmarshaler.Unmarshal(b, this)
+}
+
+// MarshalJSON is a custom marshaler for X509SVID
+func (this *X509SVID) MarshalJSON() ([]byte, error) {
+	str, err := WorkloadMarshaler.Marshal(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for X509SVID
+func (this *X509SVID) UnmarshalJSON(b []byte) error {
+	return WorkloadUnmarshaler.Unmarshal(b, this)
+}
+
+// MarshalJSON is a custom marshaler for X509BundlesRequest
+func (this *X509BundlesRequest) MarshalJSON() ([]byte, error) {
+	str, err := WorkloadMarshaler.Marshal(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for X509BundlesRequest
+func (this *X509BundlesRequest) UnmarshalJSON(b []byte) error {
+	return WorkloadUnmarshaler.Unmarshal(b, this)
+}
+
+// MarshalJSON is a custom marshaler for X509BundlesResponse
+func (this *X509BundlesResponse) MarshalJSON() ([]byte, error) {
+	str, err := WorkloadMarshaler.Marshal(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for X509BundlesResponse
+func (this *X509BundlesResponse) UnmarshalJSON(b []byte) error {
+	return WorkloadUnmarshaler.Unmarshal(b, this)
+}
+
+// MarshalJSON is a custom marshaler for JWTSVIDRequest
+func (this *JWTSVIDRequest) MarshalJSON() ([]byte, error) {
+	str, err := WorkloadMarshaler.Marshal(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for JWTSVIDRequest
+func (this *JWTSVIDRequest) UnmarshalJSON(b []byte) error {
+	return WorkloadUnmarshaler.Unmarshal(b, this)
+}
+
+// MarshalJSON is a custom marshaler for JWTSVIDResponse
+func (this *JWTSVIDResponse) MarshalJSON() ([]byte, error) {
+	str, err := WorkloadMarshaler.Marshal(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for JWTSVIDResponse
+func (this *JWTSVIDResponse) UnmarshalJSON(b []byte) error {
+	return WorkloadUnmarshaler.Unmarshal(b, this)
+}
+
+// MarshalJSON is a custom marshaler for JWTSVID
+func (this *JWTSVID) MarshalJSON() ([]byte, error) {
+	str, err := WorkloadMarshaler.Marshal(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for JWTSVID
+func (this *JWTSVID) UnmarshalJSON(b []byte) error {
+	return WorkloadUnmarshaler.Unmarshal(b, this)
+}
+
+// MarshalJSON is a custom marshaler for JWTBundlesRequest
+func (this *JWTBundlesRequest) MarshalJSON() ([]byte, error) {
+	str, err := WorkloadMarshaler.Marshal(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for JWTBundlesRequest
+func (this *JWTBundlesRequest) UnmarshalJSON(b []byte) error {
+	return WorkloadUnmarshaler.Unmarshal(b, this)
+}
+
+// MarshalJSON is a custom marshaler for JWTBundlesResponse
+func (this *JWTBundlesResponse) MarshalJSON() ([]byte, error) {
+	str, err := WorkloadMarshaler.Marshal(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for JWTBundlesResponse
+func (this *JWTBundlesResponse) UnmarshalJSON(b []byte) error {
+	return WorkloadUnmarshaler.Unmarshal(b, this)
+}
+
+// MarshalJSON is a custom marshaler for ValidateJWTSVIDRequest
+func (this *ValidateJWTSVIDRequest) MarshalJSON() ([]byte, error) {
+	str, err := WorkloadMarshaler.Marshal(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for ValidateJWTSVIDRequest
+func (this *ValidateJWTSVIDRequest) UnmarshalJSON(b []byte) error {
+	return WorkloadUnmarshaler.Unmarshal(b, this)
+}
+
+// MarshalJSON is a custom marshaler for ValidateJWTSVIDResponse
+func (this *ValidateJWTSVIDResponse) MarshalJSON() ([]byte, error) {
+	str, err := WorkloadMarshaler.Marshal(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for ValidateJWTSVIDResponse
+func (this *ValidateJWTSVIDResponse) UnmarshalJSON(b []byte) error {
+	return WorkloadUnmarshaler.Unmarshal(b, this)
+}
+
+var (
+	WorkloadMarshaler   = &protojson.MarshalOptions{}
+	WorkloadUnmarshaler = &protojson.UnmarshalOptions{DiscardUnknown: false}
+)
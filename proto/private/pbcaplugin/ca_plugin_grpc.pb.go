@@ -0,0 +1,463 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: private/pbcaplugin/ca_plugin.proto
+
+package pbcaplugin
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// CAProviderClient is the client API for CAProvider service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CAProviderClient interface {
+	Configure(ctx context.Context, in *ConfigureRequest, opts ...grpc.CallOption) (*ConfigureResponse, error)
+	State(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (*StateResponse, error)
+	ActiveLeafSigningCert(ctx context.Context, in *ActiveLeafSigningCertRequest, opts ...grpc.CallOption) (*ActiveLeafSigningCertResponse, error)
+	Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error)
+	Cleanup(ctx context.Context, in *CleanupRequest, opts ...grpc.CallOption) (*CleanupResponse, error)
+	GenerateCAChain(ctx context.Context, in *GenerateCAChainRequest, opts ...grpc.CallOption) (*GenerateCAChainResponse, error)
+	SignIntermediate(ctx context.Context, in *SignIntermediateRequest, opts ...grpc.CallOption) (*SignIntermediateResponse, error)
+	CrossSignCA(ctx context.Context, in *CrossSignCARequest, opts ...grpc.CallOption) (*CrossSignCAResponse, error)
+	SupportsCrossSigning(ctx context.Context, in *SupportsCrossSigningRequest, opts ...grpc.CallOption) (*SupportsCrossSigningResponse, error)
+	GenerateIntermediateCSR(ctx context.Context, in *GenerateIntermediateCSRRequest, opts ...grpc.CallOption) (*GenerateIntermediateCSRResponse, error)
+	SetIntermediate(ctx context.Context, in *SetIntermediateRequest, opts ...grpc.CallOption) (*SetIntermediateResponse, error)
+}
+
+type cAProviderClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCAProviderClient(cc grpc.ClientConnInterface) CAProviderClient {
+	return &cAProviderClient{cc}
+}
+
+func (c *cAProviderClient) Configure(ctx context.Context, in *ConfigureRequest, opts ...grpc.CallOption) (*ConfigureResponse, error) {
+	out := new(ConfigureResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.consul.internal.caplugin.CAProvider/Configure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cAProviderClient) State(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (*StateResponse, error) {
+	out := new(StateResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.consul.internal.caplugin.CAProvider/State", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cAProviderClient) ActiveLeafSigningCert(ctx context.Context, in *ActiveLeafSigningCertRequest, opts ...grpc.CallOption) (*ActiveLeafSigningCertResponse, error) {
+	out := new(ActiveLeafSigningCertResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.consul.internal.caplugin.CAProvider/ActiveLeafSigningCert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cAProviderClient) Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error) {
+	out := new(SignResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.consul.internal.caplugin.CAProvider/Sign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cAProviderClient) Cleanup(ctx context.Context, in *CleanupRequest, opts ...grpc.CallOption) (*CleanupResponse, error) {
+	out := new(CleanupResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.consul.internal.caplugin.CAProvider/Cleanup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cAProviderClient) GenerateCAChain(ctx context.Context, in *GenerateCAChainRequest, opts ...grpc.CallOption) (*GenerateCAChainResponse, error) {
+	out := new(GenerateCAChainResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.consul.internal.caplugin.CAProvider/GenerateCAChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cAProviderClient) SignIntermediate(ctx context.Context, in *SignIntermediateRequest, opts ...grpc.CallOption) (*SignIntermediateResponse, error) {
+	out := new(SignIntermediateResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.consul.internal.caplugin.CAProvider/SignIntermediate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cAProviderClient) CrossSignCA(ctx context.Context, in *CrossSignCARequest, opts ...grpc.CallOption) (*CrossSignCAResponse, error) {
+	out := new(CrossSignCAResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.consul.internal.caplugin.CAProvider/CrossSignCA", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cAProviderClient) SupportsCrossSigning(ctx context.Context, in *SupportsCrossSigningRequest, opts ...grpc.CallOption) (*SupportsCrossSigningResponse, error) {
+	out := new(SupportsCrossSigningResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.consul.internal.caplugin.CAProvider/SupportsCrossSigning", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cAProviderClient) GenerateIntermediateCSR(ctx context.Context, in *GenerateIntermediateCSRRequest, opts ...grpc.CallOption) (*GenerateIntermediateCSRResponse, error) {
+	out := new(GenerateIntermediateCSRResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.consul.internal.caplugin.CAProvider/GenerateIntermediateCSR", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cAProviderClient) SetIntermediate(ctx context.Context, in *SetIntermediateRequest, opts ...grpc.CallOption) (*SetIntermediateResponse, error) {
+	out := new(SetIntermediateResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.consul.internal.caplugin.CAProvider/SetIntermediate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CAProviderServer is the server API for CAProvider service.
+// All implementations should embed UnimplementedCAProviderServer
+// for forward compatibility
+type CAProviderServer interface {
+	Configure(context.Context, *ConfigureRequest) (*ConfigureResponse, error)
+	State(context.Context, *StateRequest) (*StateResponse, error)
+	ActiveLeafSigningCert(context.Context, *ActiveLeafSigningCertRequest) (*ActiveLeafSigningCertResponse, error)
+	Sign(context.Context, *SignRequest) (*SignResponse, error)
+	Cleanup(context.Context, *CleanupRequest) (*CleanupResponse, error)
+	GenerateCAChain(context.Context, *GenerateCAChainRequest) (*GenerateCAChainResponse, error)
+	SignIntermediate(context.Context, *SignIntermediateRequest) (*SignIntermediateResponse, error)
+	CrossSignCA(context.Context, *CrossSignCARequest) (*CrossSignCAResponse, error)
+	SupportsCrossSigning(context.Context, *SupportsCrossSigningRequest) (*SupportsCrossSigningResponse, error)
+	GenerateIntermediateCSR(context.Context, *GenerateIntermediateCSRRequest) (*GenerateIntermediateCSRResponse, error)
+	SetIntermediate(context.Context, *SetIntermediateRequest) (*SetIntermediateResponse, error)
+}
+
+// UnimplementedCAProviderServer should be embedded to have forward compatible implementations.
+type UnimplementedCAProviderServer struct {
+}
+
+func (UnimplementedCAProviderServer) Configure(context.Context, *ConfigureRequest) (*ConfigureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Configure not implemented")
+}
+func (UnimplementedCAProviderServer) State(context.Context, *StateRequest) (*StateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method State not implemented")
+}
+func (UnimplementedCAProviderServer) ActiveLeafSigningCert(context.Context, *ActiveLeafSigningCertRequest) (*ActiveLeafSigningCertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveLeafSigningCert not implemented")
+}
+func (UnimplementedCAProviderServer) Sign(context.Context, *SignRequest) (*SignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sign not implemented")
+}
+func (UnimplementedCAProviderServer) Cleanup(context.Context, *CleanupRequest) (*CleanupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cleanup not implemented")
+}
+func (UnimplementedCAProviderServer) GenerateCAChain(context.Context, *GenerateCAChainRequest) (*GenerateCAChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateCAChain not implemented")
+}
+func (UnimplementedCAProviderServer) SignIntermediate(context.Context, *SignIntermediateRequest) (*SignIntermediateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignIntermediate not implemented")
+}
+func (UnimplementedCAProviderServer) CrossSignCA(context.Context, *CrossSignCARequest) (*CrossSignCAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CrossSignCA not implemented")
+}
+func (UnimplementedCAProviderServer) SupportsCrossSigning(context.Context, *SupportsCrossSigningRequest) (*SupportsCrossSigningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupportsCrossSigning not implemented")
+}
+func (UnimplementedCAProviderServer) GenerateIntermediateCSR(context.Context, *GenerateIntermediateCSRRequest) (*GenerateIntermediateCSRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateIntermediateCSR not implemented")
+}
+func (UnimplementedCAProviderServer) SetIntermediate(context.Context, *SetIntermediateRequest) (*SetIntermediateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIntermediate not implemented")
+}
+
+// UnsafeCAProviderServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CAProviderServer will
+// result in compilation errors.
+type UnsafeCAProviderServer interface {
+	mustEmbedUnimplementedCAProviderServer()
+}
+
+func RegisterCAProviderServer(s grpc.ServiceRegistrar, srv CAProviderServer) {
+	s.RegisterService(&CAProvider_ServiceDesc, srv)
+}
+
+func _CAProvider_Configure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CAProviderServer).Configure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.consul.internal.caplugin.CAProvider/Configure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CAProviderServer).Configure(ctx, req.(*ConfigureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CAProvider_State_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CAProviderServer).State(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.consul.internal.caplugin.CAProvider/State",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CAProviderServer).State(ctx, req.(*StateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CAProvider_ActiveLeafSigningCert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActiveLeafSigningCertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CAProviderServer).ActiveLeafSigningCert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.consul.internal.caplugin.CAProvider/ActiveLeafSigningCert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CAProviderServer).ActiveLeafSigningCert(ctx, req.(*ActiveLeafSigningCertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CAProvider_Sign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CAProviderServer).Sign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.consul.internal.caplugin.CAProvider/Sign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CAProviderServer).Sign(ctx, req.(*SignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CAProvider_Cleanup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CleanupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CAProviderServer).Cleanup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.consul.internal.caplugin.CAProvider/Cleanup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CAProviderServer).Cleanup(ctx, req.(*CleanupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CAProvider_GenerateCAChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateCAChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CAProviderServer).GenerateCAChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.consul.internal.caplugin.CAProvider/GenerateCAChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CAProviderServer).GenerateCAChain(ctx, req.(*GenerateCAChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CAProvider_SignIntermediate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignIntermediateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CAProviderServer).SignIntermediate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.consul.internal.caplugin.CAProvider/SignIntermediate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CAProviderServer).SignIntermediate(ctx, req.(*SignIntermediateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CAProvider_CrossSignCA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CrossSignCARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CAProviderServer).CrossSignCA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.consul.internal.caplugin.CAProvider/CrossSignCA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CAProviderServer).CrossSignCA(ctx, req.(*CrossSignCARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CAProvider_SupportsCrossSigning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupportsCrossSigningRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CAProviderServer).SupportsCrossSigning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.consul.internal.caplugin.CAProvider/SupportsCrossSigning",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CAProviderServer).SupportsCrossSigning(ctx, req.(*SupportsCrossSigningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CAProvider_GenerateIntermediateCSR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateIntermediateCSRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CAProviderServer).GenerateIntermediateCSR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.consul.internal.caplugin.CAProvider/GenerateIntermediateCSR",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CAProviderServer).GenerateIntermediateCSR(ctx, req.(*GenerateIntermediateCSRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CAProvider_SetIntermediate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetIntermediateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CAProviderServer).SetIntermediate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.consul.internal.caplugin.CAProvider/SetIntermediate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CAProviderServer).SetIntermediate(ctx, req.(*SetIntermediateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CAProvider_ServiceDesc is the grpc.ServiceDesc for CAProvider service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CAProvider_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hashicorp.consul.internal.caplugin.CAProvider",
+	HandlerType: (*CAProviderServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Configure",
+			Handler:    _CAProvider_Configure_Handler,
+		},
+		{
+			MethodName: "State",
+			Handler:    _CAProvider_State_Handler,
+		},
+		{
+			MethodName: "ActiveLeafSigningCert",
+			Handler:    _CAProvider_ActiveLeafSigningCert_Handler,
+		},
+		{
+			MethodName: "Sign",
+			Handler:    _CAProvider_Sign_Handler,
+		},
+		{
+			MethodName: "Cleanup",
+			Handler:    _CAProvider_Cleanup_Handler,
+		},
+		{
+			MethodName: "GenerateCAChain",
+			Handler:    _CAProvider_GenerateCAChain_Handler,
+		},
+		{
+			MethodName: "SignIntermediate",
+			Handler:    _CAProvider_SignIntermediate_Handler,
+		},
+		{
+			MethodName: "CrossSignCA",
+			Handler:    _CAProvider_CrossSignCA_Handler,
+		},
+		{
+			MethodName: "SupportsCrossSigning",
+			Handler:    _CAProvider_SupportsCrossSigning_Handler,
+		},
+		{
+			MethodName: "GenerateIntermediateCSR",
+			Handler:    _CAProvider_GenerateIntermediateCSR_Handler,
+		},
+		{
+			MethodName: "SetIntermediate",
+			Handler:    _CAProvider_SetIntermediate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "private/pbcaplugin/ca_plugin.proto",
+}
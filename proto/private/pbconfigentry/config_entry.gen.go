@@ -4,6 +4,80 @@ package pbconfigentry
 
 import "github.com/hashicorp/consul/agent/structs"
 
+func ACMECertificateToStructs(s *ACMECertificate, t *structs.ACMECertificateConfigEntry) {
+	if s == nil {
+		return
+	}
+	t.Directory = s.Directory
+	t.DirectoryCACert = s.DirectoryCACert
+	t.Email = s.Email
+	t.Domains = s.Domains
+	t.Challenge = s.Challenge
+	t.RenewBefore = structs.DurationFromProto(s.RenewBefore)
+	t.Certificate = s.Certificate
+	t.PrivateKey = s.PrivateKey
+	t.AccountKey = s.AccountKey
+	{
+		t.Challenges = make([]structs.ACMEChallenge, len(s.Challenges))
+		for i := range s.Challenges {
+			if s.Challenges[i] != nil {
+				ACMEChallengeToStructs(s.Challenges[i], &t.Challenges[i])
+			}
+		}
+	}
+	t.Meta = s.Meta
+	if s.Status != nil {
+		StatusToStructs(s.Status, &t.Status)
+	}
+	t.Hash = s.Hash
+}
+func ACMECertificateFromStructs(t *structs.ACMECertificateConfigEntry, s *ACMECertificate) {
+	if s == nil {
+		return
+	}
+	s.Directory = t.Directory
+	s.DirectoryCACert = t.DirectoryCACert
+	s.Email = t.Email
+	s.Domains = t.Domains
+	s.Challenge = t.Challenge
+	s.RenewBefore = structs.DurationToProto(t.RenewBefore)
+	s.Certificate = t.Certificate
+	s.PrivateKey = t.PrivateKey
+	s.AccountKey = t.AccountKey
+	{
+		s.Challenges = make([]*ACMEChallenge, len(t.Challenges))
+		for i := range t.Challenges {
+			{
+				var x ACMEChallenge
+				ACMEChallengeFromStructs(&t.Challenges[i], &x)
+				s.Challenges[i] = &x
+			}
+		}
+	}
+	s.Meta = t.Meta
+	{
+		var x Status
+		StatusFromStructs(&t.Status, &x)
+		s.Status = &x
+	}
+	s.Hash = t.Hash
+}
+func ACMEChallengeToStructs(s *ACMEChallenge, t *structs.ACMEChallenge) {
+	if s == nil {
+		return
+	}
+	t.Domain = s.Domain
+	t.Token = s.Token
+	t.KeyAuthorization = s.KeyAuthorization
+}
+func ACMEChallengeFromStructs(t *structs.ACMEChallenge, s *ACMEChallenge) {
+	if s == nil {
+		return
+	}
+	s.Domain = t.Domain
+	s.Token = t.Token
+	s.KeyAuthorization = t.KeyAuthorization
+}
 func APIGatewayToStructs(s *APIGateway, t *structs.APIGatewayConfigEntry) {
 	if s == nil {
 		return
@@ -218,6 +292,90 @@ func BoundAPIGatewayListenerFromStructs(t *structs.BoundAPIGatewayListener, s *B
 		}
 	}
 }
+func CanaryRolloutToStructs(s *CanaryRollout, t *structs.CanaryRolloutConfigEntry) {
+	if s == nil {
+		return
+	}
+	t.StableSubset = s.StableSubset
+	t.CanarySubset = s.CanarySubset
+	{
+		t.Steps = make([]structs.CanaryRolloutStep, len(s.Steps))
+		for i := range s.Steps {
+			if s.Steps[i] != nil {
+				CanaryRolloutStepToStructs(s.Steps[i], &t.Steps[i])
+			}
+		}
+	}
+	if s.HealthGate != nil {
+		CanaryRolloutHealthGateToStructs(s.HealthGate, &t.HealthGate)
+	}
+	t.Meta = s.Meta
+	if s.Status != nil {
+		StatusToStructs(s.Status, &t.Status)
+	}
+	t.Hash = s.Hash
+}
+func CanaryRolloutFromStructs(t *structs.CanaryRolloutConfigEntry, s *CanaryRollout) {
+	if s == nil {
+		return
+	}
+	s.StableSubset = t.StableSubset
+	s.CanarySubset = t.CanarySubset
+	{
+		s.Steps = make([]*CanaryRolloutStep, len(t.Steps))
+		for i := range t.Steps {
+			{
+				var x CanaryRolloutStep
+				CanaryRolloutStepFromStructs(&t.Steps[i], &x)
+				s.Steps[i] = &x
+			}
+		}
+	}
+	{
+		var x CanaryRolloutHealthGate
+		CanaryRolloutHealthGateFromStructs(&t.HealthGate, &x)
+		s.HealthGate = &x
+	}
+	s.Meta = t.Meta
+	{
+		var x Status
+		StatusFromStructs(&t.Status, &x)
+		s.Status = &x
+	}
+	s.Hash = t.Hash
+}
+func CanaryRolloutHealthGateToStructs(s *CanaryRolloutHealthGate, t *structs.CanaryRolloutHealthGate) {
+	if s == nil {
+		return
+	}
+	t.MinPassingRatio = s.MinPassingRatio
+	t.MinPassingInstances = int(s.MinPassingInstances)
+	t.GracePeriod = structs.DurationFromProto(s.GracePeriod)
+	t.MaxErrorRatio = s.MaxErrorRatio
+}
+func CanaryRolloutHealthGateFromStructs(t *structs.CanaryRolloutHealthGate, s *CanaryRolloutHealthGate) {
+	if s == nil {
+		return
+	}
+	s.MinPassingRatio = t.MinPassingRatio
+	s.MinPassingInstances = int32(t.MinPassingInstances)
+	s.GracePeriod = structs.DurationToProto(t.GracePeriod)
+	s.MaxErrorRatio = t.MaxErrorRatio
+}
+func CanaryRolloutStepToStructs(s *CanaryRolloutStep, t *structs.CanaryRolloutStep) {
+	if s == nil {
+		return
+	}
+	t.Weight = s.Weight
+	t.Pause = structs.DurationFromProto(s.Pause)
+}
+func CanaryRolloutStepFromStructs(t *structs.CanaryRolloutStep, s *CanaryRolloutStep) {
+	if s == nil {
+		return
+	}
+	s.Weight = t.Weight
+	s.Pause = structs.DurationToProto(t.Pause)
+}
 func ConditionToStructs(s *Condition, t *structs.Condition) {
 	if s == nil {
 		return
@@ -278,6 +436,20 @@ func DestinationConfigFromStructs(t *structs.DestinationConfig, s *DestinationCo
 	s.Addresses = t.Addresses
 	s.Port = int32(t.Port)
 }
+func DirectResponseFilterToStructs(s *DirectResponseFilter, t *structs.DirectResponseFilter) {
+	if s == nil {
+		return
+	}
+	t.Status = s.Status
+	t.Body = s.Body
+}
+func DirectResponseFilterFromStructs(t *structs.DirectResponseFilter, s *DirectResponseFilter) {
+	if s == nil {
+		return
+	}
+	s.Status = t.Status
+	s.Body = t.Body
+}
 func ExportedServicesToStructs(s *ExportedServices, t *structs.ExportedServicesConfigEntry) {
 	if s == nil {
 		return
@@ -426,6 +598,260 @@ func FileSystemCertificateFromStructs(t *structs.FileSystemCertificateConfigEntr
 	s.Meta = t.Meta
 	s.Hash = t.Hash
 }
+func GRPCFiltersToStructs(s *GRPCFilters, t *structs.GRPCFilters) {
+	if s == nil {
+		return
+	}
+	{
+		t.Headers = make([]structs.HTTPHeaderFilter, len(s.Headers))
+		for i := range s.Headers {
+			if s.Headers[i] != nil {
+				HTTPHeaderFilterToStructs(s.Headers[i], &t.Headers[i])
+			}
+		}
+	}
+	if s.RetryFilter != nil {
+		var x structs.RetryFilter
+		RetryFilterToStructs(s.RetryFilter, &x)
+		t.RetryFilter = &x
+	}
+	if s.TimeoutFilter != nil {
+		var x structs.TimeoutFilter
+		TimeoutFilterToStructs(s.TimeoutFilter, &x)
+		t.TimeoutFilter = &x
+	}
+}
+func GRPCFiltersFromStructs(t *structs.GRPCFilters, s *GRPCFilters) {
+	if s == nil {
+		return
+	}
+	{
+		s.Headers = make([]*HTTPHeaderFilter, len(t.Headers))
+		for i := range t.Headers {
+			{
+				var x HTTPHeaderFilter
+				HTTPHeaderFilterFromStructs(&t.Headers[i], &x)
+				s.Headers[i] = &x
+			}
+		}
+	}
+	if t.RetryFilter != nil {
+		var x RetryFilter
+		RetryFilterFromStructs(t.RetryFilter, &x)
+		s.RetryFilter = &x
+	}
+	if t.TimeoutFilter != nil {
+		var x TimeoutFilter
+		TimeoutFilterFromStructs(t.TimeoutFilter, &x)
+		s.TimeoutFilter = &x
+	}
+}
+func GRPCMatchToStructs(s *GRPCMatch, t *structs.GRPCMatch) {
+	if s == nil {
+		return
+	}
+	{
+		t.Headers = make([]structs.HTTPHeaderMatch, len(s.Headers))
+		for i := range s.Headers {
+			if s.Headers[i] != nil {
+				HTTPHeaderMatchToStructs(s.Headers[i], &t.Headers[i])
+			}
+		}
+	}
+	if s.Method != nil {
+		GRPCMethodMatchToStructs(s.Method, &t.Method)
+	}
+}
+func GRPCMatchFromStructs(t *structs.GRPCMatch, s *GRPCMatch) {
+	if s == nil {
+		return
+	}
+	{
+		s.Headers = make([]*HTTPHeaderMatch, len(t.Headers))
+		for i := range t.Headers {
+			{
+				var x HTTPHeaderMatch
+				HTTPHeaderMatchFromStructs(&t.Headers[i], &x)
+				s.Headers[i] = &x
+			}
+		}
+	}
+	{
+		var x GRPCMethodMatch
+		GRPCMethodMatchFromStructs(&t.Method, &x)
+		s.Method = &x
+	}
+}
+func GRPCMethodMatchToStructs(s *GRPCMethodMatch, t *structs.GRPCMethodMatch) {
+	if s == nil {
+		return
+	}
+	t.Match = grpcMethodMatchToStructs(s.Match)
+	t.Service = s.Service
+	t.Method = s.Method
+}
+func GRPCMethodMatchFromStructs(t *structs.GRPCMethodMatch, s *GRPCMethodMatch) {
+	if s == nil {
+		return
+	}
+	s.Match = grpcMethodMatchFromStructs(t.Match)
+	s.Service = t.Service
+	s.Method = t.Method
+}
+func GRPCRouteToStructs(s *GRPCRoute, t *structs.GRPCRouteConfigEntry) {
+	if s == nil {
+		return
+	}
+	{
+		t.Parents = make([]structs.ResourceReference, len(s.Parents))
+		for i := range s.Parents {
+			if s.Parents[i] != nil {
+				ResourceReferenceToStructs(s.Parents[i], &t.Parents[i])
+			}
+		}
+	}
+	{
+		t.Rules = make([]structs.GRPCRouteRule, len(s.Rules))
+		for i := range s.Rules {
+			if s.Rules[i] != nil {
+				GRPCRouteRuleToStructs(s.Rules[i], &t.Rules[i])
+			}
+		}
+	}
+	t.Hostnames = s.Hostnames
+	t.Meta = s.Meta
+	if s.Status != nil {
+		StatusToStructs(s.Status, &t.Status)
+	}
+	t.Hash = s.Hash
+}
+func GRPCRouteFromStructs(t *structs.GRPCRouteConfigEntry, s *GRPCRoute) {
+	if s == nil {
+		return
+	}
+	{
+		s.Parents = make([]*ResourceReference, len(t.Parents))
+		for i := range t.Parents {
+			{
+				var x ResourceReference
+				ResourceReferenceFromStructs(&t.Parents[i], &x)
+				s.Parents[i] = &x
+			}
+		}
+	}
+	{
+		s.Rules = make([]*GRPCRouteRule, len(t.Rules))
+		for i := range t.Rules {
+			{
+				var x GRPCRouteRule
+				GRPCRouteRuleFromStructs(&t.Rules[i], &x)
+				s.Rules[i] = &x
+			}
+		}
+	}
+	s.Hostnames = t.Hostnames
+	s.Meta = t.Meta
+	{
+		var x Status
+		StatusFromStructs(&t.Status, &x)
+		s.Status = &x
+	}
+	s.Hash = t.Hash
+}
+func GRPCRouteRuleToStructs(s *GRPCRouteRule, t *structs.GRPCRouteRule) {
+	if s == nil {
+		return
+	}
+	if s.Filters != nil {
+		GRPCFiltersToStructs(s.Filters, &t.Filters)
+	}
+	if s.ResponseFilters != nil {
+		HTTPResponseFiltersToStructs(s.ResponseFilters, &t.ResponseFilters)
+	}
+	{
+		t.Matches = make([]structs.GRPCMatch, len(s.Matches))
+		for i := range s.Matches {
+			if s.Matches[i] != nil {
+				GRPCMatchToStructs(s.Matches[i], &t.Matches[i])
+			}
+		}
+	}
+	{
+		t.Services = make([]structs.GRPCService, len(s.Services))
+		for i := range s.Services {
+			if s.Services[i] != nil {
+				GRPCServiceToStructs(s.Services[i], &t.Services[i])
+			}
+		}
+	}
+}
+func GRPCRouteRuleFromStructs(t *structs.GRPCRouteRule, s *GRPCRouteRule) {
+	if s == nil {
+		return
+	}
+	{
+		var x GRPCFilters
+		GRPCFiltersFromStructs(&t.Filters, &x)
+		s.Filters = &x
+	}
+	{
+		var x HTTPResponseFilters
+		HTTPResponseFiltersFromStructs(&t.ResponseFilters, &x)
+		s.ResponseFilters = &x
+	}
+	{
+		s.Matches = make([]*GRPCMatch, len(t.Matches))
+		for i := range t.Matches {
+			{
+				var x GRPCMatch
+				GRPCMatchFromStructs(&t.Matches[i], &x)
+				s.Matches[i] = &x
+			}
+		}
+	}
+	{
+		s.Services = make([]*GRPCService, len(t.Services))
+		for i := range t.Services {
+			{
+				var x GRPCService
+				GRPCServiceFromStructs(&t.Services[i], &x)
+				s.Services[i] = &x
+			}
+		}
+	}
+}
+func GRPCServiceToStructs(s *GRPCService, t *structs.GRPCService) {
+	if s == nil {
+		return
+	}
+	t.Name = s.Name
+	t.Weight = int(s.Weight)
+	if s.Filters != nil {
+		GRPCFiltersToStructs(s.Filters, &t.Filters)
+	}
+	if s.ResponseFilters != nil {
+		HTTPResponseFiltersToStructs(s.ResponseFilters, &t.ResponseFilters)
+	}
+	t.EnterpriseMeta = enterpriseMetaToStructs(s.EnterpriseMeta)
+}
+func GRPCServiceFromStructs(t *structs.GRPCService, s *GRPCService) {
+	if s == nil {
+		return
+	}
+	s.Name = t.Name
+	s.Weight = int32(t.Weight)
+	{
+		var x GRPCFilters
+		GRPCFiltersFromStructs(&t.Filters, &x)
+		s.Filters = &x
+	}
+	{
+		var x HTTPResponseFilters
+		HTTPResponseFiltersFromStructs(&t.ResponseFilters, &x)
+		s.ResponseFilters = &x
+	}
+	s.EnterpriseMeta = enterpriseMetaFromStructs(t.EnterpriseMeta)
+}
 func GatewayServiceTLSConfigToStructs(s *GatewayServiceTLSConfig, t *structs.GatewayServiceTLSConfig) {
 	if s == nil {
 		return
@@ -516,6 +942,16 @@ func HTTPFiltersToStructs(s *HTTPFilters, t *structs.HTTPFilters) {
 		t.TimeoutFilter = &x
 	}
 	t.JWT = routeJWTFilterToStructs(s.JWT)
+	if s.RedirectFilter != nil {
+		var x structs.RedirectFilter
+		RedirectFilterToStructs(s.RedirectFilter, &x)
+		t.RedirectFilter = &x
+	}
+	if s.DirectResponseFilter != nil {
+		var x structs.DirectResponseFilter
+		DirectResponseFilterToStructs(s.DirectResponseFilter, &x)
+		t.DirectResponseFilter = &x
+	}
 }
 func HTTPFiltersFromStructs(t *structs.HTTPFilters, s *HTTPFilters) {
 	if s == nil {
@@ -547,6 +983,16 @@ func HTTPFiltersFromStructs(t *structs.HTTPFilters, s *HTTPFilters) {
 		s.TimeoutFilter = &x
 	}
 	s.JWT = routeJWTFilterFromStructs(t.JWT)
+	if t.RedirectFilter != nil {
+		var x RedirectFilter
+		RedirectFilterFromStructs(t.RedirectFilter, &x)
+		s.RedirectFilter = &x
+	}
+	if t.DirectResponseFilter != nil {
+		var x DirectResponseFilter
+		DirectResponseFilterFromStructs(t.DirectResponseFilter, &x)
+		s.DirectResponseFilter = &x
+	}
 }
 func HTTPHeaderFilterToStructs(s *HTTPHeaderFilter, t *structs.HTTPHeaderFilter) {
 	if s == nil {
@@ -844,6 +1290,11 @@ func HTTPServiceToStructs(s *HTTPService, t *structs.HTTPService) {
 	if s.ResponseFilters != nil {
 		HTTPResponseFiltersToStructs(s.ResponseFilters, &t.ResponseFilters)
 	}
+	if s.SessionAffinity != nil {
+		var x structs.SessionAffinity
+		SessionAffinityToStructs(s.SessionAffinity, &x)
+		t.SessionAffinity = &x
+	}
 	t.EnterpriseMeta = enterpriseMetaToStructs(s.EnterpriseMeta)
 }
 func HTTPServiceFromStructs(t *structs.HTTPService, s *HTTPService) {
@@ -862,6 +1313,11 @@ func HTTPServiceFromStructs(t *structs.HTTPService, s *HTTPService) {
 		HTTPResponseFiltersFromStructs(&t.ResponseFilters, &x)
 		s.ResponseFilters = &x
 	}
+	if t.SessionAffinity != nil {
+		var x SessionAffinity
+		SessionAffinityFromStructs(t.SessionAffinity, &x)
+		s.SessionAffinity = &x
+	}
 	s.EnterpriseMeta = enterpriseMetaFromStructs(t.EnterpriseMeta)
 }
 func HashPolicyToStructs(s *HashPolicy, t *structs.HashPolicy) {
@@ -892,6 +1348,20 @@ func HashPolicyFromStructs(t *structs.HashPolicy, s *HashPolicy) {
 	s.SourceIP = t.SourceIP
 	s.Terminal = t.Terminal
 }
+func HedgePolicyToStructs(s *HedgePolicy, t *structs.HedgePolicy) {
+	if s == nil {
+		return
+	}
+	t.InitialRequests = s.InitialRequests
+	t.HedgeOnPerTryTimeout = s.HedgeOnPerTryTimeout
+}
+func HedgePolicyFromStructs(t *structs.HedgePolicy, s *HedgePolicy) {
+	if s == nil {
+		return
+	}
+	s.InitialRequests = t.InitialRequests
+	s.HedgeOnPerTryTimeout = t.HedgeOnPerTryTimeout
+}
 func IngressGatewayToStructs(s *IngressGateway, t *structs.IngressGatewayConfigEntry) {
 	if s == nil {
 		return
@@ -1661,6 +2131,11 @@ func LoadBalancerToStructs(s *LoadBalancer, t *structs.LoadBalancer) {
 			}
 		}
 	}
+	if s.SessionAffinity != nil {
+		var x structs.SessionAffinity
+		SessionAffinityToStructs(s.SessionAffinity, &x)
+		t.SessionAffinity = &x
+	}
 }
 func LoadBalancerFromStructs(t *structs.LoadBalancer, s *LoadBalancer) {
 	if s == nil {
@@ -1687,6 +2162,11 @@ func LoadBalancerFromStructs(t *structs.LoadBalancer, s *LoadBalancer) {
 			}
 		}
 	}
+	if t.SessionAffinity != nil {
+		var x SessionAffinity
+		SessionAffinityFromStructs(t.SessionAffinity, &x)
+		s.SessionAffinity = &x
+	}
 }
 func LocalJWKSToStructs(s *LocalJWKS, t *structs.LocalJWKS) {
 	if s == nil {
@@ -1876,6 +2356,28 @@ func RateLimitsFromStructs(t *structs.RateLimits, s *RateLimits) {
 		s.InstanceLevel = &x
 	}
 }
+func RedirectFilterToStructs(s *RedirectFilter, t *structs.RedirectFilter) {
+	if s == nil {
+		return
+	}
+	t.Scheme = s.Scheme
+	t.Hostname = s.Hostname
+	t.Port = s.Port
+	t.Path = s.Path
+	t.PrefixRewrite = s.PrefixRewrite
+	t.StatusCode = s.StatusCode
+}
+func RedirectFilterFromStructs(t *structs.RedirectFilter, s *RedirectFilter) {
+	if s == nil {
+		return
+	}
+	s.Scheme = t.Scheme
+	s.Hostname = t.Hostname
+	s.Port = t.Port
+	s.Path = t.Path
+	s.PrefixRewrite = t.PrefixRewrite
+	s.StatusCode = t.StatusCode
+}
 func RemoteJWKSToStructs(s *RemoteJWKS, t *structs.RemoteJWKS) {
 	if s == nil {
 		return
@@ -1932,6 +2434,20 @@ func ResourceReferenceFromStructs(t *structs.ResourceReference, s *ResourceRefer
 	s.SectionName = t.SectionName
 	s.EnterpriseMeta = enterpriseMetaFromStructs(t.EnterpriseMeta)
 }
+func RetryBudgetToStructs(s *RetryBudget, t *structs.RetryBudget) {
+	if s == nil {
+		return
+	}
+	t.BudgetPercent = pointerToFloat64FromDouble(s.BudgetPercent)
+	t.MinRetryConcurrency = pointerToIntFromNonZeroInt32(s.MinRetryConcurrency)
+}
+func RetryBudgetFromStructs(t *structs.RetryBudget, s *RetryBudget) {
+	if s == nil {
+		return
+	}
+	s.BudgetPercent = doubleFromPointerToFloat64(t.BudgetPercent)
+	s.MinRetryConcurrency = int32FromPointerToInt(t.MinRetryConcurrency)
+}
 func RetryFilterToStructs(s *RetryFilter, t *structs.RetryFilter) {
 	if s == nil {
 		return
@@ -1940,6 +2456,17 @@ func RetryFilterToStructs(s *RetryFilter, t *structs.RetryFilter) {
 	t.RetryOn = s.RetryOn
 	t.RetryOnStatusCodes = s.RetryOnStatusCodes
 	t.RetryOnConnectFailure = s.RetryOnConnectFailure
+	t.PerTryTimeout = structs.DurationFromProto(s.PerTryTimeout)
+	if s.HedgePolicy != nil {
+		var x structs.HedgePolicy
+		HedgePolicyToStructs(s.HedgePolicy, &x)
+		t.HedgePolicy = &x
+	}
+	if s.RetryBudget != nil {
+		var x structs.RetryBudget
+		RetryBudgetToStructs(s.RetryBudget, &x)
+		t.RetryBudget = &x
+	}
 }
 func RetryFilterFromStructs(t *structs.RetryFilter, s *RetryFilter) {
 	if s == nil {
@@ -1949,6 +2476,17 @@ func RetryFilterFromStructs(t *structs.RetryFilter, s *RetryFilter) {
 	s.RetryOn = t.RetryOn
 	s.RetryOnStatusCodes = t.RetryOnStatusCodes
 	s.RetryOnConnectFailure = t.RetryOnConnectFailure
+	s.PerTryTimeout = structs.DurationToProto(t.PerTryTimeout)
+	if t.HedgePolicy != nil {
+		var x HedgePolicy
+		HedgePolicyFromStructs(t.HedgePolicy, &x)
+		s.HedgePolicy = &x
+	}
+	if t.RetryBudget != nil {
+		var x RetryBudget
+		RetryBudgetFromStructs(t.RetryBudget, &x)
+		s.RetryBudget = &x
+	}
 }
 func RetryPolicyBackOffToStructs(s *RetryPolicyBackOff, t *structs.RetryPolicyBackOff) {
 	if s == nil {
@@ -2204,6 +2742,16 @@ func ServiceResolverToStructs(s *ServiceResolver, t *structs.ServiceResolverConf
 		LoadBalancerToStructs(s.LoadBalancer, &x)
 		t.LoadBalancer = &x
 	}
+	if s.Limits != nil {
+		var x structs.UpstreamLimits
+		UpstreamLimitsToStructs(s.Limits, &x)
+		t.Limits = &x
+	}
+	if s.PassiveHealthCheck != nil {
+		var x structs.PassiveHealthCheck
+		PassiveHealthCheckToStructs(s.PassiveHealthCheck, &x)
+		t.PassiveHealthCheck = &x
+	}
 	t.Meta = s.Meta
 	t.Hash = s.Hash
 }
@@ -2253,6 +2801,16 @@ func ServiceResolverFromStructs(t *structs.ServiceResolverConfigEntry, s *Servic
 		LoadBalancerFromStructs(t.LoadBalancer, &x)
 		s.LoadBalancer = &x
 	}
+	if t.Limits != nil {
+		var x UpstreamLimits
+		UpstreamLimitsFromStructs(t.Limits, &x)
+		s.Limits = &x
+	}
+	if t.PassiveHealthCheck != nil {
+		var x PassiveHealthCheck
+		PassiveHealthCheckFromStructs(t.PassiveHealthCheck, &x)
+		s.PassiveHealthCheck = &x
+	}
 	s.Meta = t.Meta
 	s.Hash = t.Hash
 }
@@ -2390,6 +2948,46 @@ func ServiceResolverSubsetFromStructs(t *structs.ServiceResolverSubset, s *Servi
 	s.Filter = t.Filter
 	s.OnlyPassing = t.OnlyPassing
 }
+func SessionAffinityToStructs(s *SessionAffinity, t *structs.SessionAffinity) {
+	if s == nil {
+		return
+	}
+	t.Type = s.Type
+	t.Name = s.Name
+	if s.CookieConfig != nil {
+		var x structs.SessionAffinityCookieConfig
+		SessionAffinityCookieConfigToStructs(s.CookieConfig, &x)
+		t.CookieConfig = &x
+	}
+	t.Strict = s.Strict
+}
+func SessionAffinityFromStructs(t *structs.SessionAffinity, s *SessionAffinity) {
+	if s == nil {
+		return
+	}
+	s.Type = t.Type
+	s.Name = t.Name
+	if t.CookieConfig != nil {
+		var x SessionAffinityCookieConfig
+		SessionAffinityCookieConfigFromStructs(t.CookieConfig, &x)
+		s.CookieConfig = &x
+	}
+	s.Strict = t.Strict
+}
+func SessionAffinityCookieConfigToStructs(s *SessionAffinityCookieConfig, t *structs.SessionAffinityCookieConfig) {
+	if s == nil {
+		return
+	}
+	t.TTL = structs.DurationFromProto(s.TTL)
+	t.Path = s.Path
+}
+func SessionAffinityCookieConfigFromStructs(t *structs.SessionAffinityCookieConfig, s *SessionAffinityCookieConfig) {
+	if s == nil {
+		return
+	}
+	s.TTL = structs.DurationToProto(t.TTL)
+	s.Path = t.Path
+}
 func SourceIntentionToStructs(s *SourceIntention, t *structs.SourceIntention) {
 	if s == nil {
 		return
@@ -2544,6 +3142,66 @@ func TCPServiceFromStructs(t *structs.TCPService, s *TCPService) {
 	s.Name = t.Name
 	s.EnterpriseMeta = enterpriseMetaFromStructs(t.EnterpriseMeta)
 }
+func TLSRouteToStructs(s *TLSRoute, t *structs.TLSRouteConfigEntry) {
+	if s == nil {
+		return
+	}
+	{
+		t.Parents = make([]structs.ResourceReference, len(s.Parents))
+		for i := range s.Parents {
+			if s.Parents[i] != nil {
+				ResourceReferenceToStructs(s.Parents[i], &t.Parents[i])
+			}
+		}
+	}
+	t.Hostnames = s.Hostnames
+	{
+		t.Services = make([]structs.TCPService, len(s.Services))
+		for i := range s.Services {
+			if s.Services[i] != nil {
+				TCPServiceToStructs(s.Services[i], &t.Services[i])
+			}
+		}
+	}
+	t.Meta = s.Meta
+	if s.Status != nil {
+		StatusToStructs(s.Status, &t.Status)
+	}
+	t.Hash = s.Hash
+}
+func TLSRouteFromStructs(t *structs.TLSRouteConfigEntry, s *TLSRoute) {
+	if s == nil {
+		return
+	}
+	{
+		s.Parents = make([]*ResourceReference, len(t.Parents))
+		for i := range t.Parents {
+			{
+				var x ResourceReference
+				ResourceReferenceFromStructs(&t.Parents[i], &x)
+				s.Parents[i] = &x
+			}
+		}
+	}
+	s.Hostnames = t.Hostnames
+	{
+		s.Services = make([]*TCPService, len(t.Services))
+		for i := range t.Services {
+			{
+				var x TCPService
+				TCPServiceFromStructs(&t.Services[i], &x)
+				s.Services[i] = &x
+			}
+		}
+	}
+	s.Meta = t.Meta
+	{
+		var x Status
+		StatusFromStructs(&t.Status, &x)
+		s.Status = &x
+	}
+	s.Hash = t.Hash
+}
 func TimeoutFilterToStructs(s *TimeoutFilter, t *structs.TimeoutFilter) {
 	if s == nil {
 		return
@@ -2584,6 +3242,62 @@ func TransparentProxyMeshConfigFromStructs(t *structs.TransparentProxyMeshConfig
 	}
 	s.MeshDestinationsOnly = t.MeshDestinationsOnly
 }
+func TrustBundleToStructs(s *TrustBundle, t *structs.TrustBundleConfigEntry) {
+	if s == nil {
+		return
+	}
+	if s.BundleEndpoint != nil {
+		TrustBundleEndpointToStructs(s.BundleEndpoint, &t.BundleEndpoint)
+	}
+	t.RefreshInterval = structs.DurationFromProto(s.RefreshInterval)
+	t.RootPEMs = s.RootPEMs
+	t.Sequence = s.Sequence
+	t.LastRefresh = timeToStructs(s.LastRefresh)
+	t.Meta = s.Meta
+	if s.Status != nil {
+		StatusToStructs(s.Status, &t.Status)
+	}
+	t.Hash = s.Hash
+}
+func TrustBundleFromStructs(t *structs.TrustBundleConfigEntry, s *TrustBundle) {
+	if s == nil {
+		return
+	}
+	{
+		var x TrustBundleEndpoint
+		TrustBundleEndpointFromStructs(&t.BundleEndpoint, &x)
+		s.BundleEndpoint = &x
+	}
+	s.RefreshInterval = structs.DurationToProto(t.RefreshInterval)
+	s.RootPEMs = t.RootPEMs
+	s.Sequence = t.Sequence
+	s.LastRefresh = timeFromStructs(t.LastRefresh)
+	s.Meta = t.Meta
+	{
+		var x Status
+		StatusFromStructs(&t.Status, &x)
+		s.Status = &x
+	}
+	s.Hash = t.Hash
+}
+func TrustBundleEndpointToStructs(s *TrustBundleEndpoint, t *structs.TrustBundleEndpoint) {
+	if s == nil {
+		return
+	}
+	t.URL = s.URL
+	t.Profile = s.Profile
+	t.SpiffeID = s.SpiffeID
+	t.CACert = s.CACert
+}
+func TrustBundleEndpointFromStructs(t *structs.TrustBundleEndpoint, s *TrustBundleEndpoint) {
+	if s == nil {
+		return
+	}
+	s.URL = t.URL
+	s.Profile = t.Profile
+	s.SpiffeID = t.SpiffeID
+	s.CACert = t.CACert
+}
 func URLRewriteToStructs(s *URLRewrite, t *structs.URLRewrite) {
 	if s == nil {
 		return
@@ -2697,6 +3411,11 @@ func UpstreamLimitsToStructs(s *UpstreamLimits, t *structs.UpstreamLimits) {
 	t.MaxConnections = pointerToIntFromInt32(s.MaxConnections)
 	t.MaxPendingRequests = pointerToIntFromInt32(s.MaxPendingRequests)
 	t.MaxConcurrentRequests = pointerToIntFromInt32(s.MaxConcurrentRequests)
+	if s.RetryBudget != nil {
+		var x structs.RetryBudget
+		RetryBudgetToStructs(s.RetryBudget, &x)
+		t.RetryBudget = &x
+	}
 }
 func UpstreamLimitsFromStructs(t *structs.UpstreamLimits, s *UpstreamLimits) {
 	if s == nil {
@@ -2705,4 +3424,9 @@ func UpstreamLimitsFromStructs(t *structs.UpstreamLimits, s *UpstreamLimits) {
 	s.MaxConnections = int32FromPointerToInt(t.MaxConnections)
 	s.MaxPendingRequests = int32FromPointerToInt(t.MaxPendingRequests)
 	s.MaxConcurrentRequests = int32FromPointerToInt(t.MaxConcurrentRequests)
+	if t.RetryBudget != nil {
+		var x RetryBudget
+		RetryBudgetFromStructs(t.RetryBudget, &x)
+		s.RetryBudget = &x
+	}
 }
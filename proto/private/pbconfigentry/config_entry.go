@@ -85,6 +85,46 @@ func ConfigEntryToStructs(s *ConfigEntry) structs.ConfigEntry {
 		pbcommon.RaftIndexToStructs(s.RaftIndex, &target.RaftIndex)
 		pbcommon.EnterpriseMetaToStructs(s.EnterpriseMeta, &target.EnterpriseMeta)
 		return &target
+	case Kind_KindGRPCRoute:
+		var target structs.GRPCRouteConfigEntry
+		target.Name = s.Name
+
+		GRPCRouteToStructs(s.GetGRPCRoute(), &target)
+		pbcommon.RaftIndexToStructs(s.RaftIndex, &target.RaftIndex)
+		pbcommon.EnterpriseMetaToStructs(s.EnterpriseMeta, &target.EnterpriseMeta)
+		return &target
+	case Kind_KindTLSRoute:
+		var target structs.TLSRouteConfigEntry
+		target.Name = s.Name
+
+		TLSRouteToStructs(s.GetTLSRoute(), &target)
+		pbcommon.RaftIndexToStructs(s.RaftIndex, &target.RaftIndex)
+		pbcommon.EnterpriseMetaToStructs(s.EnterpriseMeta, &target.EnterpriseMeta)
+		return &target
+	case Kind_KindCanaryRollout:
+		var target structs.CanaryRolloutConfigEntry
+		target.Name = s.Name
+
+		CanaryRolloutToStructs(s.GetCanaryRollout(), &target)
+		pbcommon.RaftIndexToStructs(s.RaftIndex, &target.RaftIndex)
+		pbcommon.EnterpriseMetaToStructs(s.EnterpriseMeta, &target.EnterpriseMeta)
+		return &target
+	case Kind_KindTrustBundle:
+		var target structs.TrustBundleConfigEntry
+		target.Name = s.Name
+
+		TrustBundleToStructs(s.GetTrustBundle(), &target)
+		pbcommon.RaftIndexToStructs(s.RaftIndex, &target.RaftIndex)
+		pbcommon.EnterpriseMetaToStructs(s.EnterpriseMeta, &target.EnterpriseMeta)
+		return &target
+	case Kind_KindACMECertificate:
+		var target structs.ACMECertificateConfigEntry
+		target.Name = s.Name
+
+		ACMECertificateToStructs(s.GetACMECertificate(), &target)
+		pbcommon.RaftIndexToStructs(s.RaftIndex, &target.RaftIndex)
+		pbcommon.EnterpriseMetaToStructs(s.EnterpriseMeta, &target.EnterpriseMeta)
+		return &target
 	case Kind_KindFileSystemCertificate:
 		var target structs.FileSystemCertificateConfigEntry
 		target.Name = s.Name
@@ -221,6 +261,46 @@ func ConfigEntryFromStructs(s structs.ConfigEntry) *ConfigEntry {
 		configEntry.Entry = &ConfigEntry_HTTPRoute{
 			HTTPRoute: &route,
 		}
+	case *structs.GRPCRouteConfigEntry:
+		var route GRPCRoute
+		GRPCRouteFromStructs(v, &route)
+
+		configEntry.Kind = Kind_KindGRPCRoute
+		configEntry.Entry = &ConfigEntry_GRPCRoute{
+			GRPCRoute: &route,
+		}
+	case *structs.TLSRouteConfigEntry:
+		var route TLSRoute
+		TLSRouteFromStructs(v, &route)
+
+		configEntry.Kind = Kind_KindTLSRoute
+		configEntry.Entry = &ConfigEntry_TLSRoute{
+			TLSRoute: &route,
+		}
+	case *structs.CanaryRolloutConfigEntry:
+		var rollout CanaryRollout
+		CanaryRolloutFromStructs(v, &rollout)
+
+		configEntry.Kind = Kind_KindCanaryRollout
+		configEntry.Entry = &ConfigEntry_CanaryRollout{
+			CanaryRollout: &rollout,
+		}
+	case *structs.TrustBundleConfigEntry:
+		var bundle TrustBundle
+		TrustBundleFromStructs(v, &bundle)
+
+		configEntry.Kind = Kind_KindTrustBundle
+		configEntry.Entry = &ConfigEntry_TrustBundle{
+			TrustBundle: &bundle,
+		}
+	case *structs.ACMECertificateConfigEntry:
+		var cert ACMECertificate
+		ACMECertificateFromStructs(v, &cert)
+
+		configEntry.Kind = Kind_KindACMECertificate
+		configEntry.Entry = &ConfigEntry_ACMECertificate{
+			ACMECertificate: &cert,
+		}
 	case *structs.FileSystemCertificateConfigEntry:
 		var cert FileSystemCertificate
 		FileSystemCertificateFromStructs(v, &cert)
@@ -350,6 +430,29 @@ func int32FromPointerToInt(i *int) int32 {
 	return 0
 }
 
+// pointerToIntFromNonZeroInt32 is like pointerToIntFromInt32 but treats
+// zero as unset, so that Envoy defaults apply.
+func pointerToIntFromNonZeroInt32(i32 int32) *int {
+	if i32 == 0 {
+		return nil
+	}
+	return pointerToIntFromInt32(i32)
+}
+
+func pointerToFloat64FromDouble(d float64) *float64 {
+	if d == 0 {
+		return nil
+	}
+	return &d
+}
+
+func doubleFromPointerToFloat64(f *float64) float64 {
+	if f != nil {
+		return *f
+	}
+	return 0
+}
+
 func pointerToUint32FromUint32(ui32 uint32) *uint32 {
 	i := ui32
 	return &i
@@ -450,6 +553,8 @@ func apiGatewayProtocolFromStructs(a structs.APIGatewayListenerProtocol) APIGate
 		return APIGatewayListenerProtocol_ListenerProtocolHTTP
 	case structs.ListenerProtocolTCP:
 		return APIGatewayListenerProtocol_ListenerProtocolTCP
+	case structs.ListenerProtocolTLS:
+		return APIGatewayListenerProtocol_ListenerProtocolTLS
 	default:
 		return APIGatewayListenerProtocol_ListenerProtocolHTTP
 	}
@@ -461,6 +566,8 @@ func apiGatewayProtocolToStructs(a APIGatewayListenerProtocol) structs.APIGatewa
 		return structs.ListenerProtocolHTTP
 	case APIGatewayListenerProtocol_ListenerProtocolTCP:
 		return structs.ListenerProtocolTCP
+	case APIGatewayListenerProtocol_ListenerProtocolTLS:
+		return structs.ListenerProtocolTLS
 	default:
 		return structs.ListenerProtocolHTTP
 	}
@@ -606,6 +713,28 @@ func httpQueryMatchToStructs(a HTTPQueryMatchType) structs.HTTPQueryMatchType {
 	}
 }
 
+func grpcMethodMatchFromStructs(a structs.GRPCMethodMatchType) GRPCMethodMatchType {
+	switch a {
+	case structs.GRPCMethodMatchExact:
+		return GRPCMethodMatchType_GRPCMethodMatchExact
+	case structs.GRPCMethodMatchRegularExpression:
+		return GRPCMethodMatchType_GRPCMethodMatchRegularExpression
+	default:
+		return GRPCMethodMatchType_GRPCMethodMatchUnspecified
+	}
+}
+
+func grpcMethodMatchToStructs(a GRPCMethodMatchType) structs.GRPCMethodMatchType {
+	switch a {
+	case GRPCMethodMatchType_GRPCMethodMatchExact:
+		return structs.GRPCMethodMatchExact
+	case GRPCMethodMatchType_GRPCMethodMatchRegularExpression:
+		return structs.GRPCMethodMatchRegularExpression
+	default:
+		return ""
+	}
+}
+
 // mog: func-to=serviceRefsToStructs func-from=serviceRefFromStructs
 func serviceRefsToStructs(a map[string]*ListOfResourceReference) structs.ServiceRouteReferences {
 	m := make(structs.ServiceRouteReferences, len(a))
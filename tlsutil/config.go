@@ -981,6 +981,56 @@ func (c *Configurator) AutoEncryptCert() *x509.Certificate {
 	return cert
 }
 
+// CertificateMetadata describes a certificate the agent presents for TLS.
+type CertificateMetadata struct {
+	// Source is where the certificate was configured: the grpc, https or
+	// internal_rpc stanza of the tls configuration, or auto for the
+	// certificate received from auto-encrypt, auto-config or the server
+	// certificate manager.
+	Source string
+
+	Cert *x509.Certificate
+}
+
+// Certificates returns the metadata of the certificates the agent presents
+// for TLS. A certificate shared by several protocols is only returned once.
+//
+// This function acquires a read lock because it reads from the config.
+func (c *Configurator) Certificates() ([]CertificateMetadata, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	sources := []struct {
+		name string
+		cert *tls.Certificate
+	}{
+		{"internal_rpc", c.internalRPC.cert},
+		{"https", c.https.cert},
+		{"grpc", c.grpc.cert},
+		{"auto", c.autoTLS.cert},
+	}
+
+	var certs []CertificateMetadata
+	seen := make(map[string]struct{})
+	for _, source := range sources {
+		if source.cert == nil || len(source.cert.Certificate) == 0 {
+			continue
+		}
+		raw := source.cert.Certificate[0]
+		if _, ok := seen[string(raw)]; ok {
+			continue
+		}
+		seen[string(raw)] = struct{}{}
+
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s certificate: %w", source.name, err)
+		}
+		certs = append(certs, CertificateMetadata{Source: source.name, Cert: cert})
+	}
+	return certs, nil
+}
+
 func (c *Configurator) PeeringServerName() string {
 	c.lock.RLock()
 	defer c.lock.RUnlock()
@@ -1487,6 +1487,31 @@ func TestConfigurator_AutoEncryptCert(t *testing.T) {
 	require.Equal(t, int64(4852545616), c.AutoEncryptCert().NotAfter.Unix())
 }
 
+func TestConfigurator_Certificates(t *testing.T) {
+	c := Configurator{base: &Config{}}
+	certs, err := c.Certificates()
+	require.NoError(t, err)
+	require.Empty(t, certs)
+
+	expired, err := loadKeyPair("../test/key/something_expired.cer", "../test/key/something_expired.key")
+	require.NoError(t, err)
+	ourdomain, err := loadKeyPair("../test/key/ourdomain.cer", "../test/key/ourdomain.key")
+	require.NoError(t, err)
+
+	// Certificates shared by several protocols are only returned once.
+	c.internalRPC.cert = ourdomain
+	c.https.cert = ourdomain
+	c.autoTLS.cert = expired
+
+	certs, err = c.Certificates()
+	require.NoError(t, err)
+	require.Len(t, certs, 2)
+	require.Equal(t, "internal_rpc", certs[0].Source)
+	require.Equal(t, int64(4852545616), certs[0].Cert.NotAfter.Unix())
+	require.Equal(t, "auto", certs[1].Source)
+	require.Equal(t, int64(1561561551), certs[1].Cert.NotAfter.Unix())
+}
+
 func TestConfigurator_AuthorizeInternalRPCServerConn(t *testing.T) {
 	caPEM, caPK, err := GenerateCA(CAOpts{Days: 5, Domain: "consul"})
 	require.NoError(t, err)